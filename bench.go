@@ -0,0 +1,80 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegfried
+
+import (
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// Timing is the time spent in a matcher, or in filling buffers, during identifications made with the config.SetBench option.
+type Timing struct {
+	Name     string // the matcher (e.g. "byte"), or "fill" for the time spent reading files and streams into buffers
+	Count    int64  // the number of identifications the matcher ran for
+	Duration time.Duration
+}
+
+// matcher names, indexed by core.MatcherType
+var matcherNames = [...]string{"name", "mime", "container", "byte", "text", "xml", "riff", "mpeg", "ebml", "bmff", "struct", "data", "external"}
+
+// matchers in the order that identify runs them
+var matcherOrder = [...]core.MatcherType{core.NameMatcher, core.MIMEMatcher, core.ContainerMatcher, core.XMLMatcher, core.RIFFMatcher,
+	core.EBMLMatcher, core.BMFFMatcher, core.ByteMatcher, core.DataMatcher, core.MPEGMatcher, core.StructMatcher, core.TextMatcher, core.ExternalMatcher}
+
+type timings struct {
+	mu     sync.Mutex
+	counts [len(matcherNames)]int64
+	durs   [len(matcherNames)]time.Duration
+}
+
+// clock returns the time to start timing a matcher from, or a zero time if the config.SetBench option isn't set
+func clock() time.Time {
+	if config.Bench() {
+		return time.Now()
+	}
+	return time.Time{}
+}
+
+func (t *timings) add(mt core.MatcherType, start time.Time) {
+	if t == nil || start.IsZero() || int(mt) >= len(matcherNames) {
+		return
+	}
+	d := time.Since(start)
+	t.mu.Lock()
+	t.counts[mt]++
+	t.durs[mt] += d
+	t.mu.Unlock()
+}
+
+// Timings reports, for identifications made with the config.SetBench option, the time spent in each matcher that ran and the time spent filling buffers.
+// Matcher times include the time the matchers spent waiting on reads. Buffer fills are timed for all Siegfrieds in the process.
+func (s *Siegfried) Timings() []Timing {
+	if s.timings == nil {
+		return []Timing{{Name: "fill", Duration: siegreader.FillTime()}}
+	}
+	s.timings.mu.Lock()
+	defer s.timings.mu.Unlock()
+	ret := make([]Timing, 0, len(matcherOrder)+1)
+	for _, mt := range matcherOrder {
+		if s.timings.counts[mt] > 0 {
+			ret = append(ret, Timing{matcherNames[mt], s.timings.counts[mt], s.timings.durs[mt]})
+		}
+	}
+	return append(ret, Timing{Name: "fill", Duration: siegreader.FillTime()})
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// bundleManifest is the name of the manifest at the root of a bundle
+const bundleManifest = "manifest.json"
+
+// bundle sources (other than the DROID and container signature files and the PRONOM reports) included if found in the home directory
+var bundleSources = []string{"tika-mimetypes.xml", "freedesktop.org.xml", "mime-info.json", "fddXML.zip", "release-notes.xml"}
+
+type manifest struct {
+	Created time.Time         `json:"created"`
+	Files   map[string]string `json:"files"` // slash separated paths, relative to the home directory, and their SHA256 hashes
+}
+
+// makeBundle writes a gzipped tarball of the sources roy builds signature files from: the DROID and container signature files and
+// PRONOM reports, tika and freedesktop.org MIMEInfo files, the LOC FDD file and any signature extensions in the custom directory;
+// with a manifest of their hashes.
+func makeBundle(out string) error {
+	var files []string
+	for _, p := range []string{config.Droid(), config.Container()} {
+		if p == "" {
+			return fmt.Errorf("roy: no DROID or container signature file in %s", config.Home())
+		}
+		files = append(files, p)
+	}
+	for _, dir := range []string{config.Reports(), config.Local("custom")} {
+		if fis, err := ioutil.ReadDir(dir); err == nil {
+			for _, fi := range fis {
+				if fi.Mode().IsRegular() {
+					files = append(files, filepath.Join(dir, fi.Name()))
+				}
+			}
+		}
+	}
+	for _, s := range bundleSources {
+		if _, err := os.Stat(config.Local(s)); err == nil {
+			files = append(files, config.Local(s))
+		}
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	m := manifest{Created: time.Now().UTC(), Files: make(map[string]string)}
+	for _, p := range files {
+		name := filepath.Base(p)
+		if rel, err := filepath.Rel(config.Home(), p); err == nil && !strings.HasPrefix(rel, "..") {
+			name = filepath.ToSlash(rel)
+		}
+		hash, err := addToBundle(tw, p, name)
+		if err != nil {
+			return err
+		}
+		m.Files[name] = hash
+	}
+	byts, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: bundleManifest, Mode: 0644, Size: int64(len(byts)), ModTime: m.Created}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(byts); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func addToBundle(tw *tar.Writer, p, name string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: fi.Size(), ModTime: fi.ModTime()}); err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tw, h), f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// openBundle extracts a bundle made by makeBundle to a temporary directory, verifying its contents against the manifest,
+// and makes that directory the home directory. The signature file is still saved to the original home directory.
+// It returns the temporary directory, to be removed once the build is done.
+func openBundle(bundle string) (string, error) {
+	f, err := os.Open(bundle)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", fmt.Errorf("roy: %s is not a bundle: %v", bundle, err)
+	}
+	dir, err := ioutil.TempDir("", "roy-bundle")
+	if err != nil {
+		return "", err
+	}
+	fail := func(err error) (string, error) {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	hashes := make(map[string]string)
+	var m manifest
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+		name := path.Clean(hdr.Name)
+		if name == bundleManifest {
+			if err := json.NewDecoder(tr).Decode(&m); err != nil {
+				return fail(fmt.Errorf("roy: bad manifest in %s: %v", bundle, err))
+			}
+			continue
+		}
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fail(fmt.Errorf("roy: bad path in %s: %s", bundle, hdr.Name))
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return fail(err)
+		}
+		out, err := os.Create(target)
+		if err != nil {
+			return fail(err)
+		}
+		h := sha256.New()
+		_, err = io.Copy(io.MultiWriter(out, h), tr)
+		out.Close()
+		if err != nil {
+			return fail(err)
+		}
+		hashes[name] = hex.EncodeToString(h.Sum(nil))
+	}
+	if m.Files == nil {
+		return fail(fmt.Errorf("roy: no manifest in %s", bundle))
+	}
+	names := make([]string, 0, len(m.Files))
+	for name := range m.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if hashes[name] != m.Files[name] {
+			return fail(fmt.Errorf("roy: %s in %s is missing or doesn't match its SHA256 hash in the manifest", name, bundle))
+		}
+		delete(hashes, name)
+	}
+	for name := range hashes {
+		return fail(fmt.Errorf("roy: %s in %s isn't listed in the manifest", name, bundle))
+	}
+	config.SetSignature(config.Signature())
+	config.SetHome(dir)
+	return dir, nil
+}
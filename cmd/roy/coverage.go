@@ -0,0 +1,167 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/pkg/core"
+	"github.com/richardlehane/siegfried/pkg/reader"
+)
+
+// extOnly reports whether a warning says a format matched on its extension or filename without a content signature
+// e.g. "match on extension only" or "match on filename and MIME only".
+func extOnly(warn string) bool {
+	for _, w := range strings.Split(warn, "; ") {
+		if strings.HasPrefix(w, "match on ") && (strings.Contains(w, "extension") || strings.Contains(w, "filename")) {
+			return true
+		}
+	}
+	return false
+}
+
+// coverage tallies the formats identified in scan results, per namespace
+type coverage struct {
+	files    map[string]int               // files with a result in each namespace
+	hits     map[string]map[string]int    // files identified as each format
+	extHits  map[string]map[string]int    // files identified as each format on extension alone
+	together map[string]map[[2]string]int // files identified as both formats in a pair (sorted)
+}
+
+func newCoverage() *coverage {
+	return &coverage{
+		files:    make(map[string]int),
+		hits:     make(map[string]map[string]int),
+		extHits:  make(map[string]map[string]int),
+		together: make(map[string]map[[2]string]int),
+	}
+}
+
+// add tallies the identifications of a file. The namespace of each is the first of its values.
+func (c *coverage) add(ids []core.Identification) {
+	known := make(map[string][]string)
+	for _, id := range ids {
+		vals := id.Values()
+		if len(vals) == 0 {
+			continue
+		}
+		ns := vals[0]
+		if _, ok := c.hits[ns]; !ok {
+			c.hits[ns], c.extHits[ns], c.together[ns] = make(map[string]int), make(map[string]int), make(map[[2]string]int)
+		}
+		if _, ok := known[ns]; !ok {
+			c.files[ns]++
+			known[ns] = nil
+		}
+		if !id.Known() {
+			continue
+		}
+		c.hits[ns][id.String()]++
+		if extOnly(id.Warn()) {
+			c.extHits[ns][id.String()]++
+		}
+		known[ns] = append(known[ns], id.String())
+	}
+	for ns, fids := range known {
+		sort.Strings(fids)
+		for i, a := range fids {
+			for _, b := range fids[i+1:] {
+				if a != b {
+					c.together[ns][[2]string{a, b}]++
+				}
+			}
+		}
+	}
+}
+
+// addResults tallies the identifications in a results file (any results file that roy compare reads).
+func (c *coverage) addResults(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	rdr, err := reader.New(f, path)
+	if err != nil {
+		return fmt.Errorf("roy: can't read results %s: %v", path, err)
+	}
+	for fi, e := rdr.Next(); e == nil; fi, e = rdr.Next() {
+		c.add(fi.IDs)
+	}
+	return nil
+}
+
+// addCorpus identifies the files in a corpus directory and tallies their identifications.
+func (c *coverage) addCorpus(s *siegfried.Siegfried, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		ids, _ := s.Identify(f, path, "")
+		f.Close()
+		c.add(ids)
+		return nil
+	})
+}
+
+// report lists, for each identifier in the signature file, the formats with content signatures that never matched, the formats that only
+// ever matched on extension, and the pairs of formats that always matched together (i.e. whenever either is reported the other is too).
+func (c *coverage) report(s *siegfried.Siegfried) string {
+	buf := &bytes.Buffer{}
+	signed := s.Signed()
+	for _, i := range s.Identifiers() {
+		ns := i[0]
+		var never, ext, cofire []string
+		for _, id := range signed[ns] {
+			if c.hits[ns][id] == 0 {
+				never = append(never, id)
+			}
+		}
+		for id, n := range c.hits[ns] {
+			if c.extHits[ns][id] == n {
+				ext = append(ext, id)
+			}
+		}
+		sort.Strings(ext)
+		for pair, n := range c.together[ns] {
+			if c.hits[ns][pair[0]] == n && c.hits[ns][pair[1]] == n {
+				cofire = append(cofire, pair[0]+" & "+pair[1])
+			}
+		}
+		sort.Strings(cofire)
+		fmt.Fprint(buf, "---\n")
+		for _, f := range [][2]string{
+			{"namespace", ns},
+			{"files", fmt.Sprintf("%d", c.files[ns])},
+			{"formats", fmt.Sprintf("%d identified; %d of %d with signatures never matched", len(c.hits[ns]), len(never), len(signed[ns]))},
+			{"never matched", strings.Join(never, ", ")},
+			{"extension only", strings.Join(ext, ", ")},
+			{"always together", strings.Join(cofire, ", ")},
+		} {
+			fmt.Fprintf(buf, "%-15s : %s\n", f[0], f[1])
+		}
+	}
+	return buf.String()
+}
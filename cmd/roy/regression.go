@@ -0,0 +1,142 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/pkg/core"
+	"github.com/richardlehane/siegfried/pkg/reader"
+)
+
+// expectation is an entry in an expected results file
+type expectation struct {
+	path string // slash separated
+	ids  string
+	seen bool
+}
+
+func idsString(ids []core.Identification) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	sort.Strings(strs)
+	return strings.Join(strs, ";")
+}
+
+// loadExpected reads an expected results file (any results file that roy compare reads e.g. sf YAML, CSV or JSON output, or DROID CSV).
+// Entries are indexed by the base of their paths.
+func loadExpected(path string) (map[string][]*expectation, []*expectation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	rdr, err := reader.New(f, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("roy: can't read expected results %s: %v", path, err)
+	}
+	idx := make(map[string][]*expectation)
+	var all []*expectation
+	for fi, e := rdr.Next(); e == nil; fi, e = rdr.Next() {
+		exp := &expectation{path: strings.Replace(fi.Path, "\\", "/", -1), ids: idsString(fi.IDs)}
+		base := reader.Base(exp.path)
+		idx[base], all = append(idx[base], exp), append(all, exp)
+	}
+	return idx, all, nil
+}
+
+// match finds the expected results for a file, given its slash separated path relative to the corpus directory.
+// Expected results can be for a corpus scanned from anywhere, so any path that ends with the relative path matches.
+func match(idx map[string][]*expectation, rel string) *expectation {
+	for _, exp := range idx[reader.Base(rel)] {
+		if !exp.seen && (exp.path == rel || strings.HasSuffix(exp.path, "/"+rel)) {
+			return exp
+		}
+	}
+	return nil
+}
+
+// regression identifies each file in a reference corpus and compares the results with an expected results file.
+// Failures are written to w as CSV (path, expected IDs, actual IDs), followed by a summary. Files in the expected results but
+// not in the corpus are reported as MISSING. Returns an error if any file fails or is missing.
+func regression(w io.Writer, s *siegfried.Siegfried, corpus, expected string) error {
+	idx, all, err := loadExpected(expected)
+	if err != nil {
+		return err
+	}
+	wrt := csv.NewWriter(w)
+	var tested, failed, missing, untested int
+	err = filepath.Walk(corpus, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(corpus, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		exp := match(idx, rel)
+		if exp == nil {
+			untested++
+			return nil
+		}
+		exp.seen = true
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		ids, err := s.Identify(f, path, "")
+		f.Close()
+		got := idsString(ids)
+		if len(ids) == 0 && err != nil {
+			got = "ERROR: " + err.Error()
+		}
+		tested++
+		if got != exp.ids {
+			failed++
+			return wrt.Write([]string{rel, exp.ids, got})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, exp := range all {
+		if !exp.seen {
+			missing++
+			if err := wrt.Write([]string{exp.path, exp.ids, "MISSING"}); err != nil {
+				return err
+			}
+		}
+	}
+	wrt.Flush()
+	fmt.Fprintf(w, "tested %d files: %d passed, %d failed; %d missing from the corpus; %d not in the expected results\n", tested, tested-failed, failed, missing, untested)
+	if failed > 0 || missing > 0 {
+		return fmt.Errorf("roy: regression test failed (%d failed, %d missing)", failed, missing)
+	}
+	return nil
+}
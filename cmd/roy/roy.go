@@ -24,9 +24,11 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/richardlehane/siegfried"
 	"github.com/richardlehane/siegfried/internal/chart"
+	"github.com/richardlehane/siegfried/internal/signing"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
 	"github.com/richardlehane/siegfried/pkg/loc"
@@ -44,9 +46,30 @@ Usage:
    roy build -help
    roy add -help
    roy harvest -help
+   roy bundle -help
+   roy update-data -help
    roy inspect -help
    roy sets -help
    roy compare -help
+   roy test -help
+   roy coverage -help
+`
+
+var harvestUsage = `
+Usage of harvest:
+   roy harvest
+      Harvest the PRONOM reports for the formats in the DROID signature file.
+   roy harvest -changes
+      Harvest the latest PRONOM release-notes.xml file.
+   roy harvest -wikidata
+      Harvest a static Wikidata report.
+   roy harvest loc
+      Harvest the LOC format descriptions from loc.gov and package them in
+      the fddXML.zip file (or the file named with -fdd) used by roy build -loc.
+      Give flags before loc, e.g. roy harvest -throttle 500ms loc
+      Requests are limited with the -throttle, -timeout and -retries flags.
+
+Flags:
 `
 
 var inspectUsage = `
@@ -59,7 +82,9 @@ Usage of inspect:
       Inspect  contents of a matcher e.g. roy inspect bytematcher.
       Short aliases work too e.g. roy inspect bm
       Current matchers are bytematcher (or bm), containermatcher (cm),
-      xmlmatcher (xm), riffmatcher (rm), namematcher (nm), textmatcher (tm).
+      xmlmatcher (xm), riffmatcher (rm), mpegmatcher (am), ebmlmatcher (em),
+      bmffmatcher (fm), structmatcher (sm), datamatcher (dm), namematcher (nm),
+      textmatcher (tm).
    roy inspect INTEGER
       Identify the signatures related to the numerical hits reported by the
       sf debug and slow flags (sf -log d,s). E.g. roy inspect 100
@@ -145,26 +170,56 @@ var (
 	nomime        = build.Bool("nomime", false, "skip MIME matcher")
 	noxml         = build.Bool("noxml", false, "skip XML matcher")
 	noriff        = build.Bool("noriff", false, "skip RIFF matcher")
+	nompeg        = build.Bool("nompeg", false, "skip MPEG audio matcher")
+	noebml        = build.Bool("noebml", false, "skip EBML matcher")
+	nobmff        = build.Bool("nobmff", false, "skip BMFF (ISO base media ftyp brand) matcher")
+	nostruct      = build.Bool("nostruct", false, "skip structured text (JSON, YAML, CSV and TSV) matcher")
+	nodata        = build.Bool("nodata", false, "skip scientific data (SQLite, HDF5 and netCDF) matcher")
+	compact       = build.Bool("compact", false, "omit format names, versions and descriptions (results report IDs only)")
+	linkdups      = build.Bool("linkdups", false, "give priority to the later of identical byte signatures mapped to different IDs")
 	noreports     = build.Bool("noreports", false, "build directly from DROID file rather than PRONOM reports")
 	doubleup      = build.Bool("doubleup", false, "include byte signatures for formats that also have container signatures")
-	rng           = build.Int("range", config.Range(), "define a maximum range for segmentation")
-	distance      = build.Int("distance", config.Distance(), "define a maximum distance for segmentation")
-	choices       = build.Int("choices", config.Choices(), "define a maximum number of choices for segmentation")
+	rng           = build.Int("range", config.Range(), "define a maximum range for segmentation (-1 for no maximum); larger ranges give bigger search trees but fewer follow-up tests")
+	distance      = build.Int("distance", config.Distance(), "define a maximum distance for segmentation (-1 for no maximum); larger distances give bigger search trees but fewer follow-up tests")
+	choices       = build.Int("choices", config.Choices(), "define a maximum number of choices for segmentation; more choices give bigger search trees but fewer follow-up tests")
 	cost          = build.Int("cost", config.Cost(), "define a maximum tolerable cost in the worst case for segmentation (overrides distance/range/choices)")
+	tune          = build.Bool("tune", false, "after building, report the size of the Aho-Corasick automata in dense and sparse (sf -lowmem) layouts, with the segmentation settings used")
 	repetition    = build.Int("repetition", config.Repetition(), "define a maximum tolerable repetition in a segment, used in combination with cost to determine segmentation")
+	reproducible  = build.Bool("reproducible", false, "record the date of the source data (or $SOURCE_DATE_EPOCH) rather than the build time, so that identical inputs build identical signature files")
+	signf         = build.String("sign", "", "sign the signature file with this ed25519 private key (a PEM file), writing the signature alongside it e.g. default.sig.ed25519 (see sf -verify)")
+	bundlef       = build.String("bundle", "", "build from the sources in a bundle made by roy bundle e.g. bundle.tgz, rather than the home directory")
 
 	// HARVEST
 	harvest                 = flag.NewFlagSet("harvest", flag.ExitOnError)
 	harvestHome             = harvest.String("home", config.Home(), "override the default home directory")
 	harvestDroid            = harvest.String("droid", config.Droid(), "set name/path for DROID signature file")
 	harvestChanges          = harvest.Bool("changes", false, "harvest the latest PRONOM release-notes.xml file")
+	harvestFDD              = harvest.String("fdd", "", "set name/path for the LOC FDD zip file written by roy harvest loc (default fddXML.zip)")
 	_, htimeout, _, _       = config.HarvestOptions()
 	timeout                 = harvest.Duration("timeout", htimeout, "set duration before timing-out harvesting requests e.g. 120s")
 	throttlef               = harvest.Duration("throttle", 0, "set a time to wait HTTP requests e.g. 50ms")
+	retries                 = harvest.Int("retries", config.HarvestRetries(), "set the number of times to retry failed requests (waiting 1s, then 2s, 4s etc.)")
+	resume                  = harvest.Bool("resume", false, "resume an interrupted harvest, skipping reports already saved")
+	noprogress              = harvest.Bool("noprogress", false, "don't report progress while harvesting reports")
+	nocache                 = harvest.Bool("nocache", false, "fetch every report in full, rather than only those changed since the last harvest")
 	harvestWikidataSig      = harvest.Bool("wikidata", false, "harvest a static Wikidata report")
 	harvestWikidataLang     = harvest.String("lang", config.WikidataLang(), "two-letter language-code to download Wikidata strings, e.g. \"de\"")
 	harvestWikidataEndpoint = harvest.String("wikidataendpoint", config.WikidataEndpoint(), "the endpoint to use to harvest Wikidata definitions from")
 
+	// BUNDLE
+	bundle          = flag.NewFlagSet("bundle", flag.ExitOnError)
+	bundleHome      = bundle.String("home", config.Home(), "override the default home directory")
+	bundleDroid     = bundle.String("droid", config.Droid(), "set name/path for DROID signature file")
+	bundleContainer = bundle.String("container", config.Container(), "set name/path for Droid Container signature file")
+
+	// UPDATE-DATA
+	updatef        = flag.NewFlagSet("update-data", flag.ExitOnError)
+	updateHome     = updatef.String("home", config.Home(), "override the default home directory")
+	updateReports  = updatef.Bool("reports", true, "harvest the PRONOM reports of new and changed PUIDs")
+	updateTimeout  = updatef.Duration("timeout", htimeout, "set duration before timing-out requests e.g. 120s")
+	updateThrottle = updatef.Duration("throttle", 0, "set a time to wait HTTP requests e.g. 50ms")
+	updateRetries  = updatef.Int("retries", config.HarvestRetries(), "set the number of times to retry failed requests (waiting 1s, then 2s, 4s etc.)")
+
 	// INSPECT (roy inspect | roy inspect fmt/121 | roy inspect usr/local/mysig.sig | roy inspect 10)
 	inspect         = flag.NewFlagSet("inspect", flag.ExitOnError)
 	inspectHome     = inspect.String("home", config.Home(), "override the default home directory")
@@ -190,6 +245,17 @@ var (
 	// COMPARE
 	comparef    = flag.NewFlagSet("compare", flag.ExitOnError)
 	compareJoin = comparef.Int("join", 0, "control which field(s) are used to link results files. Default is 0 (full file path). Other options are 1 (filename), 2, (filename + size), 3 (filename + modified), 4 (filename + hash), 5 (hash)")
+
+	// TEST (roy test -corpus skeleton-suite -expected expected.yaml | roy test -corpus DIR -expected expected.csv mysig.sig)
+	testf        = flag.NewFlagSet("test", flag.ExitOnError)
+	testHome     = testf.String("home", config.Home(), "override the default home directory")
+	testCorpus   = testf.String("corpus", "", "directory of reference files to identify")
+	testExpected = testf.String("expected", "", "results file (e.g. sf YAML, CSV or JSON output, or DROID CSV) with the expected identifications of the corpus files")
+
+	// COVERAGE (roy coverage results.yaml | roy coverage -sig mysig.sig DIR results.csv)
+	coveragef    = flag.NewFlagSet("coverage", flag.ExitOnError)
+	coverageHome = coveragef.String("home", config.Home(), "override the default home directory")
+	coverageSig  = coveragef.String("sig", config.SignatureBase(), "set the signature file whose formats are reported (and that corpus directories are scanned with)")
 )
 
 func savereps() error {
@@ -208,6 +274,24 @@ func savereps() error {
 	return nil
 }
 
+// harvestLOC packages the current LOC format descriptions in the zip read by roy build -loc
+func harvestLOC() error {
+	config.SetLOC(*harvestFDD)()
+	if !*noprogress {
+		config.SetHarvestProgress(func(done, total int) {
+			fmt.Fprintf(os.Stderr, "\rroy: harvested %d of %d format descriptions", done, total)
+			if done == total {
+				fmt.Fprintln(os.Stderr)
+			}
+		})
+	}
+	errs := loc.Harvest(config.LOC())
+	if len(errs) > 0 {
+		return fmt.Errorf("roy: errors harvesting LOC format descriptions %s", errs)
+	}
+	return nil
+}
+
 func makegob(s *siegfried.Siegfried, opts []config.Option) error {
 	var id core.Identifier
 	var err error
@@ -231,7 +315,21 @@ func makegob(s *siegfried.Siegfried, opts []config.Option) error {
 	} else {
 		log.Println("Identifier returned nil, not adding to a Siegfried")
 	}
-	return s.Save(config.Signature())
+	if err := s.Save(config.Signature()); err != nil {
+		return err
+	}
+	if *signf != "" {
+		return signing.SignFile(config.Signature(), *signf)
+	}
+	return nil
+}
+
+// report automata sizes to help choose segmentation settings and the sf -lowmem option
+func tuneReport(s *siegfried.Siegfried) string {
+	return fmt.Sprintf("Segmentation: distance %d; range %d; choices %d; cost %d; repetition %d\n%s"+
+		"The dense layout is used by default: use sf -lowmem for the sparse layout if memory is scarce.\n"+
+		"Shorter distances, ranges and choices give smaller automata but more follow-up tests.\n",
+		config.Distance(), config.Range(), config.Choices(), config.Cost(), config.Repetition(), s.Tune())
 }
 
 func inspectSig(t core.MatcherType) error {
@@ -408,6 +506,27 @@ the DROID signature file you should also include a regular signature extension
 	if *noriff {
 		opts = append(opts, config.SetNoRIFF())
 	}
+	if *nompeg {
+		opts = append(opts, config.SetNoMPEG())
+	}
+	if *noebml {
+		opts = append(opts, config.SetNoEBML())
+	}
+	if *nobmff {
+		opts = append(opts, config.SetNoBMFF())
+	}
+	if *nostruct {
+		opts = append(opts, config.SetNoStruct())
+	}
+	if *nodata {
+		opts = append(opts, config.SetNoData())
+	}
+	if *compact {
+		opts = append(opts, config.SetCompact())
+	}
+	if *linkdups {
+		opts = append(opts, config.SetLinkDuplicates())
+	}
 	if *noreports {
 		opts = append(opts, config.SetNoReports())
 	}
@@ -482,6 +601,23 @@ func setHarvestOptions() {
 	if *throttlef > 0 {
 		config.SetHarvestThrottle(*throttlef)
 	}
+	if *retries != config.HarvestRetries() {
+		config.SetHarvestRetries(*retries)
+	}
+	if *resume {
+		config.SetHarvestResume(true)
+	}
+	if *nocache {
+		config.SetHarvestNoCache(true)
+	}
+	if !*noprogress {
+		config.SetHarvestProgress(func(done, total int) {
+			fmt.Fprintf(os.Stderr, "\rroy: harvested %d of %d reports", done, total)
+			if done == total {
+				fmt.Fprintln(os.Stderr)
+			}
+		})
+	}
 	if *harvestWikidataLang != "" {
 		config.SetWikidataLang(*harvestWikidataLang)
 	}
@@ -502,6 +638,72 @@ func setHarvestOptions() {
 	}
 }
 
+func setBundleOptions() {
+	if *bundleDroid != config.Droid() {
+		config.SetDroid(*bundleDroid)()
+	}
+	if *bundleContainer != config.Container() {
+		config.SetContainer(*bundleContainer)()
+	}
+	if *bundleHome != config.Home() {
+		config.SetHome(*bundleHome)
+	}
+}
+
+// buildOptions returns the build options and, if building from a bundle, opens it.
+// The returned function removes the opened bundle.
+func buildOptions() ([]config.Option, func(), error) {
+	opts := getOptions()
+	done := func() {}
+	if *bundlef != "" {
+		dir, err := openBundle(*bundlef)
+		if err != nil {
+			return nil, done, err
+		}
+		done = func() { os.RemoveAll(dir) }
+	}
+	if *reproducible {
+		t, err := sourceDate()
+		if err != nil {
+			done()
+			return nil, func() {}, err
+		}
+		config.SetBuildTime(t)
+	}
+	return opts, done, nil
+}
+
+// sourceDate returns the time to record in a reproducible build: $SOURCE_DATE_EPOCH if set, otherwise the date of the DROID signature file.
+func sourceDate() (time.Time, error) {
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		secs, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("roy: bad SOURCE_DATE_EPOCH %q", epoch)
+		}
+		return time.Unix(secs, 0).UTC(), nil
+	}
+	t, err := pronom.SourceDate()
+	if err != nil {
+		return t, fmt.Errorf("roy: can't date the source data for a reproducible build (set SOURCE_DATE_EPOCH instead): %v", err)
+	}
+	return t, nil
+}
+
+func setUpdateOptions() {
+	if *updateHome != config.Home() {
+		config.SetHome(*updateHome)
+	}
+	if *updateTimeout != htimeout {
+		config.SetHarvestTimeout(*updateTimeout)
+	}
+	if *updateThrottle > 0 {
+		config.SetHarvestThrottle(*updateThrottle)
+	}
+	if *updateRetries != config.HarvestRetries() {
+		config.SetHarvestRetries(*updateRetries)
+	}
+}
+
 func setSetsOptions() {
 	if *setsDroid != config.Droid() {
 		config.SetDroid(*setsDroid)()
@@ -524,8 +726,17 @@ func main() {
 			if build.Arg(0) != "" {
 				config.SetSignature(build.Arg(0))
 			}
+			var opts []config.Option
+			var done func()
+			opts, done, err = buildOptions()
 			s := siegfried.New()
-			err = makegob(s, getOptions())
+			if err == nil {
+				err = makegob(s, opts)
+				done()
+			}
+			if err == nil && *tune {
+				fmt.Print(tuneReport(s))
+			}
 		}
 	case "add":
 		err = build.Parse(os.Args[2:])
@@ -536,14 +747,29 @@ func main() {
 			var s *siegfried.Siegfried
 			s, err = siegfried.Load(config.Signature())
 			if err == nil {
-				err = makegob(s, getOptions())
+				var opts []config.Option
+				var done func()
+				opts, done, err = buildOptions()
+				if err == nil {
+					err = makegob(s, opts)
+					done()
+				}
+			}
+			if err == nil && *tune {
+				fmt.Print(tuneReport(s))
 			}
 		}
 	case "harvest":
+		harvest.Usage = func() {
+			fmt.Print(harvestUsage)
+			harvest.PrintDefaults()
+		}
 		err = harvest.Parse(os.Args[2:])
 		if err == nil {
 			setHarvestOptions()
-			if *harvestChanges {
+			if harvest.Arg(0) == "loc" {
+				err = harvestLOC()
+			} else if *harvestChanges {
 				err = pronom.GetReleases(config.Local("release-notes.xml"))
 			} else if *harvestWikidataSig {
 				err = harvestWikidata()
@@ -551,6 +777,26 @@ func main() {
 				err = savereps()
 			}
 		}
+	case "bundle":
+		err = bundle.Parse(os.Args[2:])
+		if err == nil {
+			setBundleOptions()
+			out := "bundle.tgz"
+			if bundle.Arg(0) != "" {
+				out = bundle.Arg(0)
+			}
+			err = makeBundle(out)
+		}
+	case "update-data":
+		err = updatef.Parse(os.Args[2:])
+		if err == nil {
+			setUpdateOptions()
+			var u *pronom.Update
+			u, err = pronom.UpdateData(*updateReports)
+			if u != nil {
+				fmt.Print(u)
+			}
+		}
 	case "inspect":
 		inspect.Usage = func() { fmt.Print(inspectUsage) }
 		err = inspect.Parse(os.Args[2:])
@@ -569,6 +815,16 @@ func main() {
 				err = inspectSig(core.MIMEMatcher)
 			case input == "riffmatcher", input == "rm":
 				err = inspectSig(core.RIFFMatcher)
+			case input == "mpegmatcher", input == "am":
+				err = inspectSig(core.MPEGMatcher)
+			case input == "ebmlmatcher", input == "em":
+				err = inspectSig(core.EBMLMatcher)
+			case input == "bmffmatcher", input == "fm":
+				err = inspectSig(core.BMFFMatcher)
+			case input == "structmatcher", input == "sm":
+				err = inspectSig(core.StructMatcher)
+			case input == "datamatcher", input == "dm":
+				err = inspectSig(core.DataMatcher)
 			case input == "xmlmatcher", input == "xm":
 				err = inspectSig(core.XMLMatcher)
 			case input == "textmatcher", input == "tm":
@@ -627,6 +883,58 @@ func main() {
 		if err == nil {
 			err = reader.Compare(os.Stdout, *compareJoin, comparef.Args()...)
 		}
+	case "test":
+		err = testf.Parse(os.Args[2:])
+		if err != nil {
+			break
+		}
+		if *testCorpus == "" || *testExpected == "" {
+			err = fmt.Errorf("roy: test needs a corpus directory and an expected results file e.g. roy test -corpus DIR -expected results.yaml")
+			break
+		}
+		if *testHome != config.Home() {
+			config.SetHome(*testHome)
+		}
+		if testf.Arg(0) != "" {
+			config.SetSignature(testf.Arg(0))
+		}
+		var s *siegfried.Siegfried
+		s, err = siegfried.Load(config.Signature())
+		if err == nil {
+			err = regression(os.Stdout, s, *testCorpus, *testExpected)
+		}
+	case "coverage":
+		err = coveragef.Parse(os.Args[2:])
+		if err != nil {
+			break
+		}
+		if coveragef.NArg() == 0 {
+			err = fmt.Errorf("roy: coverage needs results files or corpus directories e.g. roy coverage results.yaml")
+			break
+		}
+		if *coverageHome != config.Home() {
+			config.SetHome(*coverageHome)
+		}
+		config.SetSignature(*coverageSig)
+		var s *siegfried.Siegfried
+		s, err = siegfried.Load(config.Signature())
+		if err != nil {
+			break
+		}
+		c := newCoverage()
+		for _, arg := range coveragef.Args() {
+			if info, serr := os.Stat(arg); serr == nil && info.IsDir() {
+				err = c.addCorpus(s, arg)
+			} else {
+				err = c.addResults(arg)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err == nil {
+			fmt.Print(c.report(s))
+		}
 	default:
 		log.Fatal(usage)
 	}
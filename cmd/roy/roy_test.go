@@ -1,7 +1,14 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/richardlehane/siegfried"
@@ -161,3 +168,159 @@ func TestArchivematica(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBundle(t *testing.T) {
+	config.SetHome(*testhome)
+	tmp, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	b := filepath.Join(tmp, "bundle.tgz")
+	if err := makeBundle(b); err != nil {
+		t.Fatal(err)
+	}
+	sig := config.SignatureBase()
+	dir, err := openBundle(b)
+	defer func() {
+		config.SetHome(*testhome)
+		config.SetSignature(sig)
+	}()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if config.Home() != dir || config.Signature() != filepath.Join(*testhome, sig) {
+		t.Errorf("expecting home %s and signature %s, got %s and %s", dir, filepath.Join(*testhome, sig), config.Home(), config.Signature())
+	}
+	s := siegfried.New()
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	// a bundle whose contents don't match the manifest is rejected
+	f, err := os.Create(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for _, file := range []struct{ name, contents string }{
+		{"DROID_SignatureFile_V96.xml", "altered"},
+		{bundleManifest, `{"files":{"DROID_SignatureFile_V96.xml":"0000"}}`},
+	} {
+		tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.contents))})
+		tw.Write([]byte(file.contents))
+	}
+	tw.Close()
+	gz.Close()
+	f.Close()
+	if _, err := openBundle(b); err == nil {
+		t.Error("expecting an error opening a bundle that doesn't match its manifest")
+	}
+}
+
+func TestRegression(t *testing.T) {
+	s, err := siegfried.Load(filepath.Join(*testhome, "default.sig"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp, err := ioutil.TempDir("", "corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	corpus := filepath.Join(tmp, "corpus")
+	os.MkdirAll(filepath.Join(corpus, "sub"), 0755)
+	suite := filepath.Join("..", "sf", "testdata", "skeleton-suite", "containers")
+	for src, dst := range map[string]string{
+		"fmt-126-container-signature-id-3000.ppt":    "a.ppt",
+		"fmt-1184-container-signature-id-28100.idml": filepath.Join("sub", "b.idml"),
+	} {
+		byts, err := ioutil.ReadFile(filepath.Join(suite, src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(corpus, dst), byts, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// expected results can come from a scan of the corpus elsewhere
+	expected := func(ids ...string) string {
+		p := filepath.Join(tmp, "expected.csv")
+		csv := "filename,filesize,modified,errors,namespace,id\n" +
+			"/scans/corpus/a.ppt,2560,2020-09-22T20:58:25Z,,pronom," + ids[0] + "\n" +
+			"/scans/corpus/sub/b.idml,158,2020-09-22T20:58:25Z,,pronom," + ids[1] + "\n"
+		if len(ids) > 2 {
+			csv += "/scans/corpus/c.pdf,100,2020-09-22T20:58:25Z,,pronom," + ids[2] + "\n"
+		}
+		if err := ioutil.WriteFile(p, []byte(csv), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	var out bytes.Buffer
+	if err := regression(&out, s, corpus, expected("fmt/126", "fmt/1184")); err != nil {
+		t.Fatalf("expecting a pass, got %v\n%s", err, out.String())
+	}
+	out.Reset()
+	if err := regression(&out, s, corpus, expected("fmt/126", "fmt/999", "fmt/276")); err == nil {
+		t.Fatal("expecting a regression test failure")
+	}
+	if !strings.Contains(out.String(), "sub/b.idml,fmt/999,fmt/1184") ||
+		!strings.Contains(out.String(), "/scans/corpus/c.pdf,fmt/276,MISSING") ||
+		!strings.Contains(out.String(), "1 passed, 1 failed; 1 missing") {
+		t.Errorf("unexpected regression report:\n%s", out.String())
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	s, err := siegfried.Load(filepath.Join(*testhome, "default.sig"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp, err := ioutil.TempDir("", "coverage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	results := filepath.Join(tmp, "results.csv")
+	if err := ioutil.WriteFile(results, []byte("filename,filesize,modified,errors,namespace,id,warning\n"+
+		"a.tif,1,,,pronom,fmt/353,\n"+
+		"a.tif,1,,,pronom,fmt/1917,\n"+
+		"b.tif,1,,,pronom,fmt/353,\n"+
+		"b.tif,1,,,pronom,fmt/1917,\n"+
+		"c.wav,1,,,pronom,fmt/1,match on extension only\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c := newCoverage()
+	if err := c.addResults(results); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.addCorpus(s, filepath.Join("..", "sf", "testdata", "skeleton-suite", "containers")); err != nil {
+		t.Fatal(err)
+	}
+	rep := c.report(s)
+	for _, expect := range []string{
+		"extension only  : fmt/1\n",
+		"always together : fmt/1917 & fmt/353\n",
+	} {
+		if !strings.Contains(rep, expect) {
+			t.Errorf("expecting %q in coverage report, got:\n%s", expect, rep)
+		}
+	}
+	never := make(map[string]bool)
+	for _, line := range strings.Split(rep, "\n") {
+		if strings.HasPrefix(line, "never matched") {
+			for _, id := range strings.Split(strings.TrimSpace(strings.SplitN(line, ":", 2)[1]), ", ") {
+				never[id] = true
+			}
+		}
+	}
+	if !never["fmt/2"] || never["fmt/1"] || never["fmt/126"] {
+		t.Error("expecting fmt/2, but not fmt/1 (extension match) or fmt/126 (in the corpus), to have never matched")
+	}
+}
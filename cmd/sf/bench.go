@@ -0,0 +1,90 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried"
+)
+
+// benchmark records how long each file takes to identify (-bench). Files within archives are timed separately from their archive.
+// A nil *benchmark records nothing.
+type benchmark struct {
+	start        time.Time
+	n            int
+	mu           sync.Mutex
+	files, bytes int64
+	slowest      []benchFile // the n slowest files, slowest first
+}
+
+type benchFile struct {
+	path string
+	sz   int64
+	d    time.Duration
+}
+
+var bench *benchmark
+
+func newBenchmark(n int) *benchmark {
+	return &benchmark{start: time.Now(), n: n}
+}
+
+func (b *benchmark) add(path string, sz int64, d time.Duration) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.files++
+	b.bytes += sz
+	i := sort.Search(len(b.slowest), func(i int) bool { return b.slowest[i].d < d })
+	if i >= b.n {
+		return
+	}
+	if len(b.slowest) < b.n {
+		b.slowest = append(b.slowest, benchFile{})
+	}
+	copy(b.slowest[i+1:], b.slowest[i:])
+	b.slowest[i] = benchFile{path, sz, d}
+}
+
+// report writes the number of files and bytes scanned, the time spent in each matcher and filling buffers, and the slowest files
+func (b *benchmark) report(w io.Writer, s *siegfried.Siegfried) {
+	if b == nil {
+		return
+	}
+	elapsed := time.Since(b.start)
+	fmt.Fprintf(w, "---\nbench :\n  files   : %d\n  bytes   : %d\n  elapsed : %v\n", b.files, b.bytes, elapsed)
+	if secs := elapsed.Seconds(); secs > 0 {
+		fmt.Fprintf(w, "  rate    : %.1f files/s, %s/s\n", float64(b.files)/secs, byteSize(int64(float64(b.bytes)/secs)))
+	}
+	fmt.Fprint(w, "  timings :\n")
+	for _, t := range s.Timings() {
+		if t.Count > 0 {
+			fmt.Fprintf(w, "    %-10s: %v (%d files, %v per file)\n", t.Name, t.Duration, t.Count, t.Duration/time.Duration(t.Count))
+		} else {
+			fmt.Fprintf(w, "    %-10s: %v\n", t.Name, t.Duration)
+		}
+	}
+	fmt.Fprint(w, "  slowest :\n")
+	for _, f := range b.slowest {
+		fmt.Fprintf(w, "    - %v : %s (%d bytes)\n", f.d, f.path, f.sz)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+	"github.com/richardlehane/siegfried/pkg/reader"
+)
+
+// comparison reports the files of a scan (or replayed results) whose identification, size or checksum differ from those in
+// a previous results file (sf -compare). Files are matched by path. Each change is written as a CSV row of
+// filename, change, previous and current values, where change is one of added, removed, identification, size, checksum or error.
+type comparison struct {
+	prev   map[string]reader.File
+	order  []string // paths of the previous results, in the order they were read
+	hh     string   // hash header of the previous results
+	hashes bool     // compare checksums: set if the previous and current results have the same hashes
+	w      *csv.Writer
+}
+
+var compared *comparison
+
+func newComparison(path string, w io.Writer) (*comparison, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rdr, err := reader.New(f, path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading results file %s; got %v", path, err)
+	}
+	c := &comparison{
+		prev: make(map[string]reader.File),
+		hh:   rdr.Head().HashHeader,
+		w:    csv.NewWriter(w),
+	}
+	var rf reader.File
+	for rf, err = rdr.Next(); err == nil; rf, err = rdr.Next() {
+		if len(rf.IDs) == 0 && rf.Err == nil { // a directory
+			continue
+		}
+		if _, ok := c.prev[rf.Path]; !ok {
+			c.order = append(c.order, rf.Path)
+		}
+		c.prev[rf.Path] = rf
+	}
+	if err != io.EOF {
+		return nil, fmt.Errorf("error reading results file %s; got %v", path, err)
+	}
+	c.w.Write([]string{"filename", "change", "previous", "current"})
+	return c, nil
+}
+
+// setHashes records the hashes of the current results: checksums are only compared if they match those of the previous results.
+// It reports whether they do.
+func (c *comparison) setHashes(hh string) bool {
+	c.hashes = hh != "" && strings.EqualFold(hh, c.hh)
+	return c.hashes || c.hh == ""
+}
+
+func idsString(ids []core.Identification) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	sort.Strings(strs)
+	return strings.Join(strs, ";")
+}
+
+// check compares a file's results with the previous results. It is called by the printer for every file.
+func (c *comparison) check(path string, sz int64, cs []byte, err error, ids []core.Identification) {
+	if c == nil || (len(ids) == 0 && err == nil) { // ignore directories
+		return
+	}
+	cur := idsString(ids)
+	p, ok := c.prev[path]
+	if !ok {
+		c.w.Write([]string{path, "added", "", cur})
+		return
+	}
+	delete(c.prev, path)
+	if len(ids) == 0 {
+		if p.Err == nil || p.Err.Error() != err.Error() {
+			c.w.Write([]string{path, "error", idsString(p.IDs), err.Error()})
+		}
+		return
+	}
+	if old := idsString(p.IDs); old != cur {
+		c.w.Write([]string{path, "identification", old, cur})
+	}
+	if p.Size != sz {
+		c.w.Write([]string{path, "size", strconv.FormatInt(p.Size, 10), strconv.FormatInt(sz, 10)})
+	}
+	if c.hashes && len(p.Hash) > 0 && len(cs) > 0 {
+		if h := hex.EncodeToString(cs); !strings.EqualFold(h, string(p.Hash)) {
+			c.w.Write([]string{path, "checksum", string(p.Hash), h})
+		}
+	}
+}
+
+// close reports the files of the previous results that weren't found, and flushes the report.
+func (c *comparison) close() error {
+	if c == nil {
+		return nil
+	}
+	for _, path := range c.order {
+		if p, ok := c.prev[path]; ok {
+			c.w.Write([]string{path, "removed", idsString(p.IDs), ""})
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
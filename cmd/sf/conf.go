@@ -28,9 +28,11 @@ import (
 
 var (
 	// list of flags that can be configured
-	setableFlags = []string{"coe", "csv", "droid", "hash", "json", "log", "multi", "nr", "serve", "sig", "throttle", "yaml", "z"}
+	setableFlags = []string{"anonymize", "bof", "buffering", "coe", "csv", "csvfields", "dedupe", "droid", "elastic", "eof", "esbatch", "esindex", "exchange", "failon", "fasthash", "format", "hash", "json", "jsonl", "known", "limit", "log", "lowmem", "macros", "maxfilesize", "maxmapped", "maxscantime", "mirrors", "multi", "nr", "ns", "ole2", "pdf", "profile", "progress", "proxy", "publish", "raw-names", "refine", "salt", "serve", "sig", "summary", "textprofile", "throttle", "topic", "unknown", "verify", "yaml", "z", "zdepth", "zipcrc", "zipguess", "zpdf", "zratio", "ztotal"}
 	// list of flags that control output - these are exclusive of each other
-	outputFlags = []string{"csv", "droid", "json", "yaml"}
+	outputFlags = []string{"csv", "droid", "elastic", "json", "jsonl", "publish", "yaml"}
+	// flags that choose the signature file - these are also exclusive of each other
+	sigFlags = []string{"profile", "sig"}
 )
 
 // also used in sf_test.go
@@ -105,6 +107,10 @@ func readconf() error {
 			for _, v := range outputFlags {
 				delete(confFlags, v)
 			}
+		} else if check(fl.Name, sigFlags) {
+			for _, v := range sigFlags {
+				delete(confFlags, v)
+			}
 		} else {
 			delete(confFlags, fl.Name)
 		}
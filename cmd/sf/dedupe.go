@@ -0,0 +1,73 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+	"github.com/richardlehane/siegfried/pkg/writer"
+)
+
+// dedupe identifies the content with each checksum only once (-dedupe). Files are hashed before they are identified,
+// and files with the checksum of a file already seen are reported with that file's identifications and a
+// "duplicate of <path>" warning. Duplicate archives aren't decompressed, as their entries are reported with the original.
+type dedupe struct {
+	mu   sync.Mutex
+	seen map[string]*original
+}
+
+// original is the first file seen with a checksum. Its identifications are set once it has been identified.
+type original struct {
+	path string
+	done chan struct{}
+	ids  []core.Identification
+}
+
+var dupes *dedupe // nil unless -dedupe is set
+
+func newDedupe() *dedupe {
+	return &dedupe{seen: make(map[string]*original)}
+}
+
+// claim returns the original file for a checksum, and whether this file is a duplicate of it.
+// If it isn't, this file is the original and identified must be called with its identifications.
+func (d *dedupe) claim(cs []byte, path string) (*original, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if o, ok := d.seen[string(cs)]; ok {
+		return o, true
+	}
+	o := &original{path: path, done: make(chan struct{})}
+	d.seen[string(cs)] = o
+	return o, false
+}
+
+func (o *original) identified(ids []core.Identification) {
+	o.ids = append([]core.Identification(nil), ids...) // copy, as archive warnings are annotated later
+	close(o.done)
+}
+
+// duplicate waits for the original to be identified and returns a copy of its identifications, with a warning naming it.
+// It returns nil if the original couldn't be identified, in which case the duplicate should be identified itself.
+func (o *original) duplicate() []core.Identification {
+	<-o.done
+	if o.ids == nil {
+		return nil
+	}
+	ids := append([]core.Identification(nil), o.ids...)
+	annotate(ids, core.Warning{Code: core.WarnDuplicate, Msg: "duplicate of " + writer.Anonymize(o.path)})
+	return ids
+}
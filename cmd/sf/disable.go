@@ -0,0 +1,101 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// per-identifier matcher flags e.g. -pronom.noext or -tika.nomagic
+// these mirror roy's build flags (noname, nomime etc.) with noext and nomagic as aliases
+var matcherFlags = map[string]core.MatcherType{
+	"noname":      core.NameMatcher,
+	"noext":       core.NameMatcher,
+	"nomime":      core.MIMEMatcher,
+	"nocontainer": core.ContainerMatcher,
+	"noxml":       core.XMLMatcher,
+	"noriff":      core.RIFFMatcher,
+	"nompeg":      core.MPEGMatcher,
+	"noebml":      core.EBMLMatcher,
+	"nobmff":      core.BMFFMatcher,
+	"nostruct":    core.StructMatcher,
+	"nodata":      core.DataMatcher,
+	"nobyte":      core.ByteMatcher,
+	"nomagic":     core.ByteMatcher,
+	"notext":      core.TextMatcher,
+}
+
+type disabled struct {
+	name string
+	mt   core.MatcherType
+}
+
+// String returns the flag for a disabled matcher, using roy's name for the matcher e.g. -pronom.noname for -pronom.noext
+func (d disabled) String() string {
+	for _, fl := range []string{"noname", "nomime", "nocontainer", "noxml", "noriff", "nompeg", "noebml", "nobmff", "nostruct", "nodata", "nobyte", "notext"} {
+		if matcherFlags[fl] == d.mt {
+			return "-" + d.name + "." + fl
+		}
+	}
+	return ""
+}
+
+// splitMatcherFlags removes per-identifier matcher flags from the command line arguments, so the rest can be parsed by the flag package.
+// Identifier names aren't known until the signature file is loaded, so these flags can't be defined ahead of time.
+func splitMatcherFlags(args []string) ([]string, []disabled) {
+	rest := make([]string, 0, len(args))
+	var dis []disabled
+	for i, arg := range args {
+		if arg == "--" {
+			return append(rest, args[i:]...), dis
+		}
+		if strings.HasPrefix(arg, "-") {
+			fl := strings.TrimLeft(arg, "-")
+			if idx := strings.LastIndex(fl, "."); idx > 0 {
+				if mt, ok := matcherFlags[fl[idx+1:]]; ok {
+					dis = append(dis, disabled{fl[:idx], mt})
+					continue
+				}
+			}
+		}
+		rest = append(rest, arg)
+	}
+	return rest, dis
+}
+
+func disableMatchers(s *siegfried.Siegfried, dis []disabled) error {
+	for _, d := range dis {
+		if err := s.Disable(d.name, d.mt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// disableIdentifiers switches off the identifiers in a comma separated list given with -ns e.g. -ns pronom,loc
+func disableIdentifiers(s *siegfried.Siegfried, ns string) error {
+	if ns == "" {
+		return nil
+	}
+	for _, name := range strings.Split(ns, ",") {
+		if err := s.DisableIdentifier(strings.TrimSpace(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// exit codes for -failon. When a scan fails on more than one condition, the highest code is used.
+const (
+	exitUnknown = 2 // a file that no identifier matched
+	exitWarning = 3 // a match with a warning
+	exitError   = 4 // a file that couldn't be identified e.g. because it couldn't be read
+)
+
+// failOn records the conditions given with -failon, and whether any file met them
+type failOn struct {
+	unknown, warning, error bool // conditions set
+	code                    int  // highest exit code of the conditions met
+}
+
+var failPolicy *failOn
+
+func newFailOn(s string) (*failOn, error) {
+	f := &failOn{}
+	for _, v := range strings.Split(s, ",") {
+		switch strings.TrimSpace(v) {
+		case "unknown", "unknowns":
+			f.unknown = true
+		case "warning", "warnings", "warn":
+			f.warning = true
+		case "error", "errors":
+			f.error = true
+		case "":
+		default:
+			return nil, fmt.Errorf("bad -failon %q: expecting a comma separated list of unknown, warning and error", v)
+		}
+	}
+	return f, nil
+}
+
+// check records a file's results. It is called by the printer for every file, whether or not it is output.
+func (f *failOn) check(err error, ids []core.Identification) {
+	if f == nil {
+		return
+	}
+	if err != nil && f.error {
+		f.set(exitError)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	var kn bool
+	for _, id := range ids {
+		if !id.Known() {
+			continue
+		}
+		kn = true
+		if f.warning && id.Warn() != "" {
+			f.set(exitWarning)
+		}
+	}
+	if !kn && f.unknown {
+		f.set(exitUnknown)
+	}
+}
+
+func (f *failOn) set(code int) {
+	if code > f.code {
+		f.code = code
+	}
+}
+
+// exitCode returns the code sf should exit with: 0 if no file met the -failon conditions
+func (f *failOn) exitCode() int {
+	if f == nil {
+		return 0
+	}
+	return f.code
+}
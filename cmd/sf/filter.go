@@ -0,0 +1,308 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// filter selects the files found when walking directories (-include and -exclude).
+//
+// Each flag takes a comma separated list of terms. A term is either a glob or a size or mtime predicate:
+//
+//	*.docx                  globs without a slash match the file's name
+//	docs/**/*.pdf           globs with a slash match the path below the directory scanned; ** matches any number of directories
+//	size<100MB              predicates compare the size (with <, <=, > or >=) ...
+//	mtime>=2020-01-01       ... or the modified time (a date or an RFC3339 time) of the file
+//
+// A file is scanned if it matches one of the -include globs (if any are given) and all of the -include predicates,
+// and if it matches none of the -exclude terms. Directories that match an -exclude glob are not walked.
+// Files named explicitly as arguments to sf are always scanned.
+//
+// In -z mode, the filter also applies to the entries within archives, with globs matched against the entry's path
+// within its archive. Entries within directories that match an -exclude glob are skipped (e.g. -exclude .git skips
+// the .git directories in tarballs). Predicates are ignored for entries that don't record their size or modified time.
+//
+// For incremental scans, -since and -newer skip the files walked that haven't been modified since a time, or since a reference
+// file was modified. Unlike an mtime predicate, these don't apply to the entries within archives, so that all the contents
+// of a modified archive are scanned.
+type filter struct {
+	include, exclude []string
+	incPreds         []predicate
+	excPreds         []predicate
+	since            *predicate
+}
+
+var walkFilter *filter // nil unless -include, -exclude, -since or -newer are set
+
+type predicate struct {
+	mtime bool // otherwise size
+	op    string
+	size  int64
+	t     time.Time
+}
+
+func newFilter(include, exclude string) (*filter, error) {
+	f := &filter{}
+	var err error
+	if f.include, f.incPreds, err = parseTerms(include); err != nil {
+		return nil, fmt.Errorf("bad -include: %v", err)
+	}
+	if f.exclude, f.excPreds, err = parseTerms(exclude); err != nil {
+		return nil, fmt.Errorf("bad -exclude: %v", err)
+	}
+	return f, nil
+}
+
+func parseTerms(s string) ([]string, []predicate, error) {
+	var globs []string
+	var preds []predicate
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		if p, ok, err := parsePredicate(term); ok {
+			if err != nil {
+				return nil, nil, err
+			}
+			preds = append(preds, p)
+			continue
+		}
+		term = strings.TrimPrefix(filepath.ToSlash(term), "./")
+		for _, seg := range strings.Split(term, "/") {
+			if _, err := path.Match(seg, ""); err != nil {
+				return nil, nil, fmt.Errorf("bad glob %q", term)
+			}
+		}
+		globs = append(globs, term)
+	}
+	return globs, preds, nil
+}
+
+// parsePredicate reports whether term is a predicate, with an error if a predicate's value can't be parsed
+func parsePredicate(term string) (predicate, bool, error) {
+	var p predicate
+	switch {
+	case strings.HasPrefix(term, "size"):
+		term = term[4:]
+	case strings.HasPrefix(term, "mtime"):
+		p.mtime, term = true, term[5:]
+	default:
+		return p, false, nil
+	}
+	for _, op := range []string{"<=", ">=", "<", ">"} {
+		if strings.HasPrefix(term, op) {
+			p.op, term = op, strings.TrimSpace(term[len(op):])
+			break
+		}
+	}
+	if p.op == "" {
+		return p, false, nil
+	}
+	var err error
+	if !p.mtime {
+		p.size, err = parseSize(term)
+		return p, true, err
+	}
+	p.t, err = parseTime(term)
+	return p, true, err
+}
+
+func parseTime(term string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", term, time.Local)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, term); err != nil {
+			return t, fmt.Errorf("bad time %q: expecting a date (2006-01-02) or an RFC3339 time", term)
+		}
+	}
+	return t, nil
+}
+
+// setSince skips files modified before a time (since) or, like find -newer, not modified after a reference file (newer).
+// Since can be a date, an RFC3339 time or a duration before now (e.g. 24h).
+func (f *filter) setSince(since, newer string) error {
+	switch {
+	case since != "" && newer != "":
+		return fmt.Errorf("-since and -newer cannot be used together")
+	case newer != "":
+		info, err := os.Stat(newer)
+		if err != nil {
+			return fmt.Errorf("bad -newer: %v", err)
+		}
+		f.since = &predicate{mtime: true, op: ">", t: info.ModTime()}
+	case since != "":
+		t, err := parseTime(since)
+		if err != nil {
+			d, derr := time.ParseDuration(since)
+			if derr != nil || d < 0 {
+				return fmt.Errorf("bad -since: expecting a date (2006-01-02), an RFC3339 time or a duration (e.g. 24h), got %q", since)
+			}
+			t = time.Now().Add(-d)
+		}
+		f.since = &predicate{mtime: true, op: ">=", t: t}
+	}
+	return nil
+}
+
+// known reports whether a file has the value the predicate compares: archive entries may have an unknown (-1) size or zero modified time
+func (p predicate) known(sz int64, mod time.Time) bool {
+	if p.mtime {
+		return !mod.IsZero()
+	}
+	return sz >= 0
+}
+
+func (p predicate) match(sz int64, mod time.Time) bool {
+	var c int // compare the file's value with the predicate's
+	if p.mtime {
+		switch {
+		case mod.Before(p.t):
+			c = -1
+		case mod.After(p.t):
+			c = 1
+		}
+	} else {
+		switch {
+		case sz < p.size:
+			c = -1
+		case sz > p.size:
+			c = 1
+		}
+	}
+	switch p.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	}
+	return c >= 0
+}
+
+// skipDir reports whether a directory (given as a slash separated path relative to the walk root) is excluded
+func (f *filter) skipDir(rel string) bool {
+	if f == nil {
+		return false
+	}
+	return matchAny(f.exclude, rel)
+}
+
+// skip reports whether a file (given as a slash separated path relative to the walk root) is filtered out
+func (f *filter) skip(rel string, sz int64, mod time.Time) bool {
+	if f == nil {
+		return false
+	}
+	if f.since != nil && !f.since.match(sz, mod) {
+		return true
+	}
+	return f.skipTerms(rel, sz, mod)
+}
+
+// skipTerms reports whether a file or archive entry is filtered out by the -include and -exclude terms
+func (f *filter) skipTerms(rel string, sz int64, mod time.Time) bool {
+	if len(f.include) > 0 && !matchAny(f.include, rel) {
+		return true
+	}
+	for _, p := range f.incPreds {
+		if p.known(sz, mod) && !p.match(sz, mod) {
+			return true
+		}
+	}
+	if matchAny(f.exclude, rel) {
+		return true
+	}
+	for _, p := range f.excPreds {
+		if p.known(sz, mod) && p.match(sz, mod) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipEntry reports whether an archive entry (given as a slash separated path within its archive) is filtered out,
+// either itself or because it is within an excluded directory
+func (f *filter) skipEntry(rel string, sz int64, mod time.Time) bool {
+	if f == nil {
+		return false
+	}
+	segs := strings.Split(rel, "/")
+	for i := 1; i < len(segs); i++ {
+		if f.skipDir(strings.Join(segs[:i], "/")) {
+			return true
+		}
+	}
+	return f.skipTerms(rel, sz, mod)
+}
+
+// entryPath returns the slash separated path of an archive entry within its archive, given the archive's path
+func entryPath(arc, p string) string {
+	rel := strings.TrimPrefix(p, arc)
+	if len(rel) > 0 && (rel[0] == '#' || rel[0] == filepath.Separator) {
+		rel = rel[1:]
+	}
+	return filepath.ToSlash(rel)
+}
+
+// relPath returns the slash separated path of a file relative to the root of a walk
+func relPath(root, p string) string {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		rel = p
+	}
+	return filepath.ToSlash(rel)
+}
+
+func matchAny(globs []string, rel string) bool {
+	for _, g := range globs {
+		if !strings.Contains(g, "/") {
+			if ok, _ := path.Match(g, path.Base(rel)); ok {
+				return true
+			}
+			continue
+		}
+		if matchSegments(strings.Split(g, "/"), strings.Split(rel, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(globs, segs []string) bool {
+	for len(globs) > 0 {
+		if globs[0] == "**" {
+			for i := 0; i <= len(segs); i++ {
+				if matchSegments(globs[1:], segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(globs[0], segs[0]); !ok {
+			return false
+		}
+		globs, segs = globs[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
@@ -0,0 +1,136 @@
+// +build go1.16
+
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+// a scan root opened with -fs: the filesystem, a function giving the path reported for a file within it, and a closer
+type fsRoot struct {
+	fsys   fs.FS
+	report func(p string) string
+	io.Closer
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// openFS opens a directory (with os.DirFS) or a zip archive (with archive/zip) as an io/fs filesystem.
+// Files in archives are reported with paths like archive.zip#dir/file, as they are with -z.
+func openFS(root string) (*fsRoot, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &fsRoot{
+			fsys:   os.DirFS(root),
+			report: func(p string) string { return filepath.Join(root, filepath.FromSlash(p)) },
+			Closer: nopCloser{},
+		}, nil
+	}
+	rdr, err := zip.OpenReader(root)
+	if err != nil {
+		return nil, fmt.Errorf("can't open %s as a filesystem (expecting a directory or a zip archive), got: %v", root, err)
+	}
+	return &fsRoot{
+		fsys:   rdr,
+		report: func(p string) string { return root + "#" + p },
+		Closer: rdr,
+	}, nil
+}
+
+// identifyFS walks the filesystem at root (sf -fs) with fs.WalkDir
+func identifyFS(ctxts chan *context, root string, coerr, norecurse bool, gf getFn) error {
+	fr, err := openFS(root)
+	if err != nil {
+		return fmt.Errorf("failed to open %s, got: %v", root, err)
+	}
+	defer fr.Close()
+	return fs.WalkDir(fr.fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if isInterrupted() {
+			return errInterrupted
+		}
+		if throttle != nil {
+			<-throttle.C
+		}
+		if err != nil {
+			if coerr {
+				printFile(ctxts, gf(fr.report(p), "", time.Time{}, 0), WalkError{fr.report(p), err})
+				return nil
+			}
+			return WalkError{fr.report(p), err}
+		}
+		if d.IsDir() {
+			if p != "." && (norecurse || walkFilter.skipDir(p)) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			printFile(ctxts, gf(fr.report(p), "", time.Time{}, 0), err)
+			return nil
+		}
+		if walkFilter.skip(p, info.Size(), info.ModTime()) {
+			return nil
+		}
+		ctx := gf(fr.report(p), "", info.ModTime(), info.Size())
+		ctx.named, ctx.name = true, path.Base(p)
+		if !info.Mode().IsRegular() {
+			printFile(ctxts, ctx, ModeError(info.Mode()))
+			return nil
+		}
+		ctx.wg.Add(1)
+		ctxts <- ctx
+		readFS(ctx, ctxts, gf, fr.fsys, p)
+		return nil
+	})
+}
+
+// readFS identifies a file within a filesystem, reading it on demand if it implements io.ReaderAt, otherwise as a stream
+func readFS(ctx *context, ctxts chan *context, gf getFn, fsys fs.FS, p string) {
+	sz := ctx.sz // ctx may be reused once its results are sent
+	prog.begin(ctx.path)
+	defer prog.end(sz)
+	if maxSize > 0 && sz > maxSize {
+		ctx.res <- results{SizeError(sz), nil, nil}
+		return
+	}
+	f, err := fsys.Open(p)
+	if err != nil {
+		ctx.res <- results{err, nil, nil}
+		return
+	}
+	defer f.Close()
+	if ra, ok := f.(io.ReaderAt); ok {
+		identifyRdr(siegreader.Window(ra, 0, sz), ctx, ctxts, gf)
+		return
+	}
+	identifyRdr(f, ctx, ctxts, gf)
+}
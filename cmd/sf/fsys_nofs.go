@@ -0,0 +1,24 @@
+// +build !go1.16
+
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "errors"
+
+// io/fs was added in Go 1.16
+func identifyFS(ctxts chan *context, root string, coerr, norecurse bool, gf getFn) error {
+	return errors.New("-fs requires sf to be built with Go 1.16 or later")
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// matcher names (and roy's short aliases) for sf -inspect MATCHER
+var inspectMatchers = map[string]core.MatcherType{
+	"bytematcher":      core.ByteMatcher,
+	"bm":               core.ByteMatcher,
+	"containermatcher": core.ContainerMatcher,
+	"cm":               core.ContainerMatcher,
+	"namematcher":      core.NameMatcher,
+	"nm":               core.NameMatcher,
+	"mimematcher":      core.MIMEMatcher,
+	"mm":               core.MIMEMatcher,
+	"riffmatcher":      core.RIFFMatcher,
+	"rm":               core.RIFFMatcher,
+	"mpegmatcher":      core.MPEGMatcher,
+	"am":               core.MPEGMatcher,
+	"ebmlmatcher":      core.EBMLMatcher,
+	"em":               core.EBMLMatcher,
+	"bmffmatcher":      core.BMFFMatcher,
+	"fm":               core.BMFFMatcher,
+	"structmatcher":    core.StructMatcher,
+	"sm":               core.StructMatcher,
+	"datamatcher":      core.DataMatcher,
+	"dm":               core.DataMatcher,
+	"xmlmatcher":       core.XMLMatcher,
+	"xm":               core.XMLMatcher,
+	"textmatcher":      core.TextMatcher,
+	"tm":               core.TextMatcher,
+}
+
+// inspect reports on the loaded signature file (sf -inspect). With no arguments, it describes the identifiers and the sizes of the
+// byte and container matchers' search trees. Arguments can name matchers (e.g. bm), "priorities" (or p), or formats (e.g. fmt/40),
+// for which the compiled byte signatures are shown. The compiled byte and container priorities can be exported as a graphviz dot graph
+// with "priorities.dot" or as JSON with "priorities.json".
+func inspect(w io.Writer, s *siegfried.Siegfried, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(w, s.Inspect(-1))
+		fmt.Fprint(w, s.Tune())
+		return nil
+	}
+	for _, arg := range args {
+		if mt, ok := inspectMatchers[arg]; ok {
+			fmt.Fprintln(w, s.Inspect(mt))
+			continue
+		}
+		if arg == "priorities" || arg == "p" {
+			fmt.Fprint(w, s.Priorities())
+			continue
+		}
+		if arg == "priorities.dot" || arg == "priorities.json" {
+			str, err := s.PriorityGraph(strings.TrimPrefix(arg, "priorities."))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(w, strings.TrimSuffix(str, "\n"))
+			continue
+		}
+		str, err := s.InspectFormat(arg)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, str)
+	}
+	return nil
+}
+
+// explain runs the byte signatures of a format against each of the files given (or stdin, for "-") and reports the results (sf -explain).
+func explain(w io.Writer, s *siegfried.Siegfried, id string, paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("-explain needs a file to test e.g. sf -explain %s file.bin", id)
+	}
+	for _, p := range paths {
+		var r io.Reader = os.Stdin
+		if p != "-" {
+			f, err := os.Open(p)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			r = f
+		}
+		str, err := s.Explain(id, r)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "---\nfilename  : %s\n%s", p, strings.TrimPrefix(str, "---\n"))
+	}
+	return nil
+}
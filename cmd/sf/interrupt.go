@@ -0,0 +1,50 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// exitInterrupted is the exit code when a scan is stopped by SIGINT or SIGTERM (the shell convention for SIGINT)
+const exitInterrupted = 130
+
+var (
+	errInterrupted = errors.New("[WARN] scan interrupted; results are incomplete")
+	interrupted    int32
+)
+
+// trapInterrupts handles SIGINT and SIGTERM by stopping the walk, so that in-flight identifications can finish and
+// the writer can close its output (e.g. the JSON array). A second signal exits immediately.
+func trapInterrupts() {
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		atomic.StoreInt32(&interrupted, 1)
+		log.Println("[WARN] interrupted: finishing the files in progress and writing results (interrupt again to quit immediately)")
+		<-c
+		os.Exit(exitInterrupted)
+	}()
+}
+
+func isInterrupted() bool {
+	return atomic.LoadInt32(&interrupted) == 1
+}
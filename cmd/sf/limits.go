@@ -0,0 +1,107 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// extraction counts the bytes decompressed from an archive, and from any archives nested within it, so that the -zratio and -ztotal
+// limits can be enforced as entries are read. It is shared by the contexts of all the entries of the outermost archive.
+type extraction struct {
+	size int64 // size of the outermost archive
+
+	mu   sync.Mutex // entries of nested archives are read while the entries that contain them are still being read
+	read int64
+	err  error // set once a limit is exceeded
+}
+
+func newExtraction(sz int64) *extraction {
+	if _, ratio, total := config.ArchiveLimits(); ratio == 0 && total == 0 {
+		return nil
+	}
+	return &extraction{size: sz}
+}
+
+// exceeded returns an error if a limit has been exceeded
+func (e *extraction) exceeded() error {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+// reader wraps the reader of an entry, counting the bytes read. Reads end (with io.EOF) once a limit is exceeded.
+func (e *extraction) reader(r io.Reader) io.Reader {
+	if e == nil {
+		return r
+	}
+	return &limitReader{r, e}
+}
+
+func (e *extraction) add(n int) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err != nil {
+		return e.err
+	}
+	e.read += int64(n)
+	_, ratio, total := config.ArchiveLimits()
+	switch {
+	case total > 0 && e.read > total:
+		e.err = fmt.Errorf("decompression abandoned after extracting %d bytes (-ztotal limit); suspected archive bomb", total)
+	case ratio > 0 && e.read > int64(ratio)*e.size:
+		e.err = fmt.Errorf("decompression abandoned after extracting %d times the size of the archive (-zratio limit); suspected archive bomb", ratio)
+	}
+	return e.err
+}
+
+type limitReader struct {
+	r io.Reader
+	e *extraction
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.e.exceeded() != nil {
+		return 0, io.EOF
+	}
+	n, err := l.r.Read(p)
+	if l.e.add(n) != nil {
+		return n, io.EOF
+	}
+	return n, err
+}
+
+// tooDeep reports whether an archive at this depth (0 for a file, 1 for an entry of an archive and so on) is beyond the -zdepth limit.
+func tooDeep(depth int) bool {
+	max, _, _ := config.ArchiveLimits()
+	return max > 0 && depth >= max
+}
+
+// annotate adds a warning to those identifications that support it.
+func annotate(ids []core.Identification, warn core.Warning) {
+	for i, id := range ids {
+		if a, ok := id.(core.Annotator); ok {
+			ids[i] = a.Annotate(nil, warn)
+		}
+	}
+}
@@ -32,7 +32,10 @@ func retryStat(path string, err error) (os.FileInfo, error) {
 
 func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bool, gf getFn) error {
 	walkFunc := func(path string, info os.FileInfo, err error) error {
-		if *throttlef > 0 {
+		if isInterrupted() {
+			return errInterrupted
+		}
+		if throttle != nil {
 			<-throttle.C
 		}
 		if err != nil {
@@ -43,7 +46,7 @@ func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bo
 			return WalkError{path, err}
 		}
 		if info.IsDir() {
-			if norecurse && path != root {
+			if norecurse && path != root || path != root && walkFilter.skipDir(relPath(root, path)) {
 				return filepath.SkipDir
 			}
 			if droid {
@@ -51,6 +54,9 @@ func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bo
 			}
 			return nil
 		}
+		if path != root && walkFilter.skip(relPath(root, path), info.Size(), info.ModTime()) {
+			return nil
+		}
 		// zero user read permissions mask, octal 400 (decimal 256)
 		if !info.Mode().IsRegular() || info.Mode()&256 == 0 {
 			printFile(ctxts, gf(path, "", info.ModTime(), info.Size()), ModeError(info.Mode()))
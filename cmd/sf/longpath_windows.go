@@ -18,6 +18,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 )
 
@@ -53,6 +54,38 @@ func shortpath(long, short string) string {
 	return long[i:]
 }
 
+// shadowPrefix begins the device paths of Volume Shadow Copy snapshots, as listed by `vssadmin list shadows`
+// e.g. \\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1
+const shadowPrefix = `\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy`
+
+// driveWide is set when the root of a walk is the root of a drive or snapshot. Directories that can't be
+// read (such as System Volume Information) are then reported as errors without ending the scan, as with -coe.
+var driveWide bool
+
+// volumeRoot reports whether a path is the root of a drive (e.g. C:\) or snapshot. Snapshot roots must end with a separator
+// to be opened, so one is added if missing.
+func volumeRoot(path string) (string, bool) {
+	if len(path) > len(shadowPrefix) && strings.EqualFold(path[:len(shadowPrefix)], shadowPrefix) {
+		num := strings.TrimSuffix(path[len(shadowPrefix):], `\`)
+		if strings.Trim(num, "0123456789") != "" {
+			return path, false
+		}
+		return path[:len(shadowPrefix)] + num + `\`, true
+	}
+	vol := filepath.VolumeName(path)
+	return path, vol != "" && path == vol+`\`
+}
+
+// isReparsePoint reports whether a file is a symbolic link, junction or mount point. These aren't followed in walks,
+// which avoids loops (e.g. the Application Data junctions in user profiles) and scanning files twice.
+func isReparsePoint(info os.FileInfo) bool {
+	if info.Mode()&(os.ModeSymlink|os.ModeIrregular) == 0 {
+		return false
+	}
+	d, ok := info.Sys().(*syscall.Win32FileAttributeData)
+	return ok && d.FileAttributes&syscall.FILE_ATTRIBUTE_REPARSE_POINT != 0
+}
+
 func retryStat(path string, err error) (os.FileInfo, error) {
 	if strings.HasPrefix(path, prefix) { // already a long path - no point retrying
 		return nil, err
@@ -76,16 +109,22 @@ func retryOpen(path string, err error) (*os.File, error) {
 }
 
 func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bool, gf getFn) error {
+	if orig == "" { // not restarted with a long path
+		root, driveWide = volumeRoot(root)
+	}
 	walkFunc := func(path string, info os.FileInfo, err error) error {
+		if isInterrupted() {
+			return errInterrupted
+		}
 		var retry bool
 		var lp, sp string
-		if *throttlef > 0 {
+		if throttle != nil {
 			<-throttle.C
 		}
 		if err != nil {
 			info, err = retryStat(path, err) // retry stat in case is a windows long path error
 			if err != nil {
-				if coerr {
+				if coerr || driveWide && os.IsPermission(err) {
 					printFile(ctxts, gf(path, "", time.Time{}, 0), WalkError{path, err})
 					return nil
 				}
@@ -94,8 +133,11 @@ func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bo
 			lp, sp = longpath(path), path
 			retry = true
 		}
+		if path != root && isReparsePoint(info) {
+			return nil
+		}
 		if info.IsDir() {
-			if norecurse && path != root {
+			if norecurse && path != root || path != root && walkFilter.skipDir(relPath(root, path)) {
 				return filepath.SkipDir
 			}
 			if retry { // if a dir long path, restart the recursion with a long path as the new root
@@ -106,6 +148,9 @@ func identify(ctxts chan *context, root, orig string, coerr, norecurse, droid bo
 			}
 			return nil
 		}
+		if path != root && walkFilter.skip(relPath(root, path), info.Size(), info.ModTime()) {
+			return nil
+		}
 		if !info.Mode().IsRegular() {
 			printFile(ctxts, gf(path, "", info.ModTime(), info.Size()), ModeError(info.Mode()))
 			return nil
@@ -0,0 +1,498 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// Object stores are walked by listing the objects under a prefix, and each object is identified with ranged GETs (see siegreader.Remote).
+// Credentials are taken from the environment:
+//   s3://bucket/prefix         AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN and AWS_REGION (or AWS_DEFAULT_REGION);
+//                              AWS_ENDPOINT_URL for S3 compatible stores such as MinIO
+//   gs://bucket/prefix         GOOGLE_OAUTH_ACCESS_TOKEN; STORAGE_EMULATOR_HOST for an emulator
+//   az://account/container/prefix  AZURE_STORAGE_SAS_TOKEN
+// Without credentials, requests are anonymous (which suits public buckets).
+
+type object struct {
+	key  string
+	size int64
+	mod  time.Time
+}
+
+type store interface {
+	list(prefix string, recurse bool, fn func(object) error) error
+	url(key string) string  // the URL used to GET an object
+	path(key string) string // the path reported for an object e.g. s3://bucket/key
+}
+
+func isStore(path string) bool {
+	return strings.HasPrefix(path, "s3://") || strings.HasPrefix(path, "gs://") || strings.HasPrefix(path, "az://")
+}
+
+// openStore parses an object store path, returning the store, an HTTP client to use with it and the prefix to list
+func openStore(p string) (store, *http.Client, string, error) {
+	u, err := url.Parse(p)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if u.Host == "" {
+		return nil, nil, "", fmt.Errorf("bad object store path %s: expecting a bucket", p)
+	}
+	prefix := strings.TrimPrefix(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		s := newS3(u.Host)
+		return s, &http.Client{Transport: s}, prefix, nil
+	case "gs":
+		g := &gcs{bucket: u.Host, endpoint: "https://storage.googleapis.com", token: os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN")}
+		if e := os.Getenv("STORAGE_EMULATOR_HOST"); e != "" {
+			if !strings.Contains(e, "://") {
+				e = "http://" + e
+			}
+			g.endpoint = strings.TrimSuffix(e, "/")
+		}
+		return g, &http.Client{Transport: g}, prefix, nil
+	case "az":
+		elements := strings.SplitN(prefix, "/", 2)
+		if elements[0] == "" {
+			return nil, nil, "", fmt.Errorf("bad object store path %s: expecting az://account/container/prefix", p)
+		}
+		a := &azure{account: u.Host, container: elements[0], endpoint: "https://" + u.Host + ".blob.core.windows.net", sas: strings.TrimPrefix(os.Getenv("AZURE_STORAGE_SAS_TOKEN"), "?")}
+		prefix = ""
+		if len(elements) > 1 {
+			prefix = elements[1]
+		}
+		return a, &http.Client{Transport: a}, prefix, nil
+	}
+	return nil, nil, "", fmt.Errorf("unknown object store %s", u.Scheme)
+}
+
+// identify the objects in an object store
+func identifyStore(ctxts chan *context, p string, norecurse bool, gf getFn) error {
+	st, client, prefix, err := openStore(p)
+	if err != nil {
+		return err
+	}
+	return st.list(prefix, !norecurse, func(o object) error {
+		if isInterrupted() {
+			return errInterrupted
+		}
+		if throttle != nil {
+			<-throttle.C
+		}
+		if walkFilter.skip(strings.TrimPrefix(strings.TrimPrefix(o.key, prefix), "/"), o.size, o.mod) {
+			return nil
+		}
+		ctx := gf(st.path(o.key), "", o.mod, o.size)
+		ctx.named, ctx.name = true, path.Base(o.key)
+		if maxSize > 0 && o.size > maxSize {
+			printFile(ctxts, ctx, SizeError(o.size))
+			return nil
+		}
+		ctx.wg.Add(1)
+		ctxts <- ctx
+		if *multi == 1 || ctx.z || config.Slow() || config.Debug() {
+			readObject(ctx, ctxts, gf, client, st.url(o.key))
+			return nil
+		}
+		ctx.wg.Add(1)
+		go func() {
+			readObject(ctx, ctxts, gf, client, st.url(o.key))
+			ctx.wg.Done()
+		}()
+		return nil
+	})
+}
+
+func readObject(ctx *context, ctxts chan *context, gf getFn, client *http.Client, u string) {
+	sz := ctx.sz // ctx may be reused once its results are sent
+	prog.begin(ctx.path)
+	defer prog.end(sz)
+	r, err := siegreader.OpenURL(client, u)
+	if err != nil {
+		ctx.res <- results{err, nil, nil}
+		return
+	}
+	identifyRdr(r.Reader(), ctx, ctxts, gf)
+	r.Close()
+}
+
+func readStoreError(resp *http.Response) error {
+	byt := make([]byte, 512)
+	n, _ := io.ReadFull(resp.Body, byt)
+	u := resp.Request.URL
+	return fmt.Errorf("error listing %s://%s%s, got %s %s", u.Scheme, u.Host, u.Path, resp.Status, strings.TrimSpace(string(byt[:n])))
+}
+
+// S3
+
+type s3 struct {
+	bucket             string
+	endpoint           string // if set, path style requests are made to this endpoint
+	region             string
+	key, secret, token string
+}
+
+func newS3(bucket string) *s3 {
+	s := &s3{
+		bucket:   bucket,
+		endpoint: strings.TrimSuffix(os.Getenv("AWS_ENDPOINT_URL"), "/"),
+		region:   os.Getenv("AWS_REGION"),
+		key:      os.Getenv("AWS_ACCESS_KEY_ID"),
+		secret:   os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		token:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if s.region == "" {
+		s.region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if s.region == "" {
+		s.region = "us-east-1"
+	}
+	return s
+}
+
+func (s *s3) base() string {
+	if s.endpoint != "" {
+		return s.endpoint + "/" + s.bucket
+	}
+	return "https://" + s.bucket + ".s3." + s.region + ".amazonaws.com"
+}
+
+func (s *s3) url(key string) string {
+	return s.base() + "/" + awsEscape(key, true)
+}
+
+func (s *s3) path(key string) string {
+	return "s3://" + s.bucket + "/" + key
+}
+
+type s3List struct {
+	IsTruncated           bool
+	NextContinuationToken string
+	Contents              []struct {
+		Key          string
+		LastModified time.Time
+		Size         int64
+	}
+}
+
+func (s *s3) list(prefix string, recurse bool, fn func(object) error) error {
+	var token string
+	client := &http.Client{Transport: s}
+	for {
+		q := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if !recurse {
+			q.Set("delimiter", "/")
+		}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		resp, err := client.Get(s.base() + "/?" + awsQuery(q)) // encoded as signed
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = readStoreError(resp)
+			resp.Body.Close()
+			return err
+		}
+		var l s3List
+		err = xml.NewDecoder(resp.Body).Decode(&l)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, c := range l.Contents {
+			if strings.HasSuffix(c.Key, "/") { // folder markers
+				continue
+			}
+			if err := fn(object{c.Key, c.Size, c.LastModified}); err != nil {
+				return err
+			}
+		}
+		if !l.IsTruncated || l.NextContinuationToken == "" {
+			return nil
+		}
+		token = l.NextContinuationToken
+	}
+}
+
+// RoundTrip signs requests with AWS Signature Version 4 (if credentials are set)
+func (s *s3) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", config.UserAgent())
+	if s.key != "" {
+		s.sign(req, time.Now().UTC(), "UNSIGNED-PAYLOAD")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (s *s3) sign(req *http.Request, now time.Time, payload string) {
+	amzDate := now.Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payload)
+	if s.token != "" {
+		req.Header.Set("x-amz-security-token", s.token)
+	}
+	headers := []string{"host"}
+	if req.Header.Get("Range") != "" {
+		headers = append(headers, "range")
+	}
+	headers = append(headers, "x-amz-content-sha256", "x-amz-date")
+	if s.token != "" {
+		headers = append(headers, "x-amz-security-token")
+	}
+	var canonHeaders strings.Builder
+	for _, h := range headers {
+		v := req.Header.Get(h)
+		if h == "host" {
+			v = req.URL.Host
+		}
+		canonHeaders.WriteString(h + ":" + strings.TrimSpace(v) + "\n")
+	}
+	signed := strings.Join(headers, ";")
+	canonical := strings.Join([]string{
+		req.Method,
+		awsEscape(req.URL.Path, true),
+		awsQuery(req.URL.Query()),
+		canonHeaders.String(),
+		signed,
+		payload,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	sum := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+	k := hmacSHA256([]byte("AWS4"+s.secret), date)
+	k = hmacSHA256(k, s.region)
+	k = hmacSHA256(k, "s3")
+	k = hmacSHA256(k, "aws4_request")
+	sig := hex.EncodeToString(hmacSHA256(k, toSign))
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.key+"/"+scope+", SignedHeaders="+signed+", Signature="+sig)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsEscape URI encodes every byte except the unreserved characters (and, optionally, slashes)
+func awsEscape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && keepSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func awsQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vals := append([]string(nil), q[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, awsEscape(k, false)+"="+awsEscape(v, false))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// Google Cloud Storage
+
+type gcs struct {
+	bucket   string
+	endpoint string
+	token    string
+}
+
+func (g *gcs) url(key string) string {
+	return g.endpoint + "/" + g.bucket + "/" + awsEscape(key, true)
+}
+
+func (g *gcs) path(key string) string {
+	return "gs://" + g.bucket + "/" + key
+}
+
+type gcsList struct {
+	NextPageToken string `json:"nextPageToken"`
+	Items         []struct {
+		Name    string    `json:"name"`
+		Size    string    `json:"size"`
+		Updated time.Time `json:"updated"`
+	} `json:"items"`
+}
+
+func (g *gcs) list(prefix string, recurse bool, fn func(object) error) error {
+	var token string
+	client := &http.Client{Transport: g}
+	for {
+		q := url.Values{"prefix": {prefix}, "fields": {"nextPageToken,items(name,size,updated)"}}
+		if !recurse {
+			q.Set("delimiter", "/")
+		}
+		if token != "" {
+			q.Set("pageToken", token)
+		}
+		resp, err := client.Get(g.endpoint + "/storage/v1/b/" + url.PathEscape(g.bucket) + "/o?" + q.Encode())
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = readStoreError(resp)
+			resp.Body.Close()
+			return err
+		}
+		var l gcsList
+		err = json.NewDecoder(resp.Body).Decode(&l)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, item := range l.Items {
+			if strings.HasSuffix(item.Name, "/") {
+				continue
+			}
+			sz, _ := strconv.ParseInt(item.Size, 10, 64)
+			if err := fn(object{item.Name, sz, item.Updated}); err != nil {
+				return err
+			}
+		}
+		if l.NextPageToken == "" {
+			return nil
+		}
+		token = l.NextPageToken
+	}
+}
+
+// RoundTrip adds a bearer token (if set)
+func (g *gcs) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", config.UserAgent())
+	if g.token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// Azure Blob Storage
+
+type azure struct {
+	account, container string
+	endpoint           string
+	sas                string
+}
+
+func (a *azure) url(key string) string {
+	return a.endpoint + "/" + a.container + "/" + awsEscape(key, true)
+}
+
+func (a *azure) path(key string) string {
+	return "az://" + a.account + "/" + a.container + "/" + key
+}
+
+type azureList struct {
+	Blobs []struct {
+		Name       string
+		Properties struct {
+			LastModified  string `xml:"Last-Modified"`
+			ContentLength int64  `xml:"Content-Length"`
+		}
+	} `xml:"Blobs>Blob"`
+	NextMarker string
+}
+
+func (a *azure) list(prefix string, recurse bool, fn func(object) error) error {
+	var marker string
+	client := &http.Client{Transport: a}
+	for {
+		q := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if !recurse {
+			q.Set("delimiter", "/")
+		}
+		if marker != "" {
+			q.Set("marker", marker)
+		}
+		resp, err := client.Get(a.endpoint + "/" + a.container + "?" + q.Encode())
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = readStoreError(resp)
+			resp.Body.Close()
+			return err
+		}
+		var l azureList
+		err = xml.NewDecoder(resp.Body).Decode(&l)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, b := range l.Blobs {
+			mod, _ := http.ParseTime(b.Properties.LastModified)
+			if err := fn(object{b.Name, b.Properties.ContentLength, mod}); err != nil {
+				return err
+			}
+		}
+		if l.NextMarker == "" {
+			return nil
+		}
+		marker = l.NextMarker
+	}
+}
+
+// RoundTrip adds the SAS token (if set) and an API version that supports range requests
+func (a *azure) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", config.UserAgent())
+	req.Header.Set("x-ms-version", "2020-04-08")
+	if a.sas != "" {
+		if req.URL.RawQuery == "" {
+			req.URL.RawQuery = a.sas
+		} else {
+			req.URL.RawQuery += "&" + a.sas
+		}
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
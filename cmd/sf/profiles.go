@@ -0,0 +1,107 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// A profile is a signature file in the home directory, named without its .sig extension
+// e.g. `roy build media.sig` makes a profile selected with `sf -profile media`.
+const profileExt = ".sig"
+
+// profiles returns the names of the profiles in the home directory, sorted.
+func profiles() ([]string, error) {
+	fis, err := ioutil.ReadDir(config.Home())
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, fi := range fis {
+		if fi.Mode().IsRegular() && filepath.Ext(fi.Name()) == profileExt {
+			names = append(names, strings.TrimSuffix(fi.Name(), profileExt))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// setProfile makes the named profile the signature file, checking that it exists.
+func setProfile(name string) error {
+	names, err := profiles()
+	if err != nil {
+		return err
+	}
+	for _, n := range names {
+		if n == name {
+			config.SetSignature(name + profileExt)
+			return nil
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no profile %s: there are no signature files in %s", name, config.Home())
+	}
+	return fmt.Errorf("no profile %s in %s; choose from %s (see sf profiles)", name, config.Home(), strings.Join(names, ", "))
+}
+
+// listProfiles describes each profile in the home directory for the `sf profiles` command.
+// The profile in use (set by -profile, -sig or the configuration file) is marked with an asterisk.
+func listProfiles() (string, error) {
+	names, err := profiles()
+	if err != nil {
+		return "", err
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("No profiles (signature files) in %s\n", config.Home()), nil
+	}
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "Profiles in %s:\n", config.Home())
+	for _, name := range names {
+		mark := " "
+		if name+profileExt == config.SignatureBase() {
+			mark = "*"
+		}
+		fmt.Fprintf(buf, "%s %s\n", mark, name)
+		s, err := siegfried.Load(filepath.Join(config.Home(), name+profileExt))
+		if err != nil {
+			fmt.Fprintf(buf, "    error       : %v\n", err)
+			continue
+		}
+		fmt.Fprintf(buf, "    created     : %s\n", s.C.Format(time.RFC3339))
+		for _, id := range s.Identifiers() {
+			fmt.Fprintf(buf, "    identifier  : %s (%s)\n", id[0], id[1])
+		}
+	}
+	return buf.String(), nil
+}
+
+// isProfilesCommand reports whether sf was run as `sf profiles`, rather than to scan a file or directory named profiles.
+func isProfilesCommand(args []string) bool {
+	if len(args) != 1 || args[0] != "profiles" {
+		return false
+	}
+	_, err := os.Stat(args[0])
+	return os.IsNotExist(err)
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const progressInterval = 5 * time.Second
+
+// progress periodically reports the rate of a scan (-progress). It counts the files read from disk
+// (files within archives are included in the scanning time of their archive, not counted separately).
+// A nil *progress reports nothing.
+type progress struct {
+	w     io.Writer
+	start time.Time
+	// totals from the pre-walk (set once counted is true)
+	counted      int32
+	total, bytes int64
+	// scanned so far
+	files, sz int64
+	mu        sync.Mutex
+	current   string
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+var prog *progress
+
+// newProgress starts reporting to w. The files and directories in paths are counted in the background so that an ETA can be given.
+func newProgress(w io.Writer, paths []string, list, norecurse bool) *progress {
+	p := &progress{
+		w:     w,
+		start: time.Now(),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go p.count(paths, list, norecurse)
+	go func() {
+		t := time.NewTicker(progressInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				p.report()
+			case <-p.stop:
+				close(p.done)
+				return
+			}
+		}
+	}()
+	return p
+}
+
+// count does a pre-walk of the paths, totalling the number and size of regular files
+func (p *progress) count(paths []string, list, norecurse bool) {
+	var total, bytes int64
+	walk := func(root string) {
+		filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			select {
+			case <-p.stop:
+				return io.EOF // abandon the count if the scan has finished
+			default:
+			}
+			if err != nil {
+				return nil
+			}
+			if info.IsDir() {
+				if norecurse && path != root || path != root && walkFilter.skipDir(relPath(root, path)) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if path != root && walkFilter.skip(relPath(root, path), info.Size(), info.ModTime()) {
+				return nil
+			}
+			if info.Mode().IsRegular() {
+				total++
+				bytes += info.Size()
+			}
+			return nil
+		})
+	}
+	for _, v := range paths {
+		if !list {
+			walk(v)
+			continue
+		}
+		f, err := os.Open(v)
+		if err != nil {
+			continue
+		}
+		scanner := listScanner(f)
+		for scanner.Scan() {
+			if scanner.Text() != "" {
+				walk(scanner.Text())
+			}
+		}
+		f.Close()
+	}
+	p.total, p.bytes = total, bytes
+	atomic.StoreInt32(&p.counted, 1)
+}
+
+func (p *progress) begin(path string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.current = path
+	p.mu.Unlock()
+}
+
+func (p *progress) end(sz int64) {
+	if p == nil {
+		return
+	}
+	atomic.AddInt64(&p.files, 1)
+	if sz > 0 {
+		atomic.AddInt64(&p.sz, sz)
+	}
+}
+
+func (p *progress) report() {
+	files, sz := atomic.LoadInt64(&p.files), atomic.LoadInt64(&p.sz)
+	secs := time.Since(p.start).Seconds()
+	p.mu.Lock()
+	current := p.current
+	p.mu.Unlock()
+	msg := fmt.Sprintf("%d files", files)
+	var eta time.Duration
+	if atomic.LoadInt32(&p.counted) == 1 && p.total > 0 {
+		msg = fmt.Sprintf("%d/%d files (%.0f%%), %s/%s", files, p.total, 100*float64(files)/float64(p.total), byteSize(sz), byteSize(p.bytes))
+		switch {
+		case p.bytes > 0 && sz > 0:
+			eta = time.Duration(float64(p.bytes-sz) / (float64(sz) / secs) * float64(time.Second))
+		case files > 0:
+			eta = time.Duration(float64(p.total-files) / (float64(files) / secs) * float64(time.Second))
+		}
+	} else {
+		msg += fmt.Sprintf(", %s (counting files)", byteSize(sz))
+	}
+	msg += fmt.Sprintf(", %.1f files/s, %s/s", float64(files)/secs, byteSize(int64(float64(sz)/secs)))
+	if eta > 0 {
+		msg += ", ETA " + eta.Round(time.Second).String()
+	}
+	if current != "" {
+		msg += ": " + current
+	}
+	fmt.Fprintf(p.w, "[PROGRESS] %s\n", msg)
+}
+
+// close stops reporting and writes a final summary
+func (p *progress) close() {
+	if p == nil {
+		return
+	}
+	close(p.stop)
+	<-p.done
+	p.mu.Lock()
+	p.current = ""
+	p.mu.Unlock()
+	p.report()
+}
+
+func byteSize(i int64) string {
+	switch {
+	case i >= 1<<30:
+		return fmt.Sprintf("%.1f GB", float64(i)/(1<<30))
+	case i >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(i)/(1<<20))
+	case i >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(i)/(1<<10))
+	}
+	return fmt.Sprintf("%d bytes", i)
+}
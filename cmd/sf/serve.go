@@ -20,12 +20,14 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/richardlehane/siegfried"
 	"github.com/richardlehane/siegfried/internal/checksum"
 	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/decompress"
 	"github.com/richardlehane/siegfried/pkg/writer"
 )
 
@@ -49,66 +51,45 @@ func decodePath(s, b64 string) (string, error) {
 	return s[10:], nil
 }
 
-func parseRequest(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfried, wg *sync.WaitGroup) (error, string, writer.Writer, bool, bool, bool, checksum.HashTyp, *siegfried.Siegfried, getFn) {
-	// json, csv, droid or yaml
-	paramsErr := func(field, expect string) (error, string, writer.Writer, bool, bool, bool, checksum.HashTyp, *siegfried.Siegfried, getFn) {
-		return fmt.Errorf("bad request; in param %s got %s; valid values %s", field, r.FormValue(field), expect), "", nil, false, false, false, -1, nil, nil
+func parseRequest(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfried, wg *sync.WaitGroup) (error, string, writer.Writer, bool, bool, bool, checksum.HashTyps, *siegfried.Siegfried, getFn) {
+	// the output format (yaml, json, jsonl, csv, droid or a registered format)
+	paramsErr := func(field, expect string) (error, string, writer.Writer, bool, bool, bool, checksum.HashTyps, *siegfried.Siegfried, getFn) {
+		return fmt.Errorf("bad request; in param %s got %s; valid values %s", field, r.FormValue(field), expect), "", nil, false, false, false, nil, nil, nil
 	}
 	var (
 		mime string
 		wr   writer.Writer
 		d    bool
-		frmt int
+		frmt = "yaml"
 	)
 	switch {
+	case *formatf != "":
+		frmt = *formatf
 	case *jsono:
-		frmt = 1
+		frmt = "json"
 	case *csvo:
-		frmt = 2
+		frmt = "csv"
 	case *droido:
-		frmt = 3
+		frmt = "droid"
+	case *jsonlo:
+		frmt = "jsonl"
 	}
 	if v := r.FormValue("format"); v != "" {
-		switch v {
-		case "yaml":
-			frmt = 0
-		case "json":
-			frmt = 1
-		case "csv":
-			frmt = 2
-		case "droid":
-			frmt = 3
-		default:
-			return paramsErr("format", "yaml, json, csv or droid")
+		if _, ok := writer.Lookup(v); !ok {
+			return paramsErr("format", strings.Join(writer.Formats(), ", "))
 		}
+		frmt = v
 	}
 	if accept := r.Header.Get("Accept"); accept != "" {
-		switch accept {
-		case "application/x-yaml":
-			frmt = 0
-		case "application/json":
-			frmt = 1
-		case "text/csv", "application/csv":
-			frmt = 2
-		case "application/x-droid":
-			frmt = 3
+		if accept == "application/csv" {
+			accept = "text/csv"
+		}
+		if f, ok := writer.LookupMIME(accept); ok {
+			frmt = f.Name
 		}
 	}
-	switch frmt {
-	case 0:
-		wr = writer.YAML(w)
-		mime = "application/x-yaml"
-	case 1:
-		wr = writer.JSON(w)
-		mime = "application/json"
-	case 2:
-		wr = writer.CSV(w)
-		mime = "text/csv"
-	case 3:
-		wr = writer.Droid(w)
-		d = true
-		mime = "application/x-droid"
-	}
+	f, _ := writer.Lookup(frmt)
+	wr, mime, d = f.New(w), f.MIME, f.Name == "droid"
 	// no recurse
 	norec := *nr
 	if v := r.FormValue("nr"); v != "" {
@@ -150,12 +131,12 @@ func parseRequest(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfried
 	if v := r.FormValue("hash"); v != "" {
 		h = v
 	}
-	ht := checksum.GetHash(h)
+	ht, _ := checksum.GetHashes(h)
 	// sig
 	sf := s
 	if v := r.FormValue("sig"); v != "" {
 		if _, err := os.Stat(config.Local(v)); err != nil {
-			return fmt.Errorf("bad request; sig param should be path to a signature file (absolute or relative to home); got %v", err), "", nil, false, false, false, -1, nil, nil
+			return fmt.Errorf("bad request; sig param should be path to a signature file (absolute or relative to home); got %v", err), "", nil, false, false, false, nil, nil, nil
 		}
 		nsf, err := siegfried.Load(config.Local(v))
 		if err == nil {
@@ -165,7 +146,7 @@ func parseRequest(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfried
 	gf := func(path, mime string, mod time.Time, sz int64) *context {
 		c := ctxPool.Get().(*context)
 		c.path, c.mime, c.mod, c.sz = path, mime, mod, sz
-		c.s, c.wg, c.w, c.d, c.z, c.h = sf, wg, wr, d, z, checksum.MakeHash(ht)
+		c.s, c.wg, c.w, c.d, c.z, c.h = sf, wg, wr, d, z, checksum.MakeHashes(ht)
 		return c
 	}
 	return nil, mime, wr, coerr, norec, d, ht, sf, gf
@@ -199,7 +180,7 @@ func handleIdentify(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfri
 			sz = r.ContentLength
 		}
 		w.Header().Set("Content-Type", mime)
-		wr.Head(config.SignatureBase(), time.Now(), sf.C, config.Version(), sf.Identifiers(), sf.Fields(), ht.String())
+		wr.Head(signaturePath(config.SignatureBase()), time.Now(), sf.C, config.Version(), sf.Identifiers(), sf.Fields(), ht.String())
 		wg.Add(1)
 		ctx := gf(h.Filename, "", mod, sz)
 		ctxts <- ctx
@@ -217,7 +198,7 @@ func handleIdentify(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfri
 			return
 		}
 		w.Header().Set("Content-Type", mime)
-		wr.Head(config.SignatureBase(), time.Now(), sf.C, config.Version(), sf.Identifiers(), sf.Fields(), ht.String())
+		wr.Head(signaturePath(config.SignatureBase()), time.Now(), sf.C, config.Version(), sf.Identifiers(), sf.Fields(), ht.String())
 		err = identify(ctxts, path, "", coerr, nrec, d, gf)
 		wg.Wait()
 		wr.Tail()
@@ -230,6 +211,51 @@ func handleIdentify(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfri
 	}
 }
 
+func handleMail(w http.ResponseWriter, r *http.Request, s *siegfried.Siegfried, ctxts chan *context) {
+	if r.Method != "POST" {
+		handleErr(w, http.StatusMethodNotAllowed, fmt.Errorf("/mail only accepts POST requests"))
+		return
+	}
+	wg := &sync.WaitGroup{}
+	err, mime, wr, _, _, _, ht, sf, gf := parseRequest(w, r, s, wg)
+	if err != nil {
+		handleErr(w, http.StatusNotFound, err)
+		return
+	}
+	// the message is either the request body or attached as form-data
+	var (
+		msg  io.Reader = r.Body
+		name           = "message"
+	)
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "message/rfc822") {
+		f, h, err := r.FormFile("file")
+		if err != nil {
+			handleErr(w, http.StatusNotFound, err)
+			return
+		}
+		defer f.Close()
+		msg, name = f, h.Filename
+	}
+	d, err := decompress.NewMail(msg, name)
+	if err != nil {
+		handleErr(w, http.StatusBadRequest, fmt.Errorf("bad message: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", mime)
+	wr.Head(signaturePath(config.SignatureBase()), time.Now(), sf.C, config.Version(), sf.Identifiers(), sf.Fields(), ht.String())
+	for err = d.Next(); err == nil; err = d.Next() {
+		ctx := gf(d.Path(), d.MIME(), d.Mod(), d.Size())
+		wg.Add(1)
+		ctxts <- ctx
+		identifyRdr(d.Reader(), ctx, ctxts, gf)
+	}
+	if err != io.EOF {
+		printFile(ctxts, gf(decompress.Arcpath(name, ""), "", time.Time{}, 0), fmt.Errorf("error occurred reading message: %v", err))
+	}
+	wg.Wait()
+	wr.Tail()
+}
+
 const usage = `
 	<html>
 		<head>
@@ -239,7 +265,8 @@ const usage = `
 			<h1><a name="top">Siegfried server usage</a></h1>
 			<p>The siegfried server has two modes of identification:
 			<ul><li><a href="#get_request">GET request</a>, where a file or directory path is given in the URL and the server retrieves the file(s);</li>
-			<li><a href="#post_request">POST request</a>, where the file is sent over the network as form-data.</li></ul></p> 
+			<li><a href="#post_request">POST request</a>, where the file is sent over the network as form-data;</li>
+			<li><a href="#mail_request">mail POST request</a>, where an email message is sent and its attachments are identified.</li></ul></p> 
 			<h2>Default settings</h2>
 			<p>When starting the server, you can use regular sf flags to set defaults for the <i>nr</i>, <i>format</i>, <i>hash</i>, <i>z</i>, and <i>sig</i> parameters that will apply to all requests unless overridden. Logging options can also be set.<p>
 			<p>E.g. sf -nr -z -hash md5 -sig pronom-tika.sig -log p,w,e -serve localhost:5138</p>
@@ -251,9 +278,9 @@ const usage = `
 			<p><i>base64</i> (optional) - use <a href="https://tools.ietf.org/html/rfc4648#section-5">URL-safe base64 encoding</a> for the file or folder name with base64=true.</p>
 			<p><i>coe</i> (optional) - continue directory scans even when fatal file access errors are encountered with coe=true.</p>
 			<p><i>nr</i> (optional) - stop sub-directory recursion when a directory path is given with nr=true.</p>
-			<p><i>format</i> (optional) - select the output format (csv, yaml, json, droid). Default is yaml. Alternatively, HTTP content negotiation can be used.</p>
-			<p><i>hash</i> (optional) - calculate file checksum (md5, sha1, sha256, sha512, crc)</p>
-			<p><i>z</i> (optional) - scan archive formats (zip, tar, gzip, warc, arc) with z=true. Default is false.</p>
+			<p><i>format</i> (optional) - select the output format (csv, yaml, json, jsonl, droid). Default is yaml. Alternatively, HTTP content negotiation can be used.</p>
+			<p><i>hash</i> (optional) - calculate file checksum (md5, sha1, sha256, sha512, crc, crc32, blake2b, blake3; comma-separate to calculate more than one e.g. md5,sha256)</p>
+			<p><i>z</i> (optional) - scan archive formats (zip, tar, gzip, bzip2, xz, zstd, warc, arc, eml, msg) with z=true. Default is false.</p>
 			<p><i>sig</i> (optional) - load a specific signature file. Default is default.sig.</p>
 			<h3>Example</h2>
 			<!-- set the get target for the example form using js function at bottom page-->
@@ -266,6 +293,7 @@ const usage = `
 			 <p>No directory recursion (nr): <input type="radio" name="nr" value="true"> true <input type="radio" name="nr" value="false" checked> false</p>
 			 <p>Format (format): <select name="format">
   				<option value="json">json</option>
+  				<option value="jsonl">jsonl</option>
   				<option value="yaml">yaml</option>
   				<option value="csv">csv</option>
  				<option value="droid">droid</option>
@@ -277,6 +305,9 @@ const usage = `
  				<option value="sha256">sha256</option>
  				<option value="sha512">sha512</option>
  				<option value="crc">crc</option>
+ 				<option value="crc32">crc32</option>
+ 				<option value="blake2b">blake2b</option>
+ 				<option value="blake3">blake3</option>
 			</select></p>
 			 <p>Scan archive (z): <input type="radio" name="z" value="true"> true <input type="radio" name="z" value="false" checked> false</p>
 			 <p>Signature file (sig): <input type="text" name="sig"></p>
@@ -288,9 +319,9 @@ const usage = `
 			<p><strong>POST</strong> <i>/identify(?format=yaml&hash=md5&z=true&sig=locfdd.sig)</i> Attach a file as form-data with the key "file".</p>
 			<p>E.g. curl "http://localhost:5138/identify?format=json&hash=crc" -F file=@myfile.doc</p>
 			<h3>Parameters</h3>
-			<p><i>format</i> (optional) - select the output format (csv, yaml, json, droid). Default is yaml. Alternatively, HTTP content negotiation can be used.</p>
-			<p><i>hash</i> (optional) - calculate file checksum (md5, sha1, sha256, sha512, crc)</p>
-			<p><i>z</i> (optional) - scan archive formats (zip, tar, gzip, warc, arc) with z=true. Default is false.</p>
+			<p><i>format</i> (optional) - select the output format (csv, yaml, json, jsonl, droid). Default is yaml. Alternatively, HTTP content negotiation can be used.</p>
+			<p><i>hash</i> (optional) - calculate file checksum (md5, sha1, sha256, sha512, crc, crc32, blake2b, blake3; comma-separate to calculate more than one e.g. md5,sha256)</p>
+			<p><i>z</i> (optional) - scan archive formats (zip, tar, gzip, bzip2, xz, zstd, warc, arc, eml, msg) with z=true. Default is false.</p>
 			<p><i>sig</i> (optional) - load a specific signature file. Default is default.sig.</p>
 			<h3>Example</h2>
 			<form action="/identify" enctype="multipart/form-data" method="post">
@@ -299,6 +330,7 @@ const usage = `
 			 <h4>Parameters:</h4>
 			 <p>Format (format): <select name="format">
   				<option value="json">json</option>
+  				<option value="jsonl">jsonl</option>
   				<option value="yaml">yaml</option>
   				<option value="csv">csv</option>
  				<option value="droid">droid</option>
@@ -310,12 +342,26 @@ const usage = `
  				<option value="sha256">sha256</option>
  				<option value="sha512">sha512</option>
  				<option value="crc">crc</option>
+ 				<option value="crc32">crc32</option>
+ 				<option value="blake2b">blake2b</option>
+ 				<option value="blake3">blake3</option>
 			</select></p>
 			 <p>Scan archive (z): <input type="radio" name="z" value="true"> true <input type="radio" name="z" value="false" checked> false</p>
 			 <p>Signature file (sig): <input type="text" name="sig"></p>
 			 <p><input type="submit" value="Submit"></p>
 			</form>
 			<p><a href="#top">Back to top</p>
+			<hr>
+			<h2><a name="mail_request">Mail POST request</a></h2>
+			<p><strong>POST</strong> <i>/mail(?format=yaml&hash=md5&z=true&sig=locfdd.sig)</i> Send an RFC 822/MIME message as the request body with the content-type message/rfc822, or attach it as form-data with the key "file".</p>
+			<p>Each attachment is identified and reported with a path of the form message#filename. The declared filename and content-type of each attachment are used as hints. Attached messages are identified but not opened.</p>
+			<p>E.g. curl "http://localhost:5138/mail?format=json" -H "Content-Type: message/rfc822" --data-binary @message.eml</p>
+			<h3>Parameters</h3>
+			<p><i>format</i> (optional) - select the output format (csv, yaml, json, jsonl, droid). Default is yaml. Alternatively, HTTP content negotiation can be used.</p>
+			<p><i>hash</i> (optional) - calculate file checksum (md5, sha1, sha256, sha512, crc, crc32, blake2b, blake3; comma-separate to calculate more than one e.g. md5,sha256)</p>
+			<p><i>z</i> (optional) - scan archive formats (zip, tar, gzip, bzip2, xz, zstd, warc, arc, eml, msg) attached to the message with z=true. Default is false.</p>
+			<p><i>sig</i> (optional) - load a specific signature file. Default is default.sig.</p>
+			<p><a href="#top">Back to top</p>
 			<script>
 				var input = document.getElementById('filename');
 				input.addEventListener('input', function()
@@ -347,7 +393,11 @@ func (m *muxer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		handleIdentify(w, r, m.s, m.ctxts)
 		return
 	}
-	handleErr(w, http.StatusNotFound, fmt.Errorf("valid paths are /, /identify and /identify/*"))
+	if r.URL.Path == "/mail" {
+		handleMail(w, r, m.s, m.ctxts)
+		return
+	}
+	handleErr(w, http.StatusNotFound, fmt.Errorf("valid paths are /, /identify, /identify/* and /mail"))
 	return
 }
 
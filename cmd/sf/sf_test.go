@@ -1,19 +1,32 @@
 package main
 
 import (
+	"archive/zip"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/richardlehane/siegfried"
+	"github.com/richardlehane/siegfried/internal/checksum"
+	"github.com/richardlehane/siegfried/internal/logger"
 	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
 	"github.com/richardlehane/siegfried/pkg/pronom"
+	"github.com/richardlehane/siegfried/pkg/writer"
 )
 
 var (
@@ -326,3 +339,646 @@ func BenchmarkMulti(bench *testing.B) {
 		multiIdentifyT(s, dir)
 	}
 }
+
+func TestMatcherFlags(t *testing.T) {
+	args, dis := splitMatcherFlags([]string{"-csv", "-pronom.noext", "--tika.nomagic", "-sig", "deluxe.sig", "-pronom.bogus", "dir", "--", "-loc.nomime"})
+	if strings.Join(args, " ") != "-csv -sig deluxe.sig -pronom.bogus dir -- -loc.nomime" {
+		t.Errorf("bad args, got %v", args)
+	}
+	if len(dis) != 2 || dis[0] != (disabled{"pronom", core.NameMatcher}) || dis[1] != (disabled{"tika", core.ByteMatcher}) {
+		t.Errorf("bad disabled matchers, got %v", dis)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := &progress{w: buf, start: time.Now().Add(-10 * time.Second), counted: 1, total: 4, bytes: 4000}
+	p.begin("a.txt")
+	p.end(1000)
+	p.report()
+	out := buf.String()
+	if !strings.HasPrefix(out, "[PROGRESS] 1/4 files (25%), 1000 bytes/3.9 KB") || !strings.Contains(out, "ETA 30s: a.txt") {
+		t.Errorf("bad progress report, got %s", out)
+	}
+}
+
+func TestListScanner(t *testing.T) {
+	for _, v := range []struct {
+		in     string
+		expect []string
+	}{
+		{"a.txt\nb c.txt\r\n\nd.txt", []string{"a.txt", "b c.txt", "", "d.txt"}},
+		{"./a\nb.txt\x00./c.txt\x00", []string{"./a\nb.txt", "./c.txt"}},
+		{"", nil},
+	} {
+		var got []string
+		scanner := listScanner(strings.NewReader(v.in))
+		for scanner.Scan() {
+			got = append(got, scanner.Text())
+		}
+		if strings.Join(got, "|") != strings.Join(v.expect, "|") || len(got) != len(v.expect) {
+			t.Errorf("%q: expecting %q, got %q", v.in, v.expect, got)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	f, err := newFilter("*.docx, docs/**/*.pdf, size<100MB, mtime>=2020-01-01", ".git,**/tmp/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recent, old := time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2019, 1, 1, 0, 0, 0, 0, time.Local)
+	for _, v := range []struct {
+		rel  string
+		sz   int64
+		mod  time.Time
+		skip bool
+	}{
+		{"a/b/report.docx", 1000, recent, false},
+		{"docs/report.pdf", 1000, recent, false},
+		{"docs/x/y/report.pdf", 1000, recent, false},
+		{"other/report.pdf", 1000, recent, true},
+		{"report.docx", 1000, old, true},
+		{"report.docx", 200 << 20, recent, true},
+		{"a/tmp/report.docx", 1000, recent, true},
+	} {
+		if skip := f.skip(v.rel, v.sz, v.mod); skip != v.skip {
+			t.Errorf("%s (%d bytes, %v): expecting skip to be %v", v.rel, v.sz, v.mod, v.skip)
+		}
+	}
+	if !f.skipDir("a/.git") || f.skipDir("a/tmp") {
+		t.Error("expecting .git, and not tmp, to be skipped")
+	}
+	for _, bad := range []string{"[a", "size<lots", "mtime>yesterday"} {
+		if _, err := newFilter(bad, ""); err == nil {
+			t.Errorf("expecting an error for %q", bad)
+		}
+	}
+}
+
+func TestFilterEntries(t *testing.T) {
+	f, err := newFilter("*.docx, size<100MB", ".git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []struct {
+		rel  string
+		sz   int64
+		skip bool
+	}{
+		{"proj/report.docx", 1000, false},
+		{"proj/.git/logs/report.docx", 1000, true},
+		{"proj/report.txt", 1000, true},
+		{"report.docx", -1, false}, // unknown size e.g. within a bzip2 stream
+	} {
+		if skip := f.skipEntry(v.rel, v.sz, time.Time{}); skip != v.skip {
+			t.Errorf("%s (%d bytes): expecting skip to be %v", v.rel, v.sz, v.skip)
+		}
+	}
+	if rel := entryPath("a/b.tar", "a/b.tar#proj/report.docx"); rel != "proj/report.docx" {
+		t.Errorf("expecting the path within the archive, got %s", rel)
+	}
+}
+
+func TestFilterSince(t *testing.T) {
+	f, err := newFilter("*.docx", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.setSince("2020-01-01", ""); err != nil {
+		t.Fatal(err)
+	}
+	recent, old := time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2019, 1, 1, 0, 0, 0, 0, time.Local)
+	if f.skip("report.docx", 1000, recent) || !f.skip("report.docx", 1000, old) {
+		t.Error("expecting files modified before -since to be skipped")
+	}
+	// the entries of a modified archive are all scanned
+	if f.skipEntry("report.docx", 1000, old) {
+		t.Error("expecting -since not to apply to archive entries")
+	}
+	if err := f.setSince("48h", ""); err != nil || f.since.t.After(time.Now().Add(-47*time.Hour)) {
+		t.Errorf("expecting -since 48h to be two days ago, got %v (%v)", f.since.t, err)
+	}
+	ref, err := ioutil.TempFile("", "sfnewer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref.Close()
+	defer os.Remove(ref.Name())
+	info, _ := os.Stat(ref.Name())
+	if err := f.setSince("", ref.Name()); err != nil {
+		t.Fatal(err)
+	}
+	if !f.skip("report.docx", 1000, info.ModTime()) || f.skip("report.docx", 1000, info.ModTime().Add(time.Second)) {
+		t.Error("expecting files not modified after the -newer file to be skipped")
+	}
+	for _, bad := range [][2]string{{"yesterday", ""}, {"", "missing.file"}, {"24h", ref.Name()}} {
+		if err := f.setSince(bad[0], bad[1]); err == nil {
+			t.Errorf("expecting an error for %v", bad)
+		}
+	}
+}
+
+func TestBenchmark(t *testing.T) {
+	b := newBenchmark(3)
+	for i, d := range []time.Duration{5, 1, 9, 3, 7} {
+		b.add(fmt.Sprintf("file%d", i), 10, d)
+	}
+	if b.files != 5 || b.bytes != 50 {
+		t.Errorf("expecting 5 files and 50 bytes, got %d and %d", b.files, b.bytes)
+	}
+	if len(b.slowest) != 3 || b.slowest[0].path != "file2" || b.slowest[1].path != "file4" || b.slowest[2].path != "file0" {
+		t.Errorf("expecting the three slowest files, slowest first, got %v", b.slowest)
+	}
+	bench.add("nil", 0, 0) // a nil benchmark records nothing
+}
+
+func TestArchiveLimits(t *testing.T) {
+	defer config.SetArchiveLimits(0, 0, 0)
+	if newExtraction(10) != nil || tooDeep(5) {
+		t.Fatal("expecting no limits by default")
+	}
+	config.SetArchiveLimits(2, 0, 100)
+	if tooDeep(1) || !tooDeep(2) {
+		t.Error("expecting archives nested 2 deep not to be decompressed")
+	}
+	ext := newExtraction(10)
+	n, _ := io.Copy(ioutil.Discard, ext.reader(bytes.NewReader(make([]byte, 1000))))
+	if err := ext.exceeded(); err == nil || !strings.Contains(err.Error(), "-ztotal") {
+		t.Errorf("expecting the -ztotal limit to be exceeded, got %v", err)
+	}
+	if n > 1000 || n < 100 {
+		t.Errorf("expecting reads to stop once the limit is exceeded, read %d bytes", n)
+	}
+	config.SetArchiveLimits(0, 5, 0)
+	ext = newExtraction(10)
+	io.Copy(ioutil.Discard, ext.reader(bytes.NewReader(make([]byte, 49))))
+	if err := ext.exceeded(); err != nil {
+		t.Errorf("expecting the -zratio limit not to be exceeded, got %v", err)
+	}
+	io.Copy(ioutil.Discard, ext.reader(bytes.NewReader(make([]byte, 2))))
+	if err := ext.exceeded(); err == nil {
+		t.Error("expecting the -zratio limit to be exceeded")
+	}
+}
+
+// recorder is a writer that records the last warning reported for each file
+type recorder map[string]core.Warning
+
+func (r recorder) Head(string, time.Time, time.Time, [3]int, [][2]string, [][]string, string) {}
+
+func (r recorder) File(name string, sz int64, mod string, cs []byte, err error, ids []core.Identification) {
+	var warn core.Warning
+	if len(ids) > 0 {
+		warn.Msg = ids[0].Warn()
+		if codes := core.WarnCodes(ids[0]); len(codes) > 0 {
+			warn.Code = codes[len(codes)-1]
+		}
+	}
+	r[name] = warn
+}
+
+func (r recorder) Tail() {}
+
+// zipOf returns a zip archive holding n copies of a file, named name, name1, name2 etc.
+func zipOf(name string, n int, content []byte) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for i := 0; i < n; i++ {
+		nm := name
+		if i > 0 {
+			nm = fmt.Sprintf("%d%s", i, name)
+		}
+		w, _ := zw.Create(nm)
+		w.Write(content)
+	}
+	zw.Close()
+	return buf.Bytes()
+}
+
+// Contexts are reused once printed, which may be while the entries of an archive are still being identified.
+// Check that the -zdepth limit holds for nested archives when contexts are reused.
+func TestArchiveDepth(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "zdepth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// an archive with many nested archives in it, so that its context is likely to be printed and reused for one of them
+	nested := zipOf("b.zip", 1, zipOf("c.zip", 8, zipOf("t.txt", 1, []byte("hello world"))))
+	for i := 0; i < 16; i++ {
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("a%d.zip", i)), nested, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config.SetArchiveFilterPermissive(config.ListAllArcTypes())
+	config.SetArchiveLimits(2, 0, 0)
+	oldMulti := *multi
+	defer func() {
+		config.SetArchiveFilterPermissive("")
+		config.SetArchiveLimits(0, 0, 0)
+		*multi = oldMulti
+	}()
+	*multi = 4
+	lg, _ := logger.New("")
+	rec := recorder{}
+	wg := &sync.WaitGroup{}
+	setCtxPool(s, wg, rec, false, true, checksum.HashTyps{})
+	ctxts := make(chan *context, *multi)
+	printed := make(chan struct{})
+	go func() {
+		printer(ctxts, lg)
+		close(printed)
+	}()
+	infos, _ := ioutil.ReadDir(dir)
+	for _, info := range infos {
+		identifyFile(getCtx(filepath.Join(dir, info.Name()), "", info.ModTime(), info.Size()), ctxts, getCtx)
+	}
+	wg.Wait()
+	close(ctxts)
+	<-printed
+	var deep int
+	for name, warn := range rec {
+		switch {
+		case strings.HasSuffix(name, "t.txt"):
+			t.Errorf("expecting archives nested 2 deep not to be decompressed, got %s", name)
+		case strings.HasSuffix(name, "c.zip"):
+			deep++
+			if !strings.Contains(warn.Msg, "-zdepth limit") || warn.Code != core.WarnNotDecompressed {
+				t.Errorf("expecting a -zdepth warning for %s, got %v", name, warn)
+			}
+		}
+	}
+	if deep != 16*8 {
+		t.Errorf("expecting %d archives nested 2 deep, got %d", 16*8, deep)
+	}
+}
+
+func TestInvalidName(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "names")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"good.txt", "bad\xff.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("hello world"), 0666); err != nil {
+			t.Skipf("can't make a file named %q: %v", name, err)
+		}
+	}
+	lg, _ := logger.New("")
+	rec := recorder{}
+	wg := &sync.WaitGroup{}
+	setCtxPool(s, wg, rec, false, false, checksum.HashTyps{})
+	ctxts := make(chan *context, 1)
+	printed := make(chan struct{})
+	go func() {
+		printer(ctxts, lg)
+		close(printed)
+	}()
+	infos, _ := ioutil.ReadDir(dir)
+	for _, info := range infos {
+		identifyFile(getCtx(filepath.Join(dir, info.Name()), "", info.ModTime(), info.Size()), ctxts, getCtx)
+	}
+	wg.Wait()
+	close(ctxts)
+	<-printed
+	if warn := rec[filepath.Join(dir, "bad\xff.txt")]; warn.Code != core.WarnInvalidName || !strings.Contains(warn.Msg, "not valid UTF-8") {
+		t.Errorf("expecting an invalid filename warning, got %v", warn)
+	}
+	if warn := rec[filepath.Join(dir, "good.txt")]; strings.Contains(warn.Msg, "UTF-8") {
+		t.Errorf("expecting no invalid filename warning for a valid name, got %v", warn)
+	}
+}
+
+// Results written with -jsonl can be replayed (e.g. as CSV)
+func TestReplayJSONL(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	results := &bytes.Buffer{}
+	jw := writer.JSONL(results)
+	jw.Head(config.SignatureBase(), time.Now(), time.Now(), [3]int{}, s.Identifiers(), s.Fields(), "")
+	for _, f := range [][2]string{{"a.pdf", "%PDF-1.4\n%%EOF\n"}, {"b.txt", "hello world"}} {
+		ids, err := s.Identify(strings.NewReader(f[1]), f[0], "")
+		jw.File(filepath.Join(dir, f[0]), int64(len(f[1])), "2020-01-02T03:04:05Z", nil, err, ids)
+	}
+	jw.Tail()
+	path := filepath.Join(dir, "results.jsonl")
+	if err := ioutil.WriteFile(path, results.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	cw := writer.CSV(out)
+	lg, _ := logger.New("")
+	wg := &sync.WaitGroup{}
+	setCtxPool(s, wg, cw, false, false, checksum.HashTyps{})
+	ctxts := make(chan *context, 1)
+	printed := make(chan struct{})
+	go func() {
+		printer(ctxts, lg)
+		close(printed)
+	}()
+	firstReplay = sync.Once{}
+	err = replayFile(path, ctxts, cw)
+	wg.Wait()
+	close(ctxts)
+	<-printed
+	cw.Tail()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "filename,filesize,modified,errors,namespace,id,format,version,mime,basis,warning,warncode\n" +
+		filepath.Join(dir, "a.pdf") + ",15,2020-01-02T03:04:05Z,,pronom,fmt/18,"
+	if !strings.HasPrefix(out.String(), expect) || !strings.Contains(out.String(), filepath.Join(dir, "b.txt")+",11,2020-01-02T03:04:05Z,,pronom,x-fmt/111,") {
+		t.Errorf("expecting the replayed results, got %s", out.String())
+	}
+}
+
+// Anonymized results and manifests don't reveal the absolute paths of the files scanned or of the signature file
+func TestAnonymizePaths(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := filepath.Abs(filepath.Join("secret", "plans"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigPath := filepath.Join(dir, "default.sig")
+	*anonymize = true
+	writer.SetAnonymize([]byte("salt"))
+	oldSig := config.SignatureBase()
+	config.SetSignature(sigPath)
+	defer func() {
+		*anonymize = false
+		writer.SetAnonymize(nil)
+		config.SetSignature(oldSig)
+	}()
+	writer.SetProvenance(provenance(nil))
+	ids, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "report.pdf", "")
+	for _, typ := range []string{"yaml", "json", "csv"} {
+		results, man := &bytes.Buffer{}, &bytes.Buffer{}
+		var w writer.Writer
+		switch typ {
+		case "yaml":
+			w = writer.YAML(results)
+		case "json":
+			w = writer.JSON(results)
+		case "csv":
+			w = writer.CSV(results)
+		}
+		mw := writer.Manifest(w, man)
+		mw.Head(signaturePath(config.SignatureBase()), time.Now(), time.Now(), [3]int{}, s.Identifiers(), s.Fields(), "")
+		mw.File(filepath.Join(dir, "report.pdf"), 15, "2020-01-02T03:04:05Z", nil, nil, ids)
+		mw.Tail()
+		if err := mw.Err(); err != nil {
+			t.Fatal(err)
+		}
+		for _, out := range []string{results.String(), man.String()} {
+			if strings.Contains(out, "secret") || strings.Contains(out, filepath.ToSlash(dir)) || strings.Contains(out, strings.ReplaceAll(dir, `\`, `\\`)) {
+				t.Errorf("expecting no absolute paths in anonymized %s output, got %s", typ, out)
+			}
+		}
+		if !strings.Contains(man.String(), `"signature": "default.sig"`) {
+			t.Errorf("expecting the manifest to name just the signature file, got %s", man.String())
+		}
+	}
+}
+
+func TestParseThrottle(t *testing.T) {
+	wait, rate, ops, err := parseThrottle("20MB/s, 200iops,50ms")
+	if err != nil || wait != 50*time.Millisecond || rate != 20<<20 || ops != 200 {
+		t.Errorf("expecting 50ms, 20MB/s and 200iops, got %v, %d, %d (%v)", wait, rate, ops, err)
+	}
+	if _, _, _, err := parseThrottle("fast"); err == nil {
+		t.Error("expecting an error for a bad -throttle")
+	}
+}
+
+func TestUpdateMirrors(t *testing.T) {
+	sig := []byte("signature file")
+	h := sha256.Sum256(sig)
+	var mirror *httptest.Server
+	mirror = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/default.sig" {
+			w.Write(sig)
+			return
+		}
+		json.NewEncoder(w).Encode(Update{
+			Version: config.Version(),
+			Created: time.Now().Format(time.RFC3339),
+			Hash:    hex.EncodeToString(h[:]),
+			Size:    len(sig),
+			Path:    mirror.URL + "/default.sig",
+		})
+	}))
+	defer mirror.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	home, err := ioutil.TempDir("", "update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	url, _, _ := config.UpdateOptions()
+	oldSig := config.SignatureBase()
+	defer func() {
+		config.SetHome(*testhome)
+		config.SetSignature(oldSig)
+		config.SetUpdateURL(url)
+		config.SetUpdateMirrors(nil)
+	}()
+	config.SetHome(home)
+	config.SetSignature("default.sig")
+	config.SetUpdateURL(broken.URL)
+	if _, err := updateSigs("", nil, false); err == nil {
+		t.Fatal("expecting an error without mirrors")
+	}
+	config.SetUpdateMirrors([]string{broken.URL, mirror.URL})
+	msg, err := updateSigs("", nil, true)
+	if err != nil || !strings.HasPrefix(msg, "Would install") {
+		t.Fatalf("expecting a dry run to report the update, got %q (%v)", msg, err)
+	}
+	if _, err := os.Stat(config.Signature()); err == nil {
+		t.Fatal("expecting a dry run not to write the signature file")
+	}
+	if _, err = updateSigs("", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if byts, err := ioutil.ReadFile(config.Signature()); err != nil || !bytes.Equal(byts, sig) {
+		t.Errorf("expecting the signature file from the mirror, got %q (%v)", byts, err)
+	}
+	if err := config.SetUpdateProxy(":bad"); err == nil {
+		t.Error("expecting an error for a bad proxy URL")
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	config.SetHome(*testhome)
+	oldSig := config.SignatureBase()
+	defer config.SetSignature(oldSig)
+	if err := setProfile("tika"); err != nil || config.SignatureBase() != "tika.sig" {
+		t.Fatalf("expecting the tika profile to be tika.sig, got %s (%v)", config.SignatureBase(), err)
+	}
+	if err := setProfile("bogus"); err == nil || !strings.Contains(err.Error(), "deluxe, freedesktop") {
+		t.Errorf("expecting an error listing the profiles, got %v", err)
+	}
+	list, err := listProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(list, "* tika\n") || !strings.Contains(list, "  deluxe\n") || !strings.Contains(list, "identifier  : freedesktop.org") {
+		t.Errorf("unexpected profiles listing:\n%s", list)
+	}
+	if !isProfilesCommand([]string{"profiles"}) || isProfilesCommand([]string{"profiles", "other"}) {
+		t.Error("expecting sf profiles to be recognised as a command")
+	}
+}
+
+func TestReported(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	known, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "", "")
+	unknown, _ := s.Identify(bytes.NewReader([]byte{0, 1, 2, 3}), "", "")
+	defer func() { *knownf, *unknownf = false, false }()
+	for _, c := range []struct {
+		known, unknown bool
+		expect         [3]bool // reported for known, unknown and no results
+	}{
+		{false, false, [3]bool{true, true, true}},
+		{true, false, [3]bool{true, false, false}},
+		{false, true, [3]bool{false, true, false}},
+	} {
+		*knownf, *unknownf = c.known, c.unknown
+		if got := [3]bool{reported(known), reported(unknown), reported(nil)}; got != c.expect {
+			t.Errorf("with -known %v and -unknown %v, expecting %v, got %v", c.known, c.unknown, c.expect, got)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	ids, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "a.pdf", "")
+	d := newDedupe()
+	orig, dup := d.claim([]byte{1}, "a.pdf")
+	if dup {
+		t.Fatal("expecting the first file with a checksum not to be a duplicate")
+	}
+	o, dup := d.claim([]byte{1}, "b.pdf")
+	if !dup || o != orig {
+		t.Fatal("expecting the second file with a checksum to be a duplicate of the first")
+	}
+	go orig.identified(ids)
+	got := o.duplicate()
+	if len(got) != len(ids) || got[0].String() != ids[0].String() || got[0].Warn() != "duplicate of a.pdf" || core.JoinCodes(core.WarnCodes(got[0])) != string(core.WarnDuplicate) {
+		t.Errorf("expecting the original's identification with a duplicate warning, got %v", got)
+	}
+	if ids[0].Warn() != "" {
+		t.Errorf("expecting the original's identification not to be changed, got warning %q", ids[0].Warn())
+	}
+	// duplicates of files that couldn't be identified are identified themselves
+	failed, _ := d.claim([]byte{2}, "c.pdf")
+	failed.identified(nil)
+	if o, _ := d.claim([]byte{2}, "d.pdf"); o.duplicate() != nil {
+		t.Error("expecting no identifications for the duplicate of a file that couldn't be identified")
+	}
+}
+
+func TestFailOn(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	known, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "", "")
+	unknown, _ := s.Identify(bytes.NewReader([]byte{0, 1, 2, 3}), "", "")
+	if _, err := newFailOn("unknown,bad"); err == nil {
+		t.Error("expecting an error for a bad -failon condition")
+	}
+	for _, c := range []struct {
+		failon string
+		expect int
+	}{
+		{"", 0},
+		{"error", 4},
+		{"unknown", 2},
+		{"unknown,error", 4},
+	} {
+		f, err := newFailOn(c.failon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.check(nil, known)
+		f.check(nil, unknown)
+		f.check(errors.New("can't read"), nil)
+		if got := f.exitCode(); got != c.expect {
+			t.Errorf("with -failon %q, expecting exit code %d, got %d", c.failon, c.expect, got)
+		}
+	}
+	var f *failOn // no -failon
+	f.check(errors.New("can't read"), unknown)
+	if f.exitCode() != 0 {
+		t.Error("expecting exit code 0 without -failon")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatal(err)
+	}
+	known, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "", "")
+	unknown, _ := s.Identify(bytes.NewReader([]byte{0, 1, 2, 3}), "", "")
+	dir, err := ioutil.TempDir("", "compare")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := filepath.Join(dir, "old.csv")
+	f, err := os.Create(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := writer.CSV(f)
+	w.Head("", time.Now(), time.Now(), [3]int{}, s.Identifiers(), s.Fields(), "")
+	mod := time.Now().Format(time.RFC3339)
+	w.File("same.pdf", 16, mod, nil, nil, known)
+	w.File("grown.pdf", 16, mod, nil, nil, known)
+	w.File("changed.pdf", 16, mod, nil, nil, known)
+	w.File("gone.pdf", 16, mod, nil, nil, known)
+	w.Tail()
+	f.Close()
+	buf := &bytes.Buffer{}
+	c, err := newComparison(old, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.setHashes("")
+	c.check("same.pdf", 16, nil, nil, known)
+	c.check("grown.pdf", 20, nil, nil, known)
+	c.check("changed.pdf", 16, nil, nil, unknown)
+	c.check("new.pdf", 16, nil, nil, known)
+	if err := c.close(); err != nil {
+		t.Fatal(err)
+	}
+	expect := "filename,change,previous,current\n" +
+		"grown.pdf,size,16,20\n" +
+		"changed.pdf,identification,fmt/18,UNKNOWN\n" +
+		"new.pdf,added,,fmt/18\n" +
+		"gone.pdf,removed,fmt/18,\n"
+	if buf.String() != expect {
+		t.Errorf("expecting:\n%s\ngot:\n%s", expect, buf.String())
+	}
+}
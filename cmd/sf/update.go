@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/signing"
 	"github.com/richardlehane/siegfried/pkg/config"
 )
 
@@ -45,21 +46,30 @@ func current(buf []byte, utime string) bool {
 	if err != nil {
 		return false
 	}
-	if len(buf) < len(config.Magic())+2+15 {
+	tt, ok := created(buf)
+	if !ok {
 		return false
 	}
+	return !ut.After(tt)
+}
+
+// created reads the time a signature file was made
+func created(buf []byte) (time.Time, bool) {
+	if len(buf) < len(config.Magic())+2+15 {
+		return time.Time{}, false
+	}
 	rc := flate.NewReader(bytes.NewBuffer(buf[len(config.Magic())+2:]))
 	nbuf := make([]byte, 15)
 	if n, _ := rc.Read(nbuf); n < 15 {
-		return false
+		return time.Time{}, false
 	}
 	rc.Close()
 	ls := persist.NewLoadSaver(nbuf)
 	tt := ls.LoadTime()
 	if ls.Err != nil {
-		return false
+		return time.Time{}, false
 	}
-	return !ut.After(tt)
+	return tt, true
 }
 
 func same(buf []byte, usize int, uhash string) bool {
@@ -82,6 +92,20 @@ func uptodate(utime, uhash string, usize int) bool {
 	return false
 }
 
+// describe reports what an update would change without downloading anything, for sf -update -dry-run
+func describe(u Update, mirror string) string {
+	existing := "install"
+	if fbuf, err := ioutil.ReadFile(config.Signature()); err == nil {
+		if tt, ok := created(fbuf); ok {
+			existing = fmt.Sprintf("replace the signature file created %s with", tt.Format(time.RFC3339))
+		} else {
+			existing = "replace the existing file with"
+		}
+	}
+	return fmt.Sprintf("Would %s the signature file created %s (%d bytes, SHA256 %s)\nfrom %s (update service %s)\nto %s",
+		existing, u.Created, u.Size, u.Hash, u.Path, mirror, config.Signature())
+}
+
 func location(base, sig string, args []string) string {
 	if len(args) > 0 && len(args[0]) > 0 {
 		if args[0] == "freedesktop.org" { // freedesktop.org is more correct, but we don't use it in update service
@@ -95,18 +119,48 @@ func location(base, sig string, args []string) string {
 	return base
 }
 
-func updateSigs(sig string, args []string) (string, error) {
+// fetchError is a failure to fetch or check an update from an update service; the next mirror (if any) is then tried
+type fetchError struct {
+	error
+}
+
+// updateSigs updates the signature file from the update service or, if it fails, from the first mirror that succeeds.
+// With dry set, it reports what would change without writing anything.
+func updateSigs(sig string, args []string, dry bool) (string, error) {
 	url, _, _ := config.UpdateOptions()
-	if url == "" {
+	services := config.UpdateMirrors()
+	if url != "" {
+		services = append([]string{url}, services...)
+	}
+	if len(services) == 0 {
 		return "Update is not available for this distribution of siegfried", nil
 	}
-	response, err := getHttp(location(url, sig, args))
+	var errs []string
+	for _, service := range services {
+		msg, err := updateFrom(service, sig, args, dry)
+		if err == nil {
+			return msg, nil
+		}
+		if _, ok := err.(fetchError); !ok {
+			return "", err
+		}
+		if len(services) == 1 {
+			return "", err
+		}
+		fmt.Printf("... update from %s failed: %v ...\n", service, err)
+		errs = append(errs, service+": "+err.Error())
+	}
+	return "", fmt.Errorf("Siegfried: all update services failed\n%s", strings.Join(errs, "\n"))
+}
+
+func updateFrom(service, sig string, args []string, dry bool) (string, error) {
+	response, err := getHttp(location(service, sig, args))
 	if err != nil {
-		return "", err
+		return "", fetchError{err}
 	}
 	var u Update
 	if err := json.Unmarshal(response, &u); err != nil {
-		return "", err
+		return "", fetchError{err}
 	}
 	version := config.Version()
 	if version[0] < u.Version[0] || (version[0] == u.Version[0] && version[1] < u.Version[1]) || // if the version is out of date
@@ -116,6 +170,9 @@ func updateSigs(sig string, args []string) (string, error) {
 	if uptodate(u.Created, u.Hash, u.Size) {
 		return "You are already up to date!", nil
 	}
+	if dry {
+		return describe(u, service), nil
+	}
 	// this hairy bit of golang exception handling is thanks to Ross! :)
 	if _, err = os.Stat(config.Home()); err != nil {
 		if os.IsNotExist(err) {
@@ -130,19 +187,46 @@ func updateSigs(sig string, args []string) (string, error) {
 	fmt.Println("... downloading latest signature file ...")
 	response, err = getHttp(u.Path)
 	if err != nil {
-		return "", fmt.Errorf("Siegfried: error retrieving %s.\nThis may be a network or firewall issue. See https://github.com/richardlehane/siegfried/wiki/Getting-started for manual instructions.\nSystem error: %v", config.SignatureBase(), err)
+		return "", fetchError{fmt.Errorf("Siegfried: error retrieving %s.\nThis may be a network or firewall issue. See https://github.com/richardlehane/siegfried/wiki/Getting-started for manual instructions.\nSystem error: %v", config.SignatureBase(), err)}
 	}
 	if !same(response, u.Size, u.Hash) {
-		return "", fmt.Errorf("Siegfried: error retrieving %s; SHA256 hash of response doesn't match %s", config.SignatureBase(), u.Hash)
+		return "", fetchError{fmt.Errorf("Siegfried: error retrieving %s; SHA256 hash of response doesn't match %s", config.SignatureBase(), u.Hash)}
+	}
+	var sigResponse []byte
+	if key := config.VerifyKey(); key != "" {
+		if sigResponse, err = verifyUpdate(response, u.Path, key); err != nil {
+			return "", fetchError{err}
+		}
 	}
 	err = ioutil.WriteFile(config.Signature(), response, os.ModePerm)
 	if err != nil {
 		return "", fmt.Errorf("Siegfried: error writing to directory, %v", err)
 	}
+	if sigResponse != nil {
+		if err = ioutil.WriteFile(config.Signature()+signing.Ext, sigResponse, os.ModePerm); err != nil {
+			return "", fmt.Errorf("Siegfried: error writing to directory, %v", err)
+		}
+	}
 	fmt.Printf("... writing %s ...\n", config.Signature())
 	return "Your signature file has been updated", nil
 }
 
+// verifyUpdate downloads the detached signature published alongside a signature file and checks the download against it
+func verifyUpdate(response []byte, path, key string) ([]byte, error) {
+	pub, err := signing.PublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := getHttp(path + signing.Ext)
+	if err != nil {
+		return nil, fmt.Errorf("Siegfried: error retrieving the signature of %s, %v", config.SignatureBase(), err)
+	}
+	if err := signing.Verify(response, sig, pub); err != nil {
+		return nil, fmt.Errorf("Siegfried: %s failed verification with key %s, %v", config.SignatureBase(), key, err)
+	}
+	return sig, nil
+}
+
 func getHttp(url string) ([]byte, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -163,5 +247,8 @@ func getHttp(url string) ([]byte, error) {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
 	return ioutil.ReadAll(resp.Body)
 }
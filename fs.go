@@ -0,0 +1,60 @@
+// +build go1.16
+
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegfried
+
+import (
+	"io"
+	"io/fs"
+	"path"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// FSFunc is called by IdentifyFS with the results for each file, or the error that prevented the file from being identified
+// (which may come from walking the filesystem). Returning an error stops the walk and IdentifyFS returns that error.
+type FSFunc func(path string, ids []core.Identification, err error) error
+
+// IdentifyFS walks an io/fs filesystem (such as an embed.FS, a zip.Reader or a virtual filesystem) from its root, identifying each regular file.
+// Paths given to fn are those within fsys; use fs.Sub to identify a subtree. Files that implement io.ReaderAt (as those of
+// embed.FS and os.DirFS do) are read on demand; other files are read as streams.
+func (s *Siegfried) IdentifyFS(fsys fs.FS, fn FSFunc) error {
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fn(p, nil, err)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		ids, err := s.IdentifyFSFile(fsys, p)
+		return fn(p, ids, err)
+	})
+}
+
+// IdentifyFSFile identifies the file at path p within an io/fs filesystem. The base of the path is used as the name.
+func (s *Siegfried) IdentifyFSFile(fsys fs.FS, p string) ([]core.Identification, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if ra, ok := f.(io.ReaderAt); ok {
+		if info, err := f.Stat(); err == nil {
+			return s.IdentifyReaderAt(ra, info.Size(), path.Base(p), "")
+		}
+	}
+	return s.Identify(f, path.Base(p), "")
+}
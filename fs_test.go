@@ -0,0 +1,35 @@
+// +build go1.16
+
+package siegfried
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+func TestIdentifyFS(t *testing.T) {
+	s, err := Load("./cmd/roy/data/default.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"a/test.pdf": &fstest.MapFile{Data: []byte("%PDF-1.4\n%%EOF\n")},
+		"b.txt":      &fstest.MapFile{Data: []byte("hello world\n")},
+	}
+	res := make(map[string]string)
+	err = s.IdentifyFS(fsys, func(p string, ids []core.Identification, err error) error {
+		if err != nil {
+			return err
+		}
+		res[p] = ids[0].String()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res["a/test.pdf"] != "fmt/18" || res["b.txt"] != "x-fmt/111" {
+		t.Errorf("expecting fmt/18 and x-fmt/111, got %v", res)
+	}
+}
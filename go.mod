@@ -1,15 +1,19 @@
 module github.com/richardlehane/siegfried
 
 require (
+	github.com/klauspost/compress v1.11.4
 	github.com/richardlehane/characterize v1.0.0
 	github.com/richardlehane/match v1.0.0
 	github.com/richardlehane/mscfb v1.0.3
 	github.com/richardlehane/webarchive v1.0.0
 	github.com/richardlehane/xmldetect v1.0.2
 	github.com/ross-spencer/spargo v0.0.0-20200323024642-38971d4365a7
+	github.com/ulikunitz/xz v0.5.12
+	golang.org/x/crypto v0.0.0-20200820211705-5c72a883971a
 	golang.org/x/image v0.0.0-20200922025426-e59bae62ef32
 	golang.org/x/sys v0.0.0-20200922070232-aee5d888a860
 	golang.org/x/text v0.3.3 // indirect
+	lukechampine.com/blake3 v1.0.0
 )
 
 go 1.13
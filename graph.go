@@ -0,0 +1,124 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegfried
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/richardlehane/siegfried/internal/bytematcher"
+	"github.com/richardlehane/siegfried/internal/containermatcher"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// PriorityEdge is a relation in the compiled priorities of a signature file: when the Superior format matches, matches of the
+// Subordinate format are suppressed (and while it still might match, the matcher waits on it before reporting the Subordinate).
+type PriorityEdge struct {
+	Namespace   string `json:"namespace"`
+	Matcher     string `json:"matcher"` // "byte" or "container"
+	Subordinate string `json:"subordinate"`
+	Superior    string `json:"superior"`
+}
+
+// PriorityEdges lists the priority relations between formats compiled into the byte and container matchers of the signature file.
+// These are the relations that govern result suppression, after any limits, exclusions or -multi settings given to roy were applied.
+func (s *Siegfried) PriorityEdges() []PriorityEdge {
+	var edges []PriorityEdge
+	bm, _ := s.bm.(*bytematcher.Matcher)
+	cm, _ := s.cm.(containermatcher.Matcher)
+	for i, v := range s.ids {
+		d, ok := v.(describer)
+		if !ok || s.skipped(i) {
+			continue
+		}
+		for _, mt := range []struct {
+			typ  core.MatcherType
+			name string
+			sups func(int) []int
+		}{
+			{core.ByteMatcher, "byte", func(idx int) []int {
+				if bm == nil {
+					return nil
+				}
+				return bm.Superiors(idx)
+			}},
+			{core.ContainerMatcher, "container", func(idx int) []int {
+				if cm == nil {
+					return nil
+				}
+				return cm.Superiors(idx)
+			}},
+		} {
+			seen := make(map[[2]string]bool)
+			start := d.Start(mt.typ)
+			for j, id := range d.IDs(mt.typ) {
+				for _, sup := range mt.sups(start + j) {
+					ok, sid := d.Hit(mt.typ, sup)
+					if !ok || sid == id || seen[[2]string{id, sid}] {
+						continue
+					}
+					seen[[2]string{id, sid}] = true
+					edges = append(edges, PriorityEdge{v.Name(), mt.name, id, sid})
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// PriorityGraph exports the compiled priorities of the signature file (see PriorityEdges) as a graphviz dot graph (format "dot"),
+// with a cluster for each identifier and container priorities dashed, or as a JSON array of edges (format "json").
+// View a dot graph with a command e.g. sf -inspect priorities.dot | dot -Tpng -o priorities.png
+func (s *Siegfried) PriorityGraph(format string) (string, error) {
+	edges := s.PriorityEdges()
+	switch format {
+	case "json":
+		byts, err := json.MarshalIndent(edges, "", "  ")
+		return string(byts), err
+	case "dot":
+	default:
+		return "", fmt.Errorf("siegfried: unknown priority graph format %q, expecting dot or json", format)
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString("digraph {\n")
+	var ns string
+	nodes := make(map[string]bool)
+	for _, e := range edges {
+		if e.Namespace != ns {
+			if ns != "" {
+				buf.WriteString("  }\n")
+			}
+			ns = e.Namespace
+			fmt.Fprintf(buf, "  subgraph %q {\n    label=%q\n", "cluster_"+ns, ns)
+		}
+		for _, id := range []string{e.Subordinate, e.Superior} {
+			if !nodes[ns+" "+id] {
+				nodes[ns+" "+id] = true
+				fmt.Fprintf(buf, "    %q [label=%q]\n", ns+" "+id, id)
+			}
+		}
+		var style string
+		if e.Matcher == "container" {
+			style = " [style=dashed]"
+		}
+		fmt.Fprintf(buf, "    %q -> %q%s\n", ns+" "+e.Subordinate, ns+" "+e.Superior, style)
+	}
+	if ns != "" {
+		buf.WriteString("  }\n")
+	}
+	buf.WriteString("}\n")
+	return buf.String(), nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bmffmatcher identifies ISO base media files (e.g. MP4, QuickTime, 3GPP and HEIF) by the brands in their ftyp box.
+package bmffmatcher
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/priority"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+const maxFtyp = 4096 // larger ftyp boxes are treated as invalid
+
+type Matcher struct {
+	brands     [][4]byte
+	priorities *priority.Set
+}
+
+func Load(ls *persist.LoadSaver) core.Matcher {
+	brands := ls.LoadStrings()
+	if len(brands) == 0 {
+		return nil
+	}
+	m := &Matcher{brands: make([][4]byte, len(brands))}
+	for i, v := range brands {
+		copy(m.brands[i][:], v)
+	}
+	m.priorities = priority.Load(ls)
+	return m
+}
+
+func Save(c core.Matcher, ls *persist.LoadSaver) {
+	if c == nil {
+		ls.SaveStrings(nil)
+		return
+	}
+	m := c.(*Matcher)
+	brands := make([]string, len(m.brands))
+	for i, v := range m.brands {
+		brands[i] = string(v[:])
+	}
+	ls.SaveStrings(brands)
+	if len(brands) == 0 {
+		return
+	}
+	m.priorities.Save(ls)
+}
+
+// SignatureSet for the BMFF matcher is a slice of ftyp brands e.g. {'m', 'p', '4', '2'}.
+type SignatureSet [][4]byte
+
+func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, int, error) {
+	sigs, ok := ss.(SignatureSet)
+	if !ok {
+		return nil, -1, fmt.Errorf("BMFFmatcher: can't cast persist set")
+	}
+	if len(sigs) == 0 {
+		return c, 0, nil
+	}
+	var m *Matcher
+	if c == nil {
+		m = &Matcher{priorities: &priority.Set{}}
+	} else {
+		m = c.(*Matcher)
+	}
+	length := len(m.brands)
+	m.brands = append(m.brands, sigs...)
+	// add priorities
+	m.priorities.Add(p, len(sigs), 0, 0)
+	return m, length + len(sigs), nil
+}
+
+type result struct {
+	idx   int
+	brand [4]byte
+	major bool
+}
+
+func (r result) Index() int {
+	return r.idx
+}
+
+func (r result) Basis() string {
+	if r.major {
+		return fmt.Sprintf("ftyp major brand %q", string(r.brand[:]))
+	}
+	return fmt.Sprintf("ftyp compatible brand %q", string(r.brand[:]))
+}
+
+// Identify matches the major brand of the file's ftyp box. Only if no signatures match the major brand are the compatible brands matched:
+// a major brand identifies a file more specifically than the compatible brands (e.g. a HEIC image has "heic" as its major brand and "mif1", the generic HEIF brand, as a compatible brand).
+func (m Matcher) Identify(na string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
+	res := make(chan core.Result)
+	major, compatible, ok := parse(b)
+	if !ok {
+		close(res)
+		return res, nil
+	}
+	if config.Debug() {
+		fmt.Fprintf(config.Out(), "ftyp major brand %q, compatible brands %q\n", string(major[:]), compatible)
+	}
+	var isMajor bool
+	for _, v := range m.brands {
+		if v == major {
+			isMajor = true
+			break
+		}
+	}
+	waitset := m.priorities.WaitSet(hints...)
+	go func() {
+		for i, v := range m.brands {
+			if isMajor {
+				if v != major {
+					continue
+				}
+			} else if !contains(compatible, v) {
+				continue
+			}
+			if !waitset.Check(i) {
+				continue
+			}
+			res <- result{i, v, isMajor}
+			if waitset.Put(i) {
+				break
+			}
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
+func (m Matcher) String() string {
+	strs := make([]string, len(m.brands))
+	for i, v := range m.brands {
+		strs[i] = fmt.Sprintf("%q", string(v[:]))
+	}
+	return fmt.Sprintf("BMFF matcher: %s\n", strings.Join(strs, ", "))
+}
+
+func contains(brands [][4]byte, brand [4]byte) bool {
+	for _, v := range brands {
+		if v == brand {
+			return true
+		}
+	}
+	return false
+}
+
+// parse reads the major and compatible brands from an ftyp box at the start of the file.
+func parse(b *siegreader.Buffer) ([4]byte, [][4]byte, bool) {
+	var major [4]byte
+	buf, _ := b.Slice(0, 16)
+	if len(buf) < 16 || string(buf[4:8]) != "ftyp" {
+		return major, nil, false
+	}
+	sz := int(binary.BigEndian.Uint32(buf[:4]))
+	if sz < 16 || sz > maxFtyp || sz%4 != 0 {
+		return major, nil, false
+	}
+	copy(major[:], buf[8:12])
+	buf, _ = b.Slice(0, sz)
+	if len(buf) < sz {
+		return major, nil, true
+	}
+	compatible := make([][4]byte, 0, (sz-16)/4)
+	for i := 16; i < sz; i += 4 {
+		var brand [4]byte
+		copy(brand[:], buf[i:i+4])
+		compatible = append(compatible, brand)
+	}
+	return major, compatible, true
+}
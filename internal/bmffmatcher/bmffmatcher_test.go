@@ -0,0 +1,92 @@
+package bmffmatcher
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var fmts = SignatureSet{
+	{'i', 's', 'o', 'm'},
+	{'m', 'p', '4', '2'},
+	{'M', '4', 'A', ' '},
+	{'q', 't', ' ', ' '},
+}
+
+var fm core.Matcher
+
+func init() {
+	fm, _, _ = Add(fm, fmts, nil)
+}
+
+// file makes an ftyp box followed by an empty mdat box
+func file(major string, compatible ...string) []byte {
+	box := make([]byte, 16, 16+4*len(compatible)+8)
+	binary.BigEndian.PutUint32(box, uint32(16+4*len(compatible)))
+	copy(box[4:], "ftyp"+major)
+	for _, v := range compatible {
+		box = append(box, v...)
+	}
+	return append(box, 0, 0, 0, 8, 'm', 'd', 'a', 't')
+}
+
+func identify(t *testing.T, byt []byte) []int {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(byt))
+	defer bufs.Put(b)
+	res, err := fm.Identify("", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits []int
+	for h := range res {
+		hits = append(hits, h.Index())
+	}
+	return hits
+}
+
+func TestParse(t *testing.T) {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(file("M4A ", "M4A ", "mp42", "isom")))
+	major, compatible, ok := parse(b)
+	if !ok || string(major[:]) != "M4A " || len(compatible) != 3 || string(compatible[2][:]) != "isom" {
+		t.Errorf("expecting major brand M4A and three compatible brands, got %q %q (%v)", major, compatible, ok)
+	}
+	b, _ = bufs.Get(bytes.NewReader([]byte("RIFF....WAVEfmt ")))
+	if _, _, ok := parse(b); ok {
+		t.Error("expecting a non-BMFF file not to parse")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	// the major brand outranks the compatible brands
+	if hits := identify(t, file("M4A ", "M4A ", "mp42", "isom")); len(hits) != 1 || hits[0] != 2 {
+		t.Errorf("expecting an M4A hit, got %v", hits)
+	}
+	if hits := identify(t, file("qt  ", "qt  ")); len(hits) != 1 || hits[0] != 3 {
+		t.Errorf("expecting a quicktime hit, got %v", hits)
+	}
+	// an unknown major brand falls back to the compatible brands
+	if hits := identify(t, file("dash", "iso6", "mp42", "isom")); len(hits) != 2 || hits[0] != 0 || hits[1] != 1 {
+		t.Errorf("expecting isom and mp42 hits, got %v", hits)
+	}
+	if hits := identify(t, file("dash", "iso6")); len(hits) != 0 {
+		t.Errorf("expecting no hits, got %v", hits)
+	}
+}
+
+func TestIO(t *testing.T) {
+	str := fm.String()
+	saver := persist.NewLoadSaver(nil)
+	Save(fm, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	newfm := Load(loader)
+	str2 := newfm.String()
+	if str != str2 {
+		t.Errorf("Load bmff matcher: expecting first matcher (%v), to equal second matcher (%v)", str, str2)
+	}
+}
@@ -17,6 +17,8 @@ package bytematcher
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	wac "github.com/richardlehane/match/fwac"
@@ -24,6 +26,7 @@ import (
 	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
 )
 
@@ -47,6 +50,9 @@ type Matcher struct {
 	bAho   wac.Wac
 	eAho   wac.Wac
 	lowmem bool
+	tracer core.Tracer
+	limit  []int  // if not nil, the only signatures matched (see Limit)
+	limOff [2]int // max BOF and EOF offsets of the signatures in the limit
 }
 
 // SignatureSet for a bytematcher is a slice of frames.Signature.
@@ -108,10 +114,20 @@ func (se sigErrors) Error() string {
 
 // Add a set of signatures to a bytematcher.
 // The priority list should be of equal length to the signatures, or nil (if no priorities are to be set).
+// Signatures are segmented with the settings in effect (see config.GetSegmentation).
 //
 // Example:
 //   m, n, err := Add(bm, []frames.Signature{frames.Signature{frames.NewFrame(frames.BOF, patterns.Sequence{'p','d','f'}, 0, 0)}}, nil)
 func Add(c core.Matcher, ss core.SignatureSet, priorities priority.List) (core.Matcher, int, error) {
+	return AddSegmented(c, ss, priorities, config.GetSegmentation())
+}
+
+// AddSegmented adds a set of signatures to a bytematcher, as Add does, but segments them with the given settings.
+// This lets identifiers sharing a bytematcher be tuned separately.
+func AddSegmented(c core.Matcher, ss core.SignatureSet, priorities priority.List, seg config.Segmentation) (core.Matcher, int, error) {
+	if err := seg.Validate(); err != nil {
+		return nil, -1, fmt.Errorf("Byte matcher: %v", err)
+	}
 	var b *Matcher
 	if c == nil {
 		b = &Matcher{
@@ -137,7 +153,7 @@ func Add(c core.Matcher, ss core.SignatureSet, priorities priority.List) (core.M
 	// process each of the sigs, adding them to b.Sigs and the various seq/frame/testTree sets
 	var bof, eof int
 	for _, sig := range sigs {
-		if err := b.addSignature(sig); err == nil {
+		if err := b.addSignature(sig, seg); err == nil {
 			// get the local max bof and eof by popping last keyframe and testing
 			kf := b.keyFrames[len(b.keyFrames)-1]
 			bof, eof = maxBOF(bof, kf), maxEOF(eof, kf)
@@ -170,10 +186,31 @@ func Add(c core.Matcher, ss core.SignatureSet, priorities priority.List) (core.M
 //   }
 func (b *Matcher) Identify(name string, sb *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
 	quit, ret := make(chan struct{}), make(chan core.Result)
-	go b.identify(sb, quit, ret, hints...)
+	go b.identify(sb, quit, ret, trace{b.tracer, name}, hints...)
 	return ret, nil
 }
 
+// Limit restricts matching to the signatures at the given indexes e.g. those of a set of formats of interest, so that scans stop
+// once these are resolved and read no further into files than they need. Other signatures are treated as though they can't match.
+// A nil slice removes the limit. This is a runtime setting: don't call Limit while identifying files.
+func (b *Matcher) Limit(sigs []int) {
+	if sigs == nil {
+		b.limit = nil
+		return
+	}
+	b.limit = make([]int, 0, len(sigs))
+	var bof, eof int
+	for _, v := range sigs {
+		if v < 0 || v >= len(b.keyFrames) {
+			continue
+		}
+		b.limit = append(b.limit, v)
+		bof, eof = maxBOF(bof, b.keyFrames[v]), maxEOF(eof, b.keyFrames[v])
+	}
+	sort.Ints(b.limit)
+	b.limOff = [2]int{bof, eof}
+}
+
 // String returns information about the Bytematcher including the number of BOF, VAR and EOF sequences, the number of BOF and EOF frames, and the total number of tests.
 func (b *Matcher) String() string {
 	str := fmt.Sprintf("BOF seqs: %v\n", len(b.bofSeq.set))
@@ -231,6 +268,11 @@ func (b *Matcher) DescribeTestTree(i int) ([]int, []int, int, int, int, int) {
 	return cres, ires, t.maxLeftDistance, t.maxRightDistance, maxMatches(t.left, t.maxLeftDistance), maxMatches(t.right, t.maxRightDistance)
 }
 
+// Superiors reports the indexes of signatures that take priority over the signature at index i.
+func (b *Matcher) Superiors(i int) []int {
+	return b.priorities.Superiors(i)
+}
+
 func (b *Matcher) TestTreeLen() int {
 	return len(b.tests)
 }
@@ -246,6 +288,63 @@ func (b *Matcher) DescribeKeyFrames(i int) []string {
 	return ret
 }
 
+// DescribeSignature describes how signature i was compiled: for each segment, its key frame and the sequences or frames
+// the matcher searches for, with the tests made to the left and right of a hit on them.
+func (b *Matcher) DescribeSignature(i int) []string {
+	if i < 0 || i >= len(b.keyFrames) {
+		return nil
+	}
+	ret := make([]string, 0, len(b.keyFrames[i])*2)
+	for j, kf := range b.keyFrames[i] {
+		ret = append(ret, kf.String())
+		ret = append(ret, b.describeSegment(keyFrameID{i, j})...)
+	}
+	return ret
+}
+
+func (b *Matcher) describeSegment(kfID keyFrameID) []string {
+	var ret []string
+	searches := func(name string, ss *seqSet) {
+		for k, seq := range ss.set {
+			for c, choice := range seq.Choices {
+				if tti := ss.testTreeIndex[k] + c; tti < len(b.tests) {
+					for _, test := range b.tests[tti].describe(kfID) {
+						ret = append(ret, fmt.Sprintf("  %s sequence %s%s", name, choiceString(choice, name == "EOF"), test))
+					}
+				}
+			}
+		}
+	}
+	frms := func(name string, fs *frameSet) {
+		for k, f := range fs.set {
+			if tti := fs.testTreeIndex[k]; tti < len(b.tests) {
+				for _, test := range b.tests[tti].describe(kfID) {
+					ret = append(ret, fmt.Sprintf("  %s frame %s%s", name, f, test))
+				}
+			}
+		}
+	}
+	searches("BOF", b.bofSeq)
+	frms("BOF", b.bofFrames)
+	searches("EOF", b.eofSeq)
+	frms("EOF", b.eofFrames)
+	return ret
+}
+
+func choiceString(c wac.Choice, rev bool) string {
+	strs := make([]string, len(c))
+	for i, byts := range c {
+		if rev { // EOF sequences are stored reversed
+			byts = append([]byte(nil), byts...)
+			for l, r := 0, len(byts)-1; l < r; l, r = l+1, r-1 {
+				byts[l], byts[r] = byts[r], byts[l]
+			}
+		}
+		strs[i] = fmt.Sprintf("%X", byts)
+	}
+	return strings.Join(strs, "|")
+}
+
 func (b *Matcher) KeyFramesLen() int {
 	return len(b.keyFrames)
 }
@@ -254,3 +353,33 @@ func (b *Matcher) KeyFramesLen() int {
 func (b *Matcher) SetLowMem() {
 	b.lowmem = true
 }
+
+// SetTracer attaches a core.Tracer that is called with the strikes and key frame matches made as the Matcher identifies files.
+// The name given to Identify is passed to the Tracer's callbacks.
+func (b *Matcher) SetTracer(t core.Tracer) {
+	b.tracer = t
+}
+
+// a trace makes the Tracer callbacks for an identification; a trace with a nil Tracer makes none
+type trace struct {
+	t    core.Tracer
+	name string
+}
+
+func (tr trace) strike(st strike) {
+	if tr.t != nil {
+		tr.t.Strike(tr.name, core.ByteMatcher, core.Strike{Offset: st.offset, Length: st.length, Reverse: st.reverse, Frame: st.frame})
+	}
+}
+
+func (tr trace) keyFrame(k kfHit) {
+	if tr.t != nil {
+		tr.t.KeyFrame(tr.name, core.ByteMatcher, core.KeyFrame{Signature: k.id[0], Segment: k.id[1], Offset: k.offset, Length: k.length})
+	}
+}
+
+// lowMem reports whether the Aho Corasick search trees should be built with the low memory (sparse) layout,
+// either because of SetLowMem or because the config.SetLowMem option was set for the scan.
+func (b *Matcher) lowMem() bool {
+	return b.lowmem || config.LowMem()
+}
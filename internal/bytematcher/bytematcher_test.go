@@ -3,11 +3,13 @@ package bytematcher
 import (
 	"bytes"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames/tests"
 	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
 )
 
@@ -82,3 +84,60 @@ func TestMatch(t *testing.T) {
 		t.Errorf("Missing result, got: %v, expecting:%v\n", results, bm)
 	}
 }
+
+func TestAddSegmented(t *testing.T) {
+	seg := config.DefaultSegmentation()
+	def, _, err := AddSegmented(nil, SignatureSet(tests.TestSignatures), nil, seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seg.Distance, seg.Range = 0, 0
+	short, _, err := AddSegmented(nil, SignatureSet(tests.TestSignatures), nil, seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d, s int
+	for i := range tests.TestSignatures {
+		d, s = d+len(def.(*Matcher).keyFrames[i]), s+len(short.(*Matcher).keyFrames[i])
+	}
+	if s <= d {
+		t.Errorf("expecting a zero distance and range to split signatures into more segments; got %d segments, and %d by default", s, d)
+	}
+	seg.Choices = 0
+	if _, _, err := AddSegmented(nil, SignatureSet(tests.TestSignatures), nil, seg); err == nil {
+		t.Error("expecting an error for zero choices")
+	}
+}
+
+func TestDescribeSignature(t *testing.T) {
+	bm, _, err := Add(nil, SignatureSet(tests.TestSignatures), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := bm.(*Matcher).DescribeSignature(4) // [BOF *:junk]
+	if len(desc) != 2 || desc[1] != "  BOF sequence 6A756E6B" {
+		t.Errorf("expecting a BOF sequence for junk, got %q", desc)
+	}
+	if bm.(*Matcher).DescribeSignature(len(tests.TestSignatures)) != nil {
+		t.Error("expecting nil for an out of range signature")
+	}
+}
+
+func TestExplain(t *testing.T) {
+	bm, _, err := Add(nil, SignatureSet(tests.TestSignatures), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bufs := siegreader.New()
+	buf, err := bufs.Get(bytes.NewBuffer(TestSample1))
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	exp := strings.Join(bm.(*Matcher).Explain(buf, []int{1, 4}), "\n")
+	if !strings.Contains(exp, "BOF sequence 74657374 at 0: failed the test to the right") || !strings.Contains(exp, "result: no match (segment 0 not matched)") {
+		t.Errorf("expecting signature 1 to fail the test to the right of its BOF sequence, got %s", exp)
+	}
+	if !strings.Contains(exp, "BOF sequence 6A756E6B at 29: matched [[29 4]]") || !strings.Contains(exp, "result: byte match at 20, 4") {
+		t.Errorf("expecting signature 4 to match junk at 20 and 29, got %s", exp)
+	}
+}
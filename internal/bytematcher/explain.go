@@ -0,0 +1,274 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import (
+	"fmt"
+
+	wac "github.com/richardlehane/match/fwac"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+// maximum number of hits listed for each segment by Explain
+const explainLimit = 20
+
+// an explanation of a strike for a single key frame: either the hits it produced, or why it failed
+type explanation struct {
+	kf   keyFrameID
+	hits [][2]int64
+	fail string
+}
+
+// Explain runs only the signatures at the given indexes against the buffer. For each signature, it reports where the sequences and frames
+// searched for each segment were found, whether those hits were within the segment's offsets and passed the tests to their left and right,
+// and, finally, whether the segments combine into a complete match.
+func (b *Matcher) Explain(buf *siegreader.Buffer, sigs []int) []string {
+	want := make(map[int]bool)
+	var bof, eof int
+	for _, v := range sigs {
+		if v < 0 || v >= len(b.keyFrames) {
+			continue
+		}
+		want[v] = true
+		bof, eof = maxBOF(bof, b.keyFrames[v]), maxEOF(eof, b.keyFrames[v])
+	}
+	// the test trees linked to the signatures
+	trees := make(map[int]bool)
+	for i, t := range b.tests {
+		for _, kf := range t.keyFrames() {
+			if want[kf[0]] {
+				trees[i] = true
+				break
+			}
+		}
+	}
+	quit := make(chan struct{})
+	defer close(quit)
+	buf.Quit = quit
+	hits := make(map[keyFrameID][]string)
+	partials := make(map[keyFrameID][][2]int64)
+	test := func(st strike, desc string) {
+		where := fmt.Sprintf("BOF %s at %d", desc, st.offset)
+		if st.reverse {
+			where = fmt.Sprintf("EOF %s at %d (%d from EOF)", desc, buf.Size()-st.offset-int64(st.length), st.offset)
+		}
+		for _, e := range b.explainStrike(buf, st, want) {
+			str := where
+			if e.fail != "" {
+				str += ": " + e.fail
+			} else {
+				str += fmt.Sprintf(": matched %v", e.hits)
+				partials[e.kf] = append(partials[e.kf], e.hits...)
+			}
+			hits[e.kf] = append(hits[e.kf], str)
+		}
+	}
+	frms := func(fs *frameSet, rev bool) {
+		sub := &frameSet{}
+		for i, f := range fs.set {
+			if trees[fs.testTreeIndex[i]] {
+				sub.set = append(sub.set, f)
+				sub.testTreeIndex = append(sub.testTreeIndex, fs.testTreeIndex[i])
+			}
+		}
+		for fm := range sub.index(buf, rev, quit) {
+			test(strike{sub.testTreeIndex[fm.idx], 0, fm.off, fm.length, rev, true}, "frame "+sub.set[fm.idx].String())
+		}
+	}
+	seqs := func(ss *seqSet, rev bool, max int) {
+		sub := &seqSet{}
+		for i, seq := range ss.set {
+			for c := range seq.Choices {
+				if trees[ss.testTreeIndex[i]+c] {
+					sub.set = append(sub.set, seq)
+					sub.testTreeIndex = append(sub.testTreeIndex, ss.testTreeIndex[i])
+					break
+				}
+			}
+		}
+		if len(sub.set) == 0 {
+			return
+		}
+		rdr := siegreader.LimitReaderFrom(buf, max)
+		if rev {
+			rdr = siegreader.LimitReverseReaderFrom(buf, max)
+		}
+		for r := range wac.NewWac(b.lowMem(), sub.set).Index(rdr) {
+			if r.Index[0] == -1 {
+				continue
+			}
+			choice := sub.set[r.Index[0]].Choices[r.Index[1]]
+			test(strike{sub.testTreeIndex[r.Index[0]], r.Index[1], r.Offset, r.Length, rev, false}, "sequence "+choiceString(choice, rev))
+		}
+	}
+	frms(b.bofFrames, false)
+	seqs(b.bofSeq, false, bof)
+	if eof != 0 {
+		_, _ = buf.CanSeek(0, true) // force a full read to enable EOF scan to proceed for streams
+	}
+	frms(b.eofFrames, true)
+	seqs(b.eofSeq, true, eof)
+	// now report on each signature
+	sc := getScratch()
+	defer putScratch(sc)
+	var ret []string
+	for _, v := range sigs {
+		if !want[v] {
+			ret = append(ret, fmt.Sprintf("signature %d: no such signature", v))
+			continue
+		}
+		want[v] = false // only report once
+		kfs := b.keyFrames[v]
+		ret = append(ret, fmt.Sprintf("signature %d", v))
+		parts := make([][][2]int64, len(kfs))
+		missing := -1
+		for i, kf := range kfs {
+			id := keyFrameID{v, i}
+			ret = append(ret, fmt.Sprintf("  segment %d: %s", i, kf))
+			strs := hits[id]
+			if len(strs) == 0 {
+				ret = append(ret, "    not found")
+			}
+			for j, str := range strs {
+				if j == explainLimit {
+					ret = append(ret, fmt.Sprintf("    ... and %d more", len(strs)-j))
+					break
+				}
+				ret = append(ret, "    "+str)
+			}
+			parts[i] = partials[id]
+			if parts[i] == nil && missing < 0 {
+				missing = i
+			}
+		}
+		switch {
+		case missing >= 0:
+			ret = append(ret, fmt.Sprintf("  result: no match (segment %d not matched)", missing))
+		case len(kfs) == 1:
+			ret = append(ret, fmt.Sprintf("  result: byte match at %d, %d", parts[0][0][0], parts[0][0][1]))
+		default:
+			if ok, basis := searchPartials(parts, kfs, sc); ok {
+				ret = append(ret, "  result: "+basis)
+			} else {
+				ret = append(ret, "  result: no match (segments matched but not at the required distances from each other)")
+			}
+		}
+	}
+	return ret
+}
+
+// explainStrike is a version of the scorer's testStrike that doesn't consult priorities and that reports failures:
+// it returns an explanation for each key frame of the wanted signatures linked to the strike's test tree
+func (b *Matcher) explainStrike(buf *siegreader.Buffer, st strike, want map[int]bool) []explanation {
+	var ret []explanation
+	off := st.offset
+	if st.reverse {
+		off = buf.Size() - st.offset - int64(st.length)
+	}
+	t := b.tests[st.idxa+st.idxb]
+	const outside = "outside the segment's offsets"
+	for _, kf := range t.complete {
+		if !want[kf[0]] {
+			continue
+		}
+		if b.keyFrames[kf[0]][kf[1]].check(st.offset) {
+			ret = append(ret, explanation{kf: kf, hits: [][2]int64{{off, int64(st.length)}}})
+		} else {
+			ret = append(ret, explanation{kf: kf, fail: outside})
+		}
+	}
+	var checkl, checkr bool
+	for _, v := range t.incomplete {
+		if want[v.kf[0]] && b.keyFrames[v.kf[0]][v.kf[1]].check(st.offset) {
+			checkl, checkr = checkl || v.l, checkr || v.r
+		}
+	}
+	var lpos, rpos int64
+	var llen, rlen int
+	if st.reverse {
+		lpos, llen = st.offset+int64(st.length), t.maxLeftDistance
+		rpos, rlen = st.offset-int64(t.maxRightDistance), t.maxRightDistance
+		if rpos < 0 {
+			rlen = rlen + int(rpos)
+			rpos = 0
+		}
+	} else {
+		lpos, llen = st.offset-int64(t.maxLeftDistance), t.maxLeftDistance
+		rpos, rlen = st.offset+int64(st.length), t.maxRightDistance
+		if lpos < 0 {
+			llen = llen + int(lpos)
+			lpos = 0
+		}
+	}
+	partials := make([]partial, len(t.incomplete))
+	if checkl {
+		var lslc []byte
+		if st.reverse {
+			lslc, _ = buf.EofSlice(lpos, llen)
+		} else {
+			lslc, _ = buf.Slice(lpos, llen)
+		}
+		for _, lp := range matchTestNodes(t.left, lslc, true) {
+			partials[lp.followUp].ldistances = append(partials[lp.followUp].ldistances, lp.distances...)
+		}
+	}
+	if checkr {
+		var rslc []byte
+		if st.reverse {
+			rslc, _ = buf.EofSlice(rpos, rlen)
+		} else {
+			rslc, _ = buf.Slice(rpos, rlen)
+		}
+		for _, rp := range matchTestNodes(t.right, rslc, false) {
+			partials[rp.followUp].rdistances = append(partials[rp.followUp].rdistances, rp.distances...)
+		}
+	}
+	for i, v := range t.incomplete {
+		if !want[v.kf[0]] {
+			continue
+		}
+		if !b.keyFrames[v.kf[0]][v.kf[1]].check(st.offset) {
+			ret = append(ret, explanation{kf: v.kf, fail: outside})
+			continue
+		}
+		p := partials[i]
+		lfail, rfail := v.l && len(p.ldistances) == 0, v.r && len(p.rdistances) == 0
+		switch {
+		case lfail && rfail:
+			ret = append(ret, explanation{kf: v.kf, fail: "failed the tests to the left and right"})
+			continue
+		case lfail:
+			ret = append(ret, explanation{kf: v.kf, fail: "failed the test to the left"})
+			continue
+		case rfail:
+			ret = append(ret, explanation{kf: v.kf, fail: "failed the test to the right"})
+			continue
+		}
+		if p.ldistances == nil {
+			p.ldistances = []int{0}
+		}
+		if p.rdistances == nil {
+			p.rdistances = []int{0}
+		}
+		e := explanation{kf: v.kf}
+		for _, ldistance := range p.ldistances {
+			for _, rdistance := range p.rdistances {
+				e.hits = append(e.hits, [2]int64{off - int64(ldistance), int64(ldistance + st.length + rdistance)})
+			}
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
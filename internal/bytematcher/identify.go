@@ -16,15 +16,81 @@ package bytematcher
 
 import (
 	"fmt"
+	"sync"
 
 	wac "github.com/richardlehane/match/fwac"
+	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
+	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/internal/siegreader"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
 )
 
+// waitingOnEOF reports whether any of the signatures that the WaitSet is still waiting on have segments anchored to the end of file.
+// If nothing has matched yet, the WaitSet is waiting on everything and this is true.
+func (b *Matcher) waitingOnEOF(ws *priority.WaitSet) bool {
+	w := ws.WaitingOn()
+	if w == nil {
+		return true
+	}
+	for _, v := range w {
+		for _, kf := range b.keyFrames[v] {
+			if kf.typ > frames.PREV {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// clamp limits a max offset (< 0 is unlimited) to a scan window (0 is no window)
+func clamp(max, window int) int {
+	if window > 0 && (max < 0 || max > window) {
+		return window
+	}
+	return max
+}
+
+// nearer returns the smaller of two max offsets (< 0 is unlimited)
+func nearer(a, b int) int {
+	if a < 0 || (b >= 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// fastSigs returns the signatures, among those that identifiers have suggested through hints (e.g. because of an extension match),
+// that lie wholly within the first fastWindow bytes of a file. In -fasthash ext mode, a match on one of these ends the scan.
+func (b *Matcher) fastSigs(hints []core.Hint) map[int]bool {
+	var ret map[int]bool
+	for _, h := range hints {
+		for _, v := range h.Pivot {
+			if v < 0 || v >= len(b.keyFrames) || !withinFast(b.keyFrames[v]) {
+				continue
+			}
+			if ret == nil {
+				ret = make(map[int]bool)
+			}
+			ret[v] = true
+		}
+	}
+	return ret
+}
+
+// the window at the beginning of file within which signatures must lie to be used by the -fasthash ext mode
+const fastWindow = 1024
+
+func withinFast(kfs []keyFrame) bool {
+	for _, kf := range kfs {
+		if kf.typ > frames.PREV || kf.key.pMax < 0 || kf.key.pMax+int64(kf.key.lMax) > fastWindow {
+			return false
+		}
+	}
+	return len(kfs) > 0
+}
+
 // identify function - brings a new matcher into existence
-func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan core.Result, hints ...core.Hint) {
+func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan core.Result, tr trace, hints ...core.Hint) {
 	buf.Quit = quit
 	waitSet := b.priorities.WaitSet(hints...)
 	maxBOF, maxEOF := b.maxBOF, b.maxEOF
@@ -40,7 +106,18 @@ func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan co
 			maxBOF, maxEOF = waitSet.MaxOffsets()
 		}
 	}
-	incoming := b.scorer(buf, waitSet, quit, r)
+	if b.limit != nil {
+		waitSet.Limit(b.limit)
+		maxBOF, maxEOF = nearer(maxBOF, b.limOff[0]), nearer(maxEOF, b.limOff[1])
+	}
+	if bof, eof := config.ScanWindows(); bof > 0 || eof > 0 {
+		maxBOF, maxEOF = clamp(maxBOF, bof), clamp(maxEOF, eof)
+	}
+	var fast map[int]bool
+	if config.FastExt() {
+		fast = b.fastSigs(hints)
+	}
+	incoming := b.scorer(buf, waitSet, quit, r, tr, fast)
 	rdr := siegreader.LimitReaderFrom(buf, maxBOF)
 	// First test BOF frameset
 	bfchan := b.bofFrames.index(buf, false, quit)
@@ -61,7 +138,7 @@ func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan co
 
 	// start bof matcher if not yet started
 	b.bmu.Do(func() {
-		b.bAho = wac.NewWac(b.lowmem, b.bofSeq.set)
+		b.bAho = wac.NewWac(b.lowMem(), b.bofSeq.set)
 	})
 	var bchan chan wac.Result
 
@@ -89,18 +166,48 @@ func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan co
 	default:
 	}
 
+	finishBOF := func() {
+		for br := range bchan {
+			if br.Index[0] == -1 {
+				incoming <- progressStrike(br.Offset, false)
+			} else {
+				if config.Debug() {
+					fmt.Fprintln(config.Out(), strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false})
+				}
+				incoming <- strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false}
+			}
+		}
+	}
+
+	// If the matches so far have narrowed the candidates to signatures without EOF segments, skip the EOF scan
+	// (for streams, this also avoids forcing a full read). The remaining BOF scan stops once no candidate's
+	// segments can be found beyond the current offset (see continueWaiting in the scorer).
+	if !b.waitingOnEOF(waitSet) {
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), "skipping EOF scan: no remaining candidates have EOF segments")
+		}
+		finishBOF()
+		close(incoming)
+		return
+	}
+
 	// Setup EOF tests
 	efchan := b.eofFrames.index(buf, true, quit)
 	b.emu.Do(func() {
-		b.eAho = wac.NewWac(b.lowmem, b.eofSeq.set)
+		b.eAho = wac.NewWac(b.lowMem(), b.eofSeq.set)
 	})
 	rrdr := siegreader.LimitReverseReaderFrom(buf, maxEOF)
 	echan := b.eAho.Index(rrdr)
 
-	// if we have a maximum value on EOF do a sequential search
-	if maxEOF >= 0 {
-		if maxEOF != 0 {
-			_, _ = buf.CanSeek(0, true) // force a full read to enable EOF scan to proceed for streams
+	// Walk the EOF frames and sequences in their own goroutine, so that the rest of the BOF walk runs concurrently with the EOF walk.
+	// Both walks send their strikes to the scorer, which tallies them in the order they arrive.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// if we have a maximum value on EOF, the EOF walk is short: force a full read to enable it to proceed for streams
+		if maxEOF > 0 {
+			_, _ = buf.CanSeek(0, true)
 		}
 		for ef := range efchan {
 			if config.Debug() {
@@ -108,7 +215,6 @@ func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan co
 			}
 			incoming <- strike{b.eofFrames.testTreeIndex[ef.idx], 0, ef.off, ef.length, true, true}
 		}
-		// Scan complete EOF
 		for er := range echan {
 			if er.Index[0] == -1 {
 				incoming <- progressStrike(er.Offset, true)
@@ -120,66 +226,14 @@ func (b *Matcher) identify(buf *siegreader.Buffer, quit chan struct{}, r chan co
 			}
 		}
 		// send a final progress strike with the maximum EOF
-		incoming <- progressStrike(int64(maxEOF), true)
-		// Finally, finish BOF scan
-		for br := range bchan {
-			if br.Index[0] == -1 {
-				incoming <- progressStrike(br.Offset, false)
-			} else {
-				if config.Debug() {
-					fmt.Fprintln(config.Out(), strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false})
-				}
-				incoming <- strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false}
-			}
-		}
-		close(incoming)
-		return
-	}
-	// If no maximum on EOF do a parallel search
-	for {
-		select {
-		case br, ok := <-bchan:
-			if !ok {
-				if maxBOF < 0 && maxEOF != 0 {
-					_, _ = buf.CanSeek(0, true) // if we've a limit BOF reader, force a full read to enable EOF scan to proceed for streams
-				}
-				bchan = nil
-			} else {
-				if br.Index[0] == -1 {
-					incoming <- progressStrike(br.Offset, false)
-				} else {
-					if config.Debug() {
-						fmt.Fprintln(config.Out(), strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false})
-					}
-					incoming <- strike{b.bofSeq.testTreeIndex[br.Index[0]], br.Index[1], br.Offset, br.Length, false, false}
-				}
-			}
-		case ef, ok := <-efchan:
-			if !ok {
-				efchan = nil
-			} else {
-				if config.Debug() {
-					fmt.Fprintln(config.Out(), strike{b.eofFrames.testTreeIndex[ef.idx], 0, ef.off, ef.length, true, true})
-				}
-				incoming <- strike{b.eofFrames.testTreeIndex[ef.idx], 0, ef.off, ef.length, true, true}
-			}
-		case er, ok := <-echan:
-			if !ok {
-				echan = nil
-			} else {
-				if er.Index[0] == -1 {
-					incoming <- progressStrike(er.Offset, true)
-				} else {
-					if config.Debug() {
-						fmt.Fprintln(config.Out(), strike{b.eofSeq.testTreeIndex[er.Index[0]], er.Index[1], er.Offset, er.Length, true, false})
-					}
-					incoming <- strike{b.eofSeq.testTreeIndex[er.Index[0]], er.Index[1], er.Offset, er.Length, true, false}
-				}
-			}
-		}
-		if bchan == nil && efchan == nil && echan == nil {
-			close(incoming)
-			return
+		if maxEOF >= 0 {
+			incoming <- progressStrike(int64(maxEOF), true)
 		}
+	}()
+	finishBOF()
+	if maxEOF < 0 && maxBOF < 0 {
+		_, _ = buf.CanSeek(0, true) // if EOF is unlimited, force a full read to enable the EOF walk to proceed for streams
 	}
+	wg.Wait()
+	close(incoming)
 }
@@ -1,5 +1,42 @@
 package bytematcher
 
+import (
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
+	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
+	"github.com/richardlehane/siegfried/internal/priority"
+)
+
+func TestWaitingOnEOF(t *testing.T) {
+	bof := frames.NewFrame(frames.BOF, patterns.Sequence("abc"), 0, 0)
+	eof := frames.NewFrame(frames.EOF, patterns.Sequence("xyz"), 0, 0)
+	sigs := SignatureSet{
+		frames.Signature{bof},      // 0: subordinate to 1
+		frames.Signature{bof, eof}, // 1
+		frames.Signature{bof},      // 2: subordinate to 3
+		frames.Signature{bof},      // 3
+	}
+	m, _, err := Add(nil, sigs, priority.List{{1}, {}, {3}, {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bm := m.(*Matcher)
+	ws := bm.priorities.WaitSet()
+	if !bm.waitingOnEOF(ws) {
+		t.Error("expecting to wait on EOF before any matches")
+	}
+	ws.Put(0)
+	if !bm.waitingOnEOF(ws) {
+		t.Error("expecting to wait on EOF for a superior signature with an EOF segment")
+	}
+	ws = bm.priorities.WaitSet()
+	ws.Put(2)
+	if bm.waitingOnEOF(ws) {
+		t.Error("expecting no need to wait on EOF when the only superior signature has no EOF segments")
+	}
+}
+
 // TODO: something!
 
 /*
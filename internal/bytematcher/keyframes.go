@@ -416,14 +416,15 @@ func checkRelatedKF(thisKf, prevKf keyFrame, thisOff, prevOff [2]int64) (bool, b
 	}
 }
 
-func checkRelated(thisKf, prevKf, nextKf keyFrame, thisOff, prevOff [][2]int64) ([][2]int64, []int, bool) {
+// checkRelated returns those offsets of thisOff that are related to prevOff, with indexes into prevOff. Returned slices are allocated from sc.
+func checkRelated(thisKf, prevKf, nextKf keyFrame, thisOff, prevOff [][2]int64, sc *scratch) ([][2]int64, []int, bool) {
 	switch thisKf.typ {
 	case frames.BOF:
-		return thisOff, make([]int, len(thisOff)), true
+		return thisOff, sc.zeros(len(thisOff)), true
 	case frames.EOF, frames.SUCC:
 		if prevKf.typ == frames.SUCC && !(prevKf.seg.pMax == -1 && prevKf.seg.pMin == 0) {
-			ret := make([][2]int64, 0, len(thisOff))
-			idx := make([]int, 0, len(prevOff))
+			ret := sc.offsets()
+			idx := sc.indexes()
 			success := false
 			for _, v := range thisOff {
 				for i, v1 := range prevOff {
@@ -437,22 +438,22 @@ func checkRelated(thisKf, prevKf, nextKf keyFrame, thisOff, prevOff [][2]int64)
 							success = true
 							// if this type is EOF, we only need one match
 							if thisKf.typ == frames.EOF {
-								return ret, idx, success
+								return sc.keep(ret, idx, success)
 							}
 						}
 					}
 				}
 			}
-			return ret, idx, success
+			return sc.keep(ret, idx, success)
 		} else {
-			return thisOff, make([]int, len(thisOff)), true
+			return thisOff, sc.zeros(len(thisOff)), true
 		}
 	default:
 		if thisKf.seg.pMax == -1 && thisKf.seg.pMin == 0 {
-			return thisOff, make([]int, len(thisOff)), true
+			return thisOff, sc.zeros(len(thisOff)), true
 		}
-		ret := make([][2]int64, 0, len(thisOff))
-		idx := make([]int, 0, len(prevOff))
+		ret := sc.offsets()
+		idx := sc.indexes()
 		success := false
 		for _, v := range thisOff {
 			for i, v1 := range prevOff {
@@ -466,12 +467,12 @@ func checkRelated(thisKf, prevKf, nextKf keyFrame, thisOff, prevOff [][2]int64)
 						success = true
 						// if the next type isn't a non-wild PREV, we only need one match
 						if nextKf.typ != frames.PREV || (nextKf.seg.pMax == -1 && nextKf.seg.pMin == 0) {
-							return ret, idx, success
+							return sc.keep(ret, idx, success)
 						}
 					}
 				}
 			}
 		}
-		return ret, idx, success
+		return sc.keep(ret, idx, success)
 	}
 }
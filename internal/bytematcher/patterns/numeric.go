@@ -0,0 +1,509 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patterns
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+)
+
+// Numeric patterns match fixed size integers, stored in a given byte order. Host16 and Host32 match in either byte order.
+// These are the numeric types of freedesktop.org magic (see pkg/mimeinfo) but can be used by any identifier.
+
+type Int8 byte
+
+// Test bytes against the pattern.
+func (n Int8) Test(b []byte) ([]int, int) {
+	if len(b) < 1 {
+		return nil, 0
+	}
+	if b[0] == byte(n) {
+		return []int{1}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Int8) TestR(b []byte) ([]int, int) {
+	if len(b) < 1 {
+		return nil, 0
+	}
+	if b[len(b)-1] == byte(n) {
+		return []int{1}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Int8) Equals(pat Pattern) bool {
+	n2, ok := pat.(Int8)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Int8) Length() (int, int) {
+	return 1, 1
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Int8) NumSequences() int {
+	return 1
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Int8) Sequences() []Sequence {
+	return []Sequence{{byte(n)}}
+}
+
+func (n Int8) String() string {
+	return "int8 " + hex.EncodeToString([]byte{byte(n)})
+}
+
+// Save persists the pattern.
+func (n Int8) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(int8Loader)
+	ls.SaveByte(byte(n))
+}
+
+func loadInt8(ls *persist.LoadSaver) Pattern {
+	return Int8(ls.LoadByte())
+}
+
+type Big16 uint16
+
+// Test bytes against the pattern.
+func (n Big16) Test(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.BigEndian.Uint16(b[:2]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Big16) TestR(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.BigEndian.Uint16(b[len(b)-2:]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Big16) Equals(pat Pattern) bool {
+	n2, ok := pat.(Big16)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Big16) Length() (int, int) {
+	return 2, 2
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Big16) NumSequences() int {
+	return 1
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Big16) Sequences() []Sequence {
+	seq := make(Sequence, 2)
+	binary.BigEndian.PutUint16([]byte(seq), uint16(n))
+	return []Sequence{seq}
+}
+
+func (n Big16) String() string {
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, uint16(n))
+	return "big16 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Big16) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(big16Loader)
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, uint16(n))
+	ls.SaveBytes(buf)
+}
+
+func loadBig16(ls *persist.LoadSaver) Pattern {
+	return Big16(binary.BigEndian.Uint16(ls.LoadBytes()))
+}
+
+type Big32 uint32
+
+// Test bytes against the pattern.
+func (n Big32) Test(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.BigEndian.Uint32(b[:4]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Big32) TestR(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.BigEndian.Uint32(b[len(b)-4:]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Big32) Equals(pat Pattern) bool {
+	n2, ok := pat.(Big32)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Big32) Length() (int, int) {
+	return 4, 4
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Big32) NumSequences() int {
+	return 1
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Big32) Sequences() []Sequence {
+	seq := make(Sequence, 4)
+	binary.BigEndian.PutUint32([]byte(seq), uint32(n))
+	return []Sequence{seq}
+}
+
+func (n Big32) String() string {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, uint32(n))
+	return "big32 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Big32) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(big32Loader)
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, uint32(n))
+	ls.SaveBytes(buf)
+}
+
+func loadBig32(ls *persist.LoadSaver) Pattern {
+	return Big32(binary.BigEndian.Uint32(ls.LoadBytes()))
+}
+
+type Little16 uint16
+
+// Test bytes against the pattern.
+func (n Little16) Test(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint16(b[:2]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Little16) TestR(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint16(b[len(b)-2:]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Little16) Equals(pat Pattern) bool {
+	n2, ok := pat.(Little16)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Little16) Length() (int, int) {
+	return 2, 2
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Little16) NumSequences() int {
+	return 1
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Little16) Sequences() []Sequence {
+	seq := make(Sequence, 2)
+	binary.LittleEndian.PutUint16([]byte(seq), uint16(n))
+	return []Sequence{seq}
+}
+
+func (n Little16) String() string {
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(n))
+	return "little16 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Little16) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(little16Loader)
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(n))
+	ls.SaveBytes(buf)
+}
+
+func loadLittle16(ls *persist.LoadSaver) Pattern {
+	return Little16(binary.LittleEndian.Uint16(ls.LoadBytes()))
+}
+
+type Little32 uint32
+
+// Test bytes against the pattern.
+func (n Little32) Test(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint32(b[:4]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Little32) TestR(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint32(b[len(b)-4:]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Little32) Equals(pat Pattern) bool {
+	n2, ok := pat.(Little32)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Little32) Length() (int, int) {
+	return 4, 4
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Little32) NumSequences() int {
+	return 1
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Little32) Sequences() []Sequence {
+	seq := make(Sequence, 4)
+	binary.LittleEndian.PutUint32([]byte(seq), uint32(n))
+	return []Sequence{seq}
+}
+
+func (n Little32) String() string {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(n))
+	return "little32 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Little32) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(little32Loader)
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(n))
+	ls.SaveBytes(buf)
+}
+
+func loadLittle32(ls *persist.LoadSaver) Pattern {
+	return Little32(binary.LittleEndian.Uint32(ls.LoadBytes()))
+}
+
+type Host16 uint16
+
+// Test bytes against the pattern.
+func (n Host16) Test(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint16(b[:2]) == uint16(n) {
+		return []int{2}, 1
+	}
+	if binary.BigEndian.Uint16(b[:2]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Host16) TestR(b []byte) ([]int, int) {
+	if len(b) < 2 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint16(b[len(b)-2:]) == uint16(n) {
+		return []int{2}, 1
+	}
+	if binary.BigEndian.Uint16(b[len(b)-2:]) == uint16(n) {
+		return []int{2}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Host16) Equals(pat Pattern) bool {
+	n2, ok := pat.(Host16)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Host16) Length() (int, int) {
+	return 2, 2
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Host16) NumSequences() int {
+	return 2
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Host16) Sequences() []Sequence {
+	seq, seq2 := make(Sequence, 2), make(Sequence, 2)
+	binary.LittleEndian.PutUint16([]byte(seq), uint16(n))
+	binary.BigEndian.PutUint16([]byte(seq2), uint16(n))
+	return []Sequence{seq, seq2}
+}
+
+func (n Host16) String() string {
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, uint16(n))
+	return "host16 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Host16) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(host16Loader)
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(n))
+	ls.SaveBytes(buf)
+}
+
+func loadHost16(ls *persist.LoadSaver) Pattern {
+	return Host16(binary.LittleEndian.Uint16(ls.LoadBytes()))
+}
+
+type Host32 uint32
+
+// Test bytes against the pattern.
+func (n Host32) Test(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint32(b[:4]) == uint32(n) {
+		return []int{4}, 1
+	}
+	if binary.BigEndian.Uint32(b[:4]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Test bytes against the pattern in reverse.
+func (n Host32) TestR(b []byte) ([]int, int) {
+	if len(b) < 4 {
+		return nil, 0
+	}
+	if binary.LittleEndian.Uint32(b[len(b)-4:]) == uint32(n) {
+		return []int{4}, 1
+	}
+	if binary.BigEndian.Uint32(b[len(b)-4:]) == uint32(n) {
+		return []int{4}, 1
+	}
+	return nil, 1
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (n Host32) Equals(pat Pattern) bool {
+	n2, ok := pat.(Host32)
+	if ok {
+		return n == n2
+	}
+	return false
+}
+
+// Length returns a minimum and maximum length for the pattern.
+func (n Host32) Length() (int, int) {
+	return 4, 4
+}
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+func (n Host32) NumSequences() int {
+	return 2
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (n Host32) Sequences() []Sequence {
+	seq, seq2 := make(Sequence, 4), make(Sequence, 4)
+	binary.LittleEndian.PutUint32([]byte(seq), uint32(n))
+	binary.BigEndian.PutUint32([]byte(seq2), uint32(n))
+	return []Sequence{seq, seq2}
+}
+
+func (n Host32) String() string {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, uint32(n))
+	return "host32 " + hex.EncodeToString(buf)
+}
+
+// Save persists the pattern.
+func (n Host32) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(host32Loader)
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(n))
+	ls.SaveBytes(buf)
+}
+
+func loadHost32(ls *persist.LoadSaver) Pattern {
+	return Host32(binary.LittleEndian.Uint32(ls.LoadBytes()))
+}
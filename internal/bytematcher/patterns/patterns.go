@@ -13,7 +13,8 @@
 // limitations under the License.
 
 // Package patterns describes the Pattern interface.
-// Standard patterns are also defined in this package: Sequence (as well as BMH and reverse BMH Sequence), Choice, List and Not.
+// Standard patterns are also defined in this package: Sequence (as well as BMH and reverse BMH Sequence), Choice, List and Not,
+// and the numeric patterns: Int8, Big16, Big32, Little16, Little32, Host16 and Host32.
 package patterns
 
 import (
@@ -36,6 +37,13 @@ func init() {
 	Register(rbmhLoader, loadRBMH)
 	Register(maskLoader, loadMask)
 	Register(anyMaskLoader, loadAnyMask)
+	Register(int8Loader, loadInt8)
+	Register(big16Loader, loadBig16)
+	Register(big32Loader, loadBig32)
+	Register(little16Loader, loadLittle16)
+	Register(little32Loader, loadLittle32)
+	Register(host16Loader, loadHost16)
+	Register(host32Loader, loadHost32)
 }
 
 // Stringify returns a string version of a byte slice.
@@ -76,9 +84,20 @@ const (
 	anyMaskLoader
 )
 
+// the numeric patterns keep the ids they had when defined in the mimeinfo package, so existing signature files load
+const (
+	int8Loader byte = iota + 16
+	big16Loader
+	big32Loader
+	little16Loader
+	little32Loader
+	host16Loader
+	host32Loader
+)
+
 var loaders = [32]Loader{}
 
-// Register a new Loader (provide an id higher than 16).
+// Register a new Loader (provide an id higher than 24: lower ids are used by this package and by the frames, pronom and mimeinfo packages).
 func Register(id byte, l Loader) {
 	loaders[int(id)] = l
 }
@@ -22,9 +22,9 @@ import (
 	"github.com/richardlehane/siegfried/pkg/config"
 )
 
-func (b *Matcher) addSignature(sig frames.Signature) error {
+func (b *Matcher) addSignature(sig frames.Signature, seg config.Segmentation) error {
 	// todo: add cost to the Segment - or merge segments based on cost?
-	segments := sig.Segment(config.Distance(), config.Range(), config.Cost(), config.Repetition())
+	segments := sig.Segment(seg.Distance, seg.Range, seg.Cost, seg.Repetition)
 	// apply config no eof option
 	if config.NoEOF() {
 		var hasEof bool
@@ -54,11 +54,11 @@ func (b *Matcher) addSignature(sig frames.Signature) error {
 		case frames.Unknown:
 			return fmt.Errorf("Zero length segment: signature %d, %v, segment %d", len(b.keyFrames), sig, i)
 		case frames.BOFZero:
-			pos = frames.BOFLength(segment, config.Choices())
+			pos = frames.BOFLength(segment, seg.Choices)
 		case frames.EOFZero:
-			pos = frames.EOFLength(segment, config.Choices())
+			pos = frames.EOFLength(segment, seg.Choices)
 		default:
-			pos = frames.VarLength(segment, config.Choices())
+			pos = frames.VarLength(segment, seg.Choices)
 		}
 		if pos.Length < 1 {
 			switch c {
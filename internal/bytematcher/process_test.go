@@ -40,7 +40,7 @@ func TestProcess(t *testing.T) {
 	config.SetRange(2059)()
 	config.SetChoices(9)()
 	for i, v := range tests.TestSignatures {
-		err := b.addSignature(v)
+		err := b.addSignature(v, config.GetSegmentation())
 		if err != nil {
 			t.Errorf("Unexpected error adding persist; sig %v; error %v", i, v)
 		}
@@ -89,7 +89,7 @@ func TestProcessFmt418(t *testing.T) {
 	config.SetDistance(2000)()
 	config.SetRange(500)()
 	config.SetChoices(10)()
-	b.addSignature(tests.TestFmts[418])
+	b.addSignature(tests.TestFmts[418], config.GetSegmentation())
 	saver := persist.NewLoadSaver(nil)
 	Save(b, saver)
 	loader := persist.NewLoadSaver(saver.Bytes())
@@ -108,7 +108,7 @@ func TestProcessFmt134(t *testing.T) {
 	config.SetDistance(1000)
 	config.SetRange(500)
 	config.SetChoices(3)
-	b.addSignature(tests.TestFmts[134])
+	b.addSignature(tests.TestFmts[134], config.GetSegmentation())
 	saver := persist.NewLoadSaver(nil)
 	Save(b, saver)
 	loader := persist.NewLoadSaver(saver.Bytes())
@@ -132,7 +132,7 @@ func TestProcessFmt134(t *testing.T) {
 
 func TestProcessFmt363(t *testing.T) {
 	b := newMatcher()
-	b.addSignature(tests.TestFmts[363])
+	b.addSignature(tests.TestFmts[363], config.GetSegmentation())
 	saver := persist.NewLoadSaver(nil)
 	Save(b, saver)
 	loader := persist.NewLoadSaver(saver.Bytes())
@@ -90,9 +90,8 @@ type hitItem struct {
 }
 
 // search a set of partials for a complete match
-func searchPartials(partials [][][2]int64, kfs []keyFrame) (bool, string) {
-	res := make([][][2]int64, len(partials))
-	idxs := make([][]int, len(partials))
+func searchPartials(partials [][][2]int64, kfs []keyFrame, sc *scratch) (bool, string) {
+	res, idxs := sc.search(len(partials))
 	prevOff := partials[0]
 	var idx []int
 	ok := false
@@ -102,7 +101,7 @@ func searchPartials(partials [][][2]int64, kfs []keyFrame) (bool, string) {
 		if i+2 < len(kfs) {
 			nextKf = kfs[i+2]
 		}
-		prevOff, idx, ok = checkRelated(kf, kfs[i], nextKf, partials[i+1], prevOff)
+		prevOff, idx, ok = checkRelated(kf, kfs[i], nextKf, partials[i+1], prevOff, sc)
 		if !ok {
 			return false, ""
 		}
@@ -200,10 +199,10 @@ func (r result) Basis() string {
 	return r.basis
 }
 
-func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q chan struct{}, r chan<- core.Result) chan<- strike {
+func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q chan struct{}, r chan<- core.Result, tr trace, fast map[int]bool) chan<- strike {
 	incoming := make(chan strike)
-	hits := make(map[int]*hitItem)
-	strikes := make(map[int]*strikeItem)
+	sc := getScratch()
+	hits, strikes := sc.hits, sc.strikes
 
 	var bof int64
 	var eof int64
@@ -215,11 +214,7 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 	}
 
 	newHit := func(i int) *hitItem {
-		l := len(b.keyFrames[i])
-		hit := &hitItem{
-			potentialIdxs: make([]int, l),
-			partials:      make([][][2]int64, l),
-		}
+		hit := sc.newHit(len(b.keyFrames[i]))
 		hits[i] = hit
 		return hit
 	}
@@ -270,7 +265,8 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 		}
 		// grab the relevant testTree
 		t := b.tests[st.idxa+st.idxb]
-		res := make([]kfHit, 0, 10)
+		res := sc.kfHits[:0]
+		defer func() { sc.kfHits = res[:0] }()
 		// immediately apply key frames for the completes
 		for _, kf := range t.complete {
 			if b.keyFrames[kf[0]][kf[1]].check(st.offset) && waitSet.Check(kf[0]) {
@@ -399,7 +395,7 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 				return false, ""
 			}
 		}
-		return searchPartials(h.partials, kfs)
+		return searchPartials(h.partials, kfs, sc)
 	}
 
 	go func() {
@@ -433,6 +429,7 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 				continue
 			}
 			// HANDLE MATCH STRIKES
+			tr.strike(in)
 			var hasPotential bool
 			potentials := filterKF(b.tests[in.idxa+in.idxb].keyFrames(), waitSet)
 			for _, pot := range potentials {
@@ -450,12 +447,9 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 				// cache the strike
 				s, ok := strikes[in.idxa+in.idxb]
 				if !ok {
-					s = &strikeItem{in, -1, nil}
+					s = sc.newStrike(in)
 					strikes[in.idxa+in.idxb] = s
 				} else {
-					if s.successive == nil {
-						s.successive = make([][2]int64, 0, 10)
-					}
 					s.successive = append(s.successive, [2]int64{in.offset, int64(in.length)})
 				}
 				// range over the potentials, linking to the strike
@@ -474,10 +468,11 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 			for {
 				ks := testStrike(in)
 				for _, k := range ks {
+					tr.keyFrame(k)
 					if match, basis := applyKeyFrame(k); match {
 						if waitSet.Check(k.id[0]) {
 							r <- result{k.id[0], basis}
-							if waitSet.PutAt(k.id[0], bof, eof) {
+							if waitSet.PutAt(k.id[0], bof, eof) || fast[k.id[0]] {
 								quit()
 								goto end
 							}
@@ -502,6 +497,7 @@ func (b *Matcher) scorer(buf *siegreader.Buffer, waitSet *priority.WaitSet, q ch
 			}
 		end: // keep looping until incoming is closed
 		}
+		putScratch(sc)
 		close(r)
 	}()
 	return incoming
@@ -18,7 +18,7 @@ func setup() (chan<- strike, <-chan core.Result) {
 	buf, _ := bufs.Get(bytes.NewBuffer(TestSample1))
 	buf.SizeNow()
 	res := make(chan core.Result)
-	return bm.scorer(buf, bm.priorities.WaitSet(), make(chan struct{}), res), res
+	return bm.scorer(buf, bm.priorities.WaitSet(), make(chan struct{}), res, trace{}, nil), res
 }
 
 func TestScorer(t *testing.T) {
@@ -57,7 +57,7 @@ func TestIssue94(t *testing.T) {
 	buf, _ := bufs.Get(bytes.NewBuffer(sheetPDF))
 	buf.SizeNow()
 	res := make(chan core.Result)
-	incoming := bm.scorer(buf, bm.priorities.WaitSet(), make(chan struct{}), res)
+	incoming := bm.scorer(buf, bm.priorities.WaitSet(), make(chan struct{}), res, trace{}, nil)
 	incoming <- strike{0, 0, 0, 2, false, false}
 	if r := <-res; r.Index() != 0 {
 		t.Errorf("expecing result %d, got %d", 0, r.Index())
@@ -0,0 +1,153 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import "sync"
+
+// scratch holds the per-identification state of the scorer so that it can be reused between files.
+// When scanning many small files, allocating this state afresh for each file causes significant GC overhead.
+type scratch struct {
+	hits    map[int]*hitItem
+	strikes map[int]*strikeItem
+	// free lists of items, recycled from earlier identifications
+	freeHits    []*hitItem
+	freeStrikes []*strikeItem
+	// testStrike results
+	kfHits []kfHit
+	// arenas for searchPartials and checkRelated: reset for each search
+	res  [][][2]int64
+	idxs [][]int
+	offs [][2]int64
+	ints []int
+}
+
+// don't hang on to arenas that have grown very large
+const maxArena = 1 << 16
+
+var scratchPool = sync.Pool{
+	New: func() interface{} {
+		return &scratch{
+			hits:    make(map[int]*hitItem),
+			strikes: make(map[int]*strikeItem),
+		}
+	},
+}
+
+func getScratch() *scratch {
+	return scratchPool.Get().(*scratch)
+}
+
+// putScratch recycles the hit and strike items and returns the scratch to the pool
+func putScratch(sc *scratch) {
+	for k, h := range sc.hits {
+		sc.freeHits = append(sc.freeHits, h)
+		delete(sc.hits, k)
+	}
+	for k, s := range sc.strikes {
+		sc.freeStrikes = append(sc.freeStrikes, s)
+		delete(sc.strikes, k)
+	}
+	if cap(sc.offs) > maxArena {
+		sc.offs = nil
+	}
+	if cap(sc.ints) > maxArena {
+		sc.ints = nil
+	}
+	scratchPool.Put(sc)
+}
+
+// newHit returns an empty hitItem for a signature with l keyframes
+func (sc *scratch) newHit(l int) *hitItem {
+	var h *hitItem
+	if n := len(sc.freeHits); n > 0 {
+		h = sc.freeHits[n-1]
+		sc.freeHits = sc.freeHits[:n-1]
+	} else {
+		h = &hitItem{}
+	}
+	if cap(h.potentialIdxs) < l {
+		h.potentialIdxs = make([]int, l)
+		h.partials = make([][][2]int64, l)
+	} else {
+		h.potentialIdxs = h.potentialIdxs[:l]
+		h.partials = h.partials[:l]
+		for i := range h.potentialIdxs {
+			h.potentialIdxs[i] = 0
+			h.partials[i] = nil
+		}
+	}
+	h.matched = false
+	return h
+}
+
+// newStrike returns a strikeItem for the first strike st
+func (sc *scratch) newStrike(st strike) *strikeItem {
+	if n := len(sc.freeStrikes); n > 0 {
+		s := sc.freeStrikes[n-1]
+		sc.freeStrikes = sc.freeStrikes[:n-1]
+		s.first, s.idx, s.successive = st, -1, s.successive[:0]
+		return s
+	}
+	return &strikeItem{st, -1, nil}
+}
+
+// reset the arenas and return res and idxs slices of length l
+func (sc *scratch) search(l int) ([][][2]int64, [][]int) {
+	sc.offs, sc.ints = sc.offs[:0], sc.ints[:0]
+	if cap(sc.res) < l {
+		sc.res, sc.idxs = make([][][2]int64, l), make([][]int, l)
+	} else {
+		sc.res, sc.idxs = sc.res[:l], sc.idxs[:l]
+		for i := range sc.res {
+			sc.res[i], sc.idxs[i] = nil, nil
+		}
+	}
+	return sc.res, sc.idxs
+}
+
+// offsets and indexes return empty slices that can be appended to; call keep once done appending
+func (sc *scratch) offsets() [][2]int64 {
+	return sc.offs[len(sc.offs):]
+}
+
+func (sc *scratch) indexes() []int {
+	return sc.ints[len(sc.ints):]
+}
+
+// keep reserves the space used by slices returned by offsets and indexes.
+// If an append outgrew the arena, the new array becomes the arena (the old one is still referenced by earlier slices).
+func (sc *scratch) keep(ret [][2]int64, idx []int, ok bool) ([][2]int64, []int, bool) {
+	if len(ret) <= cap(sc.offs)-len(sc.offs) {
+		sc.offs = sc.offs[:len(sc.offs)+len(ret)]
+	} else {
+		sc.offs = ret
+	}
+	if len(idx) <= cap(sc.ints)-len(sc.ints) {
+		sc.ints = sc.ints[:len(sc.ints)+len(idx)]
+	} else {
+		sc.ints = idx
+	}
+	return ret, idx, ok
+}
+
+// zeros returns a slice of l zeros from the arena
+func (sc *scratch) zeros(l int) []int {
+	idx := sc.indexes()
+	for i := 0; i < l; i++ {
+		idx = append(idx, 0)
+	}
+	_, idx, _ = sc.keep(nil, idx, true)
+	return idx
+}
@@ -0,0 +1,30 @@
+package bytematcher
+
+import "testing"
+
+func TestScratch(t *testing.T) {
+	sc := getScratch()
+	res, _ := sc.search(2)
+	a := sc.offsets()
+	a = append(a, [2]int64{1, 1}, [2]int64{2, 2})
+	a, _, _ = sc.keep(a, nil, true)
+	b := sc.offsets()
+	b = append(b, [2]int64{3, 3})
+	b, _, _ = sc.keep(b, nil, true)
+	res[0], res[1] = a, b
+	if len(a) != 2 || a[1][0] != 2 || len(b) != 1 || b[0][0] != 3 {
+		t.Errorf("arena slices overlap, got %v and %v", a, b)
+	}
+	z := sc.zeros(3)
+	if len(z) != 3 || z[0] != 0 || z[2] != 0 {
+		t.Errorf("expecting three zeros, got %v", z)
+	}
+	h := sc.newHit(2)
+	h.potentialIdxs[0], h.partials[1], h.matched = 5, a, true
+	sc.hits[0] = h
+	putScratch(sc)
+	h = sc.newHit(2)
+	if h.potentialIdxs[0] != 0 || h.partials[1] != nil || h.matched {
+		t.Errorf("expecting a recycled hit to be reset, got %v", h)
+	}
+}
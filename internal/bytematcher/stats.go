@@ -0,0 +1,134 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+
+	wac "github.com/richardlehane/match/fwac"
+)
+
+// approximate sizes, on 64 bit platforms, of the nodes of the dense (regular) and sparse (low memory) Aho-Corasick trees built by fwac.
+// A dense node has a 256 entry transition table; a sparse node has a sorted slice of links (8 bytes plus a 16 byte link for each transition).
+const (
+	denseNode  = 2136
+	sparseNode = 80
+	sparseLink = 24
+)
+
+// AutomatonStats describes the Aho-Corasick automaton built for a set of BOF or EOF sequences.
+type AutomatonStats struct {
+	Seqs        int // number of sequences
+	Choices     int // number of byte strings (alternatives within the choices of the sequences)
+	DenseNodes  int // nodes in the dense layout (which builds two trees: one anchored at offset zero and one unanchored)
+	SparseNodes int // nodes in the sparse layout (a single tree)
+	RootFanout  int // transitions out of the root of the tree, which the sparse layout binary searches for most bytes scanned
+}
+
+// DenseBytes estimates the memory used by the dense layout.
+func (a AutomatonStats) DenseBytes() int64 {
+	return int64(a.DenseNodes) * denseNode
+}
+
+// SparseBytes estimates the memory used by the sparse layout.
+func (a AutomatonStats) SparseBytes() int64 {
+	return int64(a.SparseNodes) * (sparseNode + sparseLink)
+}
+
+// SparseCost estimates the number of comparisons the sparse layout makes for each byte scanned (the dense layout makes a single lookup).
+func (a AutomatonStats) SparseCost() int {
+	return bits.Len(uint(a.RootFanout)) + 1
+}
+
+// Add combines the statistics of two automata.
+func (a AutomatonStats) Add(b AutomatonStats) AutomatonStats {
+	a.Seqs += b.Seqs
+	a.Choices += b.Choices
+	a.DenseNodes += b.DenseNodes
+	a.SparseNodes += b.SparseNodes
+	if b.RootFanout > a.RootFanout {
+		a.RootFanout = b.RootFanout
+	}
+	return a
+}
+
+func (a AutomatonStats) String() string {
+	if a.Seqs == 0 {
+		return "no sequences"
+	}
+	return fmt.Sprintf("%d sequences, %d choices; dense: %d nodes (~%s); sparse: %d nodes (~%s), ~%d comparisons per byte",
+		a.Seqs, a.Choices, a.DenseNodes, byteSize(a.DenseBytes()), a.SparseNodes, byteSize(a.SparseBytes()), a.SparseCost())
+}
+
+func byteSize(i int64) string {
+	switch {
+	case i >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(i)/(1<<20))
+	case i >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(i)/(1<<10))
+	}
+	return fmt.Sprintf("%d bytes", i)
+}
+
+// Automata reports on the automata for the Matcher's BOF and EOF sequences.
+func (b *Matcher) Automata() (AutomatonStats, AutomatonStats) {
+	return automaton(b.bofSeq.set), automaton(b.eofSeq.set)
+}
+
+func automaton(seqs []wac.Seq) AutomatonStats {
+	var all, unanchored []string
+	for _, seq := range seqs {
+		for i, choice := range seq.Choices {
+			for _, byts := range choice {
+				all = append(all, string(byts))
+				// mirror fwac: the unanchored tree skips first choices at offset zero
+				if i > 0 || seq.MaxOffsets[0] != 0 {
+					unanchored = append(unanchored, string(byts))
+				}
+			}
+		}
+	}
+	zero, fanout := trieNodes(all)
+	root, _ := trieNodes(unanchored)
+	return AutomatonStats{
+		Seqs:        len(seqs),
+		Choices:     len(all),
+		DenseNodes:  zero + root + 2, // plus the two roots
+		SparseNodes: zero + 1,
+		RootFanout:  fanout,
+	}
+}
+
+// trieNodes counts the nodes (excluding the root) in a trie of the given strings, and the fanout of its root.
+// For sorted strings, each string adds one node for every byte beyond the prefix it shares with its predecessor.
+func trieNodes(strs []string) (int, int) {
+	sort.Strings(strs)
+	var nodes, fanout int
+	var prev string
+	for i, s := range strs {
+		var lcp int
+		for lcp < len(s) && lcp < len(prev) && s[lcp] == prev[lcp] {
+			lcp++
+		}
+		if len(s) > 0 && (i == 0 || lcp == 0) {
+			fanout++
+		}
+		nodes += len(s) - lcp
+		prev = s
+	}
+	return nodes, fanout
+}
@@ -0,0 +1,22 @@
+package bytematcher
+
+import (
+	"testing"
+
+	wac "github.com/richardlehane/match/fwac"
+)
+
+func TestAutomaton(t *testing.T) {
+	seqs := []wac.Seq{
+		{MaxOffsets: []int64{0}, Choices: []wac.Choice{{[]byte("abc"), []byte("abd")}}},
+		{MaxOffsets: []int64{-1, -1}, Choices: []wac.Choice{{[]byte("xy")}, {[]byte("ab")}}},
+	}
+	a := automaton(seqs)
+	// sparse: a-b-c, d, x-y and a root (7 nodes); dense adds a second, unanchored, tree of x-y, a-b and a root (5 nodes)
+	if a.Seqs != 2 || a.Choices != 4 || a.SparseNodes != 7 || a.DenseNodes != 12 || a.RootFanout != 2 {
+		t.Errorf("unexpected automaton stats: %+v", a)
+	}
+	if a.DenseBytes() <= a.SparseBytes() {
+		t.Errorf("expecting the dense layout to be bigger, got %d and %d", a.DenseBytes(), a.SparseBytes())
+	}
+}
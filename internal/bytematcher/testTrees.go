@@ -16,6 +16,7 @@ package bytematcher
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
 	"github.com/richardlehane/siegfried/internal/persist"
@@ -301,3 +302,45 @@ func matchTestNodes(ts []*testNode, b []byte, rev bool) []followupMatch {
 	}
 	return ret
 }
+
+// describe returns a description of each way the test tree matches a key frame: empty if a hit completes it,
+// otherwise the frames tested to the left and right of the hit
+func (t *testTree) describe(kfID keyFrameID) []string {
+	var ret []string
+	for _, c := range t.complete {
+		if c == kfID {
+			ret = append(ret, "")
+		}
+	}
+	for i, f := range t.incomplete {
+		if f.kf != kfID {
+			continue
+		}
+		var str string
+		if f.l {
+			str += "; left: " + strings.Join(testPaths(t.left, i), " or ")
+		}
+		if f.r {
+			str += "; right: " + strings.Join(testPaths(t.right, i), " or ")
+		}
+		ret = append(ret, str)
+	}
+	return ret
+}
+
+// testPaths returns the chains of frames in the test nodes that lead to success for followUp i
+func testPaths(tns []*testNode, i int) []string {
+	var ret []string
+	for _, tn := range tns {
+		for _, s := range tn.success {
+			if s == i {
+				ret = append(ret, tn.Frame.String())
+				break
+			}
+		}
+		for _, p := range testPaths(tn.tests, i) {
+			ret = append(ret, tn.Frame.String()+" "+p)
+		}
+	}
+	return ret
+}
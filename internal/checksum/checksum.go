@@ -21,9 +21,13 @@ import (
 	"crypto/sha512"
 	"hash"
 	"hash/crc32"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"lukechampine.com/blake3"
 )
 
-const HashChoices = "'md5', 'sha1', 'sha256', 'sha512', 'crc'"
+const HashChoices = "'md5', 'sha1', 'sha256', 'sha512', 'crc', 'crc32', 'blake2b', 'blake3'"
 
 type HashTyp int
 
@@ -33,6 +37,9 @@ const (
 	sha256Hash
 	sha512Hash
 	crcHash
+	crc32Hash   // same as crc (CRC-32 IEEE, as recorded in zip metadata), but named crc32 in output
+	blake2bHash // BLAKE2b-512, as output by b2sum
+	blake3Hash  // 256-bit BLAKE3, as output by b3sum
 )
 
 func GetHash(typ string) HashTyp {
@@ -48,6 +55,12 @@ func GetHash(typ string) HashTyp {
 		return sha512Hash
 	case "crc", "CRC":
 		return crcHash
+	case "crc32", "CRC32":
+		return crc32Hash
+	case "blake2b", "BLAKE2B":
+		return blake2bHash
+	case "blake3", "BLAKE3":
+		return blake3Hash
 	}
 	return -1
 }
@@ -62,8 +75,13 @@ func MakeHash(typ HashTyp) hash.Hash {
 		return sha256.New()
 	case sha512Hash:
 		return sha512.New()
-	case crcHash:
+	case crcHash, crc32Hash:
 		return crc32.NewIEEE()
+	case blake2bHash:
+		h, _ := blake2b.New512(nil)
+		return h
+	case blake3Hash:
+		return blake3.New(32, nil)
 	}
 	return nil
 }
@@ -80,6 +98,128 @@ func (typ HashTyp) String() string {
 		return "sha512"
 	case crcHash:
 		return "crc"
+	case crc32Hash:
+		return "crc32"
+	case blake2bHash:
+		return "blake2b"
+	case blake3Hash:
+		return "blake3"
 	}
 	return ""
 }
+
+// Size returns the length, in bytes, of the checksums produced by the hash algorithm.
+func (typ HashTyp) Size() int {
+	switch typ {
+	case md5Hash:
+		return md5.Size
+	case sha1Hash:
+		return sha1.Size
+	case sha256Hash:
+		return sha256.Size
+	case sha512Hash:
+		return sha512.Size
+	case crcHash, crc32Hash:
+		return crc32.Size
+	case blake2bHash:
+		return blake2b.Size
+	case blake3Hash:
+		return 32
+	}
+	return 0
+}
+
+// HashTyps is a list of hash algorithms that are calculated together in the one pass e.g. -hash md5,sha256
+type HashTyps []HashTyp
+
+// GetHashes parses a comma-separated list of hash algorithms. It returns false if any of the algorithms is invalid.
+func GetHashes(typs string) (HashTyps, bool) {
+	if typs == "" || typs == "false" {
+		return nil, true
+	}
+	var ret HashTyps
+	for _, typ := range strings.Split(typs, ",") {
+		h := GetHash(strings.TrimSpace(typ))
+		if h < 0 {
+			return nil, false
+		}
+		ret = append(ret, h)
+	}
+	return ret, true
+}
+
+// String returns the names of the hash algorithms as a comma-separated list
+func (typs HashTyps) String() string {
+	strs := make([]string, len(typs))
+	for i, typ := range typs {
+		strs[i] = typ.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// MakeHashes returns a hash that writes to each of the given hash algorithms. Its checksum is the concatenation of their checksums, in order.
+// It returns nil if no hash algorithms are given.
+func MakeHashes(typs HashTyps) hash.Hash {
+	switch len(typs) {
+	case 0:
+		return nil
+	case 1:
+		return MakeHash(typs[0])
+	}
+	m := make(multiHash, len(typs))
+	for i, typ := range typs {
+		m[i] = MakeHash(typ)
+	}
+	return m
+}
+
+type multiHash []hash.Hash
+
+func (m multiHash) Write(p []byte) (int, error) {
+	for _, h := range m {
+		h.Write(p)
+	}
+	return len(p), nil
+}
+
+func (m multiHash) Sum(b []byte) []byte {
+	for _, h := range m {
+		b = h.Sum(b)
+	}
+	return b
+}
+
+func (m multiHash) Reset() {
+	for _, h := range m {
+		h.Reset()
+	}
+}
+
+func (m multiHash) Size() int {
+	var sz int
+	for _, h := range m {
+		sz += h.Size()
+	}
+	return sz
+}
+
+func (m multiHash) BlockSize() int {
+	return m[0].BlockSize()
+}
+
+// Split divides a checksum made with MakeHashes into the checksums for each of the hash algorithms named in hh (a comma-separated list).
+func Split(hh string, cs []byte) [][]byte {
+	if hh == "" {
+		return nil
+	}
+	names := strings.Split(hh, ",")
+	ret := make([][]byte, len(names))
+	for i, name := range names {
+		sz := GetHash(name).Size()
+		if i == len(names)-1 || sz > len(cs) {
+			sz = len(cs)
+		}
+		ret[i], cs = cs[:sz], cs[sz:]
+	}
+	return ret
+}
@@ -0,0 +1,65 @@
+package checksum
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestGetHashes(t *testing.T) {
+	hs, ok := GetHashes("md5,SHA256")
+	if !ok || hs.String() != "md5,sha256" {
+		t.Errorf("expecting md5,sha256, got %s (%v)", hs, ok)
+	}
+	if _, ok := GetHashes("md5,sha3"); ok {
+		t.Error("expecting sha3 to be invalid")
+	}
+	if hs, ok := GetHashes(""); !ok || hs != nil {
+		t.Error("expecting no hashes for an empty string")
+	}
+}
+
+func TestMakeHashes(t *testing.T) {
+	typs := HashTyps{md5Hash, sha1Hash, crcHash}
+	h := MakeHashes(typs)
+	h.Write([]byte("siegfried"))
+	sum := h.Sum(nil)
+	if len(sum) != h.Size() {
+		t.Fatalf("expecting a checksum of %d bytes, got %d", h.Size(), len(sum))
+	}
+	sums := Split(typs.String(), sum)
+	if len(sums) != len(typs) {
+		t.Fatalf("expecting %d checksums, got %d", len(typs), len(sums))
+	}
+	for i, typ := range typs {
+		single := MakeHash(typ)
+		single.Write([]byte("siegfried"))
+		if want := single.Sum(nil); !bytes.Equal(sums[i], want) {
+			t.Errorf("%s: expecting %x, got %x", typ, want, sums[i])
+		}
+	}
+	if MakeHashes(nil) != nil {
+		t.Error("expecting a nil hash for no hash types")
+	}
+}
+
+func TestHashes(t *testing.T) {
+	// checksums of an empty file
+	for _, v := range [][2]string{
+		{"crc32", "00000000"},
+		{"blake2b", "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce"},
+		{"blake3", "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262"},
+	} {
+		typ := GetHash(v[0])
+		if typ.String() != v[0] {
+			t.Errorf("expecting %s, got %s", v[0], typ)
+		}
+		h := MakeHash(typ)
+		if h.Size() != typ.Size() {
+			t.Errorf("%s: expecting size %d, got %d", v[0], typ.Size(), h.Size())
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != v[1] {
+			t.Errorf("%s: expecting %s, got %s", v[0], v[1], got)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher"
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
@@ -34,6 +35,16 @@ const (
 	Mscfb                      // Mscfb container type  e.g. for .doc etc.
 )
 
+func (c containerType) String() string {
+	switch c {
+	case Zip:
+		return "zip"
+	case Mscfb:
+		return "mscfb"
+	}
+	return fmt.Sprintf("container %d", int(c))
+}
+
 // Matcher is a slice of container matchers
 type Matcher []*ContainerMatcher
 
@@ -274,7 +285,13 @@ func loadCTests(ls *persist.LoadSaver) map[string]*cTest {
 
 func saveCTests(ls *persist.LoadSaver, ct map[string]*cTest) {
 	ls.SaveSmallInt(len(ct))
-	for k, v := range ct {
+	keys := make([]string, 0, len(ct))
+	for k := range ct {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := ct[k]
 		ls.SaveString(k)
 		ls.SaveInts(v.satisfied)
 		ls.SaveInts(v.unsatisfied)
@@ -303,6 +320,40 @@ func (ct *cTest) commit() error {
 	return err
 }
 
+// Automata reports on the Aho-Corasick automata of the byte matchers that test container entries.
+// Superiors reports the indexes of signatures that take priority over the signature at index i
+// (indexes are into all of the container signatures, as in the results the matcher returns).
+func (m Matcher) Superiors(i int) []int {
+	for _, c := range m {
+		for j := range c.parts {
+			k, prev := c.priorities.Index(j)
+			if k < 0 || c.startIndexes[k]+j-prev != i {
+				continue
+			}
+			sups := c.priorities.Superiors(j)
+			for x, v := range sups {
+				sups[x] = c.startIndexes[k] + v - prev
+			}
+			return sups
+		}
+	}
+	return nil
+}
+
+func (m Matcher) Automata() (bytematcher.AutomatonStats, bytematcher.AutomatonStats) {
+	var bof, eof bytematcher.AutomatonStats
+	for _, c := range m {
+		for _, ct := range c.nameCTest {
+			if ct.bm == nil {
+				continue
+			}
+			b, e := ct.bm.(*bytematcher.Matcher).Automata()
+			bof, eof = bof.Add(b), eof.Add(e)
+		}
+	}
+	return bof, eof
+}
+
 func (m Matcher) InspectTestTree(ct int, nm string, idx int) []int {
 	for _, c := range m {
 		if c.conType == containerType(ct) {
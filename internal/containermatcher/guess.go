@@ -0,0 +1,143 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containermatcher
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// clue is a distinctive zip entry name that suggests a zip subtype
+type clue struct {
+	family string
+	score  int
+}
+
+// clues are keyed by lower case entry name
+var clues = map[string]clue{
+	"meta-inf/container.xml": {"EPUB", 2},
+	"meta-inf/manifest.xml":  {"OpenDocument", 2},
+	"androidmanifest.xml":    {"Android package", 3},
+	"classes.dex":            {"Android package", 1},
+	"meta-inf/manifest.mf":   {"Java archive", 2},
+	"[content_types].xml":    {"Office Open XML", 3},
+	"_rels/.rels":            {"Office Open XML", 1},
+	"doc.kml":                {"KMZ", 3},
+}
+
+// a mimetype file declaring the type of the package (EPUB, ODF and others) is the strongest clue
+const mimetypeScore = 4
+
+// guess accumulates evidence for possible zip subtypes from the names (and mimetype file) of a zip's entries
+type guess struct {
+	families []string
+	scores   map[string]int
+	evidence map[string][]string
+}
+
+func newGuess() *guess {
+	return &guess{scores: make(map[string]int), evidence: make(map[string][]string)}
+}
+
+func (g *guess) add(family string, score int, evidence string) {
+	if _, ok := g.scores[family]; !ok {
+		g.families = append(g.families, family)
+	}
+	g.scores[family] += score
+	g.evidence[family] = append(g.evidence[family], evidence)
+}
+
+// entry checks the name of the current entry against the clues, reading the contents of a mimetype file
+func (g *guess) entry(rdr Reader, bufs *siegreader.Buffers) {
+	name := rdr.Name()
+	lower := strings.ToLower(name)
+	if lower == "mimetype" && !rdr.IsDir() {
+		if mt := readMimetype(rdr, bufs); mt != "" {
+			g.add(mimetypeFamily(mt), mimetypeScore, "mimetype "+mt)
+		}
+		return
+	}
+	if c, ok := clues[lower]; ok {
+		g.add(c.family, c.score, name)
+	}
+}
+
+func readMimetype(rdr Reader, bufs *siegreader.Buffers) string {
+	buf, err := rdr.SetSource(bufs)
+	if err != nil && err != io.EOF {
+		return ""
+	}
+	defer bufs.Put(buf)
+	defer rdr.Close()
+	byt := make([]byte, 128)
+	n, _ := io.ReadFull(siegreader.ReaderFrom(buf), byt)
+	mt := strings.TrimSpace(string(byt[:n]))
+	// sanity check that this looks like a MIME type
+	if strings.Count(mt, "/") != 1 || strings.ContainsAny(mt, " \t\r\n;") {
+		return ""
+	}
+	return mt
+}
+
+func mimetypeFamily(mt string) string {
+	switch {
+	case mt == "application/epub+zip":
+		return "EPUB"
+	case strings.HasPrefix(mt, "application/vnd.oasis.opendocument."):
+		return "OpenDocument"
+	case mt == "application/vnd.android.package-archive":
+		return "Android package"
+	case mt == "application/java-archive":
+		return "Java archive"
+	case mt == "application/vnd.google-earth.kmz":
+		return "KMZ"
+	}
+	return mt
+}
+
+// String reports the guesses, most likely first, with the entries that suggest them e.g.
+// "zip contents suggest Android package (AndroidManifest.xml, classes.dex) or Java archive (META-INF/MANIFEST.MF)".
+// Returns an empty string if there are no guesses.
+func (g *guess) String() string {
+	if len(g.families) == 0 {
+		return ""
+	}
+	sort.SliceStable(g.families, func(i, j int) bool {
+		return g.scores[g.families[i]] > g.scores[g.families[j]]
+	})
+	strs := make([]string, len(g.families))
+	for i, f := range g.families {
+		strs[i] = f + " (" + strings.Join(g.evidence[f], ", ") + ")"
+	}
+	return "zip contents suggest " + strings.Join(strs, " or ")
+}
+
+type guessHit string
+
+func (g guessHit) Index() int {
+	return -1
+}
+
+func (g guessHit) Basis() string {
+	return string(g)
+}
+
+func (g guessHit) Annotation() ([]string, core.Warning) {
+	return nil, core.Warning{Code: core.WarnContainerGuess, Msg: string(g)}
+}
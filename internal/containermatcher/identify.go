@@ -17,6 +17,7 @@ package containermatcher
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/internal/siegreader"
@@ -35,6 +36,9 @@ func (m Matcher) Identify(n string, b *siegreader.Buffer, hints ...core.Hint) (c
 	divhints := m.divideHints(hints)
 	for i, c := range m {
 		if c.trigger(buf) {
+			if config.Debug() {
+				fmt.Fprintf(config.Out(), "{Container trigger - %s (hints %v)}\n", c.conType, divhints[i])
+			}
 			rdr, err := c.rdr(b)
 			if err != nil {
 				close(res)
@@ -130,14 +134,26 @@ func (c *ContainerMatcher) identify(n string, rdr Reader, res chan core.Result,
 		return
 	}
 	id := c.newIdentifier(len(c.parts), hints...)
+	macros := config.Macros()
+	var macro string
+	var g *guess
+	if c.conType == Zip && config.ZipGuess() {
+		g = newGuess()
+	}
 	var err error
 	for err = rdr.Next(); err == nil; err = rdr.Next() {
+		if macros && macro == "" && isMacro(c.conType, rdr.Name()) {
+			macro = rdr.Name()
+		}
+		if g != nil {
+			g.entry(rdr, c.entryBufs)
+		}
 		ct, ok := c.nameCTest[rdr.Name()]
 		if !ok {
 			continue
 		}
 		if config.Debug() {
-			fmt.Fprintf(config.Out(), "{Name match - %s (container %d))}\n", rdr.Name(), c.conType)
+			fmt.Fprintf(config.Out(), "{Name match - %s (container %s)}\n", rdr.Name(), c.conType)
 		}
 		// name has matched, let's test the CTests
 		// ct.identify will generate a slice of hits which pass to
@@ -146,19 +162,64 @@ func (c *ContainerMatcher) identify(n string, rdr Reader, res chan core.Result,
 			break
 		}
 	}
+	// if we stopped early, keep walking the names in the container to look for macros
+	if macros && macro == "" && err == nil {
+		for err = rdr.Next(); err == nil; err = rdr.Next() {
+			if isMacro(c.conType, rdr.Name()) {
+				macro = rdr.Name()
+				break
+			}
+		}
+	}
 	// send a default hit if no result and extension matches
+	var dflt bool
 	if c.extension != "" && !id.result && filepath.Ext(n) == "."+c.extension {
+		if config.Debug() {
+			fmt.Fprintf(config.Out(), "{Default match - no container signatures matched, but the extension is %s}\n", c.extension)
+		}
 		res <- defaultHit(-1 - int(c.conType))
+		dflt = true
+	}
+	if config.Debug() && !id.result && !dflt {
+		fmt.Fprintf(config.Out(), "{No container match (ruled out %v)}\n", c.signatures(id.ruledOut))
+	}
+	// if nothing matched, guess the zip subtype from the names of its entries
+	if g != nil && !id.result && !dflt {
+		if str := g.String(); str != "" {
+			res <- guessHit(str)
+		}
+	}
+	if macro != "" {
+		res <- macroHit(macro)
 	}
 	close(res)
 }
 
+// isMacro reports whether a container entry is a VBA project: either an OOXML vbaProject.bin part (in a zip),
+// or an OLE2 macro storage (Word's "Macros", Excel's "_VBA_PROJECT_CUR") or its "_VBA_PROJECT" stream.
+func isMacro(typ containerType, name string) bool {
+	switch typ {
+	case Zip:
+		return strings.EqualFold(name[strings.LastIndex(name, "/")+1:], "vbaProject.bin")
+	case Mscfb:
+		for _, seg := range strings.Split(name, "/") {
+			switch strings.ToLower(seg) {
+			case "macros", "_vba_project_cur", "_vba_project":
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (ct *cTest) identify(c *ContainerMatcher, id *identifier, rdr Reader, name string) []hit {
 	// reset hits
 	id.hits = id.hits[:0]
 	for _, h := range ct.satisfied {
 		if id.waitSet.Check(h) {
 			id.hits = append(id.hits, hit{h, name, "name only"})
+		} else if config.Debug() {
+			fmt.Fprintf(config.Out(), "{Skipped by priority - signature %d, name %s}\n", c.signature(h), name)
 		}
 	}
 	if ct.unsatisfied != nil && !rdr.IsDir() {
@@ -166,7 +227,13 @@ func (ct *cTest) identify(c *ContainerMatcher, id *identifier, rdr Reader, name
 		bmc, _ := ct.bm.Identify("", buf)
 		for r := range bmc {
 			h := ct.unsatisfied[r.Index()]
-			if id.waitSet.Check(h) && id.checkHits(h) {
+			if !id.waitSet.Check(h) {
+				if config.Debug() {
+					fmt.Fprintf(config.Out(), "{Skipped by priority - signature %d, name %s with %s}\n", c.signature(h), name, r.Basis())
+				}
+				continue
+			}
+			if id.checkHits(h) {
 				id.hits = append(id.hits, hit{h, name, r.Basis()})
 			}
 		}
@@ -181,6 +248,9 @@ func (ct *cTest) identify(c *ContainerMatcher, id *identifier, rdr Reader, name
 func (c *ContainerMatcher) processHits(hits []hit, id *identifier, ct *cTest, name string, res chan core.Result) bool {
 	// if there are no hits, rule out any sigs in the ctest
 	if len(hits) == 0 {
+		if config.Debug() {
+			fmt.Fprintf(config.Out(), "{No CTests satisfied - name %s; ruled out %v}\n", name, c.signatures(nil, ct.satisfied, ct.unsatisfied))
+		}
 		for _, v := range ct.satisfied {
 			id.ruledOut[v] = true
 		}
@@ -191,15 +261,29 @@ func (c *ContainerMatcher) processHits(hits []hit, id *identifier, ct *cTest, na
 	}
 	for _, h := range hits {
 		id.partsMatched[h.id] = append(id.partsMatched[h.id], h)
+		if config.Debug() {
+			fmt.Fprintf(config.Out(), "{CTest satisfied - signature %d (part %d of %d), name %s with %s}\n", c.signature(h.id), len(id.partsMatched[h.id]), c.parts[h.id], h.name, h.basis)
+		}
 		if len(id.partsMatched[h.id]) == c.parts[h.id] {
 			if id.waitSet.Check(h.id) {
+				if config.Debug() {
+					fmt.Fprintf(config.Out(), "{Container match - signature %d}\n", c.signature(h.id))
+				}
 				idx, _ := c.priorities.Index(h.id)
 				res <- toResult(c.startIndexes[idx], id.partsMatched[h.id]) // send a Result here
 				id.result = true                                            // mark id as having a result (for zip default)
 				// set a priority list and return early if can
 				if id.waitSet.Put(h.id) {
+					if config.Debug() {
+						fmt.Fprintln(config.Out(), "{Priorities satisfied - no other signatures can match}")
+					}
 					return true
 				}
+				if config.Debug() {
+					fmt.Fprintf(config.Out(), "{Waiting on %v}\n", c.signatures(nil, id.waitSet.WaitingOn()))
+				}
+			} else if config.Debug() {
+				fmt.Fprintf(config.Out(), "{Container match suppressed by priority - signature %d}\n", c.signature(h.id))
 			}
 		}
 	}
@@ -229,9 +313,35 @@ func (c *ContainerMatcher) processHits(hits []hit, id *identifier, ct *cTest, na
 			return false
 		}
 	}
+	if config.Debug() {
+		fmt.Fprintf(config.Out(), "{Signatures waited on are ruled out %v}\n", c.signatures(nil, waitingOn))
+	}
 	return true
 }
 
+// signature returns the index of a signature as reported in results i.e. with the start index of the priority set it belongs to.
+// Used in debug output.
+func (c *ContainerMatcher) signature(i int) int {
+	idx, _ := c.priorities.Index(i)
+	return c.startIndexes[idx] + i
+}
+
+// signatures returns the indexes (as reported in results) of the signatures marked in mask, as well as those in the lists given.
+func (c *ContainerMatcher) signatures(mask []bool, lists ...[]int) []int {
+	var ret []int
+	for i, v := range mask {
+		if v {
+			ret = append(ret, c.signature(i))
+		}
+	}
+	for _, l := range lists {
+		for _, v := range l {
+			ret = append(ret, c.signature(v))
+		}
+	}
+	return ret
+}
+
 // eliminate duplicate hits - must do this since rely on number of matches for each sig as test for full match
 func (id *identifier) checkHits(i int) bool {
 	for _, h := range id.hits {
@@ -290,3 +400,17 @@ func (d defaultHit) Index() int {
 func (d defaultHit) Basis() string {
 	return "container match with trigger and default extension"
 }
+
+type macroHit string
+
+func (m macroHit) Index() int {
+	return -1
+}
+
+func (m macroHit) Basis() string {
+	return "container name " + string(m) + " is a VBA project"
+}
+
+func (m macroHit) Annotation() ([]string, core.Warning) {
+	return []string{m.Basis()}, core.Warning{Code: core.WarnMacros, Msg: "contains macros"}
+}
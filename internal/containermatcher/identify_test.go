@@ -1,6 +1,7 @@
 package containermatcher
 
 import (
+	"archive/zip"
 	"bytes"
 	"io"
 	"testing"
@@ -45,3 +46,59 @@ func TestIdentify(t *testing.T) {
 		}
 	}
 }
+
+func TestIsMacro(t *testing.T) {
+	for _, n := range []string{"word/vbaProject.bin", "xl/vbaProject.bin"} {
+		if !isMacro(Zip, n) {
+			t.Errorf("expecting %s to be a macro in a zip", n)
+		}
+	}
+	for _, n := range []string{"Macros/VBA/dir", "_VBA_PROJECT_CUR", "Macros"} {
+		if !isMacro(Mscfb, n) {
+			t.Errorf("expecting %s to be a macro in an OLE2 file", n)
+		}
+	}
+	// a plain zip may have a "macros" directory
+	for _, n := range []string{"word/document.xml", "macros/readme.txt", "_VBA_PROJECT_CUR/a.txt", "vbaProject.bin.txt"} {
+		if isMacro(Zip, n) {
+			t.Errorf("not expecting %s to be a macro in a zip", n)
+		}
+	}
+	for _, n := range []string{"WordDocument", "ObjectPool/Macrosheet", "word/vbaProject.bin"} {
+		if isMacro(Mscfb, n) {
+			t.Errorf("not expecting %s to be a macro in an OLE2 file", n)
+		}
+	}
+	if _, w := macroHit("word/vbaProject.bin").Annotation(); w.Code != core.WarnMacros {
+		t.Errorf("expecting a macro warning to have the code %s, got %v", core.WarnMacros, w)
+	}
+}
+
+func TestGuess(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, n := range []string{"META-INF/MANIFEST.MF", "AndroidManifest.xml", "classes.dex", "mimetype", "res/"} {
+		w, _ := zw.Create(n)
+		if n == "mimetype" {
+			w.Write([]byte("application/vnd.android.package-archive"))
+		}
+	}
+	zw.Close()
+	bufs := siegreader.New()
+	b, _ := bufs.Get(buf)
+	rdr, err := zipRdr(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGuess()
+	for err = rdr.Next(); err == nil; err = rdr.Next() {
+		g.entry(rdr, bufs)
+	}
+	expect := "zip contents suggest Android package (AndroidManifest.xml, classes.dex, mimetype application/vnd.android.package-archive) or Java archive (META-INF/MANIFEST.MF)"
+	if g.String() != expect {
+		t.Errorf("expecting %s, got %s", expect, g.String())
+	}
+	if _, w := guessHit(g.String()).Annotation(); w.Code != core.WarnContainerGuess || w.Msg != expect {
+		t.Errorf("expecting a %s warning, got %v", core.WarnContainerGuess, w)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datamatcher identifies scientific data formats (SQLite databases, HDF5 and netCDF) by validating their headers,
+// rather than just matching magic numbers, and reports the versions and variants that byte signatures can't tell apart.
+package datamatcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/priority"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// The variants of scientific data formats identified by the matcher.
+const (
+	SQLite3           = "sqlite3"
+	HDF5v0            = "hdf5-0" // HDF5 with superblock version 0
+	HDF5v1            = "hdf5-1"
+	HDF5v2            = "hdf5-2"
+	HDF5v3            = "hdf5-3"
+	NetCDF4           = "netcdf4"             // HDF5 with netCDF-4 attributes
+	NetCDF4Classic    = "netcdf4-classic"     // netCDF-4 restricted to the classic model
+	NetCDFClassic     = "netcdf-classic"      // netCDF-3 classic format
+	NetCDF64BitOffset = "netcdf-64bit-offset" // netCDF-3 64-bit offset format
+	NetCDF64BitData   = "netcdf-64bit-data"   // CDF-5
+)
+
+var variants = []string{SQLite3, HDF5v0, HDF5v1, HDF5v2, HDF5v3, NetCDF4, NetCDF4Classic, NetCDFClassic, NetCDF64BitOffset, NetCDF64BitData}
+
+type Matcher struct {
+	variants   []string
+	priorities *priority.Set
+}
+
+func Load(ls *persist.LoadSaver) core.Matcher {
+	variants := ls.LoadStrings()
+	if len(variants) == 0 {
+		return nil
+	}
+	return &Matcher{
+		variants:   variants,
+		priorities: priority.Load(ls),
+	}
+}
+
+func Save(c core.Matcher, ls *persist.LoadSaver) {
+	if c == nil {
+		ls.SaveStrings(nil)
+		return
+	}
+	m := c.(*Matcher)
+	ls.SaveStrings(m.variants)
+	if len(m.variants) == 0 {
+		return
+	}
+	m.priorities.Save(ls)
+}
+
+// SignatureSet for the data matcher is a slice of variants of scientific data formats e.g. "sqlite3" or "hdf5-2".
+type SignatureSet []string
+
+func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, int, error) {
+	sigs, ok := ss.(SignatureSet)
+	if !ok {
+		return nil, -1, fmt.Errorf("Datamatcher: can't cast persist set")
+	}
+	for _, v := range sigs {
+		if !contains(variants, v) {
+			return nil, -1, fmt.Errorf("Datamatcher: unknown variant %q, expecting one of %s", v, strings.Join(variants, ", "))
+		}
+	}
+	if len(sigs) == 0 {
+		return c, 0, nil
+	}
+	var m *Matcher
+	if c == nil {
+		m = &Matcher{priorities: &priority.Set{}}
+	} else {
+		m = c.(*Matcher)
+	}
+	length := len(m.variants)
+	m.variants = append(m.variants, sigs...)
+	// add priorities
+	m.priorities.Add(p, len(sigs), 0, 0)
+	return m, length + len(sigs), nil
+}
+
+type result struct {
+	idx   int
+	basis string
+}
+
+func (r result) Index() int {
+	return r.idx
+}
+
+func (r result) Basis() string {
+	return r.basis
+}
+
+// validator checks the header of a format, returning the variants it identifies (most specific first) and a description of the header.
+type validator func(*siegreader.Buffer) ([]string, string, bool)
+
+var validators = []validator{sqlite, hdf5, netcdf}
+
+// Identify validates the file's header for each format in turn. A validated header may identify more than one variant (e.g. a netCDF-4 file
+// is also an HDF5 file): only the signatures for the most specific variant with signatures are reported.
+func (m Matcher) Identify(na string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
+	res := make(chan core.Result)
+	var (
+		found []string
+		basis string
+	)
+	for _, v := range validators {
+		var ok bool
+		if found, basis, ok = v(b); ok {
+			break
+		}
+	}
+	var variant string
+	for _, v := range found {
+		if contains(m.variants, v) {
+			variant = v
+			break
+		}
+	}
+	if variant == "" {
+		close(res)
+		return res, nil
+	}
+	if config.Debug() {
+		fmt.Fprintln(config.Out(), basis)
+	}
+	waitset := m.priorities.WaitSet(hints...)
+	go func() {
+		for i, v := range m.variants {
+			if v != variant || !waitset.Check(i) {
+				continue
+			}
+			res <- result{i, basis}
+			if waitset.Put(i) {
+				break
+			}
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
+func (m Matcher) String() string {
+	return fmt.Sprintf("Data matcher: %s\n", strings.Join(m.variants, ", "))
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package datamatcher
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var fmts = SignatureSet{SQLite3, HDF5v0, HDF5v2, NetCDF4, NetCDFClassic, NetCDF64BitOffset, SQLite3}
+
+var dm core.Matcher
+
+func init() {
+	dm, _, _ = Add(dm, fmts, nil)
+}
+
+func sqliteHeader() []byte {
+	hdr := make([]byte, 512)
+	copy(hdr, sqliteMagic)
+	binary.BigEndian.PutUint16(hdr[16:], 4096)
+	hdr[18], hdr[19], hdr[21], hdr[22], hdr[23] = 2, 2, 64, 32, 32
+	binary.BigEndian.PutUint32(hdr[44:], 4)
+	binary.BigEndian.PutUint32(hdr[56:], 1)
+	binary.BigEndian.PutUint32(hdr[96:], 3040001)
+	return hdr
+}
+
+// hdf5Header makes a superblock (version 0 or 2) preceded by a user block
+func hdf5Header(ver byte, userBlock int, attr string) []byte {
+	buf := make([]byte, userBlock, userBlock+1024)
+	buf = append(buf, hdf5Magic...)
+	if ver < 2 {
+		buf = append(buf, ver, 0, 0, 0, 0, 8, 8, 0, 4, 0, 16, 0, 0, 0, 0, 0)
+	} else {
+		buf = append(buf, ver, 8, 8, 0)
+		buf = append(buf, make([]byte, 32)...)
+		buf = binary.LittleEndian.AppendUint32(buf, lookup3(buf[userBlock:]))
+	}
+	buf = append(buf, make([]byte, 128)...)
+	return append(buf, attr...)
+}
+
+// netcdfHeader makes a header with a record dimension and a variable
+func netcdfHeader(ver byte) []byte {
+	r := &ncReader{offset64: ver > 1, cdf5: ver == 5}
+	buf := []byte{'C', 'D', 'F', ver}
+	count := func(n uint64) {
+		if r.cdf5 {
+			buf = binary.BigEndian.AppendUint64(buf, n)
+		} else {
+			buf = binary.BigEndian.AppendUint32(buf, uint32(n))
+		}
+	}
+	count(0) // numrecs
+	buf = binary.BigEndian.AppendUint32(buf, ncDimension)
+	count(1)
+	count(4)
+	buf = append(buf, "time"...)
+	count(0)
+	buf = binary.BigEndian.AppendUint32(buf, ncAttribute)
+	count(1)
+	count(5)
+	buf = append(buf, "title\x00\x00\x00"...)
+	buf = binary.BigEndian.AppendUint32(buf, 2)
+	count(2)
+	buf = append(buf, "ab\x00\x00"...)
+	buf = binary.BigEndian.AppendUint32(buf, ncVariable)
+	count(1)
+	count(4)
+	buf = append(buf, "time"...)
+	count(1)
+	count(0)
+	buf = append(buf, make([]byte, 4)...)
+	count(0) // absent attribute list
+	buf = binary.BigEndian.AppendUint32(buf, 6)
+	count(8)
+	if r.offset64 {
+		return binary.BigEndian.AppendUint64(buf, uint64(len(buf)+8))
+	}
+	return binary.BigEndian.AppendUint32(buf, uint32(len(buf)+4))
+}
+
+var validations = []struct {
+	name     string
+	file     []byte
+	variants []string
+	basis    string
+}{
+	{"sqlite", sqliteHeader(), []string{SQLite3}, "SQLite 3 header: page size 4096; schema format 4; UTF-8; WAL; written by SQLite 3.40.1"},
+	{"sqliteMagicOnly", sqliteMagic, nil, ""},
+	{"hdf5v0", hdf5Header(0, 0, ""), []string{HDF5v0}, "HDF5 superblock version 0 at offset 0"},
+	{"hdf5v2", hdf5Header(2, 1024, ""), []string{HDF5v2}, "HDF5 superblock version 2 at offset 1024 (checksum verified)"},
+	{"netcdf4", hdf5Header(3, 0, "_NCProperties"), []string{NetCDF4, HDF5v3}, "HDF5 superblock version 3 at offset 0 (checksum verified); netCDF-4 attribute _NCProperties"},
+	{"netcdf4Classic", hdf5Header(2, 512, "_NCProperties _nc3_strict"), []string{NetCDF4Classic, NetCDF4, HDF5v2}, "HDF5 superblock version 2 at offset 512 (checksum verified); netCDF-4 attribute _nc3_strict"},
+	{"hdf5MagicOnly", append(hdf5Magic, make([]byte, 64)...), nil, ""},
+	{"classic", netcdfHeader(1), []string{NetCDFClassic}, "netCDF classic header: 1 dimensions, 1 variables, 1 global attributes"},
+	{"64bitOffset", netcdfHeader(2), []string{NetCDF64BitOffset}, "netCDF 64-bit offset header: 1 dimensions, 1 variables, 1 global attributes"},
+	{"cdf5", netcdfHeader(5), []string{NetCDF64BitData}, "netCDF 64-bit data (CDF-5) header: 1 dimensions, 1 variables, 1 global attributes"},
+	{"netcdfMagicOnly", []byte("CDF\x01"), nil, ""},
+}
+
+func validate(file []byte) ([]string, string) {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(file))
+	defer bufs.Put(b)
+	for _, v := range validators {
+		if found, basis, ok := v(b); ok {
+			return found, basis
+		}
+	}
+	return nil, ""
+}
+
+func TestValidate(t *testing.T) {
+	for _, v := range validations {
+		found, basis := validate(v.file)
+		if strings.Join(found, ",") != strings.Join(v.variants, ",") || basis != v.basis {
+			t.Errorf("%s: expecting %v (%s), got %v (%s)", v.name, v.variants, v.basis, found, basis)
+		}
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	sql := sqliteHeader()
+	sql[21] = 63
+	hdf := hdf5Header(2, 0, "")
+	hdf[20]++ // change an address covered by the checksum
+	cdf := netcdfHeader(1)
+	cdf[len(cdf)-1] -= 8 // begin the variable's data within the header
+	for i, file := range [][]byte{sql, hdf, cdf} {
+		if found, basis := validate(file); found != nil {
+			t.Errorf("%d: expecting corrupt header to fail validation, got %v (%s)", i, found, basis)
+		}
+	}
+}
+
+func TestLookup3(t *testing.T) {
+	if h := lookup3(nil); h != 0xdeadbeef {
+		t.Errorf("expecting 0xdeadbeef, got %#x", h)
+	}
+	if h := lookup3([]byte("Four score and seven years ago")); h != 0x17770551 {
+		t.Errorf("expecting 0x17770551, got %#x", h)
+	}
+}
+
+func identify(t *testing.T, file []byte) []int {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(file))
+	defer bufs.Put(b)
+	res, err := dm.Identify("", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits []int
+	for h := range res {
+		hits = append(hits, h.Index())
+	}
+	return hits
+}
+
+func TestMatch(t *testing.T) {
+	if hits := identify(t, sqliteHeader()); len(hits) != 2 || hits[0] != 0 || hits[1] != 6 {
+		t.Errorf("expecting two sqlite hits, got %v", hits)
+	}
+	if hits := identify(t, hdf5Header(2, 0, "_NCProperties")); len(hits) != 1 || hits[0] != 3 {
+		t.Errorf("expecting a netcdf4 hit, got %v", hits)
+	}
+	if hits := identify(t, hdf5Header(2, 0, "_nc3_strict")); len(hits) != 1 || hits[0] != 3 {
+		t.Errorf("expecting a netcdf4 hit for a classic model file, got %v", hits)
+	}
+	// no signatures for HDF5 version 3
+	if hits := identify(t, hdf5Header(3, 0, "")); len(hits) != 0 {
+		t.Errorf("expecting no hits, got %v", hits)
+	}
+	if _, _, err := Add(nil, SignatureSet{"hdf4"}, nil); err == nil {
+		t.Error("expecting an error adding an unknown variant")
+	}
+}
+
+func TestIO(t *testing.T) {
+	str := dm.String()
+	saver := persist.NewLoadSaver(nil)
+	Save(dm, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	newdm := Load(loader)
+	str2 := newdm.String()
+	if str != str2 {
+		t.Errorf("Load data matcher: expecting first matcher (%v), to equal second matcher (%v)", str, str2)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datamatcher
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+const (
+	maxUserBlock = 1 << 20   // the superblock is searched for at 0, 512, 1024, 2048 etc. up to this offset
+	netCDF4Scan  = 64 * 1024 // netCDF-4 attribute names are searched for in the first 64KB
+)
+
+var (
+	hdf5Magic    = []byte{0x89, 'H', 'D', 'F', '\r', '\n', 0x1a, '\n'}
+	hdf5Variants = [...]string{HDF5v0, HDF5v1, HDF5v2, HDF5v3}
+	netCDF4Attrs = [][]byte{[]byte("_NCProperties"), []byte("_Netcdf4Dimid"), []byte("_Netcdf4Coordinates")}
+	nc3Strict    = []byte("_nc3_strict") // marks netCDF-4 files restricted to the classic model
+)
+
+// hdf5 finds and validates the superblock of an HDF5 file, which follows any user block (see https://docs.hdfgroup.org/hdf5/develop/_f_m_t3.html).
+// HDF5 files with the attributes the netCDF library adds are also identified as netCDF-4 (or netCDF-4 classic model).
+func hdf5(b *siegreader.Buffer) ([]string, string, bool) {
+	for off := 0; off <= maxUserBlock; {
+		sig, _ := b.Slice(int64(off), 8)
+		if len(sig) < 8 {
+			return nil, "", false
+		}
+		if bytes.Equal(sig, hdf5Magic) {
+			return superblock(b, off)
+		}
+		if off == 0 {
+			off = 512
+		} else {
+			off *= 2
+		}
+	}
+	return nil, "", false
+}
+
+func validSize(s byte) bool {
+	return s == 2 || s == 4 || s == 8 || s == 16 || s == 32
+}
+
+func superblock(b *siegreader.Buffer, off int) ([]string, string, bool) {
+	sb, _ := b.Slice(int64(off), 28)
+	if len(sb) < 28 || sb[8] > 3 {
+		return nil, "", false
+	}
+	ver := sb[8]
+	var checksum string
+	if ver < 2 {
+		// free-space, root group symbol table entry and shared header message versions must be 0, as must the reserved bytes
+		if sb[9] != 0 || sb[10] != 0 || sb[11] != 0 || sb[12] != 0 || sb[15] != 0 || !validSize(sb[13]) || !validSize(sb[14]) {
+			return nil, "", false
+		}
+		// group leaf and internal node K must be greater than 0
+		if binary.LittleEndian.Uint16(sb[16:]) == 0 || binary.LittleEndian.Uint16(sb[18:]) == 0 {
+			return nil, "", false
+		}
+		if ver == 1 && (binary.LittleEndian.Uint16(sb[24:]) == 0 || sb[26] != 0 || sb[27] != 0) {
+			return nil, "", false
+		}
+	} else {
+		if !validSize(sb[9]) || !validSize(sb[10]) {
+			return nil, "", false
+		}
+		// a version 2 or 3 superblock ends with a checksum of the fields before it: the base, superblock extension, end of file and root group object header addresses
+		l := 12 + 4*int(sb[9])
+		sb, _ = b.Slice(int64(off), l+4)
+		if len(sb) < l+4 || lookup3(sb[:l]) != binary.LittleEndian.Uint32(sb[l:]) {
+			return nil, "", false
+		}
+		checksum = " (checksum verified)"
+	}
+	basis := fmt.Sprintf("HDF5 superblock version %d at offset %d%s", ver, off, checksum)
+	scan, _ := b.Slice(0, netCDF4Scan)
+	if bytes.Contains(scan, nc3Strict) {
+		return []string{NetCDF4Classic, NetCDF4, hdf5Variants[ver]}, basis + "; netCDF-4 attribute " + string(nc3Strict), true
+	}
+	for _, attr := range netCDF4Attrs {
+		if bytes.Contains(scan, attr) {
+			return []string{NetCDF4, hdf5Variants[ver]}, basis + "; netCDF-4 attribute " + string(attr), true
+		}
+	}
+	return []string{hdf5Variants[ver]}, basis, true
+}
+
+// lookup3 is Bob Jenkins' lookup3 hashlittle function (with an initial value of 0), which HDF5 uses for its metadata checksums.
+func lookup3(k []byte) uint32 {
+	a := 0xdeadbeef + uint32(len(k))
+	b, c := a, a
+	for len(k) > 12 {
+		a += binary.LittleEndian.Uint32(k)
+		b += binary.LittleEndian.Uint32(k[4:])
+		c += binary.LittleEndian.Uint32(k[8:])
+		a -= c
+		a ^= bits.RotateLeft32(c, 4)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 6)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 8)
+		b += a
+		a -= c
+		a ^= bits.RotateLeft32(c, 16)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 19)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 4)
+		b += a
+		k = k[12:]
+	}
+	if len(k) == 0 {
+		return c
+	}
+	var tail [12]byte
+	copy(tail[:], k)
+	a += binary.LittleEndian.Uint32(tail[:])
+	b += binary.LittleEndian.Uint32(tail[4:])
+	c += binary.LittleEndian.Uint32(tail[8:])
+	c ^= b
+	c -= bits.RotateLeft32(b, 14)
+	a ^= c
+	a -= bits.RotateLeft32(c, 11)
+	b ^= a
+	b -= bits.RotateLeft32(a, 25)
+	c ^= b
+	c -= bits.RotateLeft32(b, 16)
+	a ^= c
+	a -= bits.RotateLeft32(c, 4)
+	b ^= a
+	b -= bits.RotateLeft32(a, 14)
+	c ^= b
+	c -= bits.RotateLeft32(b, 24)
+	return c
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datamatcher
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+const (
+	maxHeader = 1 << 20 // netCDF headers longer than 1MB aren't validated
+	maxName   = 256     // NC_MAX_NAME
+)
+
+const (
+	ncDimension = 0x0A
+	ncVariable  = 0x0B
+	ncAttribute = 0x0C
+)
+
+// sizes of the netCDF external types, indexed by nc_type: types above NC_DOUBLE (6) are only allowed in CDF-5
+var ncTypes = [...]uint64{0, 1, 1, 2, 4, 4, 8, 1, 2, 4, 8, 8}
+
+// netcdf validates the header of a netCDF classic, 64-bit offset or 64-bit data (CDF-5) file: the dimension, global attribute and
+// variable lists are parsed and the data for each variable must begin after the header (see https://docs.unidata.ucar.edu/netcdf-c/current/file_format_specifications.html).
+func netcdf(b *siegreader.Buffer) ([]string, string, bool) {
+	buf, err := b.Slice(0, maxHeader)
+	if (err != nil && err != io.EOF) || len(buf) < 4 || string(buf[:3]) != "CDF" {
+		return nil, "", false
+	}
+	r := &ncReader{buf: buf, off: 4}
+	var variant, name string
+	switch buf[3] {
+	case 1:
+		variant, name = NetCDFClassic, "classic"
+	case 2:
+		variant, name = NetCDF64BitOffset, "64-bit offset"
+		r.offset64 = true
+	case 5:
+		variant, name = NetCDF64BitData, "64-bit data (CDF-5)"
+		r.offset64, r.cdf5 = true, true
+	default:
+		return nil, "", false
+	}
+	r.count() // numrecs
+	dims, attrs, vars := r.dims(), r.attrs(), r.vars()
+	if r.err {
+		return nil, "", false
+	}
+	for _, begin := range vars {
+		if begin < uint64(r.off) {
+			return nil, "", false
+		}
+	}
+	return []string{variant}, fmt.Sprintf("netCDF %s header: %d dimensions, %d variables, %d global attributes", name, dims, len(vars), attrs), true
+}
+
+type ncReader struct {
+	buf      []byte
+	off      int
+	offset64 bool // OFFSET fields are 64 bit
+	cdf5     bool // NON_NEG fields are 64 bit and the extended types are allowed
+	ndims    uint64
+	err      bool
+}
+
+func (r *ncReader) uint32() uint32 {
+	if r.err || r.off+4 > len(r.buf) {
+		r.err = true
+		return 0
+	}
+	r.off += 4
+	return binary.BigEndian.Uint32(r.buf[r.off-4:])
+}
+
+func (r *ncReader) uint64() uint64 {
+	if r.err || r.off+8 > len(r.buf) {
+		r.err = true
+		return 0
+	}
+	r.off += 8
+	return binary.BigEndian.Uint64(r.buf[r.off-8:])
+}
+
+// count reads a NON_NEG value
+func (r *ncReader) count() uint64 {
+	if r.cdf5 {
+		return r.uint64()
+	}
+	return uint64(r.uint32())
+}
+
+// offset reads an OFFSET value
+func (r *ncReader) offset() uint64 {
+	if r.offset64 {
+		return r.uint64()
+	}
+	return uint64(r.uint32())
+}
+
+// skip moves past n bytes, padded to a four byte boundary
+func (r *ncReader) skip(n uint64) {
+	if n%4 != 0 {
+		n += 4 - n%4
+	}
+	if r.err || n > uint64(len(r.buf)-r.off) {
+		r.err = true
+		return
+	}
+	r.off += int(n)
+}
+
+func (r *ncReader) name() {
+	if n := r.count(); n == 0 || n > maxName {
+		r.err = true
+	} else {
+		r.skip(n)
+	}
+}
+
+// list reads the tag and number of elements of a list: an absent list is two zero values
+func (r *ncReader) list(tag uint32) uint64 {
+	t, n := r.uint32(), r.count()
+	if t != tag && (t != 0 || n != 0) {
+		r.err = true
+	}
+	return n
+}
+
+func (r *ncReader) ncType() uint64 {
+	t := r.uint32()
+	if t == 0 || (!r.cdf5 && t > 6) || t >= uint32(len(ncTypes)) {
+		r.err = true
+		return 0
+	}
+	return ncTypes[t]
+}
+
+// dims reads the dimension list: only one dimension (the record dimension) may have a length of 0
+func (r *ncReader) dims() uint64 {
+	var record bool
+	r.ndims = r.list(ncDimension)
+	for i := uint64(0); i < r.ndims && !r.err; i++ {
+		r.name()
+		if r.count() == 0 {
+			if record {
+				r.err = true
+			}
+			record = true
+		}
+	}
+	return r.ndims
+}
+
+func (r *ncReader) attrs() uint64 {
+	n := r.list(ncAttribute)
+	for i := uint64(0); i < n && !r.err; i++ {
+		r.name()
+		size := r.ncType()
+		nelems := r.count()
+		if nelems > uint64(len(r.buf)) {
+			r.err = true
+			break
+		}
+		r.skip(size * nelems)
+	}
+	return n
+}
+
+// vars reads the variable list, returning where the data for each variable begins
+func (r *ncReader) vars() []uint64 {
+	n := r.list(ncVariable)
+	var begins []uint64
+	for i := uint64(0); i < n && !r.err; i++ {
+		r.name()
+		ndims := r.count()
+		for j := uint64(0); j < ndims && !r.err; j++ {
+			if r.count() >= r.ndims {
+				r.err = true
+			}
+		}
+		r.attrs()
+		r.ncType()
+		r.count() // vsize
+		begins = append(begins, r.offset())
+	}
+	return begins
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datamatcher
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+var (
+	sqliteMagic     = []byte("SQLite format 3\x00")
+	sqliteEncodings = [...]string{"no encoding set", "UTF-8", "UTF-16le", "UTF-16be"}
+)
+
+// sqlite validates the 100 byte header of an SQLite 3 database (see https://www.sqlite.org/fileformat.html).
+func sqlite(b *siegreader.Buffer) ([]string, string, bool) {
+	hdr, _ := b.Slice(0, 100)
+	if len(hdr) < 100 || !bytes.Equal(hdr[:16], sqliteMagic) {
+		return nil, "", false
+	}
+	pageSize := int(binary.BigEndian.Uint16(hdr[16:]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	schema, enc := binary.BigEndian.Uint32(hdr[44:]), binary.BigEndian.Uint32(hdr[56:])
+	switch {
+	case pageSize < 512 || pageSize&(pageSize-1) != 0:
+		return nil, "", false
+	case hdr[18] < 1 || hdr[18] > 2 || hdr[19] < 1 || hdr[19] > 2: // file format write and read versions: 1 for rollback journals, 2 for WAL
+		return nil, "", false
+	case pageSize-int(hdr[20]) < 480: // reserved space at the end of each page leaves too little usable space
+		return nil, "", false
+	case hdr[21] != 64 || hdr[22] != 32 || hdr[23] != 32: // payload fractions must be 64, 32 and 32
+		return nil, "", false
+	case schema > 4 || enc > 3:
+		return nil, "", false
+	}
+	for _, c := range hdr[72:92] { // reserved for expansion, must be zero
+		if c != 0 {
+			return nil, "", false
+		}
+	}
+	journal := "rollback journal"
+	if hdr[18] == 2 {
+		journal = "WAL"
+	}
+	version := binary.BigEndian.Uint32(hdr[96:])
+	return []string{SQLite3}, fmt.Sprintf("SQLite 3 header: page size %d; schema format %d; %s; %s; written by SQLite %d.%d.%d",
+		pageSize, schema, sqliteEncodings[enc], journal, version/1000000, version/1000%1000, version%1000), true
+}
@@ -0,0 +1,309 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ebmlmatcher identifies EBML files (e.g. Matroska and WebM) by parsing the DocType in the EBML header
+// and the track types declared in the segment.
+package ebmlmatcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/priority"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// Track qualifiers for signatures. A signature with a Video qualifier matches files with a video track;
+// one with an Audio qualifier matches files with audio tracks but no video track. Signatures without a qualifier match any file with the DocType.
+const (
+	Video = "video"
+	Audio = "audio"
+)
+
+const window = 1 << 20 // tracks must be declared within the first window of the file
+
+// EBML element IDs
+const (
+	idEBML      = 0x1A45DFA3
+	idDocType   = 0x4282
+	idSegment   = 0x18538067
+	idTracks    = 0x1654AE6B
+	idTrackEnt  = 0xAE
+	idTrackType = 0x83
+	idCluster   = 0x1F43B675
+)
+
+type Matcher struct {
+	sigs       [][2]string // DocType, track qualifier
+	priorities *priority.Set
+}
+
+func Load(ls *persist.LoadSaver) core.Matcher {
+	docs := ls.LoadStrings()
+	if len(docs) == 0 {
+		return nil
+	}
+	tracks := ls.LoadStrings()
+	sigs := make([][2]string, len(docs))
+	for i := range docs {
+		sigs[i] = [2]string{docs[i], tracks[i]}
+	}
+	return &Matcher{
+		sigs:       sigs,
+		priorities: priority.Load(ls),
+	}
+}
+
+func Save(c core.Matcher, ls *persist.LoadSaver) {
+	if c == nil {
+		ls.SaveStrings(nil)
+		return
+	}
+	m := c.(*Matcher)
+	docs, tracks := make([]string, len(m.sigs)), make([]string, len(m.sigs))
+	for i, v := range m.sigs {
+		docs[i], tracks[i] = v[0], v[1]
+	}
+	ls.SaveStrings(docs)
+	if len(docs) == 0 {
+		return
+	}
+	ls.SaveStrings(tracks)
+	m.priorities.Save(ls)
+}
+
+// SignatureSet for the EBML matcher is a slice of DocType and track qualifier pairs e.g. {"webm", "audio"}.
+type SignatureSet [][2]string
+
+func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, int, error) {
+	sigs, ok := ss.(SignatureSet)
+	if !ok {
+		return nil, -1, fmt.Errorf("EBMLmatcher: can't cast persist set")
+	}
+	if len(sigs) == 0 {
+		return c, 0, nil
+	}
+	var m *Matcher
+	if c == nil {
+		m = &Matcher{priorities: &priority.Set{}}
+	} else {
+		m = c.(*Matcher)
+	}
+	for _, v := range sigs {
+		if v[1] != "" && v[1] != Video && v[1] != Audio {
+			return nil, -1, fmt.Errorf("EBMLmatcher: bad track qualifier %s for doctype %s", v[1], v[0])
+		}
+	}
+	length := len(m.sigs)
+	m.sigs = append(m.sigs, sigs...)
+	// add priorities
+	m.priorities.Add(p, len(sigs), 0, 0)
+	return m, length + len(sigs), nil
+}
+
+type result struct {
+	idx    int
+	doc    string
+	tracks string
+}
+
+func (r result) Index() int {
+	return r.idx
+}
+
+func (r result) Basis() string {
+	if r.tracks == "" {
+		return "ebml doctype " + r.doc
+	}
+	return fmt.Sprintf("ebml doctype %s with %s tracks", r.doc, r.tracks)
+}
+
+func (m Matcher) Identify(na string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
+	res := make(chan core.Result)
+	doc, tracks, ok := parse(b)
+	if !ok {
+		close(res)
+		return res, nil
+	}
+	if config.Debug() {
+		fmt.Fprintf(config.Out(), "ebml doctype %s, tracks %s\n", doc, tracks)
+	}
+	// a signature qualified by track type is more specific than the bare doctype: if one matches,
+	// skip the bare doctype signatures added by the same identifier
+	qualified := make(map[int]bool)
+	for i, s := range m.sigs {
+		if s[0] == doc && s[1] != "" && s[1] == tracks {
+			idx, _ := m.priorities.Index(i)
+			qualified[idx] = true
+		}
+	}
+	waitset := m.priorities.WaitSet(hints...)
+	go func() {
+		for i, s := range m.sigs {
+			if s[0] != doc || (s[1] != "" && s[1] != tracks) || !waitset.Check(i) {
+				continue
+			}
+			if idx, _ := m.priorities.Index(i); s[1] == "" && qualified[idx] {
+				continue
+			}
+			res <- result{i, doc, tracks}
+			if waitset.Put(i) {
+				break
+			}
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
+func (m Matcher) String() string {
+	strs := make([]string, len(m.sigs))
+	for i, v := range m.sigs {
+		if v[1] == "" {
+			strs[i] = v[0]
+		} else {
+			strs[i] = v[0] + " (" + v[1] + ")"
+		}
+	}
+	return fmt.Sprintf("EBML matcher: %s\n", strings.Join(strs, ", "))
+}
+
+// parse reads the DocType from the EBML header, then looks for track entries in the segment.
+// Tracks are reported as Video if there is a video track, Audio if there are only audio (or subtitle) tracks, and empty if none are found.
+func parse(b *siegreader.Buffer) (string, string, bool) {
+	buf, _ := b.Slice(0, window)
+	id, sz, n := element(buf)
+	if n == 0 || id != idEBML || sz < 0 || n+int(sz) > len(buf) {
+		return "", "", false
+	}
+	hdr, body := buf[n:n+int(sz)], buf[n+int(sz):]
+	var doc string
+	for len(hdr) > 0 {
+		id, sz, n = element(hdr)
+		if n == 0 || sz < 0 || n+int(sz) > len(hdr) {
+			break
+		}
+		if id == idDocType {
+			doc = strings.TrimRight(string(hdr[n:n+int(sz)]), "\x00")
+			break
+		}
+		hdr = hdr[n+int(sz):]
+	}
+	if doc == "" {
+		return "", "", false
+	}
+	return doc, tracks(body), true
+}
+
+// tracks walks the top level elements in a segment until it finds the Tracks element (or a Cluster, which always follows the Tracks)
+func tracks(buf []byte) string {
+	id, _, n := element(buf)
+	if n == 0 || id != idSegment {
+		return ""
+	}
+	buf = buf[n:]
+	for len(buf) > 0 {
+		id, sz, n := element(buf)
+		if n == 0 || id == idCluster {
+			return ""
+		}
+		if sz < 0 || n+int(sz) > len(buf) {
+			return ""
+		}
+		if id == idTracks {
+			return trackTypes(buf[n : n+int(sz)])
+		}
+		buf = buf[n+int(sz):]
+	}
+	return ""
+}
+
+func trackTypes(buf []byte) string {
+	var audio bool
+	for len(buf) > 0 {
+		id, sz, n := element(buf)
+		if n == 0 || sz < 0 || n+int(sz) > len(buf) {
+			break
+		}
+		if id == idTrackEnt {
+			entry := buf[n : n+int(sz)]
+			for len(entry) > 0 {
+				eid, esz, en := element(entry)
+				if en == 0 || esz < 0 || en+int(esz) > len(entry) {
+					break
+				}
+				if eid == idTrackType && esz == 1 {
+					switch entry[en] {
+					case 1:
+						return Video
+					case 2:
+						audio = true
+					}
+				}
+				entry = entry[en+int(esz):]
+			}
+		}
+		buf = buf[n+int(sz):]
+	}
+	if audio {
+		return Audio
+	}
+	return ""
+}
+
+// element reads an element ID and data size, returning the number of bytes read (zero if they are invalid).
+// An unknown data size is returned as -1.
+func element(buf []byte) (uint32, int64, int) {
+	if len(buf) == 0 {
+		return 0, 0, 0
+	}
+	l := vintLen(buf[0])
+	if l == 0 || l > 4 || len(buf) < l {
+		return 0, 0, 0
+	}
+	var id uint32
+	for _, c := range buf[:l] {
+		id = id<<8 | uint32(c)
+	}
+	sl := 0
+	if len(buf) > l {
+		sl = vintLen(buf[l])
+	}
+	if sl == 0 || len(buf) < l+sl {
+		return 0, 0, 0
+	}
+	sz := int64(buf[l] & (0xFF >> uint(sl)))
+	unknown := sz == int64(0xFF>>uint(sl))
+	for _, c := range buf[l+1 : l+sl] {
+		sz = sz<<8 | int64(c)
+		unknown = unknown && c == 0xFF
+	}
+	if unknown {
+		sz = -1
+	}
+	return id, sz, l + sl
+}
+
+// vintLen returns the length of a variable length integer from its first byte (zero if invalid).
+func vintLen(c byte) int {
+	for i := 0; i < 8; i++ {
+		if c&(0x80>>uint(i)) != 0 {
+			return i + 1
+		}
+	}
+	return 0
+}
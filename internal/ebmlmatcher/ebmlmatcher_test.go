@@ -0,0 +1,115 @@
+package ebmlmatcher
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var fmts = SignatureSet{
+	{"matroska", ""},
+	{"matroska", Video},
+	{"matroska", Audio},
+	{"webm", ""},
+}
+
+var em core.Matcher
+
+func init() {
+	em, _, _ = Add(em, fmts, nil)
+}
+
+// el makes an EBML element with a one byte size
+func el(id []byte, data ...[]byte) []byte {
+	body := bytes.Join(data, nil)
+	return append(append(id, 0x80|byte(len(body))), body...)
+}
+
+func file(doc string, trackTypes ...byte) []byte {
+	hdr := el([]byte{0x1A, 0x45, 0xDF, 0xA3},
+		el([]byte{0x42, 0x86}, []byte{1}),
+		el([]byte{0x42, 0x82}, []byte(doc)),
+		el([]byte{0x42, 0x87}, []byte{4}),
+	)
+	entries := make([][]byte, len(trackTypes))
+	for i, t := range trackTypes {
+		entries[i] = el([]byte{0xAE}, el([]byte{0xD7}, []byte{byte(i + 1)}), el([]byte{0x83}, []byte{t}))
+	}
+	// segment with an unknown size
+	seg := append([]byte{0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		el([]byte{0x15, 0x49, 0xA9, 0x66}, el([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}))...)
+	seg = append(seg, el([]byte{0x16, 0x54, 0xAE, 0x6B}, entries...)...)
+	seg = append(seg, el([]byte{0x1F, 0x43, 0xB6, 0x75}, []byte{0xE7, 0x81, 0x00})...)
+	return append(hdr, seg...)
+}
+
+func identify(t *testing.T, byt []byte) []int {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(byt))
+	defer bufs.Put(b)
+	res, err := em.Identify("", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits []int
+	for h := range res {
+		hits = append(hits, h.Index())
+	}
+	return hits
+}
+
+func TestParse(t *testing.T) {
+	for _, v := range []struct {
+		byt    []byte
+		doc    string
+		tracks string
+	}{
+		{file("matroska", 1, 2), "matroska", Video},
+		{file("matroska", 2, 0x11), "matroska", Audio},
+		{file("webm", 2), "webm", Audio},
+		{file("matroska"), "matroska", ""},
+	} {
+		bufs := siegreader.New()
+		b, _ := bufs.Get(bytes.NewReader(v.byt))
+		doc, tracks, ok := parse(b)
+		if !ok || doc != v.doc || tracks != v.tracks {
+			t.Errorf("expecting %s with %s tracks, got %s with %s tracks (%v)", v.doc, v.tracks, doc, tracks, ok)
+		}
+	}
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader([]byte("RIFF....WAVE")))
+	if _, _, ok := parse(b); ok {
+		t.Error("expecting a non-EBML file not to parse")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	if hits := identify(t, file("matroska", 1, 2)); len(hits) != 1 || hits[0] != 1 {
+		t.Errorf("expecting a matroska video hit, got %v", hits)
+	}
+	if hits := identify(t, file("matroska", 2)); len(hits) != 1 || hits[0] != 2 {
+		t.Errorf("expecting a matroska audio hit, got %v", hits)
+	}
+	// without tracks, only the bare doctype matches
+	if hits := identify(t, file("matroska")); len(hits) != 1 || hits[0] != 0 {
+		t.Errorf("expecting a generic matroska hit, got %v", hits)
+	}
+	if hits := identify(t, file("webm", 1)); len(hits) != 1 || hits[0] != 3 {
+		t.Errorf("expecting a webm hit, got %v", hits)
+	}
+}
+
+func TestIO(t *testing.T) {
+	str := em.String()
+	saver := persist.NewLoadSaver(nil)
+	Save(em, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	newem := Load(loader)
+	str2 := newem.String()
+	if str != str2 {
+		t.Errorf("Load ebml matcher: expecting first matcher (%v), to equal second matcher (%v)", str, str2)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package harvest fetches the documents that identifiers are built from (e.g. PRONOM reports and LOC format descriptions).
+// Requests are timed-out, throttled and retried with the harvesting options (config.HarvestOptions and config.HarvestRetries).
+package harvest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// ErrNotModified is returned by a conditional request if the resource hasn't changed since the validator was recorded.
+var ErrNotModified = errors.New("harvest: not modified")
+
+// Backoff is the wait before the first retry of a failed request; it doubles with each retry.
+var Backoff = time.Second
+
+// Validator holds the headers of a response that are sent back in conditional requests for the same resource.
+type Validator struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// Get fetches a url, retrying on network errors and server errors (5xx or 429 Too Many Requests).
+func Get(url string) ([]byte, error) {
+	return Fetch(url, nil)
+}
+
+// Fetch is Get with a conditional request if v is given and holds validators from an earlier response. It returns
+// ErrNotModified if the resource hasn't changed since; otherwise v is updated with the response's validators.
+func Fetch(url string, v *Validator) ([]byte, error) {
+	wait := Backoff
+	for i := 0; ; i++ {
+		byts, retry, err := get(url, v)
+		if err == nil || !retry || i >= config.HarvestRetries() {
+			return byts, err
+		}
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// get makes a single request, reporting whether it is worth retrying if it fails.
+// The harvest timeout covers the whole request, including reading the body.
+func get(url string, v *Validator) ([]byte, bool, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	_, timeout, _, transport := config.HarvestOptions()
+	req.Header.Add("User-Agent", "siegfried/roybot (+https://github.com/richardlehane/siegfried)")
+	if v != nil && v.ETag != "" {
+		req.Header.Add("If-None-Match", v.ETag)
+	}
+	if v != nil && v.LastModified != "" {
+		req.Header.Add("If-Modified-Since", v.LastModified)
+	}
+	client := http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && v != nil {
+		return nil, false, ErrNotModified
+	}
+	if resp.StatusCode >= 300 {
+		return nil, resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests, fmt.Errorf("harvest: error fetching %s: %s", url, resp.Status)
+	}
+	byts, err := ioutil.ReadAll(resp.Body)
+	if err == nil && v != nil {
+		*v = Validator{resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")}
+	}
+	return byts, err != nil, err
+}
+
+// ApplyAll calls apply for n items, with at most max calls at once and waiting the harvest throttle between them.
+// It returns the errors of any failed calls.
+func ApplyAll(max, n int, apply func(i int) error) []error {
+	ch := make(chan error, n)
+	wg := sync.WaitGroup{}
+	queue := make(chan struct{}, max) // to avoid hammering the server
+	_, _, tf, _ := config.HarvestOptions()
+	var throttle *time.Ticker
+	if tf > 0 {
+		throttle = time.NewTicker(tf)
+		defer throttle.Stop()
+	}
+	for i := 0; i < n; i++ {
+		if tf > 0 {
+			<-throttle.C
+		}
+		wg.Add(1)
+		queue <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			if err := apply(i); err != nil {
+				ch <- err
+			}
+			<-queue
+		}(i)
+	}
+	wg.Wait()
+	close(ch)
+	var errors []error
+	for err := range ch {
+		errors = append(errors, err)
+	}
+	return errors
+}
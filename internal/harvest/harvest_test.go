@@ -0,0 +1,82 @@
+package harvest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+func TestFetch(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/flaky":
+			if atomic.AddInt32(&hits, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte("ok"))
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte("late"))
+		default:
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	oldBackoff := Backoff
+	_, oldTimeout, _, _ := config.HarvestOptions()
+	defer func() {
+		Backoff = oldBackoff
+		config.SetHarvestTimeout(oldTimeout)
+	}()
+	Backoff = time.Millisecond
+	var v Validator
+	if byts, err := Fetch(srv.URL+"/flaky", &v); err != nil || string(byts) != "ok" || v.ETag != `"v1"` {
+		t.Errorf("expecting a server error to be retried, got %q, %v, %v", byts, v, err)
+	}
+	if _, err := Fetch(srv.URL+"/unchanged", &v); err != ErrNotModified {
+		t.Errorf("expecting a conditional request to be not modified, got %v", err)
+	}
+	if _, err := Get(srv.URL + "/missing"); err == nil {
+		t.Error("expecting an error fetching a missing page")
+	}
+	config.SetHarvestTimeout(50 * time.Millisecond)
+	if _, err := Get(srv.URL + "/slow"); err == nil {
+		t.Error("expecting a slow request to time out")
+	}
+}
+
+func TestApplyAll(t *testing.T) {
+	var running, most int32
+	errs := ApplyAll(2, 10, func(i int) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&most)
+			if n <= m || atomic.CompareAndSwapInt32(&most, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		if i%5 == 0 {
+			return http.ErrMissingFile
+		}
+		return nil
+	})
+	if len(errs) != 2 {
+		t.Errorf("expecting two errors, got %v", errs)
+	}
+	if most > 2 {
+		t.Errorf("expecting at most two calls at once, got %d", most)
+	}
+}
@@ -19,14 +19,19 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/richardlehane/siegfried/internal/bmffmatcher"
 	"github.com/richardlehane/siegfried/internal/bytematcher"
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
 	"github.com/richardlehane/siegfried/internal/containermatcher"
+	"github.com/richardlehane/siegfried/internal/datamatcher"
+	"github.com/richardlehane/siegfried/internal/ebmlmatcher"
 	"github.com/richardlehane/siegfried/internal/mimematcher"
+	"github.com/richardlehane/siegfried/internal/mpegmatcher"
 	"github.com/richardlehane/siegfried/internal/namematcher"
 	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/internal/riffmatcher"
+	"github.com/richardlehane/siegfried/internal/structmatcher"
 	"github.com/richardlehane/siegfried/internal/textmatcher"
 	"github.com/richardlehane/siegfried/internal/xmlmatcher"
 	"github.com/richardlehane/siegfried/pkg/config"
@@ -39,8 +44,10 @@ type Base struct {
 	name                                     string
 	details                                  string
 	multi                                    config.Multi
+	seg                                      config.Segmentation // segmentation settings for byte signatures, captured when the identifier is created (not persisted)
 	zipDefault                               bool
 	gids, mids, cids, xids, bids, rids, tids *indexes
+	aids, eids, fids, sids, dids             *indexes // mpeg audio, ebml, bmff, struct and data indexes are persisted separately (see SaveAppended)
 }
 
 type indexes struct {
@@ -111,8 +118,9 @@ func New(p Parseable, zip string, extra ...string) *Base {
 		name:       config.Name(),
 		details:    config.Details(extra...),
 		multi:      config.GetMulti(),
+		seg:        config.GetSegmentation(),
 		zipDefault: contains(p.IDs(), zip),
-		gids:       &indexes{}, mids: &indexes{}, cids: &indexes{}, xids: &indexes{}, bids: &indexes{}, rids: &indexes{}, tids: &indexes{},
+		gids:       &indexes{}, mids: &indexes{}, cids: &indexes{}, xids: &indexes{}, bids: &indexes{}, rids: &indexes{}, tids: &indexes{}, aids: &indexes{}, eids: &indexes{}, fids: &indexes{}, sids: &indexes{}, dids: &indexes{},
 	}
 }
 
@@ -143,9 +151,33 @@ func Load(ls *persist.LoadSaver) *Base {
 		bids:       loadIndexes(ls),
 		rids:       loadIndexes(ls),
 		tids:       loadIndexes(ls),
+		aids:       &indexes{},
+		eids:       &indexes{},
+		fids:       &indexes{},
+		sids:       &indexes{},
+		dids:       &indexes{},
 	}
 }
 
+// SaveAppended persists the indexes for matchers added since the v1.9 signature file format.
+// These are saved after all the identifiers in a signature file, so that older signature files can still be loaded.
+func (b *Base) SaveAppended(ls *persist.LoadSaver) {
+	b.aids.save(ls)
+	b.eids.save(ls)
+	b.fids.save(ls)
+	b.sids.save(ls)
+	b.dids.save(ls)
+}
+
+// LoadAppended loads indexes persisted with SaveAppended.
+func (b *Base) LoadAppended(ls *persist.LoadSaver) {
+	b.aids = loadIndexes(ls)
+	b.eids = loadIndexes(ls)
+	b.fids = loadIndexes(ls)
+	b.sids = loadIndexes(ls)
+	b.dids = loadIndexes(ls)
+}
+
 func (b *Base) Name() string {
 	return b.name
 }
@@ -162,6 +194,11 @@ func (b *Base) String() string {
 	str += fmt.Sprintf("Number of XML signatures: %d \n", len(b.xids.ids))
 	str += fmt.Sprintf("Number of byte signatures: %d \n", len(b.bids.ids))
 	str += fmt.Sprintf("Number of RIFF signatures: %d \n", len(b.rids.ids))
+	str += fmt.Sprintf("Number of MPEG audio signatures: %d \n", len(b.aids.ids))
+	str += fmt.Sprintf("Number of EBML signatures: %d \n", len(b.eids.ids))
+	str += fmt.Sprintf("Number of BMFF signatures: %d \n", len(b.fids.ids))
+	str += fmt.Sprintf("Number of struct signatures: %d \n", len(b.sids.ids))
+	str += fmt.Sprintf("Number of data signatures: %d \n", len(b.dids.ids))
 	str += fmt.Sprintf("Number of text signatures: %d \n", len(b.tids.ids))
 	return str
 }
@@ -249,6 +286,16 @@ func (b *Base) Hit(m core.MatcherType, idx int) (bool, string) {
 		return b.bids.hit(idx)
 	case core.RIFFMatcher:
 		return b.rids.hit(idx)
+	case core.MPEGMatcher:
+		return b.aids.hit(idx)
+	case core.EBMLMatcher:
+		return b.eids.hit(idx)
+	case core.BMFFMatcher:
+		return b.fids.hit(idx)
+	case core.StructMatcher:
+		return b.sids.hit(idx)
+	case core.DataMatcher:
+		return b.dids.hit(idx)
 	case core.TextMatcher:
 		return b.tids.first(idx) // textmatcher is unique as only returns a single hit per identifier
 	}
@@ -270,6 +317,16 @@ func (b *Base) Place(m core.MatcherType, idx int) (int, int) {
 		return b.bids.place(idx)
 	case core.RIFFMatcher:
 		return b.rids.place(idx)
+	case core.MPEGMatcher:
+		return b.aids.place(idx)
+	case core.EBMLMatcher:
+		return b.eids.place(idx)
+	case core.BMFFMatcher:
+		return b.fids.place(idx)
+	case core.StructMatcher:
+		return b.sids.place(idx)
+	case core.DataMatcher:
+		return b.dids.place(idx)
 	case core.TextMatcher:
 		return b.tids.place(idx)
 	}
@@ -291,6 +348,16 @@ func (b *Base) Lookup(m core.MatcherType, keys []string) []int {
 		return b.bids.find(keys)
 	case core.RIFFMatcher:
 		return b.rids.find(keys)
+	case core.MPEGMatcher:
+		return b.aids.find(keys)
+	case core.EBMLMatcher:
+		return b.eids.find(keys)
+	case core.BMFFMatcher:
+		return b.fids.find(keys)
+	case core.StructMatcher:
+		return b.sids.find(keys)
+	case core.DataMatcher:
+		return b.dids.find(keys)
 	case core.TextMatcher:
 		return b.tids.find(keys)
 	}
@@ -346,7 +413,7 @@ func (b *Base) Add(m core.Matcher, t core.MatcherType) (core.Matcher, error) {
 		}
 		b.mids.start = l - len(b.mids.ids)
 	case core.XMLMatcher:
-		var xmls [][2]string
+		var xmls [][3]string
 		xmls, b.xids.ids = b.p.XMLs()
 		m, l, err = xmlmatcher.Add(m, xmlmatcher.SignatureSet(xmls), nil)
 		if err != nil {
@@ -360,7 +427,7 @@ func (b *Base) Add(m core.Matcher, t core.MatcherType) (core.Matcher, error) {
 		if err != nil {
 			return nil, err
 		}
-		m, l, err = bytematcher.Add(m, bytematcher.SignatureSet(sigs), b.p.Priorities().List(b.bids.ids))
+		m, l, err = bytematcher.AddSegmented(m, bytematcher.SignatureSet(sigs), b.p.Priorities().List(b.bids.ids), b.seg)
 		if err != nil {
 			return nil, err
 		}
@@ -373,6 +440,46 @@ func (b *Base) Add(m core.Matcher, t core.MatcherType) (core.Matcher, error) {
 			return nil, err
 		}
 		b.rids.start = l - len(b.rids.ids)
+	case core.MPEGMatcher:
+		var mpegs []byte
+		mpegs, b.aids.ids = b.p.MPEGs()
+		m, l, err = mpegmatcher.Add(m, mpegmatcher.SignatureSet(mpegs), b.p.Priorities().List(b.aids.ids))
+		if err != nil {
+			return nil, err
+		}
+		b.aids.start = l - len(b.aids.ids)
+	case core.EBMLMatcher:
+		var ebmls [][2]string
+		ebmls, b.eids.ids = b.p.EBMLs()
+		m, l, err = ebmlmatcher.Add(m, ebmlmatcher.SignatureSet(ebmls), b.p.Priorities().List(b.eids.ids))
+		if err != nil {
+			return nil, err
+		}
+		b.eids.start = l - len(b.eids.ids)
+	case core.BMFFMatcher:
+		var brands [][4]byte
+		brands, b.fids.ids = b.p.Brands()
+		m, l, err = bmffmatcher.Add(m, bmffmatcher.SignatureSet(brands), b.p.Priorities().List(b.fids.ids))
+		if err != nil {
+			return nil, err
+		}
+		b.fids.start = l - len(b.fids.ids)
+	case core.StructMatcher:
+		var structs []string
+		structs, b.sids.ids = b.p.Structs()
+		m, l, err = structmatcher.Add(m, structmatcher.SignatureSet(structs), b.p.Priorities().List(b.sids.ids))
+		if err != nil {
+			return nil, err
+		}
+		b.sids.start = l - len(b.sids.ids)
+	case core.DataMatcher:
+		var variants []string
+		variants, b.dids.ids = b.p.Data()
+		m, l, err = datamatcher.Add(m, datamatcher.SignatureSet(variants), b.p.Priorities().List(b.dids.ids))
+		if err != nil {
+			return nil, err
+		}
+		b.dids.start = l - len(b.dids.ids)
 	case core.TextMatcher:
 		b.tids.ids = b.p.Texts()
 		if len(b.tids.ids) > 0 {
@@ -399,6 +506,16 @@ func (b *Base) Active(m core.MatcherType) bool {
 		return len(b.bids.ids) > 0
 	case core.RIFFMatcher:
 		return len(b.rids.ids) > 0
+	case core.MPEGMatcher:
+		return len(b.aids.ids) > 0
+	case core.EBMLMatcher:
+		return len(b.eids.ids) > 0
+	case core.BMFFMatcher:
+		return len(b.fids.ids) > 0
+	case core.StructMatcher:
+		return len(b.sids.ids) > 0
+	case core.DataMatcher:
+		return len(b.dids.ids) > 0
 	case core.TextMatcher:
 		return len(b.tids.ids) > 0
 	}
@@ -420,6 +537,16 @@ func (b *Base) Start(m core.MatcherType) int {
 		return b.bids.start
 	case core.RIFFMatcher:
 		return b.rids.start
+	case core.MPEGMatcher:
+		return b.aids.start
+	case core.EBMLMatcher:
+		return b.eids.start
+	case core.BMFFMatcher:
+		return b.fids.start
+	case core.StructMatcher:
+		return b.sids.start
+	case core.DataMatcher:
+		return b.dids.start
 	case core.TextMatcher:
 		return b.tids.start
 	}
@@ -441,6 +568,16 @@ func (b *Base) IDs(m core.MatcherType) []string {
 		return b.bids.ids
 	case core.RIFFMatcher:
 		return b.rids.ids
+	case core.MPEGMatcher:
+		return b.aids.ids
+	case core.EBMLMatcher:
+		return b.eids.ids
+	case core.BMFFMatcher:
+		return b.fids.ids
+	case core.StructMatcher:
+		return b.sids.ids
+	case core.DataMatcher:
+		return b.dids.ids
 	case core.TextMatcher:
 		return b.tids.ids
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
+	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/pkg/core"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
@@ -146,3 +147,24 @@ func TestSorted(t *testing.T) {
 		t.Errorf("Returned: %s expected: %s", ids, idsAfterSort)
 	}
 }
+
+type dupParseable struct{ Blank }
+
+func (d dupParseable) Signatures() ([]frames.Signature, []string, error) {
+	return []frames.Signature{f0, f1, f0, f1, f2}, []string{"fmt/1", "fmt/2", "x-fmt/9", "fmt/3", "fmt/1"}, nil
+}
+
+func (d dupParseable) Priorities() priority.Map {
+	return priority.Map{"fmt/2": []string{"fmt/3"}}
+}
+
+func TestDuplicates(t *testing.T) {
+	dups := Duplicates(dupParseable{})
+	if len(dups) != 1 || dups[0] != [2]string{"fmt/1", "x-fmt/9"} {
+		t.Fatalf("expecting fmt/1 and x-fmt/9 to be reported as duplicates, got %v", dups)
+	}
+	m := linked{dupParseable{}, dups}.Priorities()
+	if len(m["fmt/1"]) != 1 || m["fmt/1"][0] != "x-fmt/9" || len(m["fmt/2"]) != 1 {
+		t.Errorf("expecting x-fmt/9 to be given priority over fmt/1, got %v", m)
+	}
+}
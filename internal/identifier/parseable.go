@@ -15,6 +15,7 @@
 package identifier
 
 import (
+	"log"
 	"sort"
 	"strings"
 
@@ -34,11 +35,16 @@ type Parseable interface {
 	Infos() map[string]FormatInfo                                // identifier specific information
 	Globs() ([]string, []string)                                 // signature set and corresponding IDs for globmatcher
 	MIMEs() ([]string, []string)                                 // signature set and corresponding IDs for mimematcher
-	XMLs() ([][2]string, []string)                               // signature set and corresponding IDs for xmlmatcher
+	XMLs() ([][3]string, []string)                               // signature set and corresponding IDs for xmlmatcher
 	Signatures() ([]frames.Signature, []string, error)           // signature set and corresponding IDs for bytematcher
 	Zips() ([][]string, [][]frames.Signature, []string, error)   // signature set and corresponding IDs for container matcher - Zip
 	MSCFBs() ([][]string, [][]frames.Signature, []string, error) // signature set and corresponding IDs for container matcher - MSCFB
 	RIFFs() ([][4]byte, []string)                                // signature set and corresponding IDs for riffmatcher
+	MPEGs() ([]byte, []string)                                   // signature set (bitmasks of MPEG audio layers) and corresponding IDs for mpegmatcher
+	EBMLs() ([][2]string, []string)                              // signature set (DocType and track qualifier) and corresponding IDs for ebmlmatcher
+	Brands() ([][4]byte, []string)                               // signature set (ftyp brands) and corresponding IDs for bmffmatcher
+	Structs() ([]string, []string)                               // signature set (kinds of structured text e.g. json or csv) and corresponding IDs for structmatcher
+	Data() ([]string, []string)                                  // signature set (variants of scientific data formats e.g. sqlite3 or hdf5-2) and corresponding IDs for datamatcher
 	Texts() []string                                             // IDs for textmatcher
 	Priorities() priority.Map                                    // priority map
 }
@@ -61,6 +67,11 @@ func inspect(p Parseable, ids ...string) (string, error) {
 		zns, zbs, zids, _    = p.Zips()
 		msns, msbs, msids, _ = p.MSCFBs()
 		rs, rids             = p.RIFFs()
+		mps, mpids           = p.MPEGs()
+		ebs, ebids           = p.EBMLs()
+		brs, brids           = p.Brands()
+		sts, stids           = p.Structs()
+		das, daids           = p.Data()
 		tids                 = p.Texts()
 		pm                   = p.Priorities()
 	)
@@ -81,11 +92,28 @@ func inspect(p Parseable, ids ...string) (string, error) {
 		}
 		return ret
 	}
-	getX := func(ss []string, rs [][2]string, s string) []string {
+	getX := func(ss []string, rs [][3]string, s string) []string {
 		ret := make([]string, 0, len(ss))
 		for i, v := range ss {
 			if s == v {
-				ret = append(ret, "root: "+rs[i][0]+"; ns: "+rs[i][1])
+				x := "root: " + rs[i][0] + "; ns: " + rs[i][1]
+				if rs[i][2] != "" {
+					x += "; qualifier: " + rs[i][2]
+				}
+				ret = append(ret, x)
+			}
+		}
+		return ret
+	}
+	getE := func(ss []string, es [][2]string, s string) []string {
+		ret := make([]string, 0, len(ss))
+		for i, v := range ss {
+			if s == v {
+				if es[i][1] == "" {
+					ret = append(ret, es[i][0])
+				} else {
+					ret = append(ret, es[i][0]+" ("+es[i][1]+" tracks)")
+				}
 			}
 		}
 		return ret
@@ -124,6 +152,21 @@ func inspect(p Parseable, ids ...string) (string, error) {
 		}
 		return ret
 	}
+	getMP := func(ss []string, rs []byte, s string) []string {
+		ret := make([]string, 0, len(ss))
+		for i, v := range ss {
+			if s == v {
+				var layers []string
+				for j, l := range []string{"layer I", "layer II", "layer III"} {
+					if rs[i]&(1<<uint(j)) != 0 {
+						layers = append(layers, l)
+					}
+				}
+				ret = append(ret, strings.Join(layers, "/"))
+			}
+		}
+		return ret
+	}
 	for _, id := range ids {
 		lines := make([]string, 0, 10)
 		info, ok := p.Infos()[id]
@@ -154,6 +197,21 @@ func inspect(p Parseable, ids ...string) (string, error) {
 			if has(rids, id) {
 				lines = append(lines, "riffs: "+strings.Join(getR(rids, rs, id), ", "))
 			}
+			if has(mpids, id) {
+				lines = append(lines, "mpeg audio: "+strings.Join(getMP(mpids, mps, id), ", "))
+			}
+			if has(ebids, id) {
+				lines = append(lines, "ebml doctypes: "+strings.Join(getE(ebids, ebs, id), ", "))
+			}
+			if has(brids, id) {
+				lines = append(lines, "ftyp brands: "+strings.Join(getR(brids, brs, id), ", "))
+			}
+			if has(stids, id) {
+				lines = append(lines, "structured text: "+strings.Join(get(stids, sts, id), ", "))
+			}
+			if has(daids, id) {
+				lines = append(lines, "data variants: "+strings.Join(get(daids, das, id), ", "))
+			}
 			if has(tids, id) {
 				lines = append(lines, "text signature")
 			}
@@ -178,17 +236,24 @@ func inspect(p Parseable, ids ...string) (string, error) {
 // Blank parseable can be embedded within other parseables in order to include default nil implementations of the interface
 type Blank struct{}
 
-func (b Blank) IDs() []string                                               { return nil }
-func (b Blank) Infos() map[string]FormatInfo                                { return nil }
-func (b Blank) Globs() ([]string, []string)                                 { return nil, nil }
-func (b Blank) MIMEs() ([]string, []string)                                 { return nil, nil }
-func (b Blank) XMLs() ([][2]string, []string)                               { return nil, nil }
-func (b Blank) Signatures() ([]frames.Signature, []string, error)           { return nil, nil, nil }
-func (b Blank) Zips() ([][]string, [][]frames.Signature, []string, error)   { return nil, nil, nil, nil }
-func (b Blank) MSCFBs() ([][]string, [][]frames.Signature, []string, error) { return nil, nil, nil, nil }
-func (b Blank) RIFFs() ([][4]byte, []string)                                { return nil, nil }
-func (b Blank) Texts() []string                                             { return nil }
-func (b Blank) Priorities() priority.Map                                    { return nil }
+func (b Blank) IDs() []string                                             { return nil }
+func (b Blank) Infos() map[string]FormatInfo                              { return nil }
+func (b Blank) Globs() ([]string, []string)                               { return nil, nil }
+func (b Blank) MIMEs() ([]string, []string)                               { return nil, nil }
+func (b Blank) XMLs() ([][3]string, []string)                             { return nil, nil }
+func (b Blank) Signatures() ([]frames.Signature, []string, error)         { return nil, nil, nil }
+func (b Blank) Zips() ([][]string, [][]frames.Signature, []string, error) { return nil, nil, nil, nil }
+func (b Blank) MSCFBs() ([][]string, [][]frames.Signature, []string, error) {
+	return nil, nil, nil, nil
+}
+func (b Blank) RIFFs() ([][4]byte, []string)   { return nil, nil }
+func (b Blank) MPEGs() ([]byte, []string)      { return nil, nil }
+func (b Blank) EBMLs() ([][2]string, []string) { return nil, nil }
+func (b Blank) Brands() ([][4]byte, []string)  { return nil, nil }
+func (b Blank) Structs() ([]string, []string)  { return nil, nil }
+func (b Blank) Data() ([]string, []string)     { return nil, nil }
+func (b Blank) Texts() []string                { return nil }
+func (b Blank) Priorities() priority.Map       { return nil }
 
 // Joint allows two parseables to be logically joined.
 type joint struct {
@@ -245,7 +310,7 @@ func (j joint) MIMEs() ([]string, []string) {
 }
 
 // XMLs returns a signature set with corresponding IDs for the xmlmatcher.
-func (j joint) XMLs() ([][2]string, []string) {
+func (j joint) XMLs() ([][3]string, []string) {
 	a, b := j.a.XMLs()
 	c, d := j.b.XMLs()
 	return append(a, c...), append(b, d...)
@@ -304,6 +369,32 @@ func (j joint) RIFFs() ([][4]byte, []string) {
 	return append(a, c...), append(b, d...)
 }
 
+func (j joint) MPEGs() ([]byte, []string) {
+	a, b := j.a.MPEGs()
+	c, d := j.b.MPEGs()
+	return append(a, c...), append(b, d...)
+}
+
+func (j joint) EBMLs() ([][2]string, []string) {
+	a, b := j.a.EBMLs()
+	c, d := j.b.EBMLs()
+	return append(a, c...), append(b, d...)
+}
+
+func (j joint) Brands() ([][4]byte, []string) {
+	a, b := j.a.Brands()
+	c, d := j.b.Brands()
+	return append(a, c...), append(b, d...)
+}
+
+func (j joint) Structs() ([]string, []string) {
+	return joinStrings(j.a.Structs, j.b.Structs)
+}
+
+func (j joint) Data() ([]string, []string) {
+	return joinStrings(j.a.Data, j.b.Data)
+}
+
 func (j joint) Texts() []string {
 	txts := make([]string, len(j.a.Texts()), len(j.a.Texts())+len(j.b.Texts()))
 	copy(txts, j.a.Texts())
@@ -381,8 +472,8 @@ func (f filtered) MIMEs() ([]string, []string) {
 }
 
 // XMLs returns a signature set with corresponding IDs for the xmlmatcher.
-func (f filtered) XMLs() ([][2]string, []string) {
-	ret, retp := make([][2]string, 0, len(f.IDs())), make([]string, 0, len(f.IDs()))
+func (f filtered) XMLs() ([][3]string, []string) {
+	ret, retp := make([][3]string, 0, len(f.IDs())), make([]string, 0, len(f.IDs()))
 	e, p := f.p.XMLs()
 	for i, v := range p {
 		for _, w := range f.IDs() {
@@ -461,6 +552,56 @@ func (f filtered) RIFFs() ([][4]byte, []string) {
 	return ret, retp
 }
 
+func (f filtered) MPEGs() ([]byte, []string) {
+	ret, retp := make([]byte, 0, len(f.IDs())), make([]string, 0, len(f.IDs()))
+	m, p := f.p.MPEGs()
+	for i, v := range p {
+		for _, w := range f.IDs() {
+			if v == w {
+				ret, retp = append(ret, m[i]), append(retp, v)
+				break
+			}
+		}
+	}
+	return ret, retp
+}
+
+func (f filtered) EBMLs() ([][2]string, []string) {
+	ret, retp := make([][2]string, 0, len(f.IDs())), make([]string, 0, len(f.IDs()))
+	e, p := f.p.EBMLs()
+	for i, v := range p {
+		for _, w := range f.IDs() {
+			if v == w {
+				ret, retp = append(ret, e[i]), append(retp, v)
+				break
+			}
+		}
+	}
+	return ret, retp
+}
+
+func (f filtered) Brands() ([][4]byte, []string) {
+	ret, retp := make([][4]byte, 0, len(f.IDs())), make([]string, 0, len(f.IDs()))
+	br, p := f.p.Brands()
+	for i, v := range p {
+		for _, w := range f.IDs() {
+			if v == w {
+				ret, retp = append(ret, br[i]), append(retp, v)
+				break
+			}
+		}
+	}
+	return ret, retp
+}
+
+func (f filtered) Structs() ([]string, []string) {
+	return filterStrings(f.p.Structs, f.IDs())
+}
+
+func (f filtered) Data() ([]string, []string) {
+	return filterStrings(f.p.Data, f.IDs())
+}
+
 func (f filtered) Texts() []string {
 	txts := make([]string, 0, len(f.p.Texts()))
 	for _, t := range f.p.Texts() {
@@ -513,7 +654,7 @@ func (nm noMIME) MIMEs() ([]string, []string) { return nil, nil }
 
 type noXML struct{ Parseable }
 
-func (nx noXML) XMLs() ([][2]string, []string) { return nil, nil }
+func (nx noXML) XMLs() ([][3]string, []string) { return nil, nil }
 
 type noByte struct{ Parseable }
 
@@ -533,6 +674,26 @@ type noRIFF struct{ Parseable }
 
 func (nr noRIFF) RIFFs() ([][4]byte, []string) { return nil, nil }
 
+type noMPEG struct{ Parseable }
+
+func (nm noMPEG) MPEGs() ([]byte, []string) { return nil, nil }
+
+type noEBML struct{ Parseable }
+
+func (ne noEBML) EBMLs() ([][2]string, []string) { return nil, nil }
+
+type noBMFF struct{ Parseable }
+
+func (nb noBMFF) Brands() ([][4]byte, []string) { return nil, nil }
+
+type noStruct struct{ Parseable }
+
+func (ns noStruct) Structs() ([]string, []string) { return nil, nil }
+
+type noData struct{ Parseable }
+
+func (nd noData) Data() ([]string, []string) { return nil, nil }
+
 type noText struct{ Parseable }
 
 func (nt noText) Texts() []string { return nil }
@@ -541,6 +702,58 @@ type noPriority struct{ Parseable }
 
 func (np noPriority) Priorities() priority.Map { return nil }
 
+// Duplicates returns pairs of IDs that have byte-identical signatures, with no priority relationship between them.
+// Pairs are ordered by the first appearance of the signature, so the second ID is typically the one introduced by an extension.
+func Duplicates(p Parseable) [][2]string {
+	sigs, ids, err := p.Signatures()
+	if err != nil {
+		return nil
+	}
+	pmap := p.Priorities()
+	related := func(a, b string) bool {
+		if pmap == nil {
+			return false
+		}
+		return contains(pmap[a], b) || contains(pmap[b], a)
+	}
+	var ret [][2]string
+	seen := make(map[[2]string]bool)
+	idx := make(map[string][]int) // signatures indexed by their string form; confirmed with Equals
+	for i, sig := range sigs {
+		str := sig.String()
+		for _, j := range idx[str] {
+			pair := [2]string{ids[j], ids[i]}
+			if pair[0] == pair[1] || seen[pair] || seen[[2]string{pair[1], pair[0]}] || !sig.Equals(sigs[j]) {
+				continue
+			}
+			seen[pair] = true
+			if !related(pair[0], pair[1]) {
+				ret = append(ret, pair)
+			}
+		}
+		idx[str] = append(idx[str], i)
+	}
+	return ret
+}
+
+// linked adds priorities between duplicate signatures so that the later ID wins.
+type linked struct {
+	Parseable
+	dups [][2]string
+}
+
+func (l linked) Priorities() priority.Map {
+	m := make(priority.Map)
+	for k, v := range l.Parseable.Priorities() {
+		m[k] = append([]string(nil), v...)
+	}
+	for _, d := range l.dups {
+		m.Add(d[0], d[1])
+	}
+	m.Complete()
+	return m
+}
+
 // sorted sorts signatures by their index so that runs of signatures
 // e.g. fmt/1, fmt/1, fmt/2, fmt/1 can be properly placed.
 type sorted struct{ Parseable }
@@ -597,6 +810,21 @@ func ApplyConfig(p Parseable) Parseable {
 	if config.NoRIFF() {
 		p = noRIFF{p}
 	}
+	if config.NoMPEG() {
+		p = noMPEG{p}
+	}
+	if config.NoEBML() {
+		p = noEBML{p}
+	}
+	if config.NoBMFF() {
+		p = noBMFF{p}
+	}
+	if config.NoStruct() {
+		p = noStruct{p}
+	}
+	if config.NoData() {
+		p = noData{p}
+	}
 	if config.NoText() {
 		p = noText{p}
 	}
@@ -616,6 +844,15 @@ func ApplyConfig(p Parseable) Parseable {
 		}
 		p = Filter(ids, p)
 	}
+	// Report identical byte signatures that would give dual identifications and, if requested, link them with priorities.
+	if dups := Duplicates(p); len(dups) > 0 {
+		for _, d := range dups {
+			log.Printf("Roy: identical byte signatures for %s and %s will give dual identifications (use -linkdups to give %s priority)", d[0], d[1], d[1])
+		}
+		if config.LinkDuplicates() && !config.NoPriority() {
+			p = linked{p, dups}
+		}
+	}
 	// Sort Parseable so runs of signatures are contiguous.
 	p = sorted{p}
 	return p
@@ -159,6 +159,14 @@ func (lg *Logger) Error(p string, e error) {
 	}
 }
 
+// Warn logs a warning about a file that isn't attached to an identification (e.g. a problem with the file name).
+func (lg *Logger) Warn(p, w string) {
+	if lg.warn {
+		lg.fp = printFile(lg.fp, lg.w, p)
+		fmt.Fprintf(lg.w, "%s %s\n", warnString, w)
+	}
+}
+
 // IDs logs warnings, known, unknown and reports matches against supplied formats.
 func (lg *Logger) IDs(p string, ids []core.Identification) {
 	if !lg.warn && !lg.known && !lg.unknown && lg.fmts == nil && lg.cht == nil {
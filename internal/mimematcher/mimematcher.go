@@ -57,7 +57,13 @@ func Save(c core.Matcher, ls *persist.LoadSaver) {
 	}
 	m := c.(Matcher)
 	ls.SaveSmallInt(len(m))
-	for k, v := range m {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := m[k]
 		ls.SaveString(k)
 		ls.SaveSmallInt(len(v))
 		for _, w := range v {
@@ -139,6 +145,21 @@ func (m Matcher) Identify(s string, na *siegreader.Buffer, hints ...core.Hint) (
 	return res, nil
 }
 
+// MIMEs returns the MIME-types that map to a result index.
+func (m Matcher) MIMEs(idx int) []string {
+	var ret []string
+	for k, v := range m {
+		for _, w := range v {
+			if w == idx {
+				ret = append(ret, k)
+				break
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 // String representation of a MIMEMatcher
 func (m Matcher) String() string {
 	var str string
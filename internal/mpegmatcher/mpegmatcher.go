@@ -0,0 +1,281 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mpegmatcher identifies raw MPEG audio streams (e.g. MP3 files without an ID3 tag) by validating a run of successive frame headers.
+package mpegmatcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/priority"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// MPEG audio layers. A signature is a bitmask of the layers that identify a format.
+const (
+	LayerI byte = 1 << iota
+	LayerII
+	LayerIII
+)
+
+const (
+	frames   = 4     // number of successive, consistent frame headers needed for a match
+	maxStart = 65536 // furthest offset at which the first frame may start (after any ID3v2 tag)
+)
+
+type Matcher struct {
+	layers     []byte
+	priorities *priority.Set
+}
+
+func Load(ls *persist.LoadSaver) core.Matcher {
+	layers := ls.LoadBytes()
+	if len(layers) == 0 {
+		return nil
+	}
+	return &Matcher{
+		layers:     layers,
+		priorities: priority.Load(ls),
+	}
+}
+
+func Save(c core.Matcher, ls *persist.LoadSaver) {
+	if c == nil {
+		ls.SaveBytes(nil)
+		return
+	}
+	m := c.(*Matcher)
+	ls.SaveBytes(m.layers)
+	if len(m.layers) == 0 {
+		return
+	}
+	m.priorities.Save(ls)
+}
+
+type SignatureSet []byte
+
+func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, int, error) {
+	sigs, ok := ss.(SignatureSet)
+	if !ok {
+		return nil, -1, fmt.Errorf("MPEGmatcher: can't cast persist set")
+	}
+	if len(sigs) == 0 {
+		return c, 0, nil
+	}
+	var m *Matcher
+	if c == nil {
+		m = &Matcher{priorities: &priority.Set{}}
+	} else {
+		m = c.(*Matcher)
+	}
+	length := len(m.layers)
+	m.layers = append(m.layers, sigs...)
+	// add priorities
+	m.priorities.Add(p, len(sigs), 0, 0)
+	return m, length + len(sigs), nil
+}
+
+type result struct {
+	idx int
+	hdr header
+	off int64
+	n   int // number of frames validated
+}
+
+func (r result) Index() int {
+	return r.idx
+}
+
+func (r result) Basis() string {
+	return fmt.Sprintf("mpeg frame sync %s at %d (%d frames)", r.hdr, r.off, r.n)
+}
+
+func (m Matcher) Identify(na string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
+	res := make(chan core.Result)
+	off, hdr, n := scan(b)
+	if n == 0 {
+		close(res)
+		return res, nil
+	}
+	if config.Debug() {
+		fmt.Fprintf(config.Out(), "mpeg match %s at %d\n", hdr, off)
+	}
+	waitset := m.priorities.WaitSet(hints...)
+	go func() {
+		for i, l := range m.layers {
+			if l&hdr.layerBit() == 0 || !waitset.Check(i) {
+				continue
+			}
+			res <- result{i, hdr, off, n}
+			if waitset.Put(i) {
+				break
+			}
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
+func (m Matcher) String() string {
+	var layers []string
+	for _, l := range []byte{LayerI, LayerII, LayerIII} {
+		var n int
+		for _, v := range m.layers {
+			if v&l == l {
+				n++
+			}
+		}
+		layers = append(layers, fmt.Sprintf("%s: %d", layerNames[l], n))
+	}
+	return fmt.Sprintf("MPEG matcher: %s\n", strings.Join(layers, ", "))
+}
+
+var layerNames = map[byte]string{LayerI: "layer I", LayerII: "layer II", LayerIII: "layer III"}
+
+// scan looks for a run of consistent MPEG audio frames at the start of the buffer (or directly after an ID3v2 tag).
+// It returns the offset and header of the first frame, and the number of frames validated (zero if no match).
+func scan(b *siegreader.Buffer) (int64, header, int) {
+	buf, _ := b.Slice(0, 10)
+	if len(buf) < 4 {
+		return 0, header{}, 0
+	}
+	var off int64
+	// skip an ID3v2 tag; the size is a 28 bit "syncsafe" integer
+	if len(buf) == 10 && buf[0] == 'I' && buf[1] == 'D' && buf[2] == '3' {
+		off = 10 + (int64(buf[6]&0x7F)<<21 | int64(buf[7]&0x7F)<<14 | int64(buf[8]&0x7F)<<7 | int64(buf[9]&0x7F))
+		if buf[5]&0x10 == 0x10 { // footer present
+			off += 10
+		}
+		if off > maxStart {
+			return 0, header{}, 0
+		}
+	}
+	first, ok := readHeader(b, off)
+	if !ok {
+		return 0, header{}, 0
+	}
+	next := off + int64(first.length())
+	for i := 1; i < frames; i++ {
+		h, ok := readHeader(b, next)
+		if !ok {
+			// a short stream that ends cleanly on a frame boundary is still a match
+			if i > 1 && next == b.SizeNow() {
+				return off, first, i
+			}
+			return 0, header{}, 0
+		}
+		if !first.consistent(h) {
+			return 0, header{}, 0
+		}
+		next += int64(h.length())
+	}
+	return off, first, frames
+}
+
+func readHeader(b *siegreader.Buffer, off int64) (header, bool) {
+	buf, _ := b.Slice(off, 4)
+	if len(buf) < 4 {
+		return header{}, false
+	}
+	return parseHeader(buf)
+}
+
+// header is a parsed MPEG audio frame header
+type header struct {
+	version    byte // 0 = MPEG-2.5, 2 = MPEG-2, 3 = MPEG-1
+	layer      byte // 1-3
+	bitrate    int  // kbps
+	samplerate int  // Hz
+	padding    bool
+}
+
+var (
+	bitrates = map[[2]byte][15]int{
+		{3, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{3, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{3, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{2, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{2, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{2, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	samplerates = map[byte][3]int{
+		3: {44100, 48000, 32000},
+		2: {22050, 24000, 16000},
+		0: {11025, 12000, 8000},
+	}
+)
+
+func parseHeader(buf []byte) (header, bool) {
+	if buf[0] != 0xFF || buf[1]&0xE0 != 0xE0 {
+		return header{}, false
+	}
+	h := header{
+		version: (buf[1] >> 3) & 3,
+		layer:   4 - (buf[1]>>1)&3,
+		padding: (buf[2]>>1)&1 == 1,
+	}
+	if h.version == 1 || h.layer == 4 {
+		return header{}, false
+	}
+	bri, sri := buf[2]>>4, (buf[2]>>2)&3
+	if bri == 0 || bri == 15 || sri == 3 { // free format bitrates can't be validated
+		return header{}, false
+	}
+	bv := h.version
+	if bv == 0 {
+		bv = 2 // MPEG-2.5 shares the MPEG-2 bitrates
+	}
+	h.bitrate = bitrates[[2]byte{bv, h.layer}][bri]
+	h.samplerate = samplerates[h.version][sri]
+	return h, true
+}
+
+func (h header) length() int {
+	var pad int
+	if h.padding {
+		pad = 1
+	}
+	switch {
+	case h.layer == 1:
+		return (12*h.bitrate*1000/h.samplerate + pad) * 4
+	case h.layer == 3 && h.version != 3:
+		return 72*h.bitrate*1000/h.samplerate + pad
+	default:
+		return 144*h.bitrate*1000/h.samplerate + pad
+	}
+}
+
+// consistent reports whether two frame headers belong to the same stream
+func (h header) consistent(j header) bool {
+	return h.version == j.version && h.layer == j.layer && h.samplerate == j.samplerate
+}
+
+func (h header) layerBit() byte {
+	return 1 << (h.layer - 1)
+}
+
+func (h header) String() string {
+	v := "MPEG-1"
+	switch h.version {
+	case 2:
+		v = "MPEG-2"
+	case 0:
+		v = "MPEG-2.5"
+	}
+	return v + " " + layerNames[h.layerBit()]
+}
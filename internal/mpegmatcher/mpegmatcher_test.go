@@ -0,0 +1,106 @@
+package mpegmatcher
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var fmts = SignatureSet{LayerIII, LayerII, LayerI | LayerIII}
+
+var am core.Matcher
+
+func init() {
+	am, _, _ = Add(am, fmts, nil)
+}
+
+// MPEG-1 layer III, 128kbps, 44.1kHz, no padding: frames are 417 bytes
+var l3 = []byte{0xFF, 0xFB, 0x90, 0x00}
+
+func stream(hdr []byte, length, n int) []byte {
+	frame := make([]byte, length)
+	copy(frame, hdr)
+	return bytes.Repeat(frame, n)
+}
+
+func identify(t *testing.T, byt []byte) []int {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader(byt))
+	defer bufs.Put(b)
+	res, err := am.Identify("", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits []int
+	for h := range res {
+		hits = append(hits, h.Index())
+	}
+	return hits
+}
+
+func TestHeader(t *testing.T) {
+	h, ok := parseHeader(l3)
+	if !ok {
+		t.Fatal("expecting a valid header")
+	}
+	if h.String() != "MPEG-1 layer III" || h.bitrate != 128 || h.samplerate != 44100 {
+		t.Errorf("bad header, got %s %dkbps %dHz", h, h.bitrate, h.samplerate)
+	}
+	if h.length() != 417 {
+		t.Errorf("expecting frame length 417, got %d", h.length())
+	}
+	// MPEG-1 layer II, 192kbps, 48kHz, padded
+	h, _ = parseHeader([]byte{0xFF, 0xFD, 0xA6, 0x00})
+	if h.String() != "MPEG-1 layer II" || h.length() != 577 {
+		t.Errorf("bad layer II header, got %s with frame length %d", h, h.length())
+	}
+	for _, bad := range [][]byte{
+		{0xFF, 0xFB, 0xF0, 0x00}, // bad bitrate
+		{0xFF, 0xFB, 0x9C, 0x00}, // reserved samplerate
+		{0xFF, 0xF9, 0x90, 0x00}, // reserved layer
+		{0xFE, 0xFB, 0x90, 0x00}, // no sync
+	} {
+		if _, ok := parseHeader(bad); ok {
+			t.Errorf("expecting %x to be rejected", bad)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	hits := identify(t, stream(l3, 417, 10))
+	if len(hits) != 2 || hits[0] != 0 || hits[1] != 2 {
+		t.Errorf("expecting hits on layer III signatures 0 and 2, got %v", hits)
+	}
+	// a short stream ending on a frame boundary
+	if hits = identify(t, stream(l3, 417, 3)); len(hits) != 2 {
+		t.Errorf("expecting a short stream to match, got %v", hits)
+	}
+	// a single frame followed by junk
+	if hits = identify(t, append(stream(l3, 417, 1), make([]byte, 2000)...)); len(hits) != 0 {
+		t.Errorf("expecting no match for a single frame, got %v", hits)
+	}
+}
+
+func TestID3(t *testing.T) {
+	tag := make([]byte, 10+300)
+	copy(tag, []byte{'I', 'D', '3', 3, 0, 0, 0, 0, 0x02, 0x2C}) // syncsafe size of 300
+	hits := identify(t, append(tag, stream(l3, 417, 5)...))
+	if len(hits) != 2 {
+		t.Errorf("expecting frames after an ID3v2 tag to match, got %v", hits)
+	}
+}
+
+func TestIO(t *testing.T) {
+	str := am.String()
+	saver := persist.NewLoadSaver(nil)
+	Save(am, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	newam := Load(loader)
+	str2 := newam.String()
+	if str != str2 {
+		t.Errorf("Load mpeg matcher: expecting first matcher (%v), to equal second matcher (%v)", str, str2)
+	}
+}
@@ -73,7 +73,13 @@ func Save(c core.Matcher, ls *persist.LoadSaver) {
 	m := c.(*Matcher)
 	ls.SaveBool(true)
 	ls.SaveSmallInt(len(m.extensions))
-	for k, v := range m.extensions {
+	keys := make([]string, 0, len(m.extensions))
+	for k := range m.extensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys) // save in a fixed order so that identical builds produce identical signature files
+	for _, k := range keys {
+		v := m.extensions[k]
 		ls.SaveString(k)
 		ls.SaveSmallInt(len(v))
 		for _, w := range v {
@@ -205,6 +211,29 @@ func (m *Matcher) Identify(s string, na *siegreader.Buffer, hints ...core.Hint)
 	return res, nil
 }
 
+// Patterns returns the extension (e.g. *.doc) and glob signatures that map to a result index.
+func (m *Matcher) Patterns(idx int) []string {
+	var ret []string
+	for k, v := range m.extensions {
+		for _, w := range v {
+			if w == idx {
+				ret = append(ret, "*."+k)
+				break
+			}
+		}
+	}
+	sort.Strings(ret)
+	for i, v := range m.globIdx {
+		for _, w := range v {
+			if w == idx {
+				ret = append(ret, m.globs[i])
+				break
+			}
+		}
+	}
+	return ret
+}
+
 func (m *Matcher) String() string {
 	var str string
 	keys := make([]string, len(m.extensions))
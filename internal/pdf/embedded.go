@@ -0,0 +1,310 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	overlap = 64 << 10 // windows overlap so that object dictionaries aren't split
+	maxDict = 16 << 10 // object dictionaries longer than this are truncated
+)
+
+var (
+	object    = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	embedded  = regexp.MustCompile(`/Type\s*/EmbeddedFile\b`)
+	ef        = regexp.MustCompile(`/EF\s*<<([^>]*)>>`)
+	efRef     = regexp.MustCompile(`/U?F\s+(\d+)\s+\d+\s+R`)
+	fileName  = regexp.MustCompile(`/(UF|F)\s*([(<])`)
+	length    = regexp.MustCompile(`/Length\s+(\d+)(\s+\d+\s+R)?`)
+	filter    = regexp.MustCompile(`/Filter\s*\[?\s*/(\w+)`)
+	subtype   = regexp.MustCompile(`/Subtype\s*/([^\s/<>\[\]()]+)`)
+	params    = regexp.MustCompile(`/Params\s*<<([^>]*)>>`)
+	size      = regexp.MustCompile(`/Size\s+(\d+)`)
+	modDate   = regexp.MustCompile(`/ModDate\s*\(D:(\d{4,14})`)
+	integer   = regexp.MustCompile(`^\s*(\d+)`)
+	streamKey = regexp.MustCompile(`>>\s*stream(?:\r\n|\r|\n)`)
+	endobj    = []byte("endobj")
+)
+
+// File is a file embedded in a PDF: in its /EmbeddedFiles name tree (e.g. the payload of a PDF/A-3 invoice) or in a file attachment annotation.
+type File struct {
+	Name   string    // the filename given by its file specification, if found
+	MIME   string    // the /Subtype of the embedded file stream
+	Size   int64     // the size given in its /Params dictionary, or -1
+	Mod    time.Time // the modification date given in its /Params dictionary
+	filter string
+	off    int64 // offset of the stream data
+	length int64
+}
+
+// Supported reports whether the embedded file's data can be decoded: it is either unfiltered or compressed with /FlateDecode.
+func (f *File) Supported() bool {
+	return f.filter == "" || f.filter == "FlateDecode"
+}
+
+// Reader returns the decoded data of the embedded file, read from ra (the PDF).
+func (f *File) Reader(ra io.ReaderAt) (io.Reader, error) {
+	rdr := io.NewSectionReader(ra, f.off, f.length)
+	switch f.filter {
+	case "":
+		return rdr, nil
+	case "FlateDecode":
+		return zlib.NewReader(rdr)
+	}
+	return nil, fmt.Errorf("unsupported filter /%s", f.filter)
+}
+
+type pdfObject struct {
+	data int64  // offset of the stream data, if a stream object (otherwise -1)
+	dict []byte // the object's dictionary, or its content up to the endobj keyword
+}
+
+// EmbeddedFiles scans a PDF of size sz for embedded file streams (those of /Type /EmbeddedFile) and names them after the
+// file specifications that refer to them. Like Analyze, it scans raw bytes rather than parsing the object graph, so it finds
+// the files in the /EmbeddedFiles name tree and in file attachment annotations alike. File specifications held in compressed
+// object streams aren't seen and the files they refer to are returned without names. It also reports whether the PDF is encrypted,
+// in which case the embedded files can't be read.
+func EmbeddedFiles(ra io.ReaderAt, sz int64) ([]*File, bool, error) {
+	objs := make(map[int]pdfObject)
+	var nums []int // embedded file streams, in the order found
+	var encrypted bool
+	buf := make([]byte, window+overlap)
+	for off := int64(0); off < sz; off += window {
+		n, err := ra.ReadAt(buf, off)
+		if err != nil && err != io.EOF {
+			return nil, false, err
+		}
+		chunk := buf[:n]
+		if !encrypted {
+			encrypted = encrypt.Match(chunk)
+		}
+		for _, loc := range object.FindAllSubmatchIndex(chunk, -1) {
+			if loc[0] >= window && off+int64(n) < sz {
+				break // found again in the next window
+			}
+			num, _ := strconv.Atoi(string(chunk[loc[2]:loc[3]]))
+			dict := chunk[loc[1]:]
+			if len(dict) > maxDict {
+				dict = dict[:maxDict]
+			}
+			if i := bytes.Index(dict, endobj); i >= 0 {
+				dict = dict[:i]
+			}
+			data := int64(-1)
+			if m := streamKey.FindIndex(dict); m != nil {
+				data = off + int64(loc[1]+m[1])
+				dict = dict[:m[0]+2]
+			}
+			isFile := embedded.Match(dict)
+			// keep only the objects needed: embedded file streams, file specifications and the integers that may be their streams' lengths
+			if !isFile && !ef.Match(dict) && (len(dict) > 32 || !integer.Match(dict)) {
+				continue
+			}
+			if _, ok := objs[num]; !ok && isFile {
+				nums = append(nums, num)
+			}
+			objs[num] = pdfObject{data, append([]byte(nil), dict...)} // later objects replace earlier ones with the same number (incremental updates)
+		}
+	}
+	names := make(map[int]string)
+	for _, o := range objs {
+		if m := ef.FindSubmatchIndex(o.dict); m != nil {
+			if ref := efRef.FindSubmatch(o.dict[m[2]:m[3]]); ref != nil {
+				num, _ := strconv.Atoi(string(ref[1]))
+				names[num] = specName(append(append([]byte(nil), o.dict[:m[0]]...), o.dict[m[1]:]...))
+			}
+		}
+	}
+	files := make([]*File, 0, len(nums))
+	for _, num := range nums {
+		o := objs[num]
+		f := &File{Name: names[num], Size: -1}
+		if m := subtype.FindSubmatch(o.dict); m != nil {
+			f.MIME = unescapeName(m[1])
+		}
+		if m := filter.FindSubmatch(o.dict); m != nil {
+			f.filter = string(m[1])
+		}
+		if m := params.FindSubmatch(o.dict); m != nil {
+			if s := size.FindSubmatch(m[1]); s != nil {
+				f.Size, _ = strconv.ParseInt(string(s[1]), 10, 64)
+			}
+			if d := modDate.FindSubmatch(m[1]); d != nil {
+				f.Mod = parseDate(string(d[1]))
+			}
+		}
+		f.off, f.length = o.data, streamLength(o, objs)
+		if f.off >= 0 && f.length >= 0 {
+			files = append(files, f)
+		}
+	}
+	return files, encrypted, nil
+}
+
+// streamLength returns the length of the data of a stream object given by its dictionary (or -1 if it can't be found)
+func streamLength(o pdfObject, objs map[int]pdfObject) int64 {
+	m := length.FindSubmatch(o.dict)
+	if m == nil {
+		return -1
+	}
+	l, _ := strconv.ParseInt(string(m[1]), 10, 64)
+	if len(m[2]) > 0 { // an indirect reference to the length
+		ref, ok := objs[int(l)]
+		if !ok {
+			return -1
+		}
+		v := integer.FindSubmatch(ref.dict)
+		if v == nil {
+			return -1
+		}
+		l, _ = strconv.ParseInt(string(v[1]), 10, 64)
+	}
+	return l
+}
+
+// specName returns the filename in a file specification dictionary (with its /EF entry removed), preferring the unicode /UF entry
+func specName(dict []byte) string {
+	var name string
+	for _, m := range fileName.FindAllSubmatchIndex(dict, -1) {
+		s := parseString(dict[m[4]:])
+		if string(dict[m[2]:m[3]]) == "UF" && s != "" {
+			return s
+		}
+		if name == "" {
+			name = s
+		}
+	}
+	return name
+}
+
+// parseString decodes the literal (in parentheses) or hex (in angle brackets) string at the start of buf
+func parseString(buf []byte) string {
+	var byts []byte
+	if buf[0] == '<' {
+		end := bytes.IndexByte(buf, '>')
+		if end < 0 {
+			return ""
+		}
+		hex := bytes.Map(func(r rune) rune {
+			if r == ' ' || r == '\r' || r == '\n' || r == '\t' {
+				return -1
+			}
+			return r
+		}, buf[1:end])
+		if len(hex)%2 == 1 {
+			hex = append(hex, '0')
+		}
+		for i := 0; i < len(hex); i += 2 {
+			b, err := strconv.ParseUint(string(hex[i:i+2]), 16, 8)
+			if err != nil {
+				return ""
+			}
+			byts = append(byts, byte(b))
+		}
+	} else {
+		depth := 0
+	literal:
+		for i := 1; i < len(buf); i++ {
+			switch c := buf[i]; c {
+			case '\\':
+				i++
+				if i >= len(buf) {
+					break literal
+				}
+				switch c = buf[i]; c {
+				case 'n':
+					byts = append(byts, '\n')
+				case 'r':
+					byts = append(byts, '\r')
+				case 't':
+					byts = append(byts, '\t')
+				case 'b':
+					byts = append(byts, '\b')
+				case 'f':
+					byts = append(byts, '\f')
+				case '\r', '\n': // line continuation
+				default:
+					if c >= '0' && c <= '7' { // octal escape of up to three digits
+						j := i
+						for j < len(buf) && j < i+3 && buf[j] >= '0' && buf[j] <= '7' {
+							j++
+						}
+						b, _ := strconv.ParseUint(string(buf[i:j]), 8, 8)
+						byts, i = append(byts, byte(b)), j-1
+					} else {
+						byts = append(byts, c)
+					}
+				}
+			case '(':
+				depth++
+				byts = append(byts, c)
+			case ')':
+				if depth == 0 {
+					break literal
+				}
+				depth--
+				byts = append(byts, c)
+			default:
+				byts = append(byts, c)
+			}
+		}
+	}
+	if len(byts) >= 2 && byts[0] == 0xFE && byts[1] == 0xFF { // UTF-16BE, with a byte order mark
+		u := make([]uint16, (len(byts)-2)/2)
+		for i := range u {
+			u[i] = uint16(byts[2+i*2])<<8 | uint16(byts[3+i*2])
+		}
+		return string(utf16.Decode(u))
+	}
+	runes := make([]rune, len(byts)) // PDFDocEncoding, treated as Latin-1
+	for i, b := range byts {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// unescapeName decodes the #xx escapes in a PDF name e.g. text#2Fxml is text/xml
+func unescapeName(name []byte) string {
+	var ret []byte
+	for i := 0; i < len(name); i++ {
+		if name[i] == '#' && i+2 < len(name) {
+			if b, err := strconv.ParseUint(string(name[i+1:i+3]), 16, 8); err == nil {
+				ret, i = append(ret, byte(b)), i+2
+				continue
+			}
+		}
+		ret = append(ret, name[i])
+	}
+	return string(ret)
+}
+
+// parseDate parses the digits of a PDF date (D:YYYYMMDDHHmmSS), ignoring the time zone
+func parseDate(d string) time.Time {
+	layout := "20060102150405"
+	if len(d) < len(layout) {
+		layout = layout[:len(d)]
+	}
+	t, _ := time.Parse(layout, d)
+	return t
+}
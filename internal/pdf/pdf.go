@@ -0,0 +1,169 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pdf implements a light-weight, post-identification analyzer for PDF files.
+// It reports the declared header and catalog versions, whether the file is encrypted or linearized,
+// and any PDF/A conformance claimed in its XMP metadata.
+//
+// The analyzer scans raw bytes rather than parsing the object graph: for large files only the
+// first and last window of the file are examined, and values held in compressed object streams are not seen.
+// EmbeddedFiles finds the files embedded in a PDF (for sf -z -zpdf) in the same way, but scans the whole file.
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+const (
+	headerSz = 1024    // the %PDF- header may be preceded by up to 1024 bytes of junk
+	window   = 1 << 20 // size of the windows scanned at the start and end of large files
+)
+
+var (
+	header     = []byte("%PDF-")
+	version    = regexp.MustCompile(`^\d\.\d`)
+	encrypt    = regexp.MustCompile(`/Encrypt\s*(?:\d|<<)`)
+	linearized = regexp.MustCompile(`/Linearized\s*\d`)
+	catalog    = regexp.MustCompile(`/Type\s*/Catalog\b`)
+	catVersion = regexp.MustCompile(`/Version\s*/(\d\.\d)`)
+	part       = regexp.MustCompile(`pdfaid:part(?:\s*=\s*["']|>)\s*(\d)`)
+	conform    = regexp.MustCompile(`pdfaid:conformance(?:\s*=\s*["']|>)\s*([A-Za-z])`)
+)
+
+// Info describes the properties of a PDF reported by the analyzer.
+type Info struct {
+	Version        string // version declared in the %PDF- header
+	CatalogVersion string // version declared by the /Version entry in the document catalog, if any
+	Encrypted      bool   // trailer references an /Encrypt dictionary
+	Linearized     bool   // first object is a linearization parameter dictionary
+	PDFA           string // PDF/A part and conformance level claimed in XMP metadata e.g. "1b"
+}
+
+// Basis returns the analysis as a slice of strings suitable for an identification's basis field.
+func (i *Info) Basis() []string {
+	basis := make([]string, 0, 4)
+	if i.Version != "" {
+		basis = append(basis, "pdf header version "+i.Version)
+	}
+	if i.CatalogVersion != "" {
+		basis = append(basis, "pdf catalog version "+i.CatalogVersion)
+	}
+	if i.Linearized {
+		basis = append(basis, "pdf linearized")
+	}
+	if i.PDFA != "" {
+		basis = append(basis, "pdf claims PDF/A-"+i.PDFA+" conformance")
+	}
+	return basis
+}
+
+// Warn returns a warning for properties of the PDF that are likely to need attention (an empty warning if there are none).
+func (i *Info) Warn() core.Warning {
+	if !i.Encrypted {
+		return core.Warning{}
+	}
+	if i.PDFA != "" {
+		return core.Warning{Code: core.WarnEncrypted, Msg: "pdf is encrypted (encryption is not permitted by PDF/A)"}
+	}
+	return core.Warning{Code: core.WarnEncrypted, Msg: "pdf is encrypted"}
+}
+
+// Analyze inspects a buffer and returns an Info for it, or false if the buffer isn't a PDF.
+func Analyze(b *siegreader.Buffer) (*Info, bool) {
+	head, _ := b.Slice(0, window)
+	if len(head) == 0 {
+		return nil, false
+	}
+	info, ok := analyzeHead(head)
+	if !ok {
+		return nil, false
+	}
+	analyzeBody(info, head)
+	// for large files, also look at the end of the file where the trailer and (usually) the catalog are found
+	if b.SizeNow() > int64(len(head)) {
+		tail, _ := b.EofSlice(0, window)
+		analyzeBody(info, tail)
+	}
+	return info, true
+}
+
+// IsPDF reports whether a buffer begins with a %PDF- header (allowing for up to 1024 bytes of junk before it).
+func IsPDF(b *siegreader.Buffer) bool {
+	head, _ := b.Slice(0, headerSz+len(header))
+	_, ok := analyzeHead(head)
+	return ok
+}
+
+func analyzeHead(buf []byte) (*Info, bool) {
+	lim := headerSz + len(header)
+	if len(buf) < lim {
+		lim = len(buf)
+	}
+	idx := bytes.Index(buf[:lim], header)
+	if idx < 0 {
+		return nil, false
+	}
+	info := &Info{}
+	if v := version.Find(buf[idx+len(header):]); v != nil {
+		info.Version = string(v)
+	}
+	// the linearization dictionary must be the first indirect object in the file
+	first := buf[idx:]
+	if e := bytes.Index(first, []byte("endobj")); e > 0 {
+		info.Linearized = linearized.Match(first[:e])
+	}
+	return info, true
+}
+
+func analyzeBody(info *Info, buf []byte) {
+	if !info.Encrypted {
+		info.Encrypted = encrypt.Match(buf)
+	}
+	if info.CatalogVersion == "" {
+		info.CatalogVersion = findCatalogVersion(buf)
+	}
+	if info.PDFA == "" {
+		if p := part.FindSubmatch(buf); p != nil {
+			info.PDFA = string(p[1])
+			if c := conform.FindSubmatch(buf); c != nil {
+				info.PDFA += strings.ToLower(string(c[1]))
+			}
+		}
+	}
+}
+
+// findCatalogVersion looks for a /Version entry in the object containing each /Type /Catalog entry.
+func findCatalogVersion(buf []byte) string {
+	for _, loc := range catalog.FindAllIndex(buf, -1) {
+		start := bytes.LastIndex(buf[:loc[0]], []byte(" obj"))
+		if start < 0 {
+			start = 0
+		}
+		end := bytes.Index(buf[loc[1]:], []byte("endobj"))
+		if end < 0 {
+			end = len(buf)
+		} else {
+			end += loc[1]
+		}
+		if v := catVersion.FindSubmatch(buf[start:end]); v != nil {
+			return string(v[1])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+const (
+	linearizedPDF = "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n" +
+		"1 0 obj\n<< /Linearized 1 /L 7945 /H [ 482 140 ] /O 4 /E 7680 /N 1 /T 7750 >>\nendobj\n" +
+		"2 0 obj\n<< /Type /Catalog /Pages 3 0 R /Metadata 5 0 R /Version /1.7 >>\nendobj\n" +
+		"5 0 obj\n<< /Type /Metadata /Subtype /XML >>\nstream\n" +
+		`<rdf:Description rdf:about="" xmlns:pdfaid="http://www.aiim.org/pdfa/ns/id/" pdfaid:part="2" pdfaid:conformance="B"/>` +
+		"\nendstream\nendobj\ntrailer\n<< /Root 2 0 R /Size 6 >>\n%%EOF\n"
+	encryptedPDF = "%PDF-1.6\n" +
+		"1 0 obj\n<</Type/Catalog/Pages 2 0 R>>\nendobj\n" +
+		"trailer\n<</Root 1 0 R/Encrypt 9 0 R/Size 10>>\n%%EOF\n"
+)
+
+func analyzeString(s string) (*Info, bool) {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(strings.NewReader(s))
+	defer bufs.Put(b)
+	return Analyze(b)
+}
+
+func TestLinearized(t *testing.T) {
+	info, ok := analyzeString(linearizedPDF)
+	if !ok {
+		t.Fatal("expecting a PDF")
+	}
+	expect := Info{Version: "1.4", CatalogVersion: "1.7", Linearized: true, PDFA: "2b"}
+	if *info != expect {
+		t.Errorf("expecting %v, got %v", expect, *info)
+	}
+	if w := info.Warn(); w.Msg != "" {
+		t.Errorf("expecting no warning, got %s", w.Msg)
+	}
+	if basis := strings.Join(info.Basis(), "; "); basis != "pdf header version 1.4; pdf catalog version 1.7; pdf linearized; pdf claims PDF/A-2b conformance" {
+		t.Errorf("bad basis: %s", basis)
+	}
+}
+
+func TestEncrypted(t *testing.T) {
+	info, ok := analyzeString(encryptedPDF)
+	if !ok {
+		t.Fatal("expecting a PDF")
+	}
+	expect := Info{Version: "1.6", Encrypted: true}
+	if *info != expect {
+		t.Errorf("expecting %v, got %v", expect, *info)
+	}
+	if w := info.Warn(); w.Msg != "pdf is encrypted" || w.Code != core.WarnEncrypted {
+		t.Errorf("bad warning: %v", w)
+	}
+}
+
+func TestLarge(t *testing.T) {
+	// the trailer falls outside the first window
+	pad := bytes.Repeat([]byte("0"), window+100)
+	info, ok := analyzeString("%PDF-1.6\n" + string(pad) + encryptedPDF[9:])
+	if !ok {
+		t.Fatal("expecting a PDF")
+	}
+	if !info.Encrypted {
+		t.Error("expecting encryption to be detected at end of a large file")
+	}
+}
+
+func TestHeader(t *testing.T) {
+	if _, ok := analyzeString("PK\x03\x04"); ok {
+		t.Error("expecting a zip not to be analyzed as a PDF")
+	}
+	if _, ok := analyzeString("junk%PDF-1.4"); !ok {
+		t.Error("expecting a PDF header within the first 1024 bytes to be recognised")
+	}
+	if _, ok := analyzeString(strings.Repeat(" ", 2048) + "%PDF-1.4"); ok {
+		t.Error("expecting no PDF when the header is beyond the first 1024 bytes")
+	}
+}
+
+func TestEmbeddedFiles(t *testing.T) {
+	xml := `<?xml version="1.0"?><rsm:CrossIndustryInvoice/>`
+	z := &bytes.Buffer{}
+	zw := zlib.NewWriter(z)
+	zw.Write([]byte(xml))
+	zw.Close()
+	doc := "%PDF-1.7\n" +
+		"1 0 obj\n<< /Type /Catalog /Names << /EmbeddedFiles 2 0 R >> >>\nendobj\n" +
+		"2 0 obj\n<< /Names [ (factur-x.xml) 3 0 R ] >>\nendobj\n" +
+		"3 0 obj\n<< /Type /Filespec /F (factur-x.xml) /UF <FEFF0069006E0076006F006900630065002E0078006D006C> /EF << /F 4 0 R >> >>\nendobj\n" +
+		"4 0 obj\n<< /Type /EmbeddedFile /Subtype /text#2Fxml /Filter /FlateDecode /Length 5 0 R /Params << /ModDate (D:20200102030405Z) >> >>\nstream\n" +
+		z.String() + "\nendstream\nendobj\n" +
+		fmt.Sprintf("5 0 obj\n%d\nendobj\n", z.Len()) +
+		"6 0 obj\n<< /Type /EmbeddedFile /Subtype /application#2Foctet-stream /Filter /LZWDecode /Length 3 >>\nstream\nabc\nendstream\nendobj\n" +
+		"trailer\n<< /Root 1 0 R /Size 7 >>\n%%EOF\n"
+	files, encrypted, err := EmbeddedFiles(strings.NewReader(doc), int64(len(doc)))
+	if err != nil || encrypted || len(files) != 2 {
+		t.Fatalf("expecting two embedded files, got %v (encrypted %v, %v)", files, encrypted, err)
+	}
+	f := files[0]
+	if f.Name != "invoice.xml" || f.MIME != "text/xml" || !f.Mod.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)) || !f.Supported() {
+		t.Errorf("bad embedded file: %+v", f)
+	}
+	rdr, err := f.Reader(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byts, err := ioutil.ReadAll(rdr); err != nil || string(byts) != xml {
+		t.Errorf("expecting %s, got %s (%v)", xml, byts, err)
+	}
+	if files[1].Name != "" || files[1].MIME != "application/octet-stream" || files[1].Supported() {
+		t.Errorf("expecting an unnamed file with an unsupported filter, got %+v", files[1])
+	}
+}
@@ -0,0 +1,64 @@
+// +build go1.18
+
+package persist
+
+import (
+	"testing"
+	"time"
+)
+
+// FuzzLoadSaver loads values from arbitrary bytes, as when loading a corrupt or truncated signature file.
+// Each byte of ops chooses the next Load method. Loading must set Err rather than panic.
+// Run with `go test -fuzz FuzzLoadSaver ./internal/persist`.
+func FuzzLoadSaver(f *testing.F) {
+	saver := NewLoadSaver(nil)
+	saver.SaveByte(5)
+	saver.SaveBool(true)
+	saver.SaveSmallInt(-300)
+	saver.SaveInt(70000)
+	saver.SaveInts([]int{5, -1, 127, 0, -127})
+	saver.SaveBigInts([]int64{1 << 40, -1})
+	saver.SaveString("fmt/1")
+	saver.SaveStrings([]string{"a", "bc"})
+	saver.SaveTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	f.Add([]byte{0, 1, 3, 4, 5, 6, 7, 8, 9}, saver.Bytes())
+	f.Add([]byte{8, 8, 10, 11, 2}, []byte{})
+	f.Fuzz(func(t *testing.T, ops, data []byte) {
+		l := NewLoadSaver(data)
+		for _, op := range ops {
+			switch op % 14 {
+			case 0:
+				l.LoadByte()
+			case 1:
+				l.LoadBool()
+			case 2:
+				l.LoadBoolField()
+			case 3:
+				l.LoadSmallInt()
+			case 4:
+				l.LoadInt()
+			case 5:
+				l.LoadInts()
+			case 6:
+				l.LoadBigInts()
+			case 7:
+				l.LoadBytes()
+			case 8:
+				l.LoadString()
+			case 9:
+				l.LoadStrings()
+			case 10:
+				l.LoadTime()
+			case 11:
+				l.LoadFourCC()
+			case 12:
+				l.LoadTinyInt()
+			case 13:
+				l.LoadTinyUInt()
+			}
+			if l.Done() {
+				return
+			}
+		}
+	})
+}
@@ -42,10 +42,20 @@ func (l *LoadSaver) Bytes() []byte {
 	return l.buf[:l.i]
 }
 
+// Done reports whether all the data in a LoadSaver has been loaded.
+// Use it to load optional data appended to the end of a signature file.
+func (l *LoadSaver) Done() bool {
+	return l.Err != nil || l.i >= len(l.buf)
+}
+
 func (l *LoadSaver) get(i int) []byte {
 	if l.Err != nil || i == 0 {
 		return nil
 	}
+	if i < 0 {
+		l.Err = errors.New("error loading signature file, bad length")
+		return nil
+	}
 	if l.i+i > len(l.buf) {
 		l.Err = errors.New("error loading signature file, overflowed")
 		return nil
@@ -433,7 +443,10 @@ func (l *LoadSaver) SaveString(s string) {
 
 func (l *LoadSaver) LoadStrings() []string {
 	le := l.LoadSmallInt()
-	if le == 0 {
+	if le <= 0 {
+		if le < 0 {
+			l.Err = errors.New("error loading signature file, bad length")
+		}
 		return nil
 	}
 	ret := make([]string, le)
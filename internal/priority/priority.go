@@ -135,11 +135,17 @@ func (m Map) priorityWalk(k string) []string {
 
 // After adding all priorities, walk the priority map to make sure that it is consistent,
 // i.e. that for any format with a superior fmt, then anything superior
-// to that superior fmt is also marked as superior to the base fmt, all the way down the tree
+// to that superior fmt is also marked as superior to the base fmt, all the way down the tree.
+// The walks are all made before the map is updated, so that the result doesn't depend on the order of map iteration.
 func (m Map) Complete() {
+	walked := make(map[string][]string, len(m))
 	for k := range m {
-		extraPriorities := m.priorityWalk(k)
-		m[k] = append(m[k], extraPriorities...)
+		walked[k] = m.priorityWalk(k)
+	}
+	for k, extraPriorities := range walked {
+		for _, v := range extraPriorities {
+			m[k] = addStr(m[k], v)
+		}
 	}
 }
 
@@ -327,12 +333,27 @@ func (s *Set) Index(i int) (int, int) {
 	return -1, -1
 }
 
+// Superiors returns the indexes of the signatures that take priority over the signature at index i.
+func (s *Set) Superiors(i int) []int {
+	idx, prev := s.Index(i)
+	if idx < 0 || s.lists[idx] == nil {
+		return nil
+	}
+	l := s.list(idx, i-prev)
+	ret := make([]int, len(l))
+	for j, v := range l {
+		ret[j] = v + prev
+	}
+	return ret
+}
+
 // A wait set is a mutating structure that holds the set of indexes that should be waited for while matching underway
 type WaitSet struct {
 	*Set
 	wait  [][]int // a nil list means we're not waiting on anything yet; an empty list means nothing to wait for i.e. satisifed
 	this  []int   // record last hit so can avoid pivotting to weaker matches
 	pivot [][]int // a pivot list is a list of indexes that we could potentially pivot to. E.g. for a .pdf file that has mp3 signatures, but is actually a PDF
+	limit [][]int // if not nil, the only signatures that can be waited on in each priority list (see Limit)
 	m     *sync.RWMutex
 }
 
@@ -343,6 +364,7 @@ func (s *Set) WaitSet(hints ...core.Hint) *WaitSet {
 		make([][]int, len(s.lists)),
 		make([]int, len(s.lists)),
 		make([][]int, len(s.lists)),
+		nil,
 		&sync.RWMutex{},
 	}
 	for _, h := range hints {
@@ -356,6 +378,50 @@ func (s *Set) WaitSet(hints ...core.Hint) *WaitSet {
 	return ws
 }
 
+// Limit restricts the WaitSet to the signatures at the given (sorted) indexes: the others are treated as though they can't match,
+// even if they would take priority over a signature in the limit. Call Limit before matching starts.
+func (w *WaitSet) Limit(sigs []int) {
+	w.limit = make([][]int, len(w.lists))
+	for idx := range w.limit {
+		w.limit[idx] = []int{}
+	}
+	for _, v := range sigs {
+		if idx, prev := w.Index(v); idx >= 0 {
+			w.limit[idx] = append(w.limit[idx], v-prev)
+		}
+	}
+	for idx, l := range w.limit {
+		if w.wait[idx] == nil {
+			w.wait[idx] = l
+		} else {
+			w.wait[idx] = w.limited(idx, w.wait[idx])
+		}
+		if len(w.pivot[idx]) > 0 {
+			pivot := make([]int, 0, len(w.pivot[idx]))
+			for _, v := range w.pivot[idx] {
+				if _, prev := w.Index(v); len(w.limited(idx, []int{v - prev})) > 0 {
+					pivot = append(pivot, v)
+				}
+			}
+			w.pivot[idx] = pivot
+		}
+	}
+}
+
+// limited returns the members of a wait list (of indexes local to the priority list at idx) within the limit, if any.
+func (w *WaitSet) limited(idx int, l []int) []int {
+	if w.limit == nil || l == nil {
+		return l
+	}
+	ret := make([]int, 0, len(l))
+	for _, v := range l {
+		if j := sort.SearchInts(w.limit[idx], v); j < len(w.limit[idx]) && w.limit[idx][j] == v {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
 // MaxOffsets returns max/min offset info in order to override the max/min offsets set on the bytematcher when
 // any identifiers have been excluded.
 func (w *WaitSet) MaxOffsets() (int, int) {
@@ -389,7 +455,7 @@ func mightPivot(i int, ii []int) bool {
 // Set the priority list & return a boolean indicating whether the WaitSet is satisfied such that matching can stop (i.e. no priority list is nil, and all are empty)
 func (w *WaitSet) Put(i int) bool {
 	idx, prev := w.Index(i)
-	l := w.list(idx, i-prev)
+	l := w.limited(idx, w.list(idx, i-prev))
 	// no priorities for this set, return false immediately
 	if l == nil {
 		return false
@@ -427,7 +493,7 @@ func (w *WaitSet) Put(i int) bool {
 // Set the priority list & return a boolean indicating whether the WaitSet is satisfied such that matching can stop (i.e. no priority list is nil, and all are empty)
 func (w *WaitSet) PutAt(i int, bof, eof int64) bool {
 	idx, prev := w.Index(i)
-	l := w.list(idx, i-prev)
+	l := w.limited(idx, w.list(idx, i-prev))
 	// no priorities for this set, return false immediately
 	if l == nil && w.await(idx, bof, eof) {
 		return false
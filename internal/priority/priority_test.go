@@ -129,6 +129,33 @@ func TestSet(t *testing.T) {
 	}
 }
 
+func TestLimit(t *testing.T) {
+	m := make(Map)
+	m.Add("apple", "orange")
+	m.Add("orange", "banana")
+	m.Add("banana", "grapes")
+	m.Complete()
+	list := m.List([]string{"apple", "grapes", "banana"})
+	list2 := m.List([]string{"grapefruit", "banana"})
+	s := &Set{}
+	s.Add(list, len(list), -1, -1)
+	s.Add(list2, len(list2), -1, -1)
+	w := s.WaitSet()
+	w.Limit([]int{1, 3})
+	if !w.Check(1) || !w.Check(3) || w.Check(0) || w.Check(4) {
+		t.Error("Priority: expecting to wait only on the signatures in the limit")
+	}
+	if wo := w.WaitingOn(); len(wo) != 2 || wo[0] != 1 || wo[1] != 3 {
+		t.Errorf("Priority: expecting to be waiting on 1 and 3, got %v", wo)
+	}
+	if w.Put(1) {
+		t.Error("Priority: should not be satisfied until grapefruit is resolved")
+	}
+	if !w.Put(3) {
+		t.Error("Priority: should be satisfied once the limited signatures have matched")
+	}
+}
+
 func TestMapFilter(t *testing.T) {
 	m := make(Map)
 	m.Add("apple", "orange")
@@ -0,0 +1,128 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+const maxBoxes = 64 // top-level boxes read before giving up on finding the codestream
+
+var jp2Signature = []byte{0, 0, 0, 0x0C, 'j', 'P', ' ', ' ', 0x0D, 0x0A, 0x87, 0x0A}
+
+// JPEG 2000 profiles given by the Rsiz (capabilities) parameter of the SIZ marker segment
+var profiles = map[uint16]struct {
+	name string
+	kind string
+}{
+	1: {"Profile 0", JP2Profile0},
+	2: {"Profile 1", JP2Profile1},
+	3: {"2K digital cinema profile", JP2Profile3},
+	4: {"4K digital cinema profile", JP2Profile4},
+}
+
+// jp2 reads the top-level boxes of a JP2 file: the compatibility list of the file type box, whether there is a reader requirements box
+// and the capabilities (Rsiz) of the codestream. A file that claims JPX compatibility and has reader requirements, or a codestream
+// that uses Part 2 extensions, is JPX; otherwise the codestream's profile is reported.
+func jp2(b *siegreader.Buffer) ([]string, string) {
+	sig, _ := b.Slice(0, 12)
+	if !bytes.Equal(sig, jp2Signature) {
+		return nil, ""
+	}
+	var (
+		off        int64 = 12
+		jpx, rreq  bool
+		rsiz       uint16
+		codestream bool
+	)
+	for i := 0; i < maxBoxes && !codestream; i++ {
+		hdr, _ := b.Slice(off, 16)
+		if len(hdr) < 8 {
+			break
+		}
+		l, hl := int64(binary.BigEndian.Uint32(hdr)), int64(8)
+		if l == 1 { // extended length
+			if len(hdr) < 16 {
+				break
+			}
+			l, hl = int64(binary.BigEndian.Uint64(hdr[8:])), 16
+		}
+		switch string(hdr[4:8]) {
+		case "ftyp":
+			if l < 16 || l > 1024 {
+				return nil, ""
+			}
+			ftyp, _ := b.Slice(off+16, int(l-16))
+			for j := 0; j+4 <= len(ftyp); j += 4 {
+				if string(ftyp[j:j+4]) == "jpx " {
+					jpx = true
+				}
+			}
+		case "rreq":
+			rreq = true
+		case "jp2c":
+			siz, _ := b.Slice(off+hl, 8)
+			if len(siz) < 8 || siz[0] != 0xFF || siz[1] != 0x4F || siz[2] != 0xFF || siz[3] != 0x51 { // SOC then SIZ markers
+				return nil, ""
+			}
+			rsiz, codestream = binary.BigEndian.Uint16(siz[6:]), true
+		}
+		if l == 0 { // the last box runs to the end of the file
+			break
+		}
+		if l < hl {
+			return nil, ""
+		}
+		off += l
+	}
+	var (
+		kinds []string
+		parts []string
+	)
+	if jpx {
+		parts = append(parts, "compatible with jpx")
+	}
+	if rreq {
+		parts = append(parts, "reader requirements box")
+	}
+	if codestream {
+		desc := "no restrictions"
+		if rsiz&0x8000 != 0 {
+			desc = "Part 2 extensions"
+		} else if p, ok := profiles[rsiz]; ok {
+			desc = p.name
+		} else if rsiz != 0 {
+			desc = fmt.Sprintf("0x%04x", rsiz)
+		}
+		parts = append(parts, fmt.Sprintf("codestream Rsiz %d (%s)", rsiz, desc))
+	}
+	switch {
+	case (jpx && rreq) || rsiz&0x8000 != 0:
+		kinds = append(kinds, JPX)
+	case codestream:
+		if p, ok := profiles[rsiz]; ok {
+			kinds = append(kinds, p.kind)
+		}
+	}
+	if len(kinds) == 0 {
+		return nil, ""
+	}
+	return kinds, "JP2 structure: " + strings.Join(parts, "; ")
+}
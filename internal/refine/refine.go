@@ -0,0 +1,69 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package refine parses a little of the structure of files identified as TIFF or JP2, after matching, to find the more specific
+// formats (e.g. GeoTIFF, BigTIFF, JPX or a JPEG 2000 profile) that identifications can be upgraded to.
+package refine
+
+import "github.com/richardlehane/siegfried/internal/siegreader"
+
+// The families of formats that can be refined.
+const (
+	TIFF = "tiff"
+	JP2  = "jp2"
+)
+
+// The kinds of more specific format that refinement finds.
+const (
+	BigTIFF     = "bigtiff"
+	GeoTIFF     = "geotiff"
+	TIFFEP      = "tiff-ep" // TIFF/EP
+	DNG         = "dng"     // Adobe Digital Negative; the DNG version, if given, is a more specific kind e.g. "dng-1.4"
+	JPX         = "jpx"     // a JP2 file that uses JPEG 2000 Part 2 extensions
+	JP2Profile0 = "jp2-profile0"
+	JP2Profile1 = "jp2-profile1"
+	JP2Profile3 = "jp2-profile3" // 2K digital cinema
+	JP2Profile4 = "jp2-profile4" // 4K digital cinema
+)
+
+// parser returns the kinds of more specific format found in a file (most specific first) and a basis describing its structure.
+type parser func(*siegreader.Buffer) ([]string, string)
+
+var parsers = map[string]parser{
+	TIFF: tiff,
+	JP2:  jp2,
+}
+
+type result struct {
+	kinds []string
+	basis string
+}
+
+// New returns a function that parses the file in the buffer as a family of formats (e.g. "tiff"), returning the kinds of more specific
+// format found (most specific first, e.g. "geotiff") and a basis describing the structure. Nothing is returned if the file has no
+// more specific kind. Results are cached, so each family is parsed once for a file however many identifiers refine it.
+func New(b *siegreader.Buffer) func(string) ([]string, string) {
+	cache := make(map[string]result)
+	return func(family string) ([]string, string) {
+		if r, ok := cache[family]; ok {
+			return r.kinds, r.basis
+		}
+		var r result
+		if p, ok := parsers[family]; ok {
+			r.kinds, r.basis = p(b)
+		}
+		cache[family] = r
+		return r.kinds, r.basis
+	}
+}
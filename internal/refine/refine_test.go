@@ -0,0 +1,100 @@
+package refine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+type entry struct {
+	tag, typ uint16
+	value    []byte
+}
+
+// mkTIFF makes a little-endian TIFF (or BigTIFF) with a single IFD.
+func mkTIFF(big bool, entries ...entry) []byte {
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+	if big {
+		buf.Write([]byte{'I', 'I', 43, 0, 8, 0, 0, 0})
+		binary.Write(&buf, le, uint64(16))
+		binary.Write(&buf, le, uint64(len(entries)))
+	} else {
+		buf.Write([]byte{'I', 'I', 42, 0, 8, 0, 0, 0})
+		binary.Write(&buf, le, uint16(len(entries)))
+	}
+	for _, e := range entries {
+		binary.Write(&buf, le, e.tag)
+		binary.Write(&buf, le, e.typ)
+		val := make([]byte, 4)
+		if big {
+			binary.Write(&buf, le, uint64(len(e.value)))
+			val = make([]byte, 8)
+		} else {
+			binary.Write(&buf, le, uint32(len(e.value)))
+		}
+		copy(val, e.value)
+		buf.Write(val)
+	}
+	buf.Write(make([]byte, 8)) // no next IFD
+	return buf.Bytes()
+}
+
+func box(typ string, data []byte) []byte {
+	b := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(b, uint32(8+len(data)))
+	copy(b[4:], typ)
+	return append(b, data...)
+}
+
+// mkJP2 makes a JP2 with a file type box, an optional reader requirements box and a codestream with the given Rsiz.
+func mkJP2(compat string, rreq bool, rsiz uint16) []byte {
+	b := append([]byte{}, jp2Signature...)
+	b = append(b, box("ftyp", []byte("jp2 \x00\x00\x00\x00"+compat))...)
+	if rreq {
+		b = append(b, box("rreq", []byte{1, 0x80, 0x80, 0, 0})...)
+	}
+	b = append(b, box("jp2h", make([]byte, 22))...)
+	return append(b, box("jp2c", []byte{0xFF, 0x4F, 0xFF, 0x51, 0, 41, byte(rsiz >> 8), byte(rsiz)})...)
+}
+
+var tests = []struct {
+	name   string
+	family string
+	file   []byte
+	kinds  []string
+	basis  string
+}{
+	{"tiff", TIFF, mkTIFF(false, entry{256, 3, []byte{1, 0}}, entry{257, 3, []byte{1, 0}}), nil, ""},
+	{"geotiff", TIFF, mkTIFF(false, entry{256, 3, []byte{1, 0}}, entry{33550, 12, make([]byte, 24)}, entry{34735, 3, make([]byte, 32)}), []string{GeoTIFF}, "TIFF structure: GeoKeyDirectory tag"},
+	{"bigGeotiff", TIFF, mkTIFF(true, entry{256, 3, []byte{1, 0}}, entry{34735, 3, make([]byte, 32)}), []string{GeoTIFF, BigTIFF}, "TIFF structure: BigTIFF header; GeoKeyDirectory tag"},
+	{"bigtiff", TIFF, mkTIFF(true, entry{256, 3, []byte{1, 0}}), []string{BigTIFF}, "TIFF structure: BigTIFF header"},
+	{"dng", TIFF, mkTIFF(false, entry{37398, 1, []byte{1, 0, 0, 0}}, entry{50706, 1, []byte{1, 4, 0, 0}}), []string{"dng-1.4", DNG, TIFFEP}, "TIFF structure: DNGVersion tag (1.4); TIFF/EPStandardID tag"},
+	{"badType", TIFF, mkTIFF(false, entry{34735, 99, nil}), nil, ""},
+	{"notTIFF", TIFF, []byte("II*\x00\xFF\xFF\xFF\xFF"), nil, ""},
+	{"jp2", JP2, mkJP2("jp2 ", false, 0), nil, ""},
+	{"profile1", JP2, mkJP2("jp2 ", false, 2), []string{JP2Profile1}, "JP2 structure: codestream Rsiz 2 (Profile 1)"},
+	{"cinema", JP2, mkJP2("jp2 ", false, 4), []string{JP2Profile4}, "JP2 structure: codestream Rsiz 4 (4K digital cinema profile)"},
+	{"jpx", JP2, mkJP2("jp2 jpx ", true, 0), []string{JPX}, "JP2 structure: compatible with jpx; reader requirements box; codestream Rsiz 0 (no restrictions)"},
+	{"part2", JP2, mkJP2("jp2 ", false, 0x8000), []string{JPX}, "JP2 structure: codestream Rsiz 32768 (Part 2 extensions)"},
+	{"unknownFamily", "png", mkJP2("jp2 ", false, 2), nil, ""},
+}
+
+func TestRefine(t *testing.T) {
+	bufs := siegreader.New()
+	for _, tt := range tests {
+		b, _ := bufs.Get(bytes.NewReader(tt.file))
+		parse := New(b)
+		kinds, basis := parse(tt.family)
+		if !reflect.DeepEqual(kinds, tt.kinds) || basis != tt.basis {
+			t.Errorf("%s: expecting %v (%s), got %v (%s)", tt.name, tt.kinds, tt.basis, kinds, basis)
+		}
+		if k, _ := parse(tt.family); !reflect.DeepEqual(k, kinds) {
+			t.Errorf("%s: expecting the cached result %v, got %v", tt.name, kinds, k)
+		}
+		bufs.Put(b)
+	}
+}
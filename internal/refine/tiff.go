@@ -0,0 +1,135 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+const (
+	maxEntries = 4096 // IFDs with more entries than this aren't parsed
+	dngVersion = 50706
+)
+
+// tags in the first IFD that mark a more specific format, most specific first
+var tiffTags = []struct {
+	tag  uint16
+	name string
+	kind string
+}{
+	{dngVersion, "DNGVersion", DNG},
+	{34735, "GeoKeyDirectory", GeoTIFF},
+	{37398, "TIFF/EPStandardID", TIFFEP},
+}
+
+// tiff reads the header and the tags in the first IFD of a TIFF or BigTIFF file.
+func tiff(b *siegreader.Buffer) ([]string, string) {
+	hdr, _ := b.Slice(0, 16)
+	if len(hdr) < 8 {
+		return nil, ""
+	}
+	var order binary.ByteOrder
+	switch string(hdr[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, ""
+	}
+	var (
+		big                         bool
+		ifd                         uint64
+		countSize, entSize, valueAt = 2, 12, 8
+	)
+	switch order.Uint16(hdr[2:]) {
+	case 42:
+		ifd = uint64(order.Uint32(hdr[4:]))
+	case 43: // BigTIFF has an offset bytesize of 8, a reserved 0 and a 64 bit offset to the first IFD
+		if len(hdr) < 16 || order.Uint16(hdr[4:]) != 8 || order.Uint16(hdr[6:]) != 0 {
+			return nil, ""
+		}
+		big, ifd = true, order.Uint64(hdr[8:])
+		countSize, entSize, valueAt = 8, 20, 12
+	default:
+		return nil, ""
+	}
+	if ifd < 8 || ifd > 1<<62 {
+		return nil, ""
+	}
+	buf, _ := b.Slice(int64(ifd), countSize)
+	if len(buf) < countSize {
+		return nil, ""
+	}
+	var n int
+	if big {
+		if c := order.Uint64(buf); c <= maxEntries {
+			n = int(c)
+		}
+	} else {
+		n = int(order.Uint16(buf))
+	}
+	if n == 0 || n > maxEntries {
+		return nil, ""
+	}
+	entries, _ := b.Slice(int64(ifd)+int64(countSize), n*entSize)
+	if len(entries) < n*entSize {
+		return nil, ""
+	}
+	var (
+		found   = make(map[uint16]bool)
+		version string
+	)
+	for i := 0; i < n; i++ {
+		e := entries[i*entSize:]
+		typ := order.Uint16(e[2:])
+		if typ == 0 || typ > 18 { // field types run from BYTE (1) to IFD8 (18)
+			return nil, ""
+		}
+		tag := order.Uint16(e)
+		found[tag] = true
+		if tag == dngVersion && typ == 1 { // the four bytes of the version fit in the entry's value
+			v := e[valueAt:]
+			version = fmt.Sprintf("%d.%d", v[0], v[1])
+		}
+	}
+	var (
+		kinds []string
+		parts []string
+	)
+	for _, t := range tiffTags {
+		if found[t.tag] {
+			if t.tag == dngVersion && version != "" {
+				kinds = append(kinds, DNG+"-"+version)
+				parts = append(parts, t.name+" tag ("+version+")")
+			} else {
+				parts = append(parts, t.name+" tag")
+			}
+			kinds = append(kinds, t.kind)
+		}
+	}
+	if big {
+		kinds = append(kinds, BigTIFF)
+		parts = append([]string{"BigTIFF header"}, parts...)
+	}
+	if len(kinds) == 0 {
+		return nil, ""
+	}
+	return kinds, "TIFF structure: " + strings.Join(parts, "; ")
+}
@@ -63,7 +63,13 @@ func Save(c core.Matcher, ls *persist.LoadSaver) {
 	if len(m.riffs) == 0 {
 		return
 	}
-	for k, v := range m.riffs {
+	keys := make([]riff.FourCC, 0, len(m.riffs))
+	for k := range m.riffs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return string(keys[i][:]) < string(keys[j][:]) })
+	for _, k := range keys {
+		v := m.riffs[k]
 		ls.SaveFourCC(k)
 		ls.SaveSmallInt(len(v))
 		for _, w := range v {
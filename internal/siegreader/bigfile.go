@@ -14,13 +14,19 @@
 
 package siegreader
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
 
 // bigfile handles files that are too large to mmap (normally encountered on 32-bit machines)
 type bigfile struct {
 	*file
-	eof   [eofSz]byte
-	wheel [wheelSz]byte
+	eof     [eofSz]byte
+	eofRead bool // the eof slice is filled on first use, so files are only read at EOF if a matcher needs it
+	wheel   [wheelSz]byte
 
 	mu                   sync.Mutex
 	i                    int   // wheel offset for next write
@@ -35,14 +41,25 @@ func (bf *bigfile) setSource(f *file) {
 	bf.file = f
 	// reset
 	bf.i = 0
+	bf.start, bf.end = 0, 0
 	bf.progress = int64(initialRead)
-	// fill the EOF slice
-	bf.src.ReadAt(bf.eof[:], bf.sz-int64(eofSz))
+	bf.eofRead = false
+}
+
+// tail returns the EOF slice, filling it if this is its first use. Call with bf.mu held.
+func (bf *bigfile) tail() []byte {
+	if !bf.eofRead {
+		bf.eofRead = true
+		limit(eofSz)
+		bf.src.ReadAt(bf.eof[:], bf.sz-int64(eofSz))
+	}
+	return bf.eof[:]
 }
 
 func (bf *bigfile) progressSlice(o int64) []byte {
 	if bf.i == 0 {
 		bf.start = o
+		limit(wheelSz)
 		i, _ := bf.src.Read(bf.wheel[:])
 		bf.end = bf.start + int64(i)
 		if i < readSz {
@@ -58,13 +75,16 @@ func (bf *bigfile) progressSlice(o int64) []byte {
 }
 
 func (bf *bigfile) slice(o int64, l int) []byte {
+	if config.Bench() {
+		defer filled(time.Now())
+	}
+	bf.mu.Lock()
+	defer bf.mu.Unlock()
 	// if within the eof, return from there
 	if bf.sz-o <= int64(eofSz) {
 		x := eofSz - int(bf.sz-o)
-		return bf.eof[x : x+l] // (l is safe because read lengths already confirmed as legal)
+		return bf.tail()[x : x+l] // (l is safe because read lengths already confirmed as legal)
 	}
-	bf.mu.Lock()
-	defer bf.mu.Unlock()
 	if l == readSz && bf.progress == o { // if adjacent to last progress read and right length, assume this is a progress read
 		bf.progress += int64(readSz)
 		return bf.progressSlice(o)
@@ -76,17 +96,22 @@ func (bf *bigfile) slice(o int64, l int) []byte {
 		return ret
 	}
 	// otherwise we just expose the underlying reader at
+	limit(l)
 	bf.src.ReadAt(ret, o)
 	return ret
 }
 
 func (bf *bigfile) eofSlice(o int64, l int) []byte {
+	if config.Bench() {
+		defer filled(time.Now())
+	}
+	bf.mu.Lock()
+	defer bf.mu.Unlock()
 	if o+int64(l) > int64(eofSz) {
 		ret := make([]byte, l)
-		bf.mu.Lock()
-		defer bf.mu.Unlock()
+		limit(l)
 		bf.src.ReadAt(ret, bf.sz-o-int64(l))
 		return ret
 	}
-	return bf.eof[eofSz-int(o)-l : eofSz-int(o)]
+	return bf.tail()[eofSz-int(o)-l : eofSz-int(o)]
 }
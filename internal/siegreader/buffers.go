@@ -17,8 +17,26 @@ package siegreader
 import (
 	"io"
 	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
 )
 
+// the time spent reading into buffers (in nanoseconds), recorded if config.Bench is set
+var filling int64
+
+// FillTime reports the time spent reading files and streams into buffers, if the config.SetBench option is set.
+// Memory mapped files are read as their pages are touched by the matchers, so this time only includes their first reads.
+func FillTime() time.Duration {
+	return time.Duration(atomic.LoadInt64(&filling))
+}
+
+// filled records the time spent on a read since start; call it as a deferred function
+func filled(start time.Time) {
+	atomic.AddInt64(&filling, int64(time.Since(start)))
+}
+
 // Buffers is a combined pool of stream, external and file buffers
 type Buffers struct {
 	spool *pool // Pool of stream Buffers
@@ -38,10 +56,19 @@ func New() *Buffers {
 			newPool(newBigFile),
 			newPool(newSmallFile),
 			newPool(newMmap),
+			newPool(newMemFile),
 		},
 	}
 }
 
+// SetSize limits the number of idle buffers of each type kept for re-use (0 is no limit).
+// Buffers returned with Put beyond this limit are left for the garbage collector.
+func (b *Buffers) SetSize(n int) {
+	for _, p := range []*pool{b.spool, b.fpool, b.epool, b.fdatas.bfpool, b.fdatas.sfpool, b.fdatas.mpool, b.fdatas.mempool} {
+		p.setMax(n)
+	}
+}
+
 // Get returns a Buffer reading from the provided io.Reader.
 // Get returns a Buffer backed by a stream, external or file
 // source buffer depending on the type of reader.
@@ -89,20 +116,44 @@ func (b *Buffers) Put(i *Buffer) {
 }
 
 // data pool (used by file)
-// pool of big files, small files, and mmap files
+// pool of big files, small files, mmap files and files read into memory
 type datas struct {
-	bfpool *pool
-	sfpool *pool
-	mpool  *pool
+	bfpool  *pool
+	sfpool  *pool
+	mpool   *pool
+	mempool *pool
 }
 
+// Strategies lists the ways files can be read (see config.SetBuffering).
+var Strategies = []string{"mmap", "stream", "memory"}
+
 func (d *datas) get(f *file) data {
-	if mmapable(f.sz) {
-		m := d.mpool.get().(*mmap)
-		if err := m.setSource(f); err == nil {
-			return m
+	if config.Bench() {
+		defer filled(time.Now())
+	}
+	max := config.MaxMapped()
+	strategy := config.Buffering()
+	if rate, ops := config.ReadLimit(); strategy == "mmap" && (rate > 0 || ops > 0) {
+		strategy = "stream" // page faults can't be metered
+	}
+	switch strategy {
+	case "stream":
+	case "memory":
+		if f.sz > int64(smallFileSz) && (max <= 0 || f.sz <= max) {
+			m := d.mempool.get().(*memfile)
+			if err := m.setSource(f); err == nil {
+				return m
+			}
+			d.mempool.put(m) // replace on error and get big file instead
+		}
+	default:
+		if mmapable(f.sz) && (max <= 0 || f.sz <= max) {
+			m := d.mpool.get().(*mmap)
+			if err := m.setSource(f); err == nil {
+				return m
+			}
+			d.mpool.put(m) // replace on error and get big file instead
 		}
-		d.mpool.put(m) // replace on error and get big file instead
 	}
 	if f.sz <= int64(smallFileSz) {
 		sf := d.sfpool.get().(*smallfile)
@@ -128,6 +179,11 @@ func (d *datas) put(i data) {
 	case *mmap:
 		v.reset()
 		d.mpool.put(v)
+	case *memfile:
+		if cap(v.buf) > streamSz {
+			v.buf = nil // don't keep very large buffers
+		}
+		d.mempool.put(v)
 	}
 	return
 }
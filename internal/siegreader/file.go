@@ -4,6 +4,9 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
 )
 
 type file struct {
@@ -24,6 +27,9 @@ type data interface {
 }
 
 func (f *file) setSource(src *os.File, p *datas) error {
+	if config.Bench() {
+		defer filled(time.Now())
+	}
 	// reset
 	f.once = &sync.Once{}
 	f.data = nil
@@ -34,6 +40,7 @@ func (f *file) setSource(src *os.File, p *datas) error {
 		return err
 	}
 	f.sz = info.Size()
+	limit(initialRead)
 	i, err := f.src.Read(f.peek[:])
 	if i < initialRead && (err == nil || err == io.EOF) {
 		if i == 0 {
@@ -0,0 +1,72 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegreader
+
+import (
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// bucket is a token bucket that holds up to a second's worth of tokens.
+// Takes larger than the tokens available are allowed, leaving the bucket in debt, so big reads wait in proportion to their size.
+type bucket struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// take removes n tokens from a bucket refilled at rate tokens per second, and returns how long to wait before using them
+func (b *bucket) take(n, rate float64) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	if b.last.IsZero() {
+		b.tokens = rate
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * rate
+		if b.tokens > rate {
+			b.tokens = rate
+		}
+	}
+	b.last = now
+	b.tokens -= n
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / rate * float64(time.Second))
+}
+
+// global buckets, shared by all buffers, for the bytes and the number of reads per second set with config.SetReadLimit
+var rateBucket, opsBucket bucket
+
+// limit waits, if a read limit is set, until n bytes can be read.
+// Call it before each read from a file or stream into a buffer.
+func limit(n int) {
+	rate, ops := config.ReadLimit()
+	var wait time.Duration
+	if rate > 0 {
+		wait = rateBucket.take(float64(n), float64(rate))
+	}
+	if ops > 0 {
+		if w := opsBucket.take(1, float64(ops)); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
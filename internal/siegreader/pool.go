@@ -21,6 +21,8 @@ type pool struct {
 	mu   *sync.Mutex
 	fn   func() interface{}
 	head *item
+	n    int // length of the free list
+	max  int // maximum length of the free list (0 is no limit)
 }
 
 type item struct {
@@ -43,11 +45,26 @@ func (p *pool) get() interface{} {
 	}
 	ret := p.head.val
 	p.head = p.head.next
+	p.n--
 	return ret
 }
 
 func (p *pool) put(v interface{}) {
 	p.mu.Lock()
-	p.head = &item{p.head, v}
+	if p.max <= 0 || p.n < p.max {
+		p.head = &item{p.head, v}
+		p.n++
+	}
+	p.mu.Unlock()
+}
+
+// setMax limits the length of the free list, dropping any items beyond it
+func (p *pool) setMax(max int) {
+	p.mu.Lock()
+	p.max = max
+	for max > 0 && p.n > max {
+		p.head = p.head.next
+		p.n--
+	}
 	p.mu.Unlock()
 }
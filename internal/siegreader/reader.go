@@ -65,6 +65,8 @@ func (r *Reader) ReadByte() (byte, error) {
 		select {
 		case <-r.Quit:
 			return 0, io.EOF
+		case <-r.stop:
+			return 0, io.EOF
 		default:
 		}
 		err := r.setBuf(r.i)
@@ -207,6 +209,8 @@ func (r *ReverseReader) ReadByte() (byte, error) {
 		select {
 		case <-r.Quit:
 			return 0, io.EOF
+		case <-r.stop:
+			return 0, io.EOF
 		default:
 		}
 		err := r.setBuf(r.i)
@@ -0,0 +1,210 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegreader
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+const (
+	remoteBlock  = 256 * 1024 // size of each ranged GET
+	remoteBlocks = 64         // number of blocks cached (16MB)
+)
+
+// Remote reads an HTTP(S) resource with ranged GETs, so that identification fetches just the parts of the resource
+// that matchers read (normally a little from the beginning and the end) rather than downloading the whole thing.
+// If the server doesn't support range requests, the response body is read as a stream.
+type Remote struct {
+	client *http.Client
+	url    string
+	size   int64
+	mod    time.Time
+	body   io.ReadCloser // set if the server doesn't support ranges
+
+	mu     sync.Mutex
+	blocks map[int64][]byte
+	order  []int64 // blocks in the order fetched, for eviction
+}
+
+// OpenURL requests the first block of a resource. If client is nil, http.DefaultClient is used.
+// Close the Remote when done.
+func OpenURL(client *http.Client, url string) (*Remote, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r := &Remote{client: client, url: url, blocks: make(map[int64][]byte)}
+	resp, err := r.get(0)
+	if err != nil {
+		return nil, err
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		r.mod = t
+	}
+	if resp.StatusCode == http.StatusOK { // ranges not supported
+		r.body, r.size = resp.Body, resp.ContentLength
+		return r, nil
+	}
+	defer resp.Body.Close()
+	if r.size, err = totalSize(resp.Header.Get("Content-Range")); err != nil {
+		return nil, err
+	}
+	buf, err := readBlock(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.keep(0, buf)
+	return r, nil
+}
+
+// totalSize parses the complete length from a Content-Range header like "bytes 0-1023/146515"
+func totalSize(cr string) (int64, error) {
+	i := strings.LastIndex(cr, "/")
+	if i < 0 {
+		return 0, fmt.Errorf("siegreader: bad Content-Range header %q", cr)
+	}
+	sz, err := strconv.ParseInt(cr[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("siegreader: unknown resource length in Content-Range header %q", cr)
+	}
+	return sz, nil
+}
+
+func readBlock(r io.Reader) ([]byte, error) {
+	buf := make([]byte, remoteBlock)
+	n, err := io.ReadFull(r, buf)
+	if err == io.ErrUnexpectedEOF || err == io.EOF {
+		err = nil
+	}
+	return buf[:n], err
+}
+
+func (r *Remote) get(block int64) (*http.Response, error) {
+	req, err := http.NewRequest("GET", r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", config.UserAgent())
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", block*remoteBlock, (block+1)*remoteBlock-1))
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp, nil
+	case http.StatusRequestedRangeNotSatisfiable: // e.g. an empty resource
+		resp.Body.Close()
+		if block == 0 {
+			return &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{"Content-Range": {"bytes */0"}}, Body: http.NoBody}, nil
+		}
+		return nil, io.EOF
+	}
+	resp.Body.Close()
+	return nil, fmt.Errorf("siegreader: error requesting %s, got %s", r.url, resp.Status)
+}
+
+func (r *Remote) keep(block int64, buf []byte) {
+	r.blocks[block] = buf
+	r.order = append(r.order, block)
+	if len(r.order) > remoteBlocks {
+		delete(r.blocks, r.order[0])
+		r.order = r.order[1:]
+	}
+}
+
+func (r *Remote) block(block int64) ([]byte, error) {
+	if buf, ok := r.blocks[block]; ok {
+		return buf, nil
+	}
+	resp, err := r.get(block)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		return nil, errors.New("siegreader: server stopped honouring range requests for " + r.url)
+	}
+	buf, err := readBlock(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.keep(block, buf)
+	return buf, nil
+}
+
+// ReadAt implements io.ReaderAt, fetching (and caching) blocks of the resource as needed.
+func (r *Remote) ReadAt(p []byte, off int64) (int, error) {
+	if r.body != nil {
+		return 0, errors.New("siegreader: server doesn't support range requests for " + r.url)
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for n < len(p) && off < r.size {
+		buf, err := r.block(off / remoteBlock)
+		if err != nil {
+			return n, err
+		}
+		i := int(off % remoteBlock)
+		if i >= len(buf) {
+			return n, io.EOF
+		}
+		c := copy(p[n:], buf[i:])
+		n += c
+		off += int64(c)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Ranged reports whether the server supports range requests. If not, the resource can only be read as a stream.
+func (r *Remote) Ranged() bool { return r.body == nil }
+
+// Size returns the length of the resource (-1 if it is unknown, which is possible for streams).
+func (r *Remote) Size() int64 { return r.size }
+
+// ModTime returns the Last-Modified time of the resource, if given.
+func (r *Remote) ModTime() time.Time { return r.mod }
+
+// Reader returns a reader for the whole resource: a Window on the Remote if range requests are supported, otherwise the response body.
+func (r *Remote) Reader() io.Reader {
+	if r.body != nil {
+		return r.body
+	}
+	return Window(r, 0, r.size)
+}
+
+// Close closes the response body of a streamed resource and frees cached blocks.
+func (r *Remote) Close() error {
+	r.blocks, r.order = nil, nil
+	if r.body != nil {
+		return r.body.Close()
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ package siegreader
 import (
 	"errors"
 	"io"
+	"time"
 
 	"github.com/richardlehane/characterize"
 )
@@ -49,6 +50,7 @@ const (
 	wheelSz         = readSz * 16
 	smallFileSz     = readSz * 16
 	streamSz        = smallFileSz * 1024
+	hashSz          = readSz * 16 // distinct from readSz so that Hash's reads aren't mistaken for the progress reads of bigfiles
 )
 
 type bufferSrc interface {
@@ -63,11 +65,43 @@ type bufferSrc interface {
 // Readers include reverse (from EOF) and limit readers.
 type Buffer struct {
 	Quit   chan struct{} // when this channel is closed, readers will return io.EOF
+	stop   chan struct{} // closed when a deadline expires; has the same effect as Quit, for every reader of the buffer
 	texted bool
 	text   characterize.CharType
 	bufferSrc
 }
 
+// Deadline abandons reads from the Buffer after d: readers then return io.EOF, and streams awaiting EOF return ErrQuit,
+// as if the quit channel had been closed. Call it before reading. It returns a function that cancels the deadline and reports whether it expired.
+func (b *Buffer) Deadline(d time.Duration) func() bool {
+	stop := make(chan struct{})
+	b.stop = stop
+	t := time.AfterFunc(d, func() { close(stop) })
+	return func() bool {
+		return !t.Stop()
+	}
+}
+
+// Hash writes the full contents of the Buffer to w (normally a hash.Hash) in a new goroutine. This means a checksum can be calculated
+// from the same buffered bytes that are being matched, while they are being matched.
+// It returns a function that blocks until all the contents have been written. Call it before returning the Buffer to its pool.
+func (b *Buffer) Hash(w io.Writer) func() {
+	done := make(chan struct{})
+	go func() {
+		for off := int64(0); ; off += int64(hashSz) {
+			buf, err := b.Slice(off, hashSz)
+			if len(buf) > 0 {
+				w.Write(buf)
+			}
+			if err != nil {
+				break
+			}
+		}
+		close(done)
+	}()
+	return func() { <-done }
+}
+
 // Bytes returns a byte slice for a full read of the buffered file or stream.
 // Returns nil on error
 func (b *Buffer) Bytes() []byte {
@@ -6,10 +6,15 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
 )
 
 const testString = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -30,6 +35,26 @@ func TestNewBufferPool(t *testing.T) {
 	}
 }
 
+func TestSetSize(t *testing.T) {
+	b := New()
+	b.SetSize(2)
+	var bufs []*Buffer
+	for i := 0; i < 4; i++ {
+		buf, _ := b.Get(strings.NewReader(testString))
+		bufs = append(bufs, buf)
+	}
+	for _, buf := range bufs {
+		b.Put(buf)
+	}
+	if b.spool.n != 2 {
+		t.Errorf("expecting 2 idle stream buffers, got %d", b.spool.n)
+	}
+	b.SetSize(1)
+	if b.spool.n != 1 {
+		t.Errorf("expecting 1 idle stream buffer, got %d", b.spool.n)
+	}
+}
+
 func setup(r io.Reader, t *testing.T) *Buffer {
 	buf, err := bufs.Get(r)
 	if err != nil && err != io.EOF {
@@ -112,6 +137,95 @@ func TestBigFile(t *testing.T) {
 	}
 }
 
+func TestBigFileLazyEOF(t *testing.T) {
+	f, err := os.Open(testBigFile)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := setup(f, t)
+	defer bufs.Put(b)
+	b.setbigfile()
+	bf := b.bufferSrc.(*file).data.(*bigfile)
+	b.Slice(0, readSz)
+	if bf.eofRead {
+		t.Error("expecting EOF slice not to be read after a BOF read")
+	}
+	slc, _ := b.EofSlice(0, 4)
+	if !bf.eofRead {
+		t.Error("expecting EOF slice to be read after an EOF read")
+	}
+	stat, _ := f.Stat()
+	want := make([]byte, 4)
+	f.ReadAt(want, stat.Size()-4)
+	if !bytes.Equal(slc, want) {
+		t.Errorf("expecting EOF slice %v, got %v", want, slc)
+	}
+}
+
+func TestBucket(t *testing.T) {
+	var b bucket
+	// a full bucket holds a second's worth of tokens
+	if w := b.take(100, 100); w != 0 {
+		t.Errorf("expecting no wait for a full bucket, got %v", w)
+	}
+	// takes beyond that wait in proportion to the debt
+	if w := b.take(50, 100); w < 400*time.Millisecond || w > 500*time.Millisecond {
+		t.Errorf("expecting to wait about half a second, got %v", w)
+	}
+}
+
+func TestReadLimit(t *testing.T) {
+	config.SetReadLimit(0, 20)
+	defer config.SetReadLimit(0, 0)
+	opsBucket = bucket{}
+	f, err := os.Open(testBigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	b, err := New().Get(f) // a separate pool, so the bigfile isn't re-used by other tests
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.EofSlice(0, 4)
+	if _, ok := b.bufferSrc.(*file).data.(*bigfile); !ok {
+		t.Error("expecting files not to be memory mapped when reads are limited")
+	}
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		limit(1)
+	}
+	if el := time.Since(start); el < 200*time.Millisecond {
+		t.Errorf("expecting reads beyond 20 per second to wait, took %v", el)
+	}
+}
+
+func TestHash(t *testing.T) {
+	want, err := ioutil.ReadFile(testBigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []func() io.Reader{
+		func() io.Reader { r, _ := os.Open(testBigFile); return r },
+		func() io.Reader { return bytes.NewReader(want) },
+	} {
+		r := f()
+		b := setup(r, t)
+		got := &bytes.Buffer{}
+		wait := b.Hash(got)
+		b.Slice(0, readSz)
+		wait()
+		bufs.Put(b)
+		if c, ok := r.(io.Closer); ok {
+			c.Close()
+		}
+		if !bytes.Equal(got.Bytes(), want) {
+			t.Errorf("Hash: expecting %d bytes written, got %d", len(want), got.Len())
+		}
+	}
+}
+
 func TestSmallFile(t *testing.T) {
 	r, err := os.Open(testSmallFile)
 	defer r.Close()
@@ -300,3 +414,145 @@ func testBuffer(t *testing.T, checks int, tf *os.File, bs *Buffer) error {
 	}
 	return joinErrs(errs)
 }
+
+func TestWindow(t *testing.T) {
+	b, err := bufs.Get(Window(strings.NewReader(testString), 10, 26))
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	defer bufs.Put(b)
+	if b.SizeNow() != 26 {
+		t.Errorf("expecting a window of 26 bytes, got %d", b.SizeNow())
+	}
+	if byt, _ := b.Slice(0, 3); string(byt) != "abc" {
+		t.Errorf("expecting BOF to be at the start of the window, got %s", byt)
+	}
+	if byt, _ := b.EofSlice(0, 3); string(byt) != "xyz" {
+		t.Errorf("expecting EOF to be at the end of the window, got %s", byt)
+	}
+	if byt, err := b.Slice(20, 10); string(byt) != "uvwxyz" || err != io.EOF {
+		t.Errorf("expecting a short read bounded by the window, got %s %v", byt, err)
+	}
+}
+
+func TestRemote(t *testing.T) {
+	content := bytes.Repeat([]byte(testString), 20000) // 1.2MB
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/noranges" {
+			w.Write(content)
+			return
+		}
+		http.ServeContent(w, r, "test.bin", time.Time{}, bytes.NewReader(content))
+	}))
+	defer srv.Close()
+	r, err := OpenURL(nil, srv.URL+"/test.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if !r.Ranged() || r.Size() != int64(len(content)) {
+		t.Fatalf("expecting a ranged resource of %d bytes, got %t and %d", len(content), r.Ranged(), r.Size())
+	}
+	b, err := bufs.Get(r.Reader())
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	defer bufs.Put(b)
+	if byt, _ := b.Slice(0, 10); string(byt) != testString[:10] {
+		t.Errorf("bad BOF slice, got %s", byt)
+	}
+	if byt, _ := b.EofSlice(0, 10); string(byt) != testString[len(testString)-10:] {
+		t.Errorf("bad EOF slice, got %s", byt)
+	}
+	if requests != 2 {
+		t.Errorf("expecting two range requests (for the first and last blocks), got %d", requests)
+	}
+	r, err = OpenURL(nil, srv.URL+"/noranges")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.Ranged() {
+		t.Error("expecting a server that ignores ranges to give a stream")
+	}
+	if byt, _ := ioutil.ReadAll(r.Reader()); !bytes.Equal(byt, content) {
+		t.Error("bad stream")
+	}
+}
+
+func TestFromBytes(t *testing.T) {
+	src := []byte(testString)
+	b, err := bufs.Get(FromBytes(src))
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	defer bufs.Put(b)
+	if b.SizeNow() != int64(len(src)) {
+		t.Errorf("expecting %d bytes, got %d", len(src), b.SizeNow())
+	}
+	byt, _ := b.Slice(10, 3)
+	if string(byt) != "abc" || &byt[0] != &src[10] {
+		t.Errorf("expecting a slice of the source, got %s", byt)
+	}
+	if byt, _ := b.EofSlice(0, 3); string(byt) != "XYZ" {
+		t.Errorf("expecting XYZ at EOF, got %s", byt)
+	}
+	if byt, err := b.EofSlice(60, 10); string(byt) != "01" || err != io.EOF {
+		t.Errorf("expecting a short read at BOF, got %s %v", byt, err)
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	go pw.Write(bytes.Repeat(testBytes, 100)) // more than the first read, then the stream stalls
+	b := setup(pr, t)
+	defer bufs.Put(b)
+	expired := b.Deadline(10 * time.Millisecond)
+	if _, err := b.EofSlice(0, 4); err != ErrQuit {
+		t.Errorf("expecting ErrQuit once the deadline expires, got %v", err)
+	}
+	if !expired() {
+		t.Error("expecting the deadline to have expired")
+	}
+	b = setup(strings.NewReader(testString), t)
+	defer bufs.Put(b)
+	expired = b.Deadline(time.Minute)
+	if slc, err := b.EofSlice(0, 4); err != nil || string(slc) != "WXYZ" {
+		t.Errorf("expecting WXYZ, got %s %v", slc, err)
+	}
+	if expired() {
+		t.Error("expecting the deadline to be cancelled")
+	}
+}
+
+func TestBuffering(t *testing.T) {
+	defer config.SetBuffering("", 0)
+	want, err := ioutil.ReadFile(testBigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, strategy := range []string{"mmap", "stream", "memory"} {
+		config.SetBuffering(strategy, 0)
+		f, err := os.Open(testBigFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := setup(f, t)
+		got, _ := b.EofSlice(0, 100)
+		typ := fmt.Sprintf("%T", b.bufferSrc.(*file).data)
+		if !bytes.Equal(got, want[len(want)-100:]) {
+			t.Errorf("%s: bad EOF slice", strategy)
+		}
+		switch {
+		case strategy == "mmap" && typ != "*siegreader.mmap",
+			strategy == "stream" && typ != "*siegreader.bigfile",
+			strategy == "memory" && typ != "*siegreader.memfile":
+			t.Errorf("%s: unexpected data type %s", strategy, typ)
+		}
+		bufs.Put(b)
+		f.Close()
+	}
+}
@@ -1,6 +1,10 @@
 package siegreader
 
-import "log"
+import (
+	"errors"
+	"io"
+	"log"
+)
 
 type smallfile struct {
 	*file
@@ -14,6 +18,7 @@ func newSmallFile() interface{} {
 
 func (sf *smallfile) setSource(f *file) {
 	sf.file = f
+	limit(int(sf.sz))
 	i, err := sf.src.ReadAt(sf.buf[:], 0)
 	if i != int(sf.sz) {
 		log.Fatalf("Siegreader fatal error: failed to read %s, got %d bytes of %d, error: %v\n", sf.src.Name(), i, sf.sz, err)
@@ -28,3 +33,37 @@ func (sf *smallfile) eofSlice(off int64, l int) []byte {
 	o := int(sf.sz - off)
 	return sf.buf[o-l : o]
 }
+
+// memfile reads a whole file into memory. Its buffer is re-used for later files (unless it has grown very large).
+type memfile struct {
+	*file
+
+	buf []byte
+}
+
+func newMemFile() interface{} {
+	return &memfile{}
+}
+
+func (mf *memfile) setSource(f *file) error {
+	if int64(int(f.sz)) != f.sz {
+		return errors.New("siegreader: file too large to read into memory")
+	}
+	mf.file = f
+	if cap(mf.buf) < int(f.sz) {
+		mf.buf = make([]byte, int(f.sz))
+	}
+	mf.buf = mf.buf[:int(f.sz)]
+	limit(len(mf.buf))
+	_, err := io.ReadFull(io.NewSectionReader(f.src, 0, f.sz), mf.buf)
+	return err
+}
+
+func (mf *memfile) slice(off int64, l int) []byte {
+	return mf.buf[int(off) : int(off)+l]
+}
+
+func (mf *memfile) eofSlice(off int64, l int) []byte {
+	o := int(mf.sz - off)
+	return mf.buf[o-l : o]
+}
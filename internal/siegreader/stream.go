@@ -5,6 +5,9 @@ import (
 	"io/ioutil"
 	"os"
 	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/config"
 )
 
 type stream struct {
@@ -53,11 +56,15 @@ func (s *stream) Size() int64 {
 		return s.sz
 	case <-s.b.Quit:
 		return 0
+	case <-s.b.stop:
+		return 0
 	}
 }
 
 // SizeNow is a non-blocking Size(). Will force a full read of a stream.
 func (s *stream) SizeNow() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	var err error
 	for _, err = s.fill(); err == nil; _, err = s.fill() {
 	}
@@ -85,6 +92,9 @@ func (s *stream) grow() error {
 }
 
 func (s *stream) fill() (int64, error) {
+	if config.Bench() {
+		defer filled(time.Now())
+	}
 	// have already scanned to the end of the stream
 	if s.eof {
 		return s.sz, io.EOF
@@ -94,6 +104,7 @@ func (s *stream) fill() (int64, error) {
 		s.grow()
 	}
 	// now let's read
+	limit(readSz)
 	var err error
 	if s.tf != nil {
 		// if we have a backing file, fill that
@@ -179,6 +190,8 @@ func (s *stream) EofSlice(o int64, l int) ([]byte, error) {
 	select {
 	case <-s.b.Quit:
 		return nil, ErrQuit
+	case <-s.b.stop:
+		return nil, ErrQuit
 	case <-s.eofc:
 	}
 	if o >= s.sz {
@@ -0,0 +1,110 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegreader
+
+import (
+	"bytes"
+	"io"
+)
+
+// window is a region of a ReaderAt that is treated as a complete file
+type window struct {
+	*io.SectionReader
+}
+
+// Window returns a reader for the region of r that starts at off and is l bytes long.
+// A Buffer made from a Window is bounded by that region: BOF is at off and EOF at off+l.
+// This allows identification of content embedded within a file, such as a partition inside a disk image.
+func Window(r io.ReaderAt, off, l int64) io.Reader {
+	return window{io.NewSectionReader(r, off, l)}
+}
+
+func (w window) IsSlicer() bool { return true }
+
+func (w window) Slice(off int64, l int) ([]byte, error) {
+	sz := w.Size()
+	if off >= sz {
+		return nil, io.EOF
+	}
+	var err error
+	if int64(l) > sz-off {
+		l, err = int(sz-off), io.EOF
+	}
+	return w.read(off, l, err)
+}
+
+func (w window) EofSlice(off int64, l int) ([]byte, error) {
+	sz := w.Size()
+	if off >= sz {
+		return nil, io.EOF
+	}
+	var err error
+	if int64(l) > sz-off {
+		l, off, err = int(sz-off), 0, io.EOF
+	} else {
+		off = sz - off - int64(l)
+	}
+	return w.read(off, l, err)
+}
+
+func (w window) read(off int64, l int, err error) ([]byte, error) {
+	buf := make([]byte, l)
+	n, err1 := w.ReadAt(buf, off)
+	if n < l {
+		err = err1
+		if err == nil {
+			err = io.EOF
+		}
+	}
+	return buf[:n], err
+}
+
+// bytesSource is a byte slice that is treated as a complete file
+type bytesSource struct {
+	*bytes.Reader
+	b []byte
+}
+
+// FromBytes returns a reader for b. A Buffer made from it slices b directly, without copying.
+// The contents of b must not change while the Buffer is in use.
+func FromBytes(b []byte) io.Reader {
+	return bytesSource{bytes.NewReader(b), b}
+}
+
+func (b bytesSource) IsSlicer() bool { return true }
+
+func (b bytesSource) Size() int64 { return int64(len(b.b)) }
+
+func (b bytesSource) Slice(off int64, l int) ([]byte, error) {
+	sz := int64(len(b.b))
+	if off >= sz {
+		return nil, io.EOF
+	}
+	if int64(l) > sz-off {
+		return b.b[off:], io.EOF
+	}
+	return b.b[off : off+int64(l)], nil
+}
+
+func (b bytesSource) EofSlice(off int64, l int) ([]byte, error) {
+	sz := int64(len(b.b))
+	if off >= sz {
+		return nil, io.EOF
+	}
+	if int64(l) > sz-off {
+		return b.b[:sz-off], io.EOF
+	}
+	return b.b[sz-off-int64(l) : sz-off], nil
+}
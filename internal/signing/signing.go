@@ -0,0 +1,126 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing signs signature files with ed25519 keys and verifies them.
+//
+// Signatures are detached: the signature of default.sig is stored, base64 encoded, in default.sig.ed25519.
+// Keys are PEM encoded, as generated by `openssl genpkey -algorithm ed25519 -out private.pem` (a PKCS #8 private key)
+// and `openssl pkey -in private.pem -pubout -out public.pem` (a PKIX public key).
+package signing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// Ext is the extension added to a signature file's name to give the name of its detached signature.
+const Ext = ".ed25519"
+
+// ErrBadSignature is returned when a signature doesn't verify.
+var ErrBadSignature = errors.New("signing: signature doesn't verify with the public key")
+
+func readPEM(path, typ string) ([]byte, error) {
+	byts, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(byts)
+	if block == nil || block.Type != typ {
+		return nil, fmt.Errorf("signing: %s isn't a PEM encoded %s", path, typ)
+	}
+	return block.Bytes, nil
+}
+
+// PrivateKey reads a PEM encoded ed25519 private key.
+func PrivateKey(path string) (ed25519.PrivateKey, error) {
+	der, err := readPEM(path, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("signing: bad private key in %s: %v", path, err)
+	}
+	k, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing: %s isn't an ed25519 private key", path)
+	}
+	return k, nil
+}
+
+// PublicKey reads a PEM encoded ed25519 public key.
+func PublicKey(path string) (ed25519.PublicKey, error) {
+	der, err := readPEM(path, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("signing: bad public key in %s: %v", path, err)
+	}
+	k, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("signing: %s isn't an ed25519 public key", path)
+	}
+	return k, nil
+}
+
+// Sign returns the base64 encoded signature of data.
+func Sign(data []byte, key ed25519.PrivateKey) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)) + "\n")
+}
+
+// Verify checks data against a base64 encoded signature.
+func Verify(data, sig []byte, key ed25519.PublicKey) error {
+	byts, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sig)))
+	if err != nil || len(byts) != ed25519.SignatureSize {
+		return errors.New("signing: malformed signature")
+	}
+	if !ed25519.Verify(key, data, byts) {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// SignFile signs the file at path with the private key at keyPath, writing the signature to path + Ext.
+func SignFile(path, keyPath string) error {
+	key, err := PrivateKey(keyPath)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path+Ext, Sign(data, key), 0666)
+}
+
+// VerifyFile checks data, the contents of the file at path, against the signature at path + Ext using the public key at keyPath.
+func VerifyFile(path string, data []byte, keyPath string) error {
+	key, err := PublicKey(keyPath)
+	if err != nil {
+		return err
+	}
+	sig, err := ioutil.ReadFile(path + Ext)
+	if err != nil {
+		return fmt.Errorf("signing: no signature for %s: %v", path, err)
+	}
+	return Verify(data, sig, key)
+}
@@ -0,0 +1,73 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeKeys(t *testing.T, dir string) (string, string) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privPath, pubPath := filepath.Join(dir, "private.pem"), filepath.Join(dir, "public.pem")
+	if err := ioutil.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}), 0666); err != nil {
+		t.Fatal(err)
+	}
+	return privPath, pubPath
+}
+
+func TestSignFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	priv, pub := writeKeys(t, dir)
+	sig := filepath.Join(dir, "default.sig")
+	data := []byte("signature file")
+	if err := ioutil.WriteFile(sig, data, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := SignFile(sig, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyFile(sig, data, pub); err != nil {
+		t.Errorf("expecting the signature to verify, got %v", err)
+	}
+	if err := VerifyFile(sig, []byte("altered file"), pub); err != ErrBadSignature {
+		t.Errorf("expecting an altered file not to verify, got %v", err)
+	}
+	if err := VerifyFile(sig, data, priv); err == nil {
+		t.Error("expecting an error verifying with a private key")
+	}
+	otherDir := filepath.Join(dir, "other")
+	if err := os.Mkdir(otherDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	_, other := writeKeys(t, otherDir)
+	if err := VerifyFile(sig, data, other); err != ErrBadSignature {
+		t.Errorf("expecting a different key not to verify, got %v", err)
+	}
+	os.Remove(sig + Ext)
+	if err := VerifyFile(sig, data, pub); err == nil {
+		t.Error("expecting an error for a missing signature")
+	}
+}
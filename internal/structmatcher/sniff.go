@@ -0,0 +1,212 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structmatcher
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var delimiters = []struct {
+	comma rune
+	name  string
+	kind  string
+}{
+	{',', "comma", CSV},
+	{'\t', "tab", TSV},
+	{';', "semicolon", CSV},
+	{'|', "pipe", CSV},
+}
+
+// sniff returns the kind of structured text in a sample, with a basis describing it (e.g. the dialect of delimited text).
+// A truncated sample is the start of a longer file: a JSON document can be cut short and the last (partial) line is ignored.
+func sniff(buf []byte, truncated bool) (string, string) {
+	buf = bytes.TrimPrefix(buf, []byte{0xEF, 0xBB, 0xBF})
+	trimmed := bytes.TrimSpace(buf)
+	if len(trimmed) == 0 {
+		return "", ""
+	}
+	var suffix string
+	if truncated {
+		suffix = fmt.Sprintf(" (first %dKB validated)", sampleSize/1024)
+		if idx := bytes.LastIndexByte(buf, '\n'); idx > 0 {
+			buf = buf[:idx+1]
+		}
+	}
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		if typ, ok := sniffJSON(trimmed, truncated); ok {
+			return JSON, "json match: " + typ + suffix
+		}
+		if n, ok := sniffNDJSON(buf); ok {
+			return NDJSON, fmt.Sprintf("ndjson match: %d records%s", n, suffix)
+		}
+		return "", ""
+	}
+	if kind, dialect, ok := sniffDelimited(buf); ok {
+		return kind, kind + " match: " + dialect + suffix
+	}
+	if typ, ok := sniffYAML(buf); ok {
+		return YAML, "yaml match: " + typ + suffix
+	}
+	return "", ""
+}
+
+// sniffJSON validates a single JSON object or array, which may be cut short if the sample is truncated.
+func sniffJSON(buf []byte, truncated bool) (string, bool) {
+	typ := "object"
+	if buf[0] == '[' {
+		typ = "array"
+	}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	var raw json.RawMessage
+	err := dec.Decode(&raw)
+	if err == nil {
+		return typ, dec.InputOffset() == int64(len(buf))
+	}
+	return typ, truncated && err == io.ErrUnexpectedEOF
+}
+
+// sniffNDJSON validates a JSON object or array on each (non-blank) line, returning the number of lines.
+func sniffNDJSON(buf []byte) (int, bool) {
+	var n int
+	for _, line := range bytes.Split(buf, []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if (line[0] != '{' && line[0] != '[') || !json.Valid(line) {
+			return 0, false
+		}
+		n++
+	}
+	return n, n > 1
+}
+
+// sniffDelimited tries each delimiter in turn, choosing the one that gives the most columns when every row has the same number of
+// columns (at least two, over at least two rows). The dialect reports the columns, delimiter, whether the first row looks like a header
+// (no numbers in it, but numbers in the rows that follow), whether fields are quoted and the number of rows validated.
+func sniffDelimited(buf []byte) (string, string, bool) {
+	var (
+		best          int
+		kind, dialect string
+	)
+	for _, d := range delimiters {
+		rdr := csv.NewReader(bytes.NewReader(buf))
+		rdr.Comma = d.comma
+		recs, err := rdr.ReadAll()
+		if err != nil || len(recs) < 2 || len(recs[0]) < 2 || len(recs[0]) <= best {
+			continue
+		}
+		best, kind = len(recs[0]), d.kind
+		var header, quoted string
+		if isHeader(recs) {
+			header = "; header"
+		}
+		if bytes.IndexByte(buf, '"') > -1 {
+			quoted = "; quoted"
+		}
+		dialect = fmt.Sprintf("%d columns; %s delimited%s%s; %d rows", best, d.name, header, quoted, len(recs))
+	}
+	return kind, dialect, best > 0
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
+
+func isHeader(recs [][]string) bool {
+	for _, f := range recs[0] {
+		if f == "" || isNumber(f) {
+			return false
+		}
+	}
+	for _, r := range recs[1:] {
+		for _, f := range r {
+			if isNumber(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var yamlKey = regexp.MustCompile(`^(?:"[^"]*"|'[^']*'|[^\s#:'"{}\[\],&*!|>%@` + "`" + `][^:#]*?):(?:\s|$)`)
+
+// sniffYAML checks that the lines of a sample are YAML block mappings and sequences: each line is a "key: value" pair, a "- item"
+// or a line of a block scalar (after a key ending with | or >), with comments, document markers and directives allowed. At least
+// one mapping is needed, so that text lists aren't mistaken for YAML. Flow collections (except as sequence items) and multi-line
+// plain scalars aren't recognised.
+func sniffYAML(buf []byte) (string, bool) {
+	var (
+		typ       string
+		mappings  int
+		scalar    = -1 // indentation of the key that began a block scalar, or -1 if not in one
+		directive = true
+	)
+	for _, line := range strings.Split(string(buf), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+		if scalar > -1 {
+			if content == "" || indent > scalar {
+				continue
+			}
+			scalar = -1
+		}
+		if content == "" || content[0] == '#' {
+			continue
+		}
+		if content[0] == '\t' {
+			return "", false // tabs can't indent YAML
+		}
+		switch {
+		case indent == 0 && (content == "---" || strings.HasPrefix(content, "--- ") || content == "..."):
+			directive = false
+			continue
+		case directive && indent == 0 && content[0] == '%':
+			continue
+		}
+		directive = false
+		item, isItem := content, false
+		for item == "-" || strings.HasPrefix(item, "- ") {
+			item, isItem = strings.TrimLeft(item[1:], " "), true
+			indent = len(line) - len(item)
+		}
+		if isItem && typ == "" {
+			typ = "sequence"
+		}
+		if !yamlKey.MatchString(item) {
+			if isItem { // a scalar or flow collection item
+				continue
+			}
+			return "", false
+		}
+		if typ == "" {
+			typ = "mapping"
+		}
+		mappings++
+		if val := strings.TrimSpace(item[strings.IndexByte(item, ':')+1:]); val != "" && (val[0] == '|' || val[0] == '>') {
+			scalar = indent
+		}
+	}
+	return typ, mappings > 0
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package structmatcher identifies structured text (JSON, newline delimited JSON, YAML and delimited text such as CSV and TSV)
+// by validating a sample from the start of a text file.
+package structmatcher
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/richardlehane/characterize"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/priority"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// The kinds of structured text identified by the matcher.
+const (
+	JSON   = "json"
+	NDJSON = "ndjson" // newline delimited JSON (or JSON Lines): a JSON object or array on each line
+	YAML   = "yaml"
+	CSV    = "csv" // delimited text, with a comma, semicolon or pipe delimiter
+	TSV    = "tsv" // tab delimited text
+)
+
+var kinds = []string{JSON, NDJSON, YAML, CSV, TSV}
+
+const sampleSize = 64 * 1024 // only the first 64KB of a file is validated
+
+type Matcher struct {
+	kinds      []string
+	priorities *priority.Set
+}
+
+func Load(ls *persist.LoadSaver) core.Matcher {
+	kinds := ls.LoadStrings()
+	if len(kinds) == 0 {
+		return nil
+	}
+	return &Matcher{
+		kinds:      kinds,
+		priorities: priority.Load(ls),
+	}
+}
+
+func Save(c core.Matcher, ls *persist.LoadSaver) {
+	if c == nil {
+		ls.SaveStrings(nil)
+		return
+	}
+	m := c.(*Matcher)
+	ls.SaveStrings(m.kinds)
+	if len(m.kinds) == 0 {
+		return
+	}
+	m.priorities.Save(ls)
+}
+
+// SignatureSet for the struct matcher is a slice of kinds of structured text e.g. "json" or "csv".
+type SignatureSet []string
+
+func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, int, error) {
+	sigs, ok := ss.(SignatureSet)
+	if !ok {
+		return nil, -1, fmt.Errorf("Structmatcher: can't cast persist set")
+	}
+	for _, v := range sigs {
+		if !contains(kinds, v) {
+			return nil, -1, fmt.Errorf("Structmatcher: unknown kind of structured text %q, expecting one of %s", v, strings.Join(kinds, ", "))
+		}
+	}
+	if len(sigs) == 0 {
+		return c, 0, nil
+	}
+	var m *Matcher
+	if c == nil {
+		m = &Matcher{priorities: &priority.Set{}}
+	} else {
+		m = c.(*Matcher)
+	}
+	length := len(m.kinds)
+	m.kinds = append(m.kinds, sigs...)
+	// add priorities
+	m.priorities.Add(p, len(sigs), 0, 0)
+	return m, length + len(sigs), nil
+}
+
+type result struct {
+	idx   int
+	basis string
+}
+
+func (r result) Index() int {
+	return r.idx
+}
+
+func (r result) Basis() string {
+	return r.basis
+}
+
+// Identify validates a sample from the start of a text file as each kind of structured text in turn, reporting the signatures for the first kind that fits.
+func (m Matcher) Identify(na string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
+	res := make(chan core.Result)
+	var kind, basis string
+	switch b.Text() {
+	case characterize.ASCII, characterize.UTF8, characterize.UTF8BOM, characterize.LATIN1, characterize.EXTENDED:
+		buf, err := b.Slice(0, sampleSize)
+		if err == nil || err == io.EOF {
+			kind, basis = sniff(buf, err == nil)
+		}
+	}
+	if kind == "" {
+		close(res)
+		return res, nil
+	}
+	if config.Debug() {
+		fmt.Fprintln(config.Out(), basis)
+	}
+	waitset := m.priorities.WaitSet(hints...)
+	go func() {
+		for i, v := range m.kinds {
+			if v != kind || !waitset.Check(i) {
+				continue
+			}
+			res <- result{i, basis}
+			if waitset.Put(i) {
+				break
+			}
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
+func (m Matcher) String() string {
+	return fmt.Sprintf("Struct matcher: %s\n", strings.Join(m.kinds, ", "))
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package structmatcher
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var fmts = SignatureSet{JSON, NDJSON, YAML, CSV, TSV, JSON}
+
+var sm core.Matcher
+
+func init() {
+	sm, _, _ = Add(sm, fmts, nil)
+}
+
+var sniffs = []struct {
+	name   string
+	sample string
+	kind   string
+	basis  string
+}{
+	{"json", "\xEF\xBB\xBF{\"a\": [1, 2, {\"b\": null}]}\n", JSON, "json match: object"},
+	{"jsonArray", "[1, 2, 3]", JSON, "json match: array"},
+	{"badJSON", "{\"a\": 1,}", "", ""},
+	{"ndjson", "{\"a\": 1}\n{\"a\": 2}\n\n[3]\n", NDJSON, "ndjson match: 3 records"},
+	{"csv", "name,size,date\nfoo,12,2020-01-01\n\"bar, baz\",13,2021-01-01\n", CSV, "csv match: 3 columns; comma delimited; header; quoted; 3 rows"},
+	{"semicolon", "1;2;3;4\r\n5;6;7;8\r\n", CSV, "csv match: 4 columns; semicolon delimited; 2 rows"},
+	{"tsv", "a\tb\n1\t2\n3\t4\n", TSV, "tsv match: 2 columns; tab delimited; header; 3 rows"},
+	{"ragged", "a,b,c\n1,2\n", "", ""},
+	{"yaml", "%YAML 1.2\n---\n# comment\nname: siegfried\nformats:\n  - name: PDF\n    puid: fmt/276\n  - TIFF\ndescription: |\n  a signature-based\n  identification tool\n...\n", YAML, "yaml match: mapping"},
+	{"yamlSequence", "- name: a\n- name: b\n", YAML, "yaml match: sequence"},
+	{"list", "- eggs\n- milk\n", "", ""},
+	{"text", "This is just some text.\nIt isn't structured at all.\n", "", ""},
+	{"tabIndent", "a:\n\tb: c\n", "", ""},
+}
+
+func TestSniff(t *testing.T) {
+	for _, s := range sniffs {
+		kind, basis := sniff([]byte(s.sample), false)
+		if kind != s.kind || basis != s.basis {
+			t.Errorf("%s: expecting %q (%s), got %q (%s)", s.name, s.kind, s.basis, kind, basis)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	// a JSON document and a CSV file cut short by the sample size
+	if kind, basis := sniff([]byte(`{"a": [1, 2, 3`), true); kind != JSON || !strings.HasPrefix(basis, "json match: object (first") {
+		t.Errorf("expecting a truncated JSON match, got %q (%s)", kind, basis)
+	}
+	if kind, _ := sniff([]byte("a,b\n1,2\n3,4\n5,"), true); kind != CSV {
+		t.Errorf("expecting a truncated CSV match, got %q", kind)
+	}
+}
+
+func identify(t *testing.T, s string) []int {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(bytes.NewReader([]byte(s)))
+	defer bufs.Put(b)
+	res, err := sm.Identify("", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hits []int
+	for h := range res {
+		hits = append(hits, h.Index())
+	}
+	return hits
+}
+
+func TestMatch(t *testing.T) {
+	if hits := identify(t, `{"a": 1}`); len(hits) != 2 || hits[0] != 0 || hits[1] != 5 {
+		t.Errorf("expecting two json hits, got %v", hits)
+	}
+	if hits := identify(t, "a\tb\n1\t2\n"); len(hits) != 1 || hits[0] != 4 {
+		t.Errorf("expecting a tsv hit, got %v", hits)
+	}
+	if hits := identify(t, "\x00\x01{\"a\": 1}"); len(hits) != 0 {
+		t.Errorf("expecting no hits for binary data, got %v", hits)
+	}
+	if _, _, err := Add(nil, SignatureSet{"xml"}, nil); err == nil {
+		t.Error("expecting an error adding an unknown kind")
+	}
+}
+
+func TestIO(t *testing.T) {
+	str := sm.String()
+	saver := persist.NewLoadSaver(nil)
+	Save(sm, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	newsm := Load(loader)
+	str2 := newsm.String()
+	if str != str2 {
+		t.Errorf("Load struct matcher: expecting first matcher (%v), to equal second matcher (%v)", str, str2)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package textprofile implements a post-identification analyzer for plain text files.
+// It reports the style of line endings (LF, CRLF, CR or mixed), any byte order mark, the length of the longest line
+// and the number of lines with trailing whitespace: the properties archives check before deciding whether to normalise text.
+//
+// The whole file is read. Line lengths are counted in characters for UTF-8 and UTF-16 text, and in bytes for other
+// encodings. EBCDIC text isn't profiled.
+package textprofile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/richardlehane/characterize"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+var boms = []struct {
+	bom  []byte
+	name string
+}{
+	{[]byte{0xEF, 0xBB, 0xBF}, "UTF-8"},
+	{[]byte{0xFF, 0xFE}, "UTF-16LE"},
+	{[]byte{0xFE, 0xFF}, "UTF-16BE"},
+}
+
+// Info describes the properties of a text file reported by the analyzer.
+type Info struct {
+	BOM      string // byte order mark e.g. "UTF-8", if any
+	LF       int    // number of lines ending with a line feed
+	CRLF     int    // number of lines ending with a carriage return and line feed
+	CR       int    // number of lines ending with a carriage return alone
+	Lines    int    // number of lines, including a final line without a line ending
+	Longest  int    // length of the longest line, not counting its line ending
+	Trailing int    // number of lines ending with spaces or tabs
+	FinalEOL bool   // the file ends with a line ending
+}
+
+// EOL returns the style of line endings: "LF", "CRLF", "CR", "mixed", or "none" for a single line without a line ending.
+func (i *Info) EOL() string {
+	var styles int
+	eol := "none"
+	for _, s := range []struct {
+		n    int
+		name string
+	}{{i.LF, "LF"}, {i.CRLF, "CRLF"}, {i.CR, "CR"}} {
+		if s.n > 0 {
+			styles++
+			eol = s.name
+		}
+	}
+	if styles > 1 {
+		return "mixed"
+	}
+	return eol
+}
+
+// Basis returns the analysis as a slice of strings suitable for an identification's basis field.
+func (i *Info) Basis() []string {
+	basis := make([]string, 0, 5)
+	if eol := i.EOL(); eol == "mixed" {
+		basis = append(basis, fmt.Sprintf("text line endings mixed (%d LF, %d CRLF, %d CR)", i.LF, i.CRLF, i.CR))
+	} else {
+		basis = append(basis, "text line endings "+eol)
+	}
+	if i.BOM != "" {
+		basis = append(basis, "text byte order mark "+i.BOM)
+	}
+	basis = append(basis, fmt.Sprintf("text longest line %d characters", i.Longest))
+	if i.Trailing > 0 {
+		basis = append(basis, fmt.Sprintf("text trailing whitespace on %d lines", i.Trailing))
+	}
+	if i.Lines > 0 && !i.FinalEOL {
+		basis = append(basis, "text has no final line ending")
+	}
+	return basis
+}
+
+// Warn returns a warning if the text has mixed line endings (otherwise an empty warning).
+func (i *Info) Warn() core.Warning {
+	if i.EOL() == "mixed" {
+		return core.Warning{Code: core.WarnMixedLineEndings, Msg: "text has mixed line endings"}
+	}
+	return core.Warning{}
+}
+
+// Analyze reads a buffer and returns an Info for it, or false if the buffer isn't text (or is EBCDIC text).
+func Analyze(b *siegreader.Buffer) (*Info, bool) {
+	tt := b.Text()
+	var wide, big bool
+	switch tt {
+	case characterize.DATA, characterize.EBCDIC, characterize.EBCDICINT:
+		return nil, false
+	case characterize.UTF16LE:
+		wide = true
+	case characterize.UTF16BE:
+		wide, big = true, true
+	}
+	info := &Info{}
+	rdr := bufio.NewReaderSize(siegreader.ReaderFrom(b), 1<<16)
+	head, _ := rdr.Peek(3)
+	for _, v := range boms {
+		if bytes.HasPrefix(head, v.bom) {
+			info.BOM = v.name
+			rdr.Discard(len(v.bom))
+			break
+		}
+	}
+	p := &profile{Info: info, utf8: tt == characterize.UTF8 || tt == characterize.UTF8BOM}
+	for {
+		c, err := rdr.ReadByte()
+		if err != nil {
+			break
+		}
+		u := uint16(c)
+		if wide {
+			c2, err := rdr.ReadByte()
+			if err == io.EOF {
+				break
+			}
+			if big {
+				u = u<<8 | uint16(c2)
+			} else {
+				u = uint16(c2)<<8 | u
+			}
+		}
+		p.next(u)
+	}
+	p.end()
+	return info, true
+}
+
+// profile counts the lines of text a character (or UTF-16 code unit) at a time.
+type profile struct {
+	*Info
+	utf8   bool
+	length int  // characters in the current line
+	ws     bool // the last character in the current line is a space or tab
+	cr     bool // the last character was a carriage return (which ends a line, but may be followed by a line feed)
+	eol    bool // the last character was a line ending
+}
+
+func (p *profile) next(u uint16) {
+	switch u {
+	case '\n':
+		if p.cr {
+			p.CRLF++
+			p.cr = false
+		} else {
+			p.LF++
+			p.endLine()
+		}
+		return
+	case '\r':
+		if p.cr {
+			p.CR++
+		}
+		p.endLine()
+		p.cr = true
+		return
+	}
+	if p.cr {
+		p.CR++
+		p.cr = false
+	}
+	p.eol = false
+	if (p.utf8 && u&0xC0 == 0x80) || (u >= 0xDC00 && u <= 0xDFFF) { // UTF-8 continuation bytes and UTF-16 low surrogates
+		return
+	}
+	p.length++
+	p.ws = u == ' ' || u == '\t'
+}
+
+func (p *profile) endLine() {
+	p.Lines++
+	if p.length > p.Longest {
+		p.Longest = p.length
+	}
+	if p.ws {
+		p.Trailing++
+	}
+	p.length, p.ws, p.eol = 0, false, true
+}
+
+func (p *profile) end() {
+	if p.cr {
+		p.CR++
+	}
+	if !p.eol && (p.length > 0 || p.Lines == 0) {
+		p.endLine()
+		p.eol = false
+	}
+	p.FinalEOL = p.eol
+}
@@ -0,0 +1,70 @@
+package textprofile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+func analyzeString(s string) (*Info, bool) {
+	bufs := siegreader.New()
+	b, _ := bufs.Get(strings.NewReader(s))
+	defer bufs.Put(b)
+	return Analyze(b)
+}
+
+var profiles = []struct {
+	name   string
+	text   string
+	expect Info
+	basis  []string
+}{
+	{"lf", "one\ntwo \nthree\n", Info{LF: 3, Lines: 3, Longest: 5, Trailing: 1, FinalEOL: true},
+		[]string{"text line endings LF", "text longest line 5 characters", "text trailing whitespace on 1 lines"}},
+	{"crlf", "\xEF\xBB\xBFcaf\xC3\xA9\r\nna\xC3\xAFve\t\r\nend", Info{BOM: "UTF-8", CRLF: 2, Lines: 3, Longest: 6, Trailing: 1},
+		[]string{"text line endings CRLF", "text byte order mark UTF-8", "text longest line 6 characters", "text trailing whitespace on 1 lines", "text has no final line ending"}},
+	{"cr", "a\rbb\r\rccc\r", Info{CR: 4, Lines: 4, Longest: 3, FinalEOL: true},
+		[]string{"text line endings CR", "text longest line 3 characters"}},
+	{"mixed", "a\nb\r\nc\rd\n", Info{LF: 2, CRLF: 1, CR: 1, Lines: 4, Longest: 1, FinalEOL: true},
+		[]string{"text line endings mixed (2 LF, 1 CRLF, 1 CR)", "text longest line 1 characters"}},
+	{"single", "just one line", Info{Lines: 1, Longest: 13},
+		[]string{"text line endings none", "text longest line 13 characters", "text has no final line ending"}},
+	{"utf16", "\xFF\xFEh\x00i\x00\r\x00\n\x00=\xD8\x00\xDE!\x00\r\x00\n\x00", Info{BOM: "UTF-16LE", CRLF: 2, Lines: 2, Longest: 2, FinalEOL: true},
+		[]string{"text line endings CRLF", "text byte order mark UTF-16LE", "text longest line 2 characters"}},
+}
+
+func TestAnalyze(t *testing.T) {
+	for _, p := range profiles {
+		info, ok := analyzeString(p.text)
+		if !ok {
+			t.Errorf("%s: expecting text", p.name)
+			continue
+		}
+		if *info != p.expect {
+			t.Errorf("%s: expecting %+v, got %+v", p.name, p.expect, *info)
+		}
+		if basis := info.Basis(); !reflect.DeepEqual(basis, p.basis) {
+			t.Errorf("%s: expecting basis %q, got %q", p.name, p.basis, basis)
+		}
+	}
+}
+
+func TestWarn(t *testing.T) {
+	info, _ := analyzeString("a\nb\r\n")
+	if w := info.Warn(); w.Msg != "text has mixed line endings" || w.Code != core.WarnMixedLineEndings {
+		t.Errorf("expecting a mixed line endings warning, got %v", w)
+	}
+	info, _ = analyzeString("a\nb\n")
+	if w := info.Warn(); w.Msg != "" {
+		t.Errorf("expecting no warning, got %q", w.Msg)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	if _, ok := analyzeString("\x00\x01\x02\x03\xFF\xFE\x00\x00"); ok {
+		t.Error("expecting binary data not to be profiled")
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xmlmatcher
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// prolog scans an XML document up to the end of the root element's start tag and returns the public ID of its DOCTYPE declaration
+// and the schema locations given in xsi:schemaLocation (the second of each namespace/location pair) and xsi:noNamespaceSchemaLocation
+// attributes of the root element.
+func prolog(in io.ByteReader) (publicID string, locations []string) {
+	s := &scanner{in: in}
+	for {
+		c, ok := s.next()
+		if !ok {
+			return
+		}
+		if c != '<' {
+			continue
+		}
+		if c, ok = s.next(); !ok {
+			return
+		}
+		switch c {
+		case '?':
+			if !s.skipTo("?>") {
+				return
+			}
+		case '!':
+			if s.name() == "--" {
+				if !s.skipTo("-->") {
+					return
+				}
+				continue
+			}
+			// a DOCTYPE declaration (or CDATA, which shouldn't precede the root and is just skipped)
+			s.space()
+			s.name()
+			s.space()
+			if s.name() == "PUBLIC" {
+				s.space()
+				if pub, ok := s.quoted(); ok {
+					publicID = strings.Join(strings.Fields(pub), " ")
+				}
+			}
+			if !s.skipDecl() {
+				return
+			}
+		default:
+			s.unread()
+			s.name()
+			return publicID, s.attrs()
+		}
+	}
+}
+
+// attrs reads the attributes of the root element, returning any schema locations.
+func (s *scanner) attrs() []string {
+	var locations []string
+	for {
+		s.space()
+		c, ok := s.next()
+		if !ok || c == '>' || c == '/' {
+			return locations
+		}
+		s.unread()
+		name := s.name()
+		if name == "" {
+			return locations
+		}
+		s.space()
+		if c, ok = s.next(); !ok || c != '=' {
+			return locations
+		}
+		s.space()
+		val, ok := s.quoted()
+		if !ok {
+			return locations
+		}
+		idx := strings.IndexByte(name, ':')
+		if idx < 1 {
+			continue
+		}
+		switch name[idx+1:] {
+		case "schemaLocation":
+			pairs := strings.Fields(val)
+			for i := 1; i < len(pairs); i += 2 {
+				locations = append(locations, pairs[i])
+			}
+		case "noNamespaceSchemaLocation":
+			locations = append(locations, strings.TrimSpace(val))
+		}
+	}
+}
+
+// scanner is a byte reader that can unread a byte
+type scanner struct {
+	in   io.ByteReader
+	last byte
+	back bool
+}
+
+func (s *scanner) next() (byte, bool) {
+	if s.back {
+		s.back = false
+		return s.last, true
+	}
+	c, err := s.in.ReadByte()
+	if err != nil {
+		return 0, false
+	}
+	s.last = c
+	return c, true
+}
+
+func (s *scanner) unread() {
+	s.back = true
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func (s *scanner) space() {
+	for c, ok := s.next(); ok; c, ok = s.next() {
+		if !isSpace(c) {
+			s.unread()
+			return
+		}
+	}
+}
+
+// name reads a name (or keyword), stopping before whitespace or markup
+func (s *scanner) name() string {
+	var buf []byte
+	for c, ok := s.next(); ok; c, ok = s.next() {
+		if isSpace(c) || strings.IndexByte("=>/'\"[", c) > -1 {
+			s.unread()
+			break
+		}
+		buf = append(buf, c)
+		if len(buf) == 2 && string(buf) == "--" { // start of a comment
+			break
+		}
+	}
+	return string(buf)
+}
+
+func (s *scanner) quoted() (string, bool) {
+	q, ok := s.next()
+	if !ok || (q != '"' && q != '\'') {
+		return "", false
+	}
+	var buf []byte
+	for c, ok := s.next(); ok; c, ok = s.next() {
+		if c == q {
+			return string(buf), true
+		}
+		buf = append(buf, c)
+	}
+	return "", false
+}
+
+func (s *scanner) skipTo(end string) bool {
+	buf := make([]byte, 0, len(end))
+	for c, ok := s.next(); ok; c, ok = s.next() {
+		if len(buf) == len(end) {
+			buf = append(buf[:0], buf[1:]...)
+		}
+		buf = append(buf, c)
+		if bytes.Equal(buf, []byte(end)) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipDecl skips to the end of a declaration, including any internal subset of a DOCTYPE
+func (s *scanner) skipDecl() bool {
+	var depth int
+	for c, ok := s.next(); ok; c, ok = s.next() {
+		switch c {
+		case '"', '\'':
+			s.unread()
+			if _, ok := s.quoted(); !ok {
+				return false
+			}
+		case '<': // comments in an internal subset may contain quotes or brackets
+			if c, ok = s.next(); !ok {
+				return false
+			}
+			if c != '!' {
+				s.unread()
+			} else if s.name() == "--" && !s.skipTo("-->") {
+				return false
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '>':
+			if depth <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
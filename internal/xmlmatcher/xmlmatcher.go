@@ -16,6 +16,7 @@ package xmlmatcher
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/richardlehane/xmldetect"
 
@@ -25,18 +26,39 @@ import (
 	"github.com/richardlehane/siegfried/pkg/core"
 )
 
-type Matcher map[[2]string][]int
+type Matcher map[[3]string][]int
 
-type SignatureSet [][2]string // slice of root, namespace (both optional)
+// SignatureSet is a slice of root, namespace and qualifier (all optional).
+// A qualifier is a schema location (given in an xsi:schemaLocation or xsi:noNamespaceSchemaLocation attribute of the root element) or a DOCTYPE public ID.
+// Qualifiers distinguish XML vocabularies, or versions of a vocabulary, that share a root and namespace (e.g. METS profiles or the TEI P4 and P5 DTDs).
+type SignatureSet [][3]string
 
+// Load loads the unqualified signatures of a matcher. Qualified signatures are loaded with LoadQualified.
 func Load(ls *persist.LoadSaver) core.Matcher {
+	return load(nil, ls, false)
+}
+
+// LoadQualified loads the qualified signatures of a matcher, adding them to any unqualified signatures already loaded.
+func LoadQualified(c core.Matcher, ls *persist.LoadSaver) core.Matcher {
+	return load(c, ls, true)
+}
+
+func load(c core.Matcher, ls *persist.LoadSaver, qualified bool) core.Matcher {
 	le := ls.LoadSmallInt()
 	if le == 0 {
-		return nil
+		return c
+	}
+	var ret Matcher
+	if c == nil {
+		ret = make(Matcher)
+	} else {
+		ret = c.(Matcher)
 	}
-	ret := make(Matcher)
 	for i := 0; i < le; i++ {
-		k := [2]string{ls.LoadString(), ls.LoadString()}
+		k := [3]string{ls.LoadString(), ls.LoadString()}
+		if qualified {
+			k[2] = ls.LoadString()
+		}
 		r := make([]int, ls.LoadSmallInt())
 		for j := range r {
 			r[j] = ls.LoadSmallInt()
@@ -46,16 +68,45 @@ func Load(ls *persist.LoadSaver) core.Matcher {
 	return ret
 }
 
+// Save saves the unqualified signatures of a matcher. Qualified signatures are saved with SaveQualified
+// (they were added after the v1.9 signature format, so are appended to signature files).
 func Save(c core.Matcher, ls *persist.LoadSaver) {
+	save(c, ls, false)
+}
+
+// SaveQualified saves the qualified signatures of a matcher.
+func SaveQualified(c core.Matcher, ls *persist.LoadSaver) {
+	save(c, ls, true)
+}
+
+func save(c core.Matcher, ls *persist.LoadSaver, qualified bool) {
 	if c == nil {
 		ls.SaveSmallInt(0)
 		return
 	}
 	m := c.(Matcher)
-	ls.SaveSmallInt(len(m))
-	for k, v := range m {
+	keys := make([][3]string, 0, len(m))
+	for k := range m {
+		if (k[2] != "") == qualified {
+			keys = append(keys, k)
+		}
+	}
+	ls.SaveSmallInt(len(keys))
+	sort.Slice(keys, func(i, j int) bool {
+		for n := range keys[i] {
+			if keys[i][n] != keys[j][n] {
+				return keys[i][n] < keys[j][n]
+			}
+		}
+		return false
+	})
+	for _, k := range keys {
+		v := m[k]
 		ls.SaveString(k[0])
 		ls.SaveString(k[1])
+		if qualified {
+			ls.SaveString(k[2])
+		}
 		ls.SaveSmallInt(len(v))
 		for _, w := range v {
 			ls.SaveSmallInt(w)
@@ -97,6 +148,8 @@ func Add(c core.Matcher, ss core.SignatureSet, p priority.List) (core.Matcher, i
 	return m, length + len(sigs), nil
 }
 
+// Identify matches the root and namespace of an XML document. If any qualified signatures match the document (i.e. their root and namespace,
+// if given, match and their qualifier is a schema location or the DOCTYPE public ID of the document), only those signatures are reported.
 func (m Matcher) Identify(s string, b *siegreader.Buffer, hints ...core.Hint) (chan core.Result, error) {
 	rdr := siegreader.TextReaderFrom(b)
 	_, root, ns, err := xmldetect.Root(rdr)
@@ -105,12 +158,20 @@ func (m Matcher) Identify(s string, b *siegreader.Buffer, hints ...core.Hint) (c
 		close(res)
 		return res, nil
 	}
-	both := m[[2]string{root, ns}]
+	if qualified := m.identifyQualified(b, root, ns); len(qualified) > 0 {
+		res := make(chan core.Result, len(qualified))
+		for _, r := range qualified {
+			res <- r
+		}
+		close(res)
+		return res, nil
+	}
+	both := m[[3]string{root, ns}]
 	var nsonly []int
 	var rootonly []int
 	if ns != "" {
-		nsonly = m[[2]string{"", ns}]
-		rootonly = m[[2]string{root, ""}]
+		nsonly = m[[3]string{"", ns}]
+		rootonly = m[[3]string{root, ""}]
 	}
 	res := make(chan core.Result, len(both)+len(rootonly)+len(nsonly))
 	for _, v := range both {
@@ -126,6 +187,54 @@ func (m Matcher) Identify(s string, b *siegreader.Buffer, hints ...core.Hint) (c
 	return res, nil
 }
 
+func (m Matcher) identifyQualified(b *siegreader.Buffer, root, ns string) []result {
+	var (
+		scanned   bool
+		publicID  string
+		locations []string
+		ret       []result
+	)
+	for k, v := range m {
+		if k[2] == "" || (k[0] != "" && k[0] != root) || (k[1] != "" && k[1] != ns) {
+			continue
+		}
+		// only scan the prolog if there is a candidate
+		if !scanned {
+			publicID, locations = prolog(siegreader.TextReaderFrom(b))
+			scanned = true
+		}
+		var basis string
+		switch {
+		case k[2] == publicID:
+			basis = "DOCTYPE public ID " + k[2]
+		case contains(locations, k[2]):
+			basis = "schema location " + k[2]
+		default:
+			continue
+		}
+		for _, idx := range v {
+			if k[0] == "" && k[1] == "" {
+				ret = append(ret, result{idx, "xml match with " + basis})
+				continue
+			}
+			r := makeResult(idx, k[0], k[1])
+			r.basis += " and " + basis
+			ret = append(ret, r)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].idx < ret[j].idx })
+	return ret
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func makeResult(idx int, root, ns string) result {
 	switch {
 	case root == "":
@@ -152,6 +261,10 @@ func (r result) Basis() string {
 func (m Matcher) String() string {
 	var str string
 	for k, v := range m {
+		if k[2] != "" {
+			str += fmt.Sprintf("%s %s (%s): %v\n", k[0], k[1], k[2], v)
+			continue
+		}
 		str += fmt.Sprintf("%s %s: %v\n", k[0], k[1], v)
 	}
 	return str
@@ -15,9 +15,11 @@
 package xmlmatcher
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/internal/siegreader"
 	"github.com/richardlehane/siegfried/pkg/core"
 )
@@ -85,3 +87,73 @@ func TestIdentify(t *testing.T) {
 		}
 	}
 }
+
+var (
+	qualifiedSet = SignatureSet{
+		{"mets", "http://www.loc.gov/METS/"},
+		{"mets", "http://www.loc.gov/METS/", "http://www.loc.gov/standards/mets/mets.xsd"},
+		{"TEI.2", ""},
+		{"", "", "-//TEI P4//DTD Main Document Type//EN"},
+	}
+	qualifiedCases = []struct {
+		name   string
+		val    string
+		expect []int
+	}{
+		{"mets", "<mets xmlns='http://www.loc.gov/METS/'>", []int{0}},
+		{"metsLocation", `<?xml version="1.0"?>
+<!-- a METS document -->
+<mets xmlns="http://www.loc.gov/METS/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+  xsi:schemaLocation="http://www.loc.gov/METS/ http://www.loc.gov/standards/mets/mets.xsd">`, []int{1}},
+		{"metsOtherLocation", `<mets xmlns="http://www.loc.gov/METS/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://www.loc.gov/METS/ mets.xsd">`, []int{0}},
+		{"tei", "<TEI.2>", []int{2}},
+		{"teiPublic", `<!DOCTYPE TEI.2 PUBLIC "-//TEI P4//DTD Main Document Type//EN" "tei2.dtd" [
+<!-- don't [trip] on this -->
+<!ENTITY % TEI.XML 'INCLUDE'>
+]>
+<TEI.2>`, []int{3}},
+	}
+)
+
+func TestProlog(t *testing.T) {
+	pub, locs := prolog(strings.NewReader(qualifiedCases[4].val))
+	if pub != "-//TEI P4//DTD Main Document Type//EN" || len(locs) != 0 {
+		t.Errorf("bad prolog: got public ID %q and locations %v", pub, locs)
+	}
+	pub, locs = prolog(strings.NewReader(`<a xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="a a.xsd  b b.xsd" xsi:noNamespaceSchemaLocation=' c.xsd '/>`))
+	if pub != "" || !reflect.DeepEqual(locs, []string{"a.xsd", "b.xsd", "c.xsd"}) {
+		t.Errorf("bad prolog: got public ID %q and locations %v", pub, locs)
+	}
+}
+
+func TestQualified(t *testing.T) {
+	m, i, e := Add(nil, qualifiedSet, nil)
+	if i != 4 || e != nil {
+		t.Fatal("failed to create matcher")
+	}
+	for _, tc := range qualifiedCases {
+		res, err := identifyString(m.(Matcher), tc.val)
+		if err != nil {
+			t.Fatalf("error identifying %s: %v", tc.name, err)
+		}
+		idxs := make([]int, len(res))
+		for i, r := range res {
+			idxs[i] = r.Index()
+		}
+		if !reflect.DeepEqual(idxs, tc.expect) {
+			t.Errorf("bad results for %s: got %v, expected %v", tc.name, idxs, tc.expect)
+		}
+	}
+}
+
+func TestIO(t *testing.T) {
+	m, _, _ := Add(nil, qualifiedSet, nil)
+	saver := persist.NewLoadSaver(nil)
+	Save(m, saver)
+	SaveQualified(m, saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	m2 := LoadQualified(Load(loader), loader)
+	if loader.Err != nil || !reflect.DeepEqual(m, m2) {
+		t.Errorf("Load xml matcher: expecting first matcher (%v), to equal second matcher (%v)", m, m2)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siegfried
+
+import (
+	"io"
+	"strings"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+	"github.com/richardlehane/siegfried/pkg/mimeinfo"
+)
+
+// DefaultMIME is the MIME-type returned by DetectMIME when no identifier gives one, as for Apache Tika.
+const DefaultMIME = "application/octet-stream"
+
+// DetectMIME identifies a file or stream (the name may be empty) and returns its single best MIME-type, for callers that
+// only need one, such as code that used to shell out to Apache Tika. When the identifiers of the signature file disagree, the MIME-type is
+// taken from, in order of precedence:
+//
+//  1. a match on a signature (byte, container, XML etc.), rather than on the filename, MIME or text alone
+//  2. a MIME-info identifier (e.g. tika or freedesktop.org), whose formats are MIME-types
+//  3. the identifier added to the signature file first
+//
+// Matches without a MIME-type (such as PRONOM formats that have none) are skipped. If there is more than one MIME-type for a format,
+// the first is returned. DefaultMIME is returned if no identifier gives a MIME-type.
+func (s *Siegfried) DetectMIME(name string, r io.Reader) (string, error) {
+	ids, err := s.Identify(r, name, "")
+	if ids == nil {
+		return DefaultMIME, err
+	}
+	return s.bestMIME(ids), err
+}
+
+func (s *Siegfried) bestMIME(ids []core.Identification) string {
+	var (
+		best      string
+		bestScore = -1
+	)
+	for _, id := range ids {
+		if !id.Known() {
+			continue
+		}
+		mime, ns := s.mimeOf(id)
+		if mime == "" {
+			continue
+		}
+		var score int
+		if !weakMatch(id) {
+			score += 2
+		}
+		if s.isMIMEInfo(ns) {
+			score++
+		}
+		// ids are in the order of their identifiers, so only a higher score displaces an earlier match
+		if score > bestScore {
+			best, bestScore = mime, score
+		}
+	}
+	if best == "" {
+		return DefaultMIME
+	}
+	return best
+}
+
+// mimeOf returns the first MIME-type of an identification, and the namespace of its identifier
+func (s *Siegfried) mimeOf(id core.Identification) (string, string) {
+	var mime, ns string
+	for _, p := range s.Label(id) {
+		switch p[0] {
+		case "namespace":
+			ns = p[1]
+		case "mime":
+			mime = p[1]
+		}
+	}
+	if i := strings.IndexAny(mime, ", "); i >= 0 {
+		mime = mime[:i]
+	}
+	return mime, ns
+}
+
+func (s *Siegfried) isMIMEInfo(ns string) bool {
+	for i, v := range s.ids {
+		if !s.skipped(i) && v.Name() == ns {
+			_, ok := v.(*mimeinfo.Identifier)
+			return ok
+		}
+	}
+	return false
+}
+
+func weakMatch(id core.Identification) bool {
+	for _, c := range core.WarnCodes(id) {
+		if c == core.WarnWeakMatch {
+			return true
+		}
+	}
+	return false
+}
@@ -15,8 +15,9 @@
 package config
 
 import (
-	"fmt"
+	"io"
 	"strings"
+	"time"
 )
 
 // Archive is a file format capable of decompression by sf.
@@ -24,20 +25,35 @@ type Archive int
 
 // Archive type enum.
 const (
-	None Archive = iota // None means the format cannot be decompressed by sf.
-	Zip                 // Zip describes a Zip type archive.
-	Gzip                // Gzip describes a Gzip type archive.	.
-	Tar                 // Tar describes a Tar type archive
-	ARC                 // ARC describes an ARC web archive.
-	WARC                // WARC describes a WARC web archive.
+	None       Archive = iota // None means the format cannot be decompressed by sf.
+	Zip                       // Zip describes a Zip type archive.
+	Gzip                      // Gzip describes a Gzip type archive.	.
+	Tar                       // Tar describes a Tar type archive
+	ARC                       // ARC describes an ARC web archive.
+	WARC                      // WARC describes a WARC web archive.
+	Bzip2                     // Bzip2 describes a bzip2 compressed file.
+	XZ                        // XZ describes an xz compressed file.
+	Zstd                      // Zstd describes a Zstandard compressed file.
+	OLE2                      // OLE2 describes an OLE2 compound file, whose streams are listed with sf -z -ole2.
+	EML                       // EML describes an email message (RFC 822/MIME), whose attachments are unpacked.
+	MSG                       // MSG describes an Outlook message, whose attachments are unpacked.
+	PDFArchive                // PDFArchive describes a PDF, whose embedded files are listed with sf -z -zpdf.
 )
 
+// the last built-in archive type: the types added with RegisterArchive follow
+const lastArchive = PDFArchive
+
 const (
 	zipArc  = "zip"
 	tarArc  = "tar"
 	gzipArc = "gzip"
 	warcArc = "warc"
 	arcArc  = "arc"
+	bz2Arc  = "bzip2"
+	xzArc   = "xz"
+	zstdArc = "zstd"
+	emlArc  = "eml"
+	msgArc  = "msg"
 )
 
 // ArcZipTypes returns a string array with all Zip identifiers Siegfried
@@ -94,17 +110,121 @@ func ArcWarcTypes() []string {
 	}
 }
 
+// ArcBzip2Types returns a string array with all bzip2 identifiers
+// Siegfried can match and decompress.
+func ArcBzip2Types() []string {
+	return []string{
+		pronom.bzip2,
+		mimeinfo.bzip2,
+		mimeinfo.bzip,
+	}
+}
+
+// ArcXZTypes returns a string array with all xz identifiers
+// Siegfried can match and decompress.
+func ArcXZTypes() []string {
+	return []string{
+		pronom.xz,
+		mimeinfo.xz,
+	}
+}
+
+// ArcZstdTypes returns a string array with all Zstandard identifiers
+// Siegfried can match and decompress.
+func ArcZstdTypes() []string {
+	return []string{
+		mimeinfo.zstd,
+	}
+}
+
+// ArcEMLTypes returns a string array with all email message identifiers
+// Siegfried can match and unpack.
+func ArcEMLTypes() []string {
+	return []string{
+		pronom.eml,
+		pronom.mimeEmail,
+		mimeinfo.eml,
+	}
+}
+
+// ArcMSGTypes returns a string array with all Outlook message identifiers
+// Siegfried can match and unpack.
+func ArcMSGTypes() []string {
+	return []string{
+		pronom.msg,
+		mimeinfo.msg,
+	}
+}
+
 // ListAllArcTypes returns a list of archive file-format extensions that
 // can be used to filter the files Siegfried will decompress to identify
 // the contents of.
 func ListAllArcTypes() string {
-	return fmt.Sprintf("%s, %s, %s, %s, %s",
+	names := []string{
 		zipArc,
 		tarArc,
 		gzipArc,
 		warcArc,
 		arcArc,
-	)
+		bz2Arc,
+		xzArc,
+		zstdArc,
+		emlArc,
+		msgArc,
+	}
+	for _, r := range registered {
+		names = append(names, r.name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Decompressor unpacks the entries of an archive. It is implemented by the decompressors in pkg/decompress
+// and must be implemented by the constructors given to RegisterArchive.
+type Decompressor interface {
+	Next() error // when finished, should return io.EOF
+	Reader() io.Reader
+	Path() string
+	MIME() string
+	Size() int64
+	Mod() time.Time
+	Dirs() []string
+}
+
+// ArchiveConstructor returns a Decompressor for an archive, given a reader for it, its path and its size.
+type ArchiveConstructor func(r io.ReaderAt, path string, sz int64) (Decompressor, error)
+
+type registeredArchive struct {
+	name        string
+	trigger     []string
+	constructor ArchiveConstructor
+}
+
+var registered []registeredArchive
+
+// RegisterArchive adds an archive type that sf can decompress in -z mode: e.g. a backup format
+// particular to an institution. The name can be used to select the archive type with -zs. Files identified
+// with any of the trigger identifiers (e.g. PUIDs or MIME-types) are decompressed with the constructor.
+// It returns the new type's Archive value. RegisterArchive isn't safe for concurrent use: call it from an
+// init function, so that the archive type is registered before flags are parsed.
+func RegisterArchive(name string, trigger []string, constructor ArchiveConstructor) Archive {
+	registered = append(registered, registeredArchive{strings.ToLower(name), trigger, constructor})
+	return lastArchive + Archive(len(registered))
+}
+
+// the registration for an Archive value returned by RegisterArchive (nil for the built-in archive types)
+func registration(a Archive) *registeredArchive {
+	if i := int(a - lastArchive - 1); i >= 0 && i < len(registered) {
+		return &registered[i]
+	}
+	return nil
+}
+
+// ArchiveConstructorFor returns the constructor given to RegisterArchive for an archive type, or nil if the type is built-in.
+func ArchiveConstructorFor(a Archive) ArchiveConstructor {
+	if r := registration(a); r != nil {
+		return r.constructor
+	}
+	return nil
 }
 
 var permissiveFilter []string
@@ -118,7 +238,13 @@ func SetArchiveFilterPermissive(value string) []string {
 	arr := []string{}
 	arcList := strings.Split(value, ",")
 	for _, arc := range arcList {
-		switch strings.TrimSpace(strings.ToLower(arc)) {
+		arc = strings.TrimSpace(strings.ToLower(arc))
+		for _, r := range registered {
+			if arc == r.name {
+				arr = append(arr, r.trigger...)
+			}
+		}
+		switch arc {
 		case zipArc:
 			arr = append(arr, ArcZipTypes()...)
 		case tarArc:
@@ -129,6 +255,16 @@ func SetArchiveFilterPermissive(value string) []string {
 			arr = append(arr, ArcWarcTypes()...)
 		case arcArc:
 			arr = append(arr, ArcArcTypes()...)
+		case bz2Arc:
+			arr = append(arr, ArcBzip2Types()...)
+		case xzArc:
+			arr = append(arr, ArcXZTypes()...)
+		case zstdArc:
+			arr = append(arr, ArcZstdTypes()...)
+		case emlArc:
+			arr = append(arr, ArcEMLTypes()...)
+		case msgArc:
+			arr = append(arr, ArcMSGTypes()...)
 		}
 	}
 	permissiveFilter = arr
@@ -153,6 +289,42 @@ func (a Archive) String() string {
 		return "ARC"
 	case WARC:
 		return "WARC"
+	case Bzip2:
+		return "bzip2"
+	case XZ:
+		return "xz"
+	case Zstd:
+		return "zstd"
+	case OLE2:
+		return "OLE2"
+	case EML:
+		return "eml"
+	case MSG:
+		return "msg"
+	case PDFArchive:
+		return "PDF"
+	}
+	if r := registration(a); r != nil {
+		return r.name
 	}
 	return ""
 }
+
+// limits on decompression in -z mode, guarding against archive bombs (0 is no limit)
+var archiveLimits struct {
+	depth int   // maximum nesting of archives within archives
+	ratio int   // maximum bytes extracted for each byte of the outermost archive
+	total int64 // maximum bytes extracted from the outermost archive, including from any archives nested within it
+}
+
+// SetArchiveLimits limits decompression in -z mode (0 is no limit): the depth to which archives nested within archives are decompressed,
+// the ratio of the bytes extracted to the size of the outermost archive, and the total bytes extracted from it.
+// When a limit is reached, decompression of the archive is abandoned with a warning.
+func SetArchiveLimits(depth, ratio int, total int64) {
+	archiveLimits.depth, archiveLimits.ratio, archiveLimits.total = depth, ratio, total
+}
+
+// ArchiveLimits reports the limits set on nesting depth, size ratio and total bytes extracted in -z mode (0 is no limit).
+func ArchiveLimits() (int, int, int64) {
+	return archiveLimits.depth, archiveLimits.ratio, archiveLimits.total
+}
@@ -35,6 +35,13 @@ var identifier = struct {
 	noMIME      bool     // don't build with MIME signatures
 	noXML       bool     // don't build with XML signatures
 	noRIFF      bool     // don't build with RIFF signatures
+	noMPEG      bool     // don't build with MPEG audio signatures
+	noEBML      bool     // don't build with EBML signatures
+	noBMFF      bool     // don't build with ISO base media (ftyp brand) signatures
+	noStruct    bool     // don't build with structured text (JSON, YAML and delimited text) signatures
+	noData      bool     // don't build with scientific data (SQLite, HDF5 and netCDF) signatures
+	compact     bool     // omit descriptive strings (format names, versions etc.) from the signature file
+	linkDups    bool     // add priorities between identical byte signatures mapped to different IDs
 	limit       []string // limit signature to a set of included PRONOM reports
 	exclude     []string // exclude a set of PRONOM reports from the signature
 	extensions  string   // directory where custom signature extensions are stored
@@ -117,6 +124,9 @@ func Details(extra ...string) string {
 	if identifier.multi != Conclusive {
 		str += "; multi set to " + identifier.multi.String()
 	}
+	if seg := GetSegmentation(); seg != DefaultSegmentation() {
+		str += "; segmentation " + seg.String()
+	}
 	if identifier.noText {
 		str += "; no text matcher"
 	}
@@ -132,6 +142,27 @@ func Details(extra ...string) string {
 	if identifier.noRIFF {
 		str += "; no RIFF matcher"
 	}
+	if identifier.noMPEG {
+		str += "; no MPEG matcher"
+	}
+	if identifier.noEBML {
+		str += "; no EBML matcher"
+	}
+	if identifier.noBMFF {
+		str += "; no BMFF matcher"
+	}
+	if identifier.noStruct {
+		str += "; no struct matcher"
+	}
+	if identifier.noData {
+		str += "; no data matcher"
+	}
+	if identifier.compact {
+		str += "; compact"
+	}
+	if identifier.linkDups {
+		str += "; duplicate signatures linked"
+	}
 	if pronom.reports == "" {
 		str += "; built without reports"
 	}
@@ -213,6 +244,41 @@ func NoRIFF() bool {
 	return identifier.noRIFF
 }
 
+// NoMPEG reports whether MPEG audio frame signatures should be omitted.
+func NoMPEG() bool {
+	return identifier.noMPEG
+}
+
+// NoEBML reports whether EBML DocType signatures should be omitted.
+func NoEBML() bool {
+	return identifier.noEBML
+}
+
+// NoBMFF reports whether ftyp brand signatures should be omitted.
+func NoBMFF() bool {
+	return identifier.noBMFF
+}
+
+// NoStruct reports whether structured text signatures should be omitted.
+func NoStruct() bool {
+	return identifier.noStruct
+}
+
+// NoData reports whether scientific data signatures should be omitted.
+func NoData() bool {
+	return identifier.noData
+}
+
+// Compact reports whether descriptive strings, such as format names, should be omitted from identifiers.
+func Compact() bool {
+	return identifier.compact
+}
+
+// LinkDuplicates reports whether priorities should be added between identical byte signatures mapped to different IDs.
+func LinkDuplicates() bool {
+	return identifier.linkDups
+}
+
 // HasLimit reports whether a limited set of signatures has been selected.
 func HasLimit() bool {
 	return len(identifier.limit) > 0
@@ -301,6 +367,21 @@ func IsArchive(id string) Archive {
 		return ARC
 	case contains(id, ArcWarcTypes()):
 		return WARC
+	case contains(id, ArcBzip2Types()):
+		return Bzip2
+	case contains(id, ArcXZTypes()):
+		return XZ
+	case contains(id, ArcZstdTypes()):
+		return Zstd
+	case contains(id, ArcEMLTypes()):
+		return EML
+	case contains(id, ArcMSGTypes()):
+		return MSG
+	}
+	for i, r := range registered {
+		if contains(id, r.trigger) {
+			return lastArchive + Archive(i+1)
+		}
 	}
 	return None
 }
@@ -435,6 +516,64 @@ func SetNoRIFF() func() private {
 	}
 }
 
+// SetNoMPEG will cause MPEG audio frame signatures to be omitted.
+func SetNoMPEG() func() private {
+	return func() private {
+		identifier.noMPEG = true
+		return private{}
+	}
+}
+
+// SetNoEBML will cause EBML DocType signatures to be omitted.
+func SetNoEBML() func() private {
+	return func() private {
+		identifier.noEBML = true
+		return private{}
+	}
+}
+
+// SetNoBMFF will cause ftyp brand signatures to be omitted.
+func SetNoBMFF() func() private {
+	return func() private {
+		identifier.noBMFF = true
+		return private{}
+	}
+}
+
+// SetNoStruct will cause structured text signatures to be omitted.
+func SetNoStruct() func() private {
+	return func() private {
+		identifier.noStruct = true
+		return private{}
+	}
+}
+
+// SetNoData will cause scientific data signatures to be omitted.
+func SetNoData() func() private {
+	return func() private {
+		identifier.noData = true
+		return private{}
+	}
+}
+
+// SetCompact will cause descriptive strings (format names, versions and descriptions) to be omitted from identifiers.
+// Results then report IDs (and MIME-types) only.
+func SetCompact() func() private {
+	return func() private {
+		identifier.compact = true
+		return private{}
+	}
+}
+
+// SetLinkDuplicates will cause priorities to be added between byte signatures that are identical but mapped to different IDs
+// (e.g. when an extension redefines an existing signature). The later ID is given priority so a single result is reported.
+func SetLinkDuplicates() func() private {
+	return func() private {
+		identifier.linkDups = true
+		return private{}
+	}
+}
+
 // SetLimit limits the set of signatures built to the list provide.
 func SetLimit(l []string) func() private {
 	return func() private {
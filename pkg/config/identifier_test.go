@@ -18,6 +18,8 @@
 package config
 
 import (
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +29,7 @@ var locArcUID = "fdd000235"
 var mimeTarUID = "application/x-tar"
 var mimeWarcUID = "application/x-warc"
 var mimeGzipUID = "application/gzip"
+var proXZUID = "fmt/1098"
 
 // Non-archive UID.
 var nonArcUID = "fmt/1000"
@@ -46,6 +49,7 @@ var isArcTests = []arcTest{
 	arcTest{"gZip", mimeGzipUID, Gzip},
 	arcTest{"warc,zip,tar", mimeWarcUID, WARC},
 	arcTest{"zip,arc", locArcUID, ARC},
+	arcTest{"xz", proXZUID, XZ},
 	// Negative tests should all return None.
 	arcTest{"zip,arc", mimeWarcUID, None},
 	arcTest{"zip,arc", mimeGzipUID, None},
@@ -68,7 +72,7 @@ func TestIsArchivePositive(t *testing.T) {
 	}
 }
 
-var arcTypes = [...]Archive{Zip, Gzip, Tar, ARC, WARC}
+var arcTypes = [...]Archive{Zip, Gzip, Tar, ARC, WARC, Bzip2, XZ, Zstd, OLE2, EML, MSG, PDFArchive}
 
 const noneType = None
 
@@ -84,3 +88,28 @@ func TestIsArchiveGreaterThanNone(t *testing.T) {
 		t.Errorf("Archive 0 type should equal zero not %d", noneType)
 	}
 }
+
+func TestRegisterArchive(t *testing.T) {
+	defer func() { registered = nil }()
+	bkp := RegisterArchive("BKP", []string{"fmt/9999"}, func(r io.ReaderAt, path string, sz int64) (Decompressor, error) {
+		return nil, nil
+	})
+	if bkp <= lastArchive || bkp.String() != "bkp" {
+		t.Fatalf("expecting a new archive type named bkp, got %d %s", bkp, bkp)
+	}
+	if !strings.HasSuffix(ListAllArcTypes(), ", bkp") {
+		t.Errorf("expecting bkp to be listed, got %s", ListAllArcTypes())
+	}
+	SetArchiveFilterPermissive("zip,bkp")
+	defer SetArchiveFilterPermissive(ListAllArcTypes())
+	if arc := IsArchive("fmt/9999"); arc != bkp {
+		t.Errorf("expecting fmt/9999 to trigger bkp, got %s", arc)
+	}
+	if ArchiveConstructorFor(bkp) == nil || ArchiveConstructorFor(Zip) != nil {
+		t.Error("expecting a constructor for bkp, and not for zip")
+	}
+	SetArchiveFilterPermissive("zip")
+	if arc := IsArchive("fmt/9999"); arc != None {
+		t.Errorf("expecting bkp to be filtered out, got %s", arc)
+	}
+}
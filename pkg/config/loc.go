@@ -27,12 +27,66 @@ var loc = struct {
 	arc      string
 	warc     string
 	text     string // n/a
+	mp3      string // raw MPEG audio identified by the mpeg matcher
+	mp2      string
+	mkv      string // EBML doctypes identified by the ebml matcher
+	webm     string
+	brands   map[string][]string // ftyp brands identified by the bmff matcher
+	structs  map[string][]string // structured text identified by the struct matcher
+	data     map[string][]string // scientific data formats identified by the data matcher
+	refine   map[string]string   // formats refined after matching, with the family of formats their structure is parsed as
+	refined  map[string][]string // the formats refinement can upgrade to, for each kind of more specific format
+	harvest  string              // URL of the format descriptions, harvested by roy harvest loc
 }{
 	def:  "fddXML.zip",
 	name: "loc",
 	zip:  "fdd000354",
 	arc:  "fdd000235",
 	warc: "fdd000236",
+	mp3:  "fdd000105",
+	mp2:  "fdd000338",
+	mkv:  "fdd000342",
+	webm: "fdd000518",
+	brands: map[string][]string{
+		"fdd000079": {"isom", "iso2"}, // ISO Base Media File Format
+		"fdd000037": {"mp41"},         // MPEG-4 File Format, Version 1
+		"fdd000155": {"mp42"},         // MPEG-4 File Format, Version 2
+		"fdd000137": {"avc1"},         // MPEG-4 File Format for AVC
+		"fdd000234": {"M4A "},         // MPEG-4 File Format, V.2, with Advanced Audio Coding
+		"fdd000052": {"qt  "},         // QuickTime File Format
+		"fdd000127": {"mjp2"},         // Motion JPEG 2000
+	},
+	structs: map[string][]string{
+		"fdd000381": {"json", "ndjson"}, // JSON (JavaScript Object Notation)
+		"fdd000323": {"csv"},            // CSV, Comma Separated Values (RFC 4180)
+	},
+	data: map[string][]string{
+		"fdd000461": {"sqlite3"},                               // SQLite, Version 3
+		"fdd000229": {"hdf5-0", "hdf5-1", "hdf5-2", "hdf5-3"},  // HDF5, Hierarchical Data Format, Version 5
+		"fdd000330": {"netcdf-classic", "netcdf-64bit-offset"}, // NetCDF-3 (Network Common Data Form, version 3)
+		"fdd000332": {"netcdf4"},                               // NetCDF-4 (Network Common Data Form, version 4)
+		"fdd000339": {"netcdf4-classic"},                       // NetCDF-4 (Network Common Data Form, Version 4), Classic Model
+	},
+	refine: map[string]string{
+		"fdd000022": "tiff", // TIFF, Revision 6.0
+		"fdd000023": "tiff", // TIFF, Uncompressed Bitmap
+		"fdd000024": "tiff", // TIFF Bitmap with Group 4 Compression
+		"fdd000074": "tiff", // TIFF Bitmap with LZW Compression
+		"fdd000145": "tiff", // TIFF Uncompressed File with Exif Metadata
+		"fdd000143": "jp2",  // JPEG 2000 Part 1 (Core) jp2 File Format
+	},
+	refined: map[string][]string{
+		"geotiff":      {"fdd000279"}, // GeoTIFF, Revision 1.0
+		"tiff-ep":      {"fdd000073"}, // TIFF/EP, ISO 12234-2:2001
+		"dng-1.1":      {"fdd000188"}, // Adobe Digital Negative (DNG), Version 1.1
+		"bigtiff":      {"fdd000328"}, // BigTIFF
+		"jpx":          {"fdd000154"}, // JPEG 2000 Part 2 (Extensions) jpf (jpx) File Format
+		"jp2-profile0": {"fdd000195"}, // JP2 File Format with JPEG 2000 Core Coding, Profile 0
+		"jp2-profile1": {"fdd000197"}, // JP2 File Format with JPEG 2000 Core Coding, Profile 1
+		"jp2-profile3": {"fdd000212"}, // JP2 File Format with JPEG 2000 Core Coding, Profile 3
+		"jp2-profile4": {"fdd000214"}, // JP2 File Format with JPEG 2000 Core Coding, Profile 4
+	},
+	harvest: "https://www.loc.gov/preservation/digital/formats/",
 }
 
 // LOC returns the location of the LOC signature file.
@@ -46,10 +100,61 @@ func LOC() string {
 	return loc.fdd
 }
 
+// LOCHarvestURL reports the URL of the LOC format descriptions website: roy harvest loc fetches the list of descriptions
+// from fdd/browse_list.shtml and each description from fddXML/ at this URL.
+func LOCHarvestURL() string {
+	return loc.harvest
+}
+
 func ZipLOC() string {
 	return loc.zip
 }
 
+// MP3LOC returns the LOC format identified by MPEG layer III frames.
+func MP3LOC() string {
+	return loc.mp3
+}
+
+// MP2LOC returns the LOC format identified by MPEG layer II frames.
+func MP2LOC() string {
+	return loc.mp2
+}
+
+// MatroskaLOC returns the LOC format identified by the "matroska" EBML doctype.
+func MatroskaLOC() string {
+	return loc.mkv
+}
+
+// WebMLOC returns the LOC format identified by the "webm" EBML doctype.
+func WebMLOC() string {
+	return loc.webm
+}
+
+// BMFFLOC returns the ftyp brands that identify a LOC format, if it is identified by the bmff matcher.
+func BMFFLOC(id string) []string {
+	return loc.brands[id]
+}
+
+// StructLOC returns the kinds of structured text (e.g. "json" or "csv") that identify a LOC format, if it is identified by the struct matcher.
+func StructLOC(id string) []string {
+	return loc.structs[id]
+}
+
+// RefineLOC returns the family of formats (e.g. "tiff") that a LOC format's structure is parsed as to refine it, if it can be refined after matching.
+func RefineLOC(id string) string {
+	return loc.refine[id]
+}
+
+// RefinedLOC returns the LOC formats that refinement can upgrade to for a kind of more specific format (e.g. "geotiff").
+func RefinedLOC(kind string) []string {
+	return loc.refined[kind]
+}
+
+// DataLOC returns the variants of scientific data formats (e.g. "sqlite3" or "hdf5-2") that identify a LOC format, if it is identified by the data matcher.
+func DataLOC(id string) []string {
+	return loc.data[id]
+}
+
 func NoPRONOM() bool {
 	return loc.nopronom
 }
@@ -71,3 +176,8 @@ func SetLOC(fdd string) func() private {
 		return private{}
 	}
 }
+
+// SetLOCHarvestURL sets the URL of the LOC format descriptions website harvested by roy harvest loc.
+func SetLOCHarvestURL(u string) {
+	loc.harvest = u
+}
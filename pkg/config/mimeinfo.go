@@ -29,7 +29,21 @@ var mimeinfo = struct {
 	tar      string
 	arc      string
 	warc     string
+	bzip2    string
+	bzip     string // freedesktop.org's name for bzip2
+	xz       string
+	zstd     string
+	eml      string
+	msg      string
 	text     string
+	mp3      string // raw MPEG audio identified by the mpeg matcher
+	mp2      string
+	ebml     map[string][2]string // MIME-types identified by the ebml matcher, with their doctype and track qualifier
+	brands   map[string][]string  // MIME-types identified by the bmff matcher, with their ftyp brands
+	structs  map[string][]string  // MIME-types identified by the struct matcher, with their kinds of structured text
+	data     map[string][]string  // MIME-types identified by the data matcher, with their variants of scientific data formats
+	refine   map[string]string    // MIME-types refined after matching, with the family of formats their structure is parsed as
+	refined  map[string][]string  // the MIME-types refinement can upgrade to, for each kind of more specific format
 }{
 	versions: "mime-info.json",
 	zip:      "application/zip",
@@ -37,7 +51,61 @@ var mimeinfo = struct {
 	tar:      "application/x-tar",
 	arc:      "application/x-arc",
 	warc:     "application/x-warc",
+	bzip2:    "application/x-bzip2",
+	bzip:     "application/x-bzip",
+	xz:       "application/x-xz",
+	zstd:     "application/zstd",
+	eml:      "message/rfc822",
+	msg:      "application/vnd.ms-outlook",
 	text:     "text/plain",
+	mp3:      "audio/mpeg",
+	mp2:      "audio/mp2",
+	ebml: map[string][2]string{
+		"application/x-matroska": {"matroska", ""},
+		"video/x-matroska":       {"matroska", "video"},
+		"audio/x-matroska":       {"matroska", "audio"},
+		"video/webm":             {"webm", "video"},
+		"audio/webm":             {"webm", "audio"},
+	},
+	brands: map[string][]string{
+		"video/mp4":           {"isom", "iso2", "mp41", "mp42", "avc1", "MSNV"},
+		"video/x-m4v":         {"M4V "},
+		"audio/mp4":           {"M4A "},
+		"audio/x-m4b":         {"M4B "},
+		"video/quicktime":     {"qt  "},
+		"video/3gpp":          {"3gp4", "3gp5", "3gp6", "3gp7", "3ge6", "3ge7", "3gg6"},
+		"video/3gpp2":         {"3g2a", "3g2b", "3g2c"},
+		"video/mj2":           {"mjp2"},
+		"image/heif":          {"mif1"},
+		"image/heif-sequence": {"msf1"},
+		"image/heic":          {"heic", "heix"},
+		"image/heic-sequence": {"hevc", "hevx"},
+		"image/avif":          {"avif"},
+		"image/avif-sequence": {"avis"},
+	},
+	structs: map[string][]string{
+		"application/json":          {"json", "ndjson"},
+		"application/x-ndjson":      {"ndjson"},
+		"application/x-yaml":        {"yaml"},
+		"application/yaml":          {"yaml"},
+		"text/x-yaml":               {"yaml"},
+		"text/csv":                  {"csv"},
+		"text/tab-separated-values": {"tsv"},
+	},
+	data: map[string][]string{
+		"application/x-sqlite3":   {"sqlite3"},
+		"application/vnd.sqlite3": {"sqlite3"},
+		"application/x-hdf":       {"hdf5-0", "hdf5-1", "hdf5-2", "hdf5-3"},
+		"application/x-netcdf":    {"netcdf4", "netcdf4-classic", "netcdf-classic", "netcdf-64bit-offset", "netcdf-64bit-data"},
+	},
+	refine: map[string]string{
+		"image/tiff": "tiff",
+		"image/jp2":  "jp2",
+	},
+	refined: map[string][]string{
+		"dng": {"image/x-adobe-dng", "image/x-raw-adobe"}, // freedesktop.org and tika names
+		"jpx": {"image/jpx"},
+	},
 }
 
 // MIMEInfo returns the location of the MIMEInfo signature file.
@@ -68,6 +136,47 @@ func TextMIME() string {
 	return mimeinfo.text
 }
 
+// MP3MIME returns the MIME-type identified by MPEG audio frames.
+func MP3MIME() string {
+	return mimeinfo.mp3
+}
+
+// MP2MIME returns the MIME-type identified by MPEG layer II frames, if the MIME-info file distinguishes these.
+func MP2MIME() string {
+	return mimeinfo.mp2
+}
+
+// EBMLMIME returns the EBML doctype and track qualifier (e.g. "audio") for a MIME-type, if it is identified by the ebml matcher.
+func EBMLMIME(mime string) ([2]string, bool) {
+	e, ok := mimeinfo.ebml[mime]
+	return e, ok
+}
+
+// BMFFMIME returns the ftyp brands that identify a MIME-type, if it is identified by the bmff matcher.
+func BMFFMIME(mime string) []string {
+	return mimeinfo.brands[mime]
+}
+
+// StructMIME returns the kinds of structured text (e.g. "json" or "csv") that identify a MIME-type, if it is identified by the struct matcher.
+func StructMIME(mime string) []string {
+	return mimeinfo.structs[mime]
+}
+
+// RefineMIME returns the family of formats (e.g. "tiff") that a MIME-type's structure is parsed as to refine it, if it can be refined after matching.
+func RefineMIME(mime string) string {
+	return mimeinfo.refine[mime]
+}
+
+// RefinedMIME returns the MIME-types that refinement can upgrade to for a kind of more specific format (e.g. "jpx").
+func RefinedMIME(kind string) []string {
+	return mimeinfo.refined[kind]
+}
+
+// DataMIME returns the variants of scientific data formats (e.g. "sqlite3" or "hdf5-2") that identify a MIME-type, if it is identified by the data matcher.
+func DataMIME(mime string) []string {
+	return mimeinfo.data[mime]
+}
+
 func SetMIMEInfo(mi string) func() private {
 	return func() private {
 		loc.fdd = "" // reset loc to prevent pollution
@@ -32,9 +32,14 @@ var pronom = struct {
 	doubleup         bool     // include byte signatures for formats that also have container signatures
 	extendc          []string //container extensions
 	changesURL       string
+	signaturesURL    string // page listing the DROID and container signature files
 	harvestURL       string
 	harvestTimeout   time.Duration
 	harvestThrottle  time.Duration
+	harvestRetries   int  // times to retry failed requests, backing off exponentially
+	harvestResume    bool // skip reports that have already been harvested
+	harvestNoCache   bool // don't make conditional requests for reports that have already been harvested
+	harvestProgress  func(done, total int)
 	harvestTransport *http.Transport
 	// archive puids
 	zip    string
@@ -43,14 +48,30 @@ var pronom = struct {
 	arc    string
 	arc1_1 string
 	warc   string
+	bzip2  string
+	xz     string
+	// email puids
+	eml       string
+	mimeEmail string
+	msg       string
 	// text puid
 	text string
+	// structured text identified by the struct matcher
+	structs map[string][]string
+	// scientific data formats identified by the data matcher
+	data map[string][]string
+	// formats refined after matching, with the family of formats their structure is parsed as
+	refine map[string]string
+	// the puids that refinement can upgrade to, for each kind of more specific format
+	refined map[string][]string
 }{
 	name:             "pronom",
 	reports:          "pronom",
 	changesURL:       "http://www.nationalarchives.gov.uk/aboutapps/pronom/release-notes.xml",
+	signaturesURL:    "https://www.nationalarchives.gov.uk/aboutapps/pronom/droid-signature-files.htm",
 	harvestURL:       "http://www.nationalarchives.gov.uk/pronom/",
 	harvestTimeout:   120 * time.Second,
+	harvestRetries:   3,
 	harvestTransport: &http.Transport{Proxy: http.ProxyFromEnvironment},
 	zip:              "x-fmt/263",
 	tar:              "x-fmt/265",
@@ -58,7 +79,47 @@ var pronom = struct {
 	arc:              "x-fmt/219",
 	arc1_1:           "fmt/410",
 	warc:             "fmt/289",
+	bzip2:            "x-fmt/268",
+	xz:               "fmt/1098",
+	eml:              "fmt/278",
+	mimeEmail:        "fmt/950",
+	msg:              "x-fmt/430",
 	text:             "x-fmt/111",
+	structs: map[string][]string{
+		"fmt/817":  {"json", "ndjson"}, // JSON Data Interchange Format
+		"fmt/818":  {"yaml"},           // YAML
+		"x-fmt/18": {"csv"},            // Comma Separated Values
+		"x-fmt/13": {"tsv"},            // Tab-separated values
+	},
+	data: map[string][]string{
+		"fmt/729": {"sqlite3"},             // SQLite Database File Format 3
+		"fmt/807": {"hdf5-0"},              // HDF5 0
+		"fmt/286": {"hdf5-1"},              // HDF5 1
+		"fmt/287": {"hdf5-2"},              // HDF5 2
+		"fmt/282": {"netcdf-classic"},      // netCDF-3 Classic
+		"fmt/283": {"netcdf-64bit-offset"}, // netCDF-3 64-bit
+	},
+	refine: map[string]string{
+		"fmt/353":   "tiff", // Tagged Image File Format
+		"fmt/7":     "tiff", // TIFF 3
+		"fmt/8":     "tiff", // TIFF 4
+		"fmt/9":     "tiff", // TIFF 5
+		"fmt/10":    "tiff", // TIFF 6
+		"x-fmt/399": "tiff", // Exif (Uncompressed) 2.0
+		"x-fmt/388": "tiff", // Exif (Uncompressed) 2.1
+		"x-fmt/387": "tiff", // Exif (Uncompressed) 2.2
+		"x-fmt/392": "jp2",  // JP2 (JPEG 2000 part 1)
+	},
+	refined: map[string][]string{
+		"geotiff": {"fmt/155"}, // GeoTIFF
+		"tiff-ep": {"fmt/154"}, // TIFF/EP
+		"dng-1.0": {"fmt/436"}, // DNG 1.0
+		"dng-1.1": {"fmt/152"}, // DNG 1.1
+		"dng-1.2": {"fmt/437"}, // DNG 1.2
+		"dng-1.3": {"fmt/438"}, // DNG 1.3
+		"dng-1.4": {"fmt/730"}, // DNG 1.4
+		"jpx":     {"fmt/151"}, // JPX (JPEG 2000 part 2)
+	},
 }
 
 // GETTERS
@@ -182,11 +243,37 @@ func ChangesURL() string {
 	return pronom.changesURL
 }
 
+// SignatureFilesURL reports the URL of the page that links to the DROID and container signature files.
+func SignatureFilesURL() string {
+	return pronom.signaturesURL
+}
+
 // HarvestOptions reports the PRONOM url, timeout and transport.
 func HarvestOptions() (string, time.Duration, time.Duration, *http.Transport) {
 	return pronom.harvestURL, pronom.harvestTimeout, pronom.harvestThrottle, pronom.harvestTransport
 }
 
+// HarvestRetries reports the number of times a failed harvesting request is retried.
+func HarvestRetries() int {
+	return pronom.harvestRetries
+}
+
+// HarvestResume reports whether harvesting skips PRONOM reports that have already been saved.
+func HarvestResume() bool {
+	return pronom.harvestResume
+}
+
+// HarvestNoCache reports whether harvesting fetches every PRONOM report in full, rather than making conditional requests
+// for reports already harvested.
+func HarvestNoCache() bool {
+	return pronom.harvestNoCache
+}
+
+// HarvestProgress returns the function called as each PRONOM report is harvested (or nil).
+func HarvestProgress() func(done, total int) {
+	return pronom.harvestProgress
+}
+
 // ZipPuid reports the puid for a zip archive.
 func ZipPuid() string {
 	return pronom.zip
@@ -197,6 +284,26 @@ func TextPuid() string {
 	return pronom.text
 }
 
+// StructPuid returns the kinds of structured text (e.g. "json" or "csv") that identify a puid, if it is identified by the struct matcher.
+func StructPuid(puid string) []string {
+	return pronom.structs[puid]
+}
+
+// DataPuid returns the variants of scientific data formats (e.g. "sqlite3" or "hdf5-2") that identify a puid, if it is identified by the data matcher.
+func DataPuid(puid string) []string {
+	return pronom.data[puid]
+}
+
+// RefinePuid returns the family of formats (e.g. "tiff") that a puid's structure is parsed as to refine it, if it can be refined after matching.
+func RefinePuid(puid string) string {
+	return pronom.refine[puid]
+}
+
+// RefinedPuid returns the puids that refinement can upgrade to for a kind of more specific format (e.g. "geotiff").
+func RefinedPuid(kind string) []string {
+	return pronom.refined[kind]
+}
+
 // SETTERS
 
 // SetDroid sets the name and/or location of the DROID signature file.
@@ -252,6 +359,42 @@ func SetHarvestThrottle(d time.Duration) {
 	pronom.harvestThrottle = d
 }
 
+// SetHarvestRetries sets the number of times a failed harvesting request is retried. Retries back off exponentially.
+func SetHarvestRetries(i int) {
+	pronom.harvestRetries = i
+}
+
+// SetHarvestResume sets whether harvesting skips PRONOM reports that have already been saved, to resume an interrupted harvest.
+func SetHarvestResume(b bool) {
+	pronom.harvestResume = b
+}
+
+// SetHarvestNoCache sets whether harvesting fetches every PRONOM report in full, rather than making conditional requests
+// for reports already harvested.
+func SetHarvestNoCache(b bool) {
+	pronom.harvestNoCache = b
+}
+
+// SetHarvestProgress sets a function to call as each PRONOM report is harvested, with counts of reports done and in total.
+func SetHarvestProgress(f func(done, total int)) {
+	pronom.harvestProgress = f
+}
+
+// SetChangesURL sets the URL of the PRONOM release notes.
+func SetChangesURL(u string) {
+	pronom.changesURL = u
+}
+
+// SetSignatureFilesURL sets the URL of the page that links to the DROID and container signature files.
+func SetSignatureFilesURL(u string) {
+	pronom.signaturesURL = u
+}
+
+// SetHarvestURL sets the URL that PRONOM reports are harvested from.
+func SetHarvestURL(u string) {
+	pronom.harvestURL = u
+}
+
 // SetHarvestTransport sets the PRONOM harvesting transport.
 func SetHarvestTransport(t *http.Transport) {
 	pronom.harvestTransport = t
@@ -0,0 +1,77 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "fmt"
+
+// Segmentation holds the bytematcher settings that control how byte signatures are split into segments, and how segments are
+// turned into the sequences searched for with Aho-Corasick. They trade the size of the search trees (memory and load time) against
+// the number of follow-up tests made while scanning (speed, especially for large files). See Distance, Range, Choices, Cost and
+// Repetition for the effect of each.
+//
+// Identifiers capture the segmentation settings in effect when they are created (e.g. by pronom.New), so identifiers added to the
+// same signature file can be tuned separately. Run roy build -tune to see the effect of the settings on the size of the search trees.
+type Segmentation struct {
+	Distance   int // maximum distance between frames in a segment (-1 for no maximum)
+	Range      int // maximum range between frames in a segment (-1 for no maximum)
+	Choices    int // maximum number of sequences generated from a segment
+	Cost       int // maximum worst case matches for a segment before it is left unsegmented
+	Repetition int // maximum repetition in a segment before it is left unsegmented (with Cost)
+}
+
+// DefaultSegmentation returns the default segmentation settings.
+func DefaultSegmentation() Segmentation {
+	return Segmentation{Distance: 8192, Range: 4096, Choices: 128, Cost: 25600000, Repetition: 4}
+}
+
+// Validate checks that segmentation settings are usable.
+func (s Segmentation) Validate() error {
+	switch {
+	case s.Distance < -1:
+		return fmt.Errorf("bad segmentation distance %d: must be 0 or more (or -1 for no maximum)", s.Distance)
+	case s.Range < -1:
+		return fmt.Errorf("bad segmentation range %d: must be 0 or more (or -1 for no maximum)", s.Range)
+	case s.Choices < 1:
+		return fmt.Errorf("bad segmentation choices %d: must be 1 or more", s.Choices)
+	case s.Cost < 1:
+		return fmt.Errorf("bad segmentation cost %d: must be 1 or more", s.Cost)
+	case s.Repetition < 0:
+		return fmt.Errorf("bad segmentation repetition %d: must be 0 or more", s.Repetition)
+	}
+	return nil
+}
+
+func (s Segmentation) String() string {
+	return fmt.Sprintf("distance %d; range %d; choices %d; cost %d; repetition %d", s.Distance, s.Range, s.Choices, s.Cost, s.Repetition)
+}
+
+// GetSegmentation returns the segmentation settings in effect.
+func GetSegmentation() Segmentation {
+	return Segmentation{
+		Distance:   siegfried.distance,
+		Range:      siegfried.rng,
+		Choices:    siegfried.choices,
+		Cost:       siegfried.cost,
+		Repetition: siegfried.repetition,
+	}
+}
+
+// SetSegmentation sets all of the segmentation settings. Settings are validated when signatures are added to the bytematcher.
+func SetSegmentation(s Segmentation) func() private {
+	return func() private {
+		siegfried.distance, siegfried.rng, siegfried.choices, siegfried.cost, siegfried.repetition = s.Distance, s.Range, s.Choices, s.Cost, s.Repetition
+		return private{}
+	}
+}
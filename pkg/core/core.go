@@ -92,6 +92,40 @@ type Identification interface {
 	Archive() config.Archive // does this format match any of the archive formats (zip, gzip, tar, warc, arc)
 }
 
+// Fielder is an optional interface for identifications that report the labels of their values.
+type Fielder interface {
+	Fields() []string // labels for the values, in the same order (the Fields() returned by the Identifier)
+}
+
+// Map returns the values of an identification keyed by their labels (e.g. m["mime"]), so that identifier-specific fields
+// can be read without knowing their order. It returns nil if the identification doesn't report its labels (see Fielder).
+func Map(id Identification) map[string]string {
+	f, ok := id.(Fielder)
+	if !ok {
+		return nil
+	}
+	fields, values := f.Fields(), id.Values()
+	m := make(map[string]string, len(fields))
+	for i, f := range fields {
+		if i < len(values) {
+			m[f] = values[i]
+		}
+	}
+	return m
+}
+
+// Annotator is an optional interface for identifications that can take extra basis and warning information from analysis run after identification.
+type Annotator interface {
+	Annotate(basis []string, warn Warning) Identification // returns a copy of the identification with the basis appended and the warning (if any) added
+}
+
+// Refiner is an optional interface for recorders that can upgrade identifications to more specific formats (e.g. TIFF to GeoTIFF) after matching.
+// Refine is called before Report with a function that parses the file as a family of formats (e.g. "tiff"), returning the kinds of more
+// specific format found (most specific first, e.g. "geotiff") and a basis describing the structure.
+type Refiner interface {
+	Refine(parse func(family string) (kinds []string, basis string))
+}
+
 // Matcher does the matching (against the name/mime string or the byte stream) and sends results
 type Matcher interface {
 	Identify(string, *siegreader.Buffer, ...Hint) (chan Result, error) // Given a name/MIME string and bytes, identify the file. Include the collected Hints
@@ -110,8 +144,21 @@ const (
 	TextMatcher
 	XMLMatcher
 	RIFFMatcher
+	MPEGMatcher
+	EBMLMatcher
+	BMFFMatcher
+	StructMatcher
+	DataMatcher
+	ExternalMatcher // matchers registered with RegisterExternal
 )
 
+// Annotation is a Result sent by a matcher to describe a property of a file, rather than a format match.
+// Annotations aren't recorded by identifiers; instead they are added to the basis and warning fields of identifications (see Annotator).
+type Annotation interface {
+	Result
+	Annotation() (basis []string, warn Warning)
+}
+
 // SignatureSet is added to a matcher. It can take any form, depending on the matcher.
 type SignatureSet interface{}
 
@@ -120,3 +167,31 @@ type Result interface {
 	Index() int
 	Basis() string
 }
+
+// Tracer is an optional interface for following identifications as they happen, e.g. to build profiling or diagnostic tools (see Siegfried.SetTracer).
+// Each callback is given the name of the file being identified. Callbacks may be made from matchers' goroutines, and from identifications of
+// different files at the same time, so Tracers must be safe for concurrent use. They should return quickly, as they hold up the matchers.
+type Tracer interface {
+	Start(name string, mt MatcherType)                // a matcher starts identifying a file
+	Stop(name string, mt MatcherType)                 // the matcher has finished
+	Strike(name string, mt MatcherType, s Strike)     // a sequence or frame that belongs to one or more signatures was found
+	KeyFrame(name string, mt MatcherType, k KeyFrame) // a segment of a signature matched
+	Result(name string, mt MatcherType, r Result)     // the matcher sent a result
+}
+
+// Strike is a hit, made while scanning a file, on a sequence or frame that belongs to one or more signatures.
+// Further tests may be needed to determine which segments of those signatures it matches.
+type Strike struct {
+	Offset  int64 // offset of the hit, from the end of the file if Reverse is set
+	Length  int
+	Reverse bool // the hit was made scanning backwards from the end of the file
+	Frame   bool // the hit was on a frame, rather than a sequence
+}
+
+// KeyFrame is a match on a segment of a signature. A signature matches once all of its segments have matched at the required distances from each other.
+type KeyFrame struct {
+	Signature int   // index of the signature
+	Segment   int   // index of the segment within the signature
+	Offset    int64 // offset of the segment from the start of the file
+	Length    int
+}
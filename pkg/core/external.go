@@ -0,0 +1,106 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"io"
+)
+
+// External is a matcher supplied by an embedder, such as a machine-learning classifier or a wrapper for another
+// identification tool. Its results are merged with those of siegfried's own matchers (see RegisterExternal).
+type External interface {
+	Name() string // short name reported in the basis field e.g. "classifier"
+	// Identify is given the name of the file (which may be empty) and its content.
+	// It must be safe for concurrent use, as siegfried may identify several files at once.
+	Identify(name string, r io.ReaderAt, size int64) ([]ExternalResult, error)
+}
+
+// ExternalResult is a format match made by an External matcher.
+type ExternalResult struct {
+	Namespace string // the identifier the ID belongs to e.g. "pronom". If empty, the first identifier that knows the ID records it
+	ID        string // the format, as identified by that identifier, e.g. a PUID, MIME-type, FDD or Wikidata ID
+	Detail    string // optional detail for the basis field e.g. "score 0.97"
+}
+
+// Confidence sets how the results of an External matcher are weighed against those of siegfried's own matchers.
+type Confidence int
+
+const (
+	// Tentative results count for as much as a filename or MIME match: they are outranked by signature matches, and are
+	// reported with a "match on external matcher only" warning when no signature agrees. As with extension matches, a
+	// tentative match on a format whose signatures didn't match is only reported as a possibility.
+	Tentative Confidence = iota
+	// Definite results count for as much as a byte signature match.
+	Definite
+)
+
+// ExternalHit is the Result sent to recorders for each ExternalResult, with the MatcherType ExternalMatcher.
+type ExternalHit struct {
+	ExternalResult
+	Matcher    string // the name of the External matcher
+	Confidence Confidence
+}
+
+// Index returns -1: external hits identify formats by their IDs, not signature indexes.
+func (e ExternalHit) Index() int { return -1 }
+
+// Basis describes the match e.g. "classifier match score 0.97".
+func (e ExternalHit) Basis() string {
+	if e.Detail == "" {
+		return e.Matcher + " match"
+	}
+	return e.Matcher + " match " + e.Detail
+}
+
+// For reports whether the hit should be recorded by the named identifier.
+func (e ExternalHit) For(namespace string) bool {
+	return e.Namespace == "" || e.Namespace == namespace
+}
+
+type registeredExternal struct {
+	External
+	conf Confidence
+}
+
+var externals []registeredExternal
+
+// RegisterExternal adds a matcher whose results are merged with those of siegfried's own matchers, for all identifications made
+// after it is registered. RegisterExternal isn't safe for concurrent use: call it from an init function, or before identifying files.
+func RegisterExternal(e External, c Confidence) {
+	externals = append(externals, registeredExternal{e, c})
+}
+
+// HasExternal reports whether any External matchers are registered.
+func HasExternal() bool {
+	return len(externals) > 0
+}
+
+// IdentifyExternal runs the registered External matchers, returning their results as hits to record.
+// An error from a matcher is returned (with the matcher's name) after the other matchers have run.
+func IdentifyExternal(name string, r io.ReaderAt, size int64) ([]ExternalHit, error) {
+	var hits []ExternalHit
+	var err error
+	for _, e := range externals {
+		res, eerr := e.Identify(name, r, size)
+		if eerr != nil && err == nil {
+			err = fmt.Errorf("%s: %v", e.Name(), eerr)
+		}
+		for _, v := range res {
+			hits = append(hits, ExternalHit{v, e.Name(), e.conf})
+		}
+	}
+	return hits, err
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "strings"
+
+// WarningCode is a machine-readable code for a kind of identification warning.
+// Codes are stable: a code, once assigned, keeps its meaning in later versions even if the warning text changes.
+type WarningCode string
+
+// Warning codes
+const (
+	WarnOther             WarningCode = "W000" // a warning not covered by the other codes
+	WarnNoMatch           WarningCode = "W001" // no match (possibilities may be listed)
+	WarnMultipleMatches   WarningCode = "W002" // multiple formats matched equally
+	WarnWeakMatch         WarningCode = "W003" // match on extension, MIME, text or glob only
+	WarnSignatureMismatch WarningCode = "W004" // byte or XML signatures for the matched format did not match
+	WarnExtMismatch       WarningCode = "W005" // extension mismatch
+	WarnMIMEMismatch      WarningCode = "W006" // MIME mismatch
+	WarnScanAbandoned     WarningCode = "W007" // scan abandoned after -maxscantime
+	WarnScanLimited       WarningCode = "W008" // byte scan limited by -bof or -eof
+	WarnEncrypted         WarningCode = "W009" // pdf is encrypted
+	WarnEmbeddedSkipped   WarningCode = "W010" // embedded files in a pdf were skipped
+	WarnEncryptedEntries  WarningCode = "W011" // encrypted zip entries were skipped
+	WarnCorruptArchive    WarningCode = "W012" // truncated or corrupt tar
+	WarnNotDecompressed   WarningCode = "W013" // archive not decompressed (-zdepth limit)
+	WarnMacros            WarningCode = "W014" // contains macros
+	WarnContainerGuess    WarningCode = "W015" // zip subtype guessed from the names of its entries
+	WarnMixedLineEndings  WarningCode = "W016" // text has mixed line endings (-textprofile)
+	WarnDuplicate         WarningCode = "W017" // duplicate of a file already identified (-dedupe)
+	WarnInvalidName       WarningCode = "W018" // filename is not valid UTF-8
+)
+
+// Warning is an identification warning: a message, with the code for its kind of warning.
+// Warnings are given their codes where they are created, so that codes don't depend on the wording of messages.
+type Warning struct {
+	Code WarningCode
+	Msg  string
+}
+
+// WarningCoder is an optional interface for identifications that report the codes of their warnings.
+type WarningCoder interface {
+	WarnCodes() []WarningCode // codes for the warnings in Warn, in order and without duplicates
+}
+
+// AddWarning adds a warning to a warning message (messages are "; " separated) and its code to a list of codes, unless
+// already listed. It returns the new message and codes. The codes are copied rather than appended to in place, as
+// identifications that share them may be annotated separately.
+func AddWarning(msg string, codes []WarningCode, w Warning) (string, []WarningCode) {
+	if w.Msg == "" {
+		return msg, codes
+	}
+	if msg == "" {
+		msg = w.Msg
+	} else {
+		msg += "; " + w.Msg
+	}
+	for _, c := range codes {
+		if c == w.Code {
+			return msg, codes
+		}
+	}
+	return msg, append(codes[:len(codes):len(codes)], w.Code)
+}
+
+// WarnCodes returns the codes for an identification's warnings. Warnings of identifications that don't report
+// their codes (see WarningCoder) are given the code WarnOther.
+func WarnCodes(id Identification) []WarningCode {
+	if wc, ok := id.(WarningCoder); ok {
+		return wc.WarnCodes()
+	}
+	if id.Warn() != "" {
+		return []WarningCode{WarnOther}
+	}
+	return nil
+}
+
+// JoinCodes returns codes as a single "; " separated value e.g. "W003; W005", for the warncode field of results.
+func JoinCodes(codes []WarningCode) string {
+	strs := make([]string, len(codes))
+	for i, c := range codes {
+		strs[i] = string(c)
+	}
+	return strings.Join(strs, "; ")
+}
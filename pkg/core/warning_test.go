@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+type testWarnID string
+
+func (t testWarnID) String() string          { return "" }
+func (t testWarnID) Known() bool             { return true }
+func (t testWarnID) Warn() string            { return string(t) }
+func (t testWarnID) Values() []string        { return nil }
+func (t testWarnID) Archive() config.Archive { return config.None }
+
+func TestAddWarning(t *testing.T) {
+	msg, codes := AddWarning("", nil, Warning{})
+	if msg != "" || codes != nil {
+		t.Errorf("expecting an empty warning to be ignored, got %q %v", msg, codes)
+	}
+	msg, codes = AddWarning("match on extension only", []WarningCode{WarnWeakMatch}, Warning{WarnExtMismatch, "extension mismatch"})
+	msg, codes = AddWarning(msg, codes, Warning{WarnExtMismatch, "filename mismatch"})
+	if msg != "match on extension only; extension mismatch; filename mismatch" || JoinCodes(codes) != "W003; W005" {
+		t.Errorf("bad warning: %q %s", msg, JoinCodes(codes))
+	}
+	// codes are copied, so identifications sharing them can be annotated separately
+	shared := make([]WarningCode, 1, 4)
+	shared[0] = WarnNoMatch
+	_, a := AddWarning("no match", shared, Warning{WarnEncrypted, "pdf is encrypted"})
+	_, b := AddWarning("no match", shared, Warning{WarnMacros, "contains macros"})
+	if JoinCodes(a) != "W001; W009" || JoinCodes(b) != "W001; W014" {
+		t.Errorf("expecting separate codes, got %v and %v", a, b)
+	}
+}
+
+func TestWarnCodes(t *testing.T) {
+	if codes := WarnCodes(testWarnID("")); codes != nil {
+		t.Errorf("expecting no codes without a warning, got %v", codes)
+	}
+	if codes := WarnCodes(testWarnID("something new")); JoinCodes(codes) != string(WarnOther) {
+		t.Errorf("expecting %s for an identification that doesn't report codes, got %v", WarnOther, codes)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decompress
+
+import (
+	"compress/bzip2"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// compressedD unpacks the single file within a bzip2, xz or Zstandard stream. Unlike gzip, these formats don't
+// record the name, modification time or (reliably) the size of the file they compress, so Size returns -1.
+type compressedD struct {
+	p     string
+	read  bool
+	rdr   io.Reader
+	close func()
+}
+
+func newCompressed(arc config.Archive, b *siegreader.Buffer, path string) (Decompressor, error) {
+	b.Quit = make(chan struct{}) // in case a stream with a closed quit channel, make a new one
+	c := &compressedD{p: path}
+	var err error
+	switch arc {
+	case config.Bzip2:
+		c.rdr = bzip2.NewReader(siegreader.ReaderFrom(b))
+	case config.XZ:
+		c.rdr, err = xz.NewReader(siegreader.ReaderFrom(b))
+	case config.Zstd:
+		var z *zstd.Decoder
+		z, err = zstd.NewReader(siegreader.ReaderFrom(b), zstd.WithDecoderConcurrency(1))
+		if err == nil {
+			c.rdr, c.close = z, z.Close
+		}
+	}
+	return c, err
+}
+
+func (c *compressedD) Next() error {
+	if c.read {
+		if c.close != nil {
+			c.close()
+		}
+		return io.EOF
+	}
+	c.read = true
+	return nil
+}
+
+func (c *compressedD) Reader() io.Reader {
+	return c.rdr
+}
+
+// Path names the file within the stream after the stream itself, minus its extension: data.csv.bz2 becomes
+// data.csv and, for the short forms of compressed tars, data.txz becomes data.tar.
+func (c *compressedD) Path() string {
+	base := filepath.Base(c.p)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	switch strings.ToLower(filepath.Ext(base)) {
+	case ".bz2", ".bz", ".xz", ".zst", ".zstd":
+	case ".tbz2", ".tbz", ".tb2", ".txz", ".tzst":
+		name += ".tar"
+	default:
+		name = base
+	}
+	return Arcpath(c.p, name)
+}
+
+func (c *compressedD) MIME() string {
+	return ""
+}
+
+func (c *compressedD) Size() int64 {
+	return -1
+}
+
+func (c *compressedD) Mod() time.Time {
+	return time.Time{}
+}
+
+func (c *compressedD) Dirs() []string {
+	return nil
+}
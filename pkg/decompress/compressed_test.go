@@ -0,0 +1,59 @@
+package decompress
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+func TestCompressed(t *testing.T) {
+	const contents = "contents of a.tar"
+	for _, test := range []struct {
+		arc  config.Archive
+		path string
+		name string
+	}{
+		{config.XZ, "a.tar.xz", "a.tar.xz#a.tar"},
+		{config.XZ, "a.txz", "a.txz#a.tar"},
+		{config.Zstd, "a.tar.zst", "a.tar.zst#a.tar"},
+		{config.Zstd, "a.TZST", "a.TZST#a.tar"},
+	} {
+		buf := &bytes.Buffer{}
+		var w io.WriteCloser
+		if test.arc == config.XZ {
+			w, _ = xz.NewWriter(buf)
+		} else {
+			w, _ = zstd.NewWriter(buf)
+		}
+		io.WriteString(w, contents)
+		w.Close()
+		b, err := siegreader.New().Get(bytes.NewReader(buf.Bytes()))
+		if err != nil && err != io.EOF {
+			t.Fatal(err)
+		}
+		d, err := New(test.arc, b, test.path, int64(buf.Len()))
+		if err != nil {
+			t.Fatalf("%s: %v", test.path, err)
+		}
+		if err := d.Next(); err != nil {
+			t.Fatalf("%s: %v", test.path, err)
+		}
+		if d.Path() != test.name {
+			t.Errorf("%s: expecting the entry to be named %s, got %s", test.path, test.name, d.Path())
+		}
+		byts, err := ioutil.ReadAll(d.Reader())
+		if err != nil || string(byts) != contents {
+			t.Errorf("%s: expecting %q, got %q (%v)", test.path, contents, byts, err)
+		}
+		if err := d.Next(); err != io.EOF {
+			t.Errorf("%s: expecting a single entry, got %v", test.path, err)
+		}
+	}
+}
@@ -12,11 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package decompress provides zip, tar, gzip and webarchive decompression/unpacking
+// Package decompress provides zip, tar, gzip, bzip2, xz, zstd, webarchive, OLE2, PDF and mail (eml and msg) decompression/unpacking
 package decompress
 
 import (
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"path/filepath"
 	"strings"
@@ -41,6 +43,14 @@ func SetDroid() {
 	droidOutput = true
 }
 
+// package flag for checking zip entries against their stored CRC32 checksums
+var verifyCRC bool
+
+// SetVerifyCRC has zip decompressors calculate the CRC32 checksum of each entry as it is read, so that it can be checked with Verify.
+func SetVerifyCRC() {
+	verifyCRC = true
+}
+
 func IsArc(ids []core.Identification) config.Archive {
 	var arc config.Archive
 	for _, id := range ids {
@@ -51,15 +61,8 @@ func IsArc(ids []core.Identification) config.Archive {
 	return arc
 }
 
-type Decompressor interface {
-	Next() error // when finished, should return io.EOF
-	Reader() io.Reader
-	Path() string
-	MIME() string
-	Size() int64
-	Mod() time.Time
-	Dirs() []string
-}
+// Decompressor is defined in pkg/config so that decompressors can be registered with config.RegisterArchive.
+type Decompressor = config.Decompressor
 
 func New(arc config.Archive, buf *siegreader.Buffer, path string, sz int64) (Decompressor, error) {
 	switch arc {
@@ -68,11 +71,24 @@ func New(arc config.Archive, buf *siegreader.Buffer, path string, sz int64) (Dec
 	case config.Gzip:
 		return newGzip(buf, path)
 	case config.Tar:
-		return newTar(siegreader.ReaderFrom(buf), path)
+		return newTar(buf, path)
 	case config.ARC:
 		return newARC(siegreader.ReaderFrom(buf), path)
 	case config.WARC:
 		return newWARC(siegreader.ReaderFrom(buf), path)
+	case config.Bzip2, config.XZ, config.Zstd:
+		return newCompressed(arc, buf, path)
+	case config.OLE2:
+		return newOLE2(siegreader.ReaderFrom(buf), path)
+	case config.EML:
+		return NewMail(siegreader.ReaderFrom(buf), path)
+	case config.MSG:
+		return newMsg(siegreader.ReaderFrom(buf), path)
+	case config.PDFArchive:
+		return newPDF(siegreader.ReaderFrom(buf), path, sz)
+	}
+	if c := config.ArchiveConstructorFor(arc); c != nil {
+		return c(siegreader.ReaderFrom(buf), path, sz)
 	}
 	return nil, fmt.Errorf("Decompress: unknown archive type %v", arc)
 }
@@ -82,12 +98,27 @@ type zipD struct {
 	p       string
 	rdr     *zip.Reader
 	rc      io.ReadCloser
+	crc     *crcReader // set if verifying CRC32 checksums
+	skipped int        // count of encrypted entries
 	written map[string]bool
 }
 
 func newZip(ra io.ReaderAt, path string, sz int64) (Decompressor, error) {
 	zr, err := zip.NewReader(ra, sz)
-	return &zipD{idx: -1, p: path, rdr: zr}, err
+	z := &zipD{idx: -1, p: path, rdr: zr}
+	if err == nil {
+		for _, f := range zr.File {
+			if encrypted(f) {
+				z.skipped++
+			}
+		}
+	}
+	return z, err
+}
+
+// encrypted reports whether a zip entry is password protected (bit 0 of the general purpose flag is set)
+func encrypted(f *zip.File) bool {
+	return f.Flags&0x1 != 0
 }
 
 func (z *zipD) close() {
@@ -101,21 +132,93 @@ func (z *zipD) Next() error {
 	z.close() // close the previous entry, if any
 	// proceed
 	z.idx++
-	// scan past directories
-	for ; z.idx < len(z.rdr.File) && z.rdr.File[z.idx].FileInfo().IsDir(); z.idx++ {
+	// scan past directories and encrypted entries
+	for ; z.idx < len(z.rdr.File) && (z.rdr.File[z.idx].FileInfo().IsDir() || encrypted(z.rdr.File[z.idx])); z.idx++ {
 	}
 	if z.idx >= len(z.rdr.File) {
 		return io.EOF
 	}
 	var err error
 	z.rc, err = z.rdr.File[z.idx].Open()
+	z.crc = nil
+	if err == nil && verifyCRC {
+		z.crc = &crcReader{rdr: z.rc, h: crc32.NewIEEE()}
+	}
 	return err
 }
 
 func (z *zipD) Reader() io.Reader {
+	if z.crc != nil {
+		return z.crc
+	}
 	return z.rc
 }
 
+// crcReader calculates the CRC32 checksum of a zip entry as it is read (if h is set). The checksum errors returned by archive/zip
+// and compress/gzip at the end of a corrupt entry are replaced with io.EOF, so that the entry can still be identified, and the mismatch
+// is reported by Verify instead.
+type crcReader struct {
+	rdr io.Reader
+	h   hash.Hash32
+	bad bool // a checksum error was returned
+	eof bool
+}
+
+func (c *crcReader) Read(p []byte) (int, error) {
+	n, err := c.rdr.Read(p)
+	if c.h != nil {
+		c.h.Write(p[:n])
+	}
+	if err == zip.ErrChecksum || err == gzip.ErrChecksum {
+		c.bad, err = true, io.EOF
+	}
+	if err == io.EOF {
+		c.eof = true
+	}
+	return n, err
+}
+
+// Verify reports an error if the current entry doesn't match the CRC32 checksum stored for it: in the trailer of a gzip or,
+// if SetVerifyCRC has been called, in a zip archive. The entry must have been read to its end. It returns nil for other archive types.
+func Verify(d Decompressor) error {
+	switch v := d.(type) {
+	case *zipD:
+		if v.crc == nil || !v.crc.eof {
+			return nil
+		}
+		stored, sum := v.rdr.File[v.idx].CRC32, v.crc.h.Sum32()
+		if stored != 0 && stored != sum { // like archive/zip, treat a zero CRC32 as unknown
+			return fmt.Errorf("zip entry CRC32 mismatch: stored %08x, calculated %08x", stored, sum)
+		}
+	case *gzipD:
+		if v.crc.bad {
+			return fmt.Errorf("gzip CRC32 mismatch: the decompressed data doesn't match the checksum in the gzip trailer")
+		}
+	}
+	return nil
+}
+
+// Warnings reports problems found when an archive is opened: encrypted zip entries, which are skipped, truncated or corrupt tars,
+// and embedded files in PDFs that can't be decoded.
+// They apply to the archive itself, rather than to any of its entries.
+func Warnings(d Decompressor) []core.Warning {
+	switch v := d.(type) {
+	case *zipD:
+		if v.skipped > 0 {
+			return []core.Warning{{Code: core.WarnEncryptedEntries, Msg: fmt.Sprintf("encrypted entries skipped: %d", v.skipped)}}
+		}
+	case *tarD:
+		if v.warn.Msg != "" {
+			return []core.Warning{v.warn}
+		}
+	case *pdfD:
+		if v.warn.Msg != "" {
+			return []core.Warning{v.warn}
+		}
+	}
+	return nil
+}
+
 func (z *zipD) Path() string {
 	return Arcpath(z.p, filepath.FromSlash(characterize.ZipName(z.rdr.File[z.idx].Name)))
 }
@@ -143,11 +246,35 @@ type tarD struct {
 	p       string
 	hdr     *tar.Header
 	rdr     *tar.Reader
+	warn    core.Warning // set if the tar is truncated or corrupt
 	written map[string]bool
 }
 
-func newTar(r io.Reader, path string) (Decompressor, error) {
-	return &tarD{p: path, rdr: tar.NewReader(r)}, nil
+func newTar(b *siegreader.Buffer, path string) (Decompressor, error) {
+	return &tarD{
+		p:    path,
+		rdr:  tar.NewReader(siegreader.ReaderFrom(b)),
+		warn: checkTar(siegreader.ReaderFrom(b), b.SizeNow()),
+	}, nil
+}
+
+// checkTar walks the headers of a tar, seeking past the contents of its entries, to find whether it is truncated or corrupt
+func checkTar(ra io.ReaderAt, sz int64) core.Warning {
+	tr := tar.NewReader(io.NewSectionReader(ra, 0, sz))
+	var n int
+	for {
+		_, err := tr.Next()
+		switch err {
+		case nil:
+			n++
+			continue
+		case io.EOF:
+			return core.Warning{}
+		case io.ErrUnexpectedEOF:
+			return core.Warning{Code: core.WarnCorruptArchive, Msg: fmt.Sprintf("truncated tar: data ends unexpectedly after %d entries", n)}
+		}
+		return core.Warning{Code: core.WarnCorruptArchive, Msg: fmt.Sprintf("corrupt tar after %d entries: %v", n, err)}
+	}
 }
 
 func (t *tarD) Next() error {
@@ -190,6 +317,7 @@ type gzipD struct {
 	p    string
 	read bool
 	rdr  *gzip.Reader
+	crc  *crcReader
 }
 
 func newGzip(b *siegreader.Buffer, path string) (Decompressor, error) {
@@ -201,7 +329,7 @@ func newGzip(b *siegreader.Buffer, path string) (Decompressor, error) {
 	}
 	sz := int64(uint32(buf[0]) | uint32(buf[1])<<8 | uint32(buf[2])<<16 | uint32(buf[3])<<24)
 	g, err := gzip.NewReader(siegreader.ReaderFrom(b))
-	return &gzipD{sz: sz, p: path, rdr: g}, err
+	return &gzipD{sz: sz, p: path, rdr: g, crc: &crcReader{rdr: g}}, err
 }
 
 func (g *gzipD) Next() error {
@@ -214,7 +342,7 @@ func (g *gzipD) Next() error {
 }
 
 func (g *gzipD) Reader() io.Reader {
-	return g.rdr
+	return g.crc
 }
 
 func (g *gzipD) Path() string {
@@ -0,0 +1,131 @@
+package decompress
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+func TestVerifyCRC(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, name := range []string{"good.txt", "bad.txt"} {
+		w, _ := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		io.WriteString(w, "contents of "+name)
+	}
+	zw.Close()
+	byts := buf.Bytes()
+	byts[bytes.Index(byts, []byte("bad.txtcontents"))+len("bad.txt")] = 'C' // corrupt the content of bad.txt, but not its name
+	SetVerifyCRC()
+	defer func() { verifyCRC = false }()
+	d, err := newZip(bytes.NewReader(byts), "test.zip", int64(len(byts)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, bad := range []bool{false, true} {
+		if err := d.Next(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.Copy(ioutil.Discard, d.Reader()); err != nil {
+			t.Fatalf("%s: expecting the entry to be read without error, got %v", d.Path(), err)
+		}
+		err := Verify(d)
+		if bad && (err == nil || !strings.Contains(err.Error(), "CRC32 mismatch")) {
+			t.Errorf("%s: expecting a CRC32 mismatch, got %v", d.Path(), err)
+		}
+		if !bad && err != nil {
+			t.Errorf("%s: expecting no error, got %v", d.Path(), err)
+		}
+	}
+}
+
+func TestEncryptedZip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for i, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		w, _ := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Flags: uint16(i % 2)}) // b.txt is flagged as encrypted
+		io.WriteString(w, "contents of "+name)
+	}
+	zw.Close()
+	d, err := newZip(bytes.NewReader(buf.Bytes()), "test.zip", int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := Warnings(d); len(w) != 1 || w[0].Msg != "encrypted entries skipped: 1" || w[0].Code != core.WarnEncryptedEntries {
+		t.Errorf("expecting a warning that an encrypted entry is skipped, got %v", w)
+	}
+	var names []string
+	for err = d.Next(); err == nil; err = d.Next() {
+		names = append(names, d.Path())
+	}
+	if len(names) != 2 || names[1] != "test.zip#c.txt" {
+		t.Errorf("expecting the encrypted entry to be skipped, got %v", names)
+	}
+}
+
+func TestTruncatedTar(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, name := range []string{"a.txt", "b.txt"} {
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: 1000})
+		tw.Write(make([]byte, 1000))
+	}
+	tw.Close()
+	if w := checkTar(bytes.NewReader(buf.Bytes()), int64(buf.Len())); w.Msg != "" {
+		t.Errorf("expecting no warning for a complete tar, got %s", w.Msg)
+	}
+	if w := checkTar(bytes.NewReader(buf.Bytes()), 2000); !strings.HasPrefix(w.Msg, "truncated tar") || w.Code != core.WarnCorruptArchive {
+		t.Errorf("expecting a truncated tar warning, got %v", w)
+	}
+}
+
+func TestPDFWarnings(t *testing.T) {
+	doc := "%PDF-1.7\n" +
+		"1 0 obj\n<< /Type /Catalog /Names << /EmbeddedFiles 2 0 R >> >>\nendobj\n" +
+		"2 0 obj\n<< /Names [ (a.bin) 3 0 R ] >>\nendobj\n" +
+		"3 0 obj\n<< /Type /Filespec /F (a.bin) /EF << /F 4 0 R >> >>\nendobj\n" +
+		"4 0 obj\n<< /Type /EmbeddedFile /Filter /LZWDecode /Length 3 >>\nstream\nabc\nendstream\nendobj\n" +
+		"trailer\n<< /Root 1 0 R /Size 5 >>\n%%EOF\n"
+	d, err := newPDF(strings.NewReader(doc), "test.pdf", int64(len(doc)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := Warnings(d); len(w) != 1 || w[0].Msg != "embedded files skipped (unsupported filters): 1" || w[0].Code != core.WarnEmbeddedSkipped {
+		t.Errorf("expecting a warning that an embedded file is skipped, got %v", w)
+	}
+	if err = d.Next(); err != io.EOF {
+		t.Errorf("expecting no embedded files, got %v", err)
+	}
+}
+
+func TestBadGzip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	io.WriteString(gw, "contents of a.txt")
+	gw.Close()
+	byts := buf.Bytes()
+	byts[len(byts)-8] ^= 0xff // corrupt the CRC32 in the trailer
+	b, err := siegreader.New().Get(bytes.NewReader(byts))
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	d, err := newGzip(b, "a.txt.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	if _, err := io.Copy(ioutil.Discard, d.Reader()); err != nil {
+		t.Fatalf("expecting the entry to be read without error, got %v", err)
+	}
+	if err := Verify(d); err == nil || !strings.Contains(err.Error(), "CRC32 mismatch") {
+		t.Errorf("expecting a CRC32 mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decompress
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path"
+	"strings"
+	"time"
+)
+
+type attachment struct {
+	name string
+	mime string
+	mod  time.Time
+	data []byte
+}
+
+type mailD struct {
+	p       string
+	mod     time.Time
+	idx     int
+	stack   []*multipart.Reader
+	pending *attachment // a message that is itself a single attachment
+	cur     *attachment
+}
+
+// NewMail returns a Decompressor for the attachments of an RFC 822/MIME message.
+// Multipart bodies are walked recursively. Attached messages (message/rfc822) are returned as attachments rather than opened.
+// Each attachment's MIME type is its declared content-type and its path uses its declared filename.
+func NewMail(r io.Reader, path string) (Decompressor, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	m := &mailD{p: path}
+	m.mod, _ = msg.Header.Date()
+	att, err := m.part(textproto.MIMEHeader(msg.Header), msg.Body)
+	m.pending = att
+	return m, err
+}
+
+func (m *mailD) Next() error {
+	if m.pending != nil {
+		m.cur, m.pending = m.pending, nil
+		return nil
+	}
+	for len(m.stack) > 0 {
+		p, err := m.stack[len(m.stack)-1].NextPart()
+		if err == io.EOF {
+			m.stack = m.stack[:len(m.stack)-1]
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		att, err := m.part(p.Header, p)
+		if err != nil {
+			return err
+		}
+		if att != nil {
+			m.cur = att
+			return nil
+		}
+	}
+	return io.EOF
+}
+
+// part inspects the headers of a message part: if it is multipart, its reader is pushed onto the stack;
+// if it is an attachment, it is decoded and returned; otherwise it is skipped and nil returned
+func (m *mailD) part(hdr textproto.MIMEHeader, body io.Reader) (*attachment, error) {
+	mt, params, _ := mime.ParseMediaType(hdr.Get("Content-Type"))
+	if strings.HasPrefix(mt, "multipart/") && params["boundary"] != "" {
+		m.stack = append(m.stack, multipart.NewReader(body, params["boundary"]))
+		return nil, nil
+	}
+	disp, dparams, _ := mime.ParseMediaType(hdr.Get("Content-Disposition"))
+	name := dparams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+	if disp != "attachment" && name == "" && mt != "message/rfc822" {
+		return nil, nil
+	}
+	m.idx++
+	if dec, err := new(mime.WordDecoder).DecodeHeader(name); err == nil {
+		name = dec
+	}
+	if name = path.Base(strings.Replace(name, "\\", "/", -1)); name == "." || name == "/" {
+		name = fmt.Sprintf("attachment%d", m.idx)
+	}
+	switch strings.ToLower(strings.TrimSpace(hdr.Get("Content-Transfer-Encoding"))) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding attachment %s: %v", name, err)
+	}
+	mod := m.mod
+	if t, err := mail.ParseDate(dparams["modification-date"]); err == nil {
+		mod = t
+	}
+	if mt == "application/octet-stream" { // not a useful hint
+		mt = ""
+	}
+	return &attachment{name, mt, mod, data}, nil
+}
+
+func (m *mailD) Reader() io.Reader {
+	return bytes.NewReader(m.cur.data)
+}
+
+func (m *mailD) Path() string {
+	return Arcpath(m.p, m.cur.name)
+}
+
+func (m *mailD) MIME() string {
+	return m.cur.mime
+}
+
+func (m *mailD) Size() int64 {
+	return int64(len(m.cur.data))
+}
+
+func (m *mailD) Mod() time.Time {
+	return m.cur.mod
+}
+
+func (m *mailD) Dirs() []string {
+	return nil
+}
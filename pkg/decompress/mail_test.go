@@ -0,0 +1,77 @@
+package decompress
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+var msg = strings.Replace(`From: a@example.com
+To: b@example.com
+Date: Mon, 2 Jan 2006 15:04:05 -0700
+Subject: attachments
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain
+
+body text
+--inner
+Content-Type: text/html
+
+<p>body text</p>
+--inner--
+--outer
+Content-Type: application/pdf; name="ignored.pdf"
+Content-Disposition: attachment; filename="report.pdf"
+Content-Transfer-Encoding: base64
+
+JVBERi0xLjQK
+--outer
+Content-Type: text/csv
+Content-Disposition: attachment; filename="=?UTF-8?Q?d=C3=A4ta.csv?="
+Content-Transfer-Encoding: quoted-printable
+
+a,b=3Dc
+--outer
+Content-Type: message/rfc822
+
+Subject: forwarded
+
+hello
+--outer--
+`, "\n", "\r\n", -1)
+
+func TestMail(t *testing.T) {
+	d, err := NewMail(strings.NewReader(msg), "msg.eml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []struct{ path, mime, content string }{
+		{"msg.eml#report.pdf", "application/pdf", "%PDF-1.4\n"},
+		{"msg.eml#däta.csv", "text/csv", "a,b=c"},
+		{"msg.eml#attachment3", "message/rfc822", "Subject: forwarded\r\n\r\nhello"},
+	}
+	var i int
+	for err = d.Next(); err == nil; err = d.Next() {
+		if i >= len(expect) {
+			t.Fatalf("unexpected attachment %s", d.Path())
+		}
+		byt, _ := ioutil.ReadAll(d.Reader())
+		if d.Path() != expect[i].path || d.MIME() != expect[i].mime || string(byt) != expect[i].content {
+			t.Errorf("expecting %v, got %s %s %q", expect[i], d.Path(), d.MIME(), byt)
+		}
+		if d.Mod().Year() != 2006 {
+			t.Errorf("expecting the message date, got %v", d.Mod())
+		}
+		i++
+	}
+	if err != io.EOF || i != len(expect) {
+		t.Errorf("expecting %d attachments, got %d (%v)", len(expect), i, err)
+	}
+}
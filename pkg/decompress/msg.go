@@ -0,0 +1,151 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decompress
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/richardlehane/mscfb"
+)
+
+// Outlook .msg files store each attachment in a storage named __attach_version1.0_#XXXXXXXX. Its properties are streams
+// named __substg1.0_ followed by the property tag: the property ID and type (001F for UTF-16 strings, 001E for 8-bit strings).
+const (
+	msgAttach       = "__attach_version1.0_#"
+	msgData         = "__substg1.0_37010102" // PR_ATTACH_DATA_BIN
+	msgLongName     = "__substg1.0_3707"     // PR_ATTACH_LONG_FILENAME
+	msgName         = "__substg1.0_3704"     // PR_ATTACH_FILENAME
+	msgDisplayName  = "__substg1.0_3001"     // PR_DISPLAY_NAME
+	msgMIME         = "__substg1.0_370E"     // PR_ATTACH_MIME_TAG
+	msgUnicode      = "001F"
+	msgString8      = "001E"
+	msgMaxPropBytes = 4096 // string properties longer than this are truncated
+)
+
+type msgAttachment struct {
+	storage string
+	mod     time.Time
+	data    *mscfb.File
+	names   [3]string // long filename, filename and display name, in order of preference
+	mime    string
+}
+
+// msgD lists the attachments of an Outlook .msg file. Attachments that are themselves messages (embedded in the
+// attachment's storage rather than stored as data) are skipped.
+type msgD struct {
+	p   string
+	idx int
+	att []*msgAttachment
+}
+
+func newMsg(ra io.ReaderAt, path string) (Decompressor, error) {
+	r, err := mscfb.New(ra)
+	if err != nil {
+		return nil, err
+	}
+	m := &msgD{p: path, idx: -1}
+	atts := make(map[string]*msgAttachment)
+	for f, err := r.Next(); err == nil; f, err = r.Next() {
+		if len(f.Path) == 0 && strings.HasPrefix(f.Name, msgAttach) && f.FileInfo().IsDir() {
+			a := &msgAttachment{storage: f.Name, mod: f.Modified()}
+			atts[f.Name], m.att = a, append(m.att, a)
+			continue
+		}
+		if len(f.Path) != 1 || atts[f.Path[0]] == nil || f.FileInfo().IsDir() {
+			continue
+		}
+		a := atts[f.Path[0]]
+		switch {
+		case f.Name == msgData:
+			a.data = f
+		case strings.HasPrefix(f.Name, msgLongName):
+			a.names[0] = msgString(f)
+		case strings.HasPrefix(f.Name, msgName):
+			a.names[1] = msgString(f)
+		case strings.HasPrefix(f.Name, msgDisplayName):
+			a.names[2] = msgString(f)
+		case strings.HasPrefix(f.Name, msgMIME):
+			a.mime = msgString(f)
+		}
+	}
+	sort.Slice(m.att, func(i, j int) bool { return m.att[i].storage < m.att[j].storage })
+	return m, nil
+}
+
+// msgString reads a string property: UTF-16 (little endian) or, for the 001E type, 8-bit text
+func msgString(f *mscfb.File) string {
+	byts, _ := ioutil.ReadAll(io.LimitReader(f, msgMaxPropBytes))
+	if strings.HasSuffix(f.Name, msgString8) {
+		return strings.TrimRight(string(byts), "\x00")
+	}
+	if !strings.HasSuffix(f.Name, msgUnicode) {
+		return ""
+	}
+	u := make([]uint16, len(byts)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(byts[i*2:])
+	}
+	return strings.TrimRight(string(utf16.Decode(u)), "\x00")
+}
+
+func (m *msgD) Next() error {
+	for m.idx++; m.idx < len(m.att) && m.att[m.idx].data == nil; m.idx++ {
+	}
+	if m.idx >= len(m.att) {
+		return io.EOF
+	}
+	return nil
+}
+
+func (m *msgD) Reader() io.Reader {
+	return m.att[m.idx].data
+}
+
+func (m *msgD) Path() string {
+	a := m.att[m.idx]
+	for _, name := range a.names {
+		if name = path.Base(strings.Replace(name, "\\", "/", -1)); name != "." && name != "/" {
+			return Arcpath(m.p, name)
+		}
+	}
+	return Arcpath(m.p, fmt.Sprintf("attachment%d", m.idx+1))
+}
+
+func (m *msgD) MIME() string {
+	if mt := m.att[m.idx].mime; mt != "application/octet-stream" { // not a useful hint
+		return mt
+	}
+	return ""
+}
+
+func (m *msgD) Size() int64 {
+	return m.att[m.idx].data.Size
+}
+
+func (m *msgD) Mod() time.Time {
+	return m.att[m.idx].mod
+}
+
+func (m *msgD) Dirs() []string {
+	return nil
+}
@@ -0,0 +1,44 @@
+package decompress
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestMsg(t *testing.T) {
+	f, err := os.Open("testdata/attachments.msg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	d, err := newMsg(f, "test.msg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []struct {
+		path string
+		mime string
+		size int64
+	}{
+		{"test.msg#test.doc", "", 12288},
+		{"test.msg#image001.gif", "image/gif", 2864},
+	}
+	var i int
+	for err = d.Next(); err == nil; err = d.Next() {
+		if i >= len(expect) {
+			t.Fatalf("unexpected attachment %s", d.Path())
+		}
+		if d.Path() != expect[i].path || d.MIME() != expect[i].mime || d.Size() != expect[i].size {
+			t.Errorf("expecting %v, got %s %s %d", expect[i], d.Path(), d.MIME(), d.Size())
+		}
+		if n, err := io.Copy(ioutil.Discard, d.Reader()); err != nil || n != expect[i].size {
+			t.Errorf("%s: expecting to read %d bytes, got %d (%v)", d.Path(), expect[i].size, n, err)
+		}
+		i++
+	}
+	if err != io.EOF || i != len(expect) {
+		t.Errorf("expecting %d attachments, got %d (%v)", len(expect), i, err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decompress
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/mscfb"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+var ole2Sig = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// IsOLE2 reports whether a buffer begins with the signature of an OLE2 compound file (e.g. a .doc, .xls or .msg).
+func IsOLE2(buf *siegreader.Buffer) bool {
+	b, err := buf.Slice(0, len(ole2Sig))
+	return err == nil && bytes.Equal(b, ole2Sig)
+}
+
+// ole2D lists the streams within an OLE2 compound file as its entries. Storages (the compound file's directories)
+// aren't entries themselves, but are reported as directories in droid output.
+type ole2D struct {
+	p       string
+	rdr     *mscfb.Reader
+	entry   *mscfb.File
+	written map[string]bool
+}
+
+func newOLE2(ra io.ReaderAt, path string) (Decompressor, error) {
+	r, err := mscfb.New(ra)
+	return &ole2D{p: path, rdr: r}, err
+}
+
+func (o *ole2D) Next() error {
+	var err error
+	for o.entry, err = o.rdr.Next(); err == nil && o.entry.FileInfo().IsDir(); o.entry, err = o.rdr.Next() {
+	}
+	return err
+}
+
+func (o *ole2D) Reader() io.Reader {
+	return o.entry
+}
+
+// the slash separated path of the current stream within the compound file
+func (o *ole2D) name() string {
+	return strings.Join(append(append([]string{}, o.entry.Path...), o.entry.Name), "/")
+}
+
+func (o *ole2D) Path() string {
+	return Arcpath(o.p, filepath.FromSlash(o.name()))
+}
+
+func (o *ole2D) MIME() string {
+	return ""
+}
+
+func (o *ole2D) Size() int64 {
+	return o.entry.Size
+}
+
+func (o *ole2D) Mod() time.Time {
+	return o.entry.Modified()
+}
+
+func (o *ole2D) Dirs() []string {
+	if o.written == nil {
+		o.written = make(map[string]bool)
+	}
+	return dirs(o.p, o.name(), o.written)
+}
@@ -0,0 +1,35 @@
+package decompress
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/richardlehane/siegfried/internal/siegreader"
+)
+
+func TestOLE2(t *testing.T) {
+	f, err := os.Open("../../cmd/sf/testdata/skeleton-suite/containers/fmt-39-container-signature-id-1000.doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	b, err := siegreader.New().Get(f)
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	if !IsOLE2(b) {
+		t.Fatal("expecting the OLE2 signature")
+	}
+	d, err := newOLE2(siegreader.ReaderFrom(b), "test.doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for err = d.Next(); err == nil; err = d.Next() {
+		names = append(names, d.Path())
+	}
+	if len(names) != 2 || names[0] != "test.doc#CompObj" || names[1] != "test.doc#WordDocument" {
+		t.Errorf("expecting the CompObj and WordDocument streams, got %v", names)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decompress
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/pdf"
+	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// IsPDF reports whether a buffer begins with a PDF header.
+func IsPDF(buf *siegreader.Buffer) bool {
+	return pdf.IsPDF(buf)
+}
+
+// pdfD lists the files embedded in a PDF. Files that can't be decoded (because the PDF is encrypted, or their
+// streams use filters other than /FlateDecode) are skipped and reported by Warnings.
+type pdfD struct {
+	p     string
+	ra    io.ReaderAt
+	idx   int
+	files []*pdf.File
+	rdr   io.Reader
+	warn  core.Warning // set if embedded files are skipped
+}
+
+func newPDF(ra io.ReaderAt, path string, sz int64) (Decompressor, error) {
+	files, encrypted, err := pdf.EmbeddedFiles(ra, sz)
+	if err != nil {
+		return nil, err
+	}
+	p := &pdfD{p: path, ra: ra, idx: -1}
+	if encrypted {
+		if len(files) > 0 {
+			p.warn = core.Warning{Code: core.WarnEmbeddedSkipped, Msg: fmt.Sprintf("pdf is encrypted: %d embedded files not identified", len(files))}
+		}
+		return p, nil
+	}
+	var skipped int
+	for _, f := range files {
+		if f.Supported() {
+			p.files = append(p.files, f)
+		} else {
+			skipped++
+		}
+	}
+	if skipped > 0 {
+		p.warn = core.Warning{Code: core.WarnEmbeddedSkipped, Msg: fmt.Sprintf("embedded files skipped (unsupported filters): %d", skipped)}
+	}
+	return p, nil
+}
+
+func (p *pdfD) Next() error {
+	p.idx++
+	if p.idx >= len(p.files) {
+		return io.EOF
+	}
+	var err error
+	p.rdr, err = p.files[p.idx].Reader(p.ra)
+	return err
+}
+
+func (p *pdfD) Reader() io.Reader {
+	return p.rdr
+}
+
+func (p *pdfD) Path() string {
+	name := path.Base(strings.Replace(p.files[p.idx].Name, "\\", "/", -1))
+	if name == "." || name == "/" {
+		name = fmt.Sprintf("attachment%d", p.idx+1)
+	}
+	return Arcpath(p.p, name)
+}
+
+func (p *pdfD) MIME() string {
+	return p.files[p.idx].MIME
+}
+
+func (p *pdfD) Size() int64 {
+	return p.files[p.idx].Size
+}
+
+func (p *pdfD) Mod() time.Time {
+	return p.files[p.idx].Mod
+}
+
+func (p *pdfD) Dirs() []string {
+	return nil
+}
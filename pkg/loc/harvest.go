@@ -0,0 +1,135 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loc
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/richardlehane/siegfried/internal/harvest"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/loc/internal/mappings"
+)
+
+var fddID = regexp.MustCompile(`fdd\d{6}`)
+
+// Harvest downloads the current set of FDD XML files from the LOC format descriptions website (see config.LOCHarvestURL)
+// and packages them in a zip at path, in the layout read by the LOC identifier. Requests are limited, timed-out and retried
+// with the PRONOM harvesting options (config.HarvestOptions and config.HarvestRetries), and progress is reported with
+// config.HarvestProgress. The zip is only written if every description is harvested, so an existing zip is left as it
+// is if the harvest fails.
+func Harvest(path string) []error {
+	url := config.LOCHarvestURL()
+	list, err := harvest.Get(url + "fdd/browse_list.shtml")
+	if err != nil {
+		return []error{err}
+	}
+	ids := fddIDs(list)
+	if len(ids) == 0 {
+		return []error{fmt.Errorf("loc: no format descriptions listed at %sfdd/browse_list.shtml", url)}
+	}
+	descs := make([][]byte, len(ids))
+	progress := config.HarvestProgress()
+	var mu sync.Mutex
+	var done int
+	errs := harvest.ApplyAll(5, len(ids), func(i int) error {
+		var err error
+		descs[i], err = fetchFDD(url, ids[i])
+		if progress != nil {
+			mu.Lock()
+			done++
+			progress(done, len(ids))
+			mu.Unlock()
+		}
+		return err
+	})
+	if len(errs) > 0 {
+		return errs
+	}
+	if err := writeZip(path, ids, descs); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// fddIDs returns the sorted, unique FDD identifiers linked from the browse list
+func fddIDs(list []byte) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, id := range fddID.FindAll(list, -1) {
+		if !seen[string(id)] {
+			seen[string(id)] = true
+			ids = append(ids, string(id))
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// fetchFDD fetches a format description and checks that it is the description asked for
+func fetchFDD(url, id string) ([]byte, error) {
+	byts, err := harvest.Get(url + "fddXML/" + id + ".xml")
+	if err != nil {
+		return nil, err
+	}
+	var f mappings.FDD
+	if err := xml.Unmarshal(byts, &f); err != nil {
+		return nil, fmt.Errorf("loc: error parsing %s: %v", id, err)
+	}
+	if f.ID != id {
+		return nil, fmt.Errorf("loc: expecting format description %s, got %q", id, f.ID)
+	}
+	return byts, nil
+}
+
+// writeZip writes the descriptions to a temporary file first so that a failed write doesn't replace an existing zip
+func writeZip(path string, ids []string, descs [][]byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.Create(path + ".part")
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(f)
+	now := time.Now()
+	for i, id := range ids {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: "fddXML/" + id + ".xml", Method: zip.Deflate, Modified: now})
+		if err == nil {
+			_, err = w.Write(descs[i])
+		}
+		if err != nil {
+			f.Close()
+			os.Remove(path + ".part")
+			return err
+		}
+	}
+	err = zw.Close()
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		os.Remove(path + ".part")
+		return err
+	}
+	return os.Rename(path+".part", path)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
 	"github.com/richardlehane/siegfried/internal/identifier"
+	"github.com/richardlehane/siegfried/internal/mpegmatcher"
 	"github.com/richardlehane/siegfried/internal/priority"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/loc/internal/mappings"
@@ -113,7 +114,11 @@ func (f formatInfo) String() string {
 func infos(m map[string]identifier.FormatInfo) map[string]formatInfo {
 	i := make(map[string]formatInfo, len(m))
 	for k, v := range m {
-		i[k] = v.(formatInfo)
+		fi := v.(formatInfo)
+		if config.Compact() {
+			fi.name, fi.longName = "", ""
+		}
+		i[k] = fi
 	}
 	return i
 }
@@ -277,6 +282,69 @@ func (f fdds) RIFFs() ([][4]byte, []string) {
 	return riffs, ids
 }
 
+// MPEGs assigns MPEG audio layers to the LOC formats for MP3 files and Layer II audio.
+func (f fdds) MPEGs() ([]byte, []string) {
+	mpegs, ids := make([]byte, 0, 2), make([]string, 0, 2)
+	for _, v := range f.f {
+		switch v.ID {
+		case config.MP3LOC():
+			mpegs, ids = append(mpegs, mpegmatcher.LayerIII), append(ids, v.ID)
+		case config.MP2LOC():
+			mpegs, ids = append(mpegs, mpegmatcher.LayerII), append(ids, v.ID)
+		}
+	}
+	return mpegs, ids
+}
+
+// EBMLs assigns EBML doctypes to the LOC formats for Matroska and WebM.
+func (f fdds) EBMLs() ([][2]string, []string) {
+	ebmls, ids := make([][2]string, 0, 2), make([]string, 0, 2)
+	for _, v := range f.f {
+		switch v.ID {
+		case config.MatroskaLOC():
+			ebmls, ids = append(ebmls, [2]string{"matroska", ""}), append(ids, v.ID)
+		case config.WebMLOC():
+			ebmls, ids = append(ebmls, [2]string{"webm", ""}), append(ids, v.ID)
+		}
+	}
+	return ebmls, ids
+}
+
+// Brands assigns ftyp brands to the LOC formats for the MP4 family of ISO base media files.
+func (f fdds) Brands() ([][4]byte, []string) {
+	brands, ids := make([][4]byte, 0, len(f.f)), make([]string, 0, len(f.f))
+	for _, v := range f.f {
+		for _, b := range config.BMFFLOC(v.ID) {
+			var brand [4]byte
+			copy(brand[:], b)
+			brands, ids = append(brands, brand), append(ids, v.ID)
+		}
+	}
+	return brands, ids
+}
+
+// Structs assigns kinds of structured text to the LOC formats for JSON and CSV.
+func (f fdds) Structs() ([]string, []string) {
+	kinds, ids := make([]string, 0, 3), make([]string, 0, 3)
+	for _, v := range f.f {
+		for _, k := range config.StructLOC(v.ID) {
+			kinds, ids = append(kinds, k), append(ids, v.ID)
+		}
+	}
+	return kinds, ids
+}
+
+// Data assigns variants of scientific data formats to the LOC formats for SQLite, HDF5 and netCDF.
+func (f fdds) Data() ([]string, []string) {
+	variants, ids := make([]string, 0, 9), make([]string, 0, 9)
+	for _, v := range f.f {
+		for _, d := range config.DataLOC(v.ID) {
+			variants, ids = append(variants, d), append(ids, v.ID)
+		}
+	}
+	return variants, ids
+}
+
 func (f fdds) Priorities() priority.Map {
 	p := make(priority.Map)
 	for _, v := range f.f {
@@ -16,10 +16,16 @@ package loc
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/richardlehane/siegfried/internal/harvest"
 	"github.com/richardlehane/siegfried/pkg/config"
 )
 
@@ -68,3 +74,60 @@ func TestUpdated(t *testing.T) {
 		t.Fatalf("expected %v, got %v", expect, f.Updated())
 	}
 }
+
+func TestHarvest(t *testing.T) {
+	var mu sync.Mutex
+	var failed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fdd/browse_list.shtml":
+			fmt.Fprint(w, `<a href="fdd000002.shtml">B</a><a href="fdd000001.shtml">A</a><a href="fdd000001.shtml">A</a>`)
+		case "/fddXML/fdd000001.xml", "/fddXML/fdd000002.xml":
+			mu.Lock()
+			retry := !failed
+			failed = true
+			mu.Unlock()
+			if retry {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			id := filepath.Base(r.URL.Path)
+			fmt.Fprintf(w, `<fdd:FDD id="%s" titleName="Test" xmlns:fdd="http://www.loc.gov/preservation/digital/formats/schemas/fdd/v1"/>`, id[:len(id)-4])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "harvest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldURL, oldBackoff := config.LOCHarvestURL(), harvest.Backoff
+	defer func() {
+		config.SetLOCHarvestURL(oldURL)
+		harvest.Backoff = oldBackoff
+	}()
+	config.SetLOCHarvestURL(srv.URL + "/")
+	harvest.Backoff = time.Millisecond
+	config.SetHome(filepath.Join("..", "..", "cmd", "roy", "data"))
+	path := filepath.Join(dir, "fddXML.zip")
+	if errs := Harvest(path); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	l, err := newLOC(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := l.IDs(); len(ids) != 2 || ids[0] != "fdd000001" || ids[1] != "fdd000002" {
+		t.Errorf("expecting two harvested format descriptions, got %v", ids)
+	}
+	// a failed harvest leaves the zip as it is
+	config.SetLOCHarvestURL(srv.URL + "/missing/")
+	if errs := Harvest(path); len(errs) == 0 {
+		t.Error("expecting an error harvesting from a missing page")
+	}
+	if _, err := newLOC(path); err != nil {
+		t.Errorf("expecting the earlier zip to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+// +build go1.18
+
+package mimeinfo
+
+import (
+	"testing"
+
+	"github.com/richardlehane/siegfried/pkg/mimeinfo/internal/mappings"
+)
+
+// FuzzMagic parses arbitrary magic matches, as found in edited freedesktop.org or tika MIME-info files.
+// Bad magic must return errors rather than panic. Run with `go test -fuzz FuzzMagic ./pkg/mimeinfo`.
+func FuzzMagic(f *testing.F) {
+	f.Add("string", "0", "%PDF-", "")
+	f.Add("string", "0:64", "\\x89PNG\\r\\n", "")
+	f.Add("stringignorecase", "8", "<html", "")
+	f.Add("big16", "0", "0xcafe", "0xffff")
+	f.Add("little32", "4:8", "1234", "")
+	f.Add("byte", "2", "0x7f", "")
+	f.Add("string", "0", "0x504b0304", "0xffff00ff")
+	f.Add("unicodeLE", "0", "\\377\\376", "")
+	f.Fuzz(func(t *testing.T, typ, offset, value, mask string) {
+		toFrames(mappings.Match{Typ: typ, Offset: offset, Value: value, Mask: mask})
+	})
+}
@@ -37,7 +37,13 @@ type Identifier struct {
 func (i *Identifier) Save(ls *persist.LoadSaver) {
 	ls.SaveByte(core.MIMEInfo)
 	ls.SaveSmallInt(len(i.infos))
-	for k, v := range i.infos {
+	keys := make([]string, 0, len(i.infos))
+	for k := range i.infos {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := i.infos[k]
 		ls.SaveString(k)
 		ls.SaveString(v.comment)
 		ls.SaveBool(v.text)
@@ -98,8 +104,20 @@ func New(opts ...config.Option) (core.Identifier, error) {
 	}, nil
 }
 
+// Describe returns the format information recorded for a MIME-type.
+func (i *Identifier) Describe(id string) ([][2]string, bool) {
+	inf, ok := i.infos[id]
+	if !ok {
+		return nil, false
+	}
+	return [][2]string{{"format", inf.comment}, {"mime", id}}, true
+}
+
+// fields of an identification, in the order of its values
+var fields = []string{"namespace", "id", "format", "mime", "basis", "warning", "warncode"}
+
 func (i *Identifier) Fields() []string {
-	return []string{"namespace", "id", "format", "mime", "basis", "warning"}
+	return fields
 }
 
 func (i *Identifier) Recorder() core.Recorder {
@@ -164,6 +182,16 @@ func (r *Recorder) Record(m core.MatcherType, res core.Result) bool {
 		} else {
 			return false
 		}
+	case core.MPEGMatcher, core.EBMLMatcher, core.BMFFMatcher, core.StructMatcher, core.DataMatcher:
+		if hit, id := r.Hit(m, res.Index()); hit {
+			if r.satisfied {
+				return true
+			}
+			r.ids = add(r.ids, r.Name(), id, r.infos[id], res.Basis(), m, 0)
+			return true
+		} else {
+			return false
+		}
 	case core.TextMatcher:
 		if hit, _ := r.Hit(m, res.Index()); hit {
 			if r.satisfied {
@@ -176,6 +204,16 @@ func (r *Recorder) Record(m core.MatcherType, res core.Result) bool {
 		} else {
 			return false
 		}
+	case core.ExternalMatcher:
+		e, ok := res.(core.ExternalHit)
+		if !ok || !e.For(r.Name()) {
+			return false
+		}
+		if _, ok := r.infos[e.ID]; !ok {
+			return false
+		}
+		r.ids = add(r.ids, r.Name(), e.ID, r.infos[e.ID], e.Basis(), m, int(e.Confidence))
+		return true
 	}
 }
 
@@ -188,6 +226,10 @@ func (r *Recorder) Satisfied(mt core.MatcherType) (bool, core.Hint) {
 		return false, core.Hint{}
 	}
 	sort.Sort(r.ids)
+	// the data matcher refines a match for a format that it validates (e.g. application/x-hdf for a netCDF-4 file)
+	if mt == core.DataMatcher && (len(r.ids) == 0 || r.HasSig(r.ids[0].ID, core.DataMatcher)) {
+		return false, core.Hint{}
+	}
 	if len(r.ids) > 0 && (r.ids[0].xmlMatch || (r.ids[0].magicScore > 0 && r.ids[0].ID != config.TextMIME())) {
 		if mt == core.ByteMatcher {
 			return true, core.Hint{r.Start(mt), nil}
@@ -197,6 +239,68 @@ func (r *Recorder) Satisfied(mt core.MatcherType) (bool, core.Hint) {
 	return false, core.Hint{}
 }
 
+// Refine upgrades magic matches for formats like TIFF and JP2 to the more specific formats (e.g. DNG or JPX) revealed by parsing the
+// file's structure. If no more specific format is in the identifier, or it has already been matched, the basis is just added. A weaker match for the more
+// specific format (e.g. on extension alone) is merged into the upgraded identification.
+func (r *Recorder) Refine(parse func(string) ([]string, string)) {
+	for i := 0; i < len(r.ids); i++ {
+		v := r.ids[i]
+		family := config.RefineMIME(v.ID)
+		if family == "" || v.magicScore == 0 {
+			continue
+		}
+		kinds, basis := parse(family)
+		if len(kinds) == 0 {
+			continue
+		}
+		id, j := r.refined(kinds)
+		if id == "" {
+			r.ids[i].Basis = append(r.ids[i].Basis, basis)
+			continue
+		}
+		r.ids[i].ID, r.ids[i].Name, r.ids[i].archive = id, r.infos[id].comment, config.IsArchive(id)
+		r.ids[i].Basis = append(r.ids[i].Basis, basis+"; refined from "+v.ID)
+		if j > -1 {
+			w := r.ids[j]
+			if w.globScore > r.ids[i].globScore {
+				r.ids[i].globScore = w.globScore
+			}
+			r.ids[i].mimeMatch = r.ids[i].mimeMatch || w.mimeMatch
+			for _, b := range w.Basis {
+				if !contains(r.ids[i].Basis, b) {
+					r.ids[i].Basis = append(r.ids[i].Basis, b)
+				}
+			}
+			r.ids = append(r.ids[:j], r.ids[j+1:]...)
+			if j < i {
+				i--
+			}
+		}
+	}
+}
+
+// refined returns the MIME-type for the most specific kind of format that is in the identifier, and the index of any weaker
+// match for it (or -1). Nothing is returned if the more specific format has already been matched.
+func (r *Recorder) refined(kinds []string) (string, int) {
+	for _, k := range kinds {
+		for _, id := range config.RefinedMIME(k) {
+			if _, ok := r.infos[id]; !ok {
+				continue
+			}
+			for j, v := range r.ids {
+				if v.ID == id {
+					if v.xmlMatch || v.magicScore > 0 {
+						return "", -1
+					}
+					return id, j
+				}
+			}
+			return id, -1
+		}
+	}
+	return "", -1
+}
+
 func (r *Recorder) Report() []core.Identification {
 	// no results
 	if len(r.ids) == 0 {
@@ -204,6 +308,7 @@ func (r *Recorder) Report() []core.Identification {
 			Namespace: r.Name(),
 			ID:        "UNKNOWN",
 			Warning:   "no match",
+			codes:     []core.WarningCode{core.WarnNoMatch},
 		}}
 	}
 	sort.Sort(r.ids)
@@ -235,6 +340,7 @@ func (r *Recorder) Report() []core.Identification {
 				Namespace: r.Name(),
 				ID:        "UNKNOWN",
 				Warning:   fmt.Sprintf("no match; possibilities based on %s are %v", conf, strings.Join(poss, ", ")),
+				codes:     []core.WarningCode{core.WarnNoMatch},
 			}}
 		}
 		r.ids = nids
@@ -252,6 +358,7 @@ func (r *Recorder) Report() []core.Identification {
 			Namespace: r.Name(),
 			ID:        "UNKNOWN",
 			Warning:   fmt.Sprintf("multiple matches %v", strings.Join(poss, ", ")),
+			codes:     []core.WarningCode{core.WarnMultipleMatches},
 		}}
 	}
 	ret := make([]core.Identification, len(r.ids))
@@ -279,35 +386,23 @@ func (r *Recorder) updateWarning(i Identification) Identification {
 	// weak match
 	if !i.xmlMatch && i.magicScore == 0 {
 		lowConfidence := confidenceTrick()
-		if len(i.Warning) > 0 {
-			i.Warning += "; " + "match on " + lowConfidence(i) + " only"
-		} else {
-			i.Warning = "match on " + lowConfidence(i) + " only"
-		}
+		i = i.addWarning(core.WarnWeakMatch, "match on "+lowConfidence(i)+" only")
 		// if the match has no corresponding byte or xml signature...
-		if r.HasSig(i.ID, core.XMLMatcher, core.ByteMatcher) {
-			i.Warning += "; byte/xml signatures for this format did not match"
+		if r.HasSig(i.ID, core.XMLMatcher, core.ByteMatcher, core.MPEGMatcher, core.EBMLMatcher, core.BMFFMatcher, core.StructMatcher, core.DataMatcher) {
+			i = i.addWarning(core.WarnSignatureMismatch, "byte/xml signatures for this format did not match")
 		}
 	}
 	// apply mismatches
 	if r.globActive && i.globScore == 0 {
 		for _, v := range r.IDs(core.NameMatcher) {
 			if i.ID == v {
-				if len(i.Warning) > 0 {
-					i.Warning += "; filename mismatch"
-				} else {
-					i.Warning = "filename mismatch"
-				}
+				i = i.addWarning(core.WarnExtMismatch, "filename mismatch")
 				break
 			}
 		}
 	}
 	if r.mimeActive && !i.mimeMatch {
-		if len(i.Warning) > 0 {
-			i.Warning += "; MIME mismatch"
-		} else {
-			i.Warning = "MIME mismatch"
-		}
+		i = i.addWarning(core.WarnMIMEMismatch, "MIME mismatch")
 	}
 	return i
 }
@@ -324,6 +419,9 @@ func confidenceTrick() func(i Identification) string {
 		if i.textMatch && !contains(ls, "text") {
 			ls = append(ls, "text")
 		}
+		if i.externalMatch && !contains(ls, "external matcher") {
+			ls = append(ls, "external matcher")
+		}
 		switch len(ls) {
 		case 0:
 			return ""
@@ -343,14 +441,16 @@ type Identification struct {
 	Name      string
 	Basis     []string
 	Warning   string
+	codes     []core.WarningCode // codes for the warnings
 	archive   config.Archive
 
-	xmlMatch    bool
-	magicScore  int
-	globScore   int
-	mimeMatch   bool
-	textMatch   bool
-	textDefault bool
+	xmlMatch      bool
+	magicScore    int
+	globScore     int
+	mimeMatch     bool
+	textMatch     bool
+	textDefault   bool
+	externalMatch bool // a Tentative result from an External matcher
 }
 
 func (id Identification) String() string {
@@ -365,6 +465,14 @@ func (id Identification) Warn() string {
 	return id.Warning
 }
 
+func (id Identification) WarnCodes() []core.WarningCode {
+	return id.codes
+}
+
+func (id Identification) Fields() []string {
+	return fields
+}
+
 func (id Identification) Values() []string {
 	var basis string
 	if len(id.Basis) > 0 {
@@ -377,6 +485,7 @@ func (id Identification) Values() []string {
 		id.ID,
 		basis,
 		id.Warning,
+		core.JoinCodes(id.codes),
 	}
 }
 
@@ -384,6 +493,17 @@ func (id Identification) Archive() config.Archive {
 	return id.archive
 }
 
+func (id Identification) Annotate(basis []string, warn core.Warning) core.Identification {
+	id.Basis = append(id.Basis[:len(id.Basis):len(id.Basis)], basis...)
+	id.Warning, id.codes = core.AddWarning(id.Warning, id.codes, warn)
+	return id
+}
+
+func (id Identification) addWarning(code core.WarningCode, msg string) Identification {
+	id.Warning, id.codes = core.AddWarning(id.Warning, id.codes, core.Warning{Code: code, Msg: msg})
+	return id
+}
+
 type ids []Identification
 
 func (m ids) Len() int { return len(m) }
@@ -450,6 +570,17 @@ func (m ids) Less(i, j int) bool {
 
 func (m ids) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
 
+// a run of valid MPEG frames, validated structured text, or a Definite result from an External matcher, scores as a magic match with the default priority;
+// a parsed EBML doctype or ftyp brand, or a validated data header, outranks byte signatures for the same formats
+const (
+	mpegScore     = 50
+	ebmlScore     = 80
+	bmffScore     = 80
+	structScore   = 50
+	dataScore     = 80
+	externalScore = 50
+)
+
 func applyScore(id Identification, info formatInfo, t core.MatcherType, rel int) Identification {
 	switch t {
 	case core.NameMatcher:
@@ -466,11 +597,37 @@ func applyScore(id Identification, info formatInfo, t core.MatcherType, rel int)
 		if score > id.magicScore {
 			id.magicScore = score
 		}
+	case core.MPEGMatcher:
+		if mpegScore > id.magicScore {
+			id.magicScore = mpegScore
+		}
+	case core.EBMLMatcher:
+		if ebmlScore > id.magicScore {
+			id.magicScore = ebmlScore
+		}
+	case core.BMFFMatcher:
+		if bmffScore > id.magicScore {
+			id.magicScore = bmffScore
+		}
+	case core.StructMatcher:
+		if structScore > id.magicScore {
+			id.magicScore = structScore
+		}
+	case core.DataMatcher:
+		if dataScore > id.magicScore {
+			id.magicScore = dataScore
+		}
 	case core.TextMatcher:
 		id.textMatch = true
 		if id.ID == config.TextMIME() {
 			id.textDefault = true
 		}
+	case core.ExternalMatcher: // rel is the core.Confidence of the result
+		if core.Confidence(rel) != core.Definite {
+			id.externalMatch = true
+		} else if externalScore > id.magicScore {
+			id.magicScore = externalScore
+		}
 	}
 	return id
 }
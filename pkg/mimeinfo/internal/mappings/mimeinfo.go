@@ -28,8 +28,10 @@ type MIMEType struct {
 		Weight  string `xml:"weight,attr"`
 	} `xml:"glob"`
 	XMLPattern []struct {
-		Local string `xml:"localName,attr"`
-		NS    string `xml:"namespaceURI,attr"`
+		Local          string `xml:"localName,attr"`
+		NS             string `xml:"namespaceURI,attr"`
+		SchemaLocation string `xml:"schemaLocation,attr"` // siegfried extension: qualifies the match with a schema location
+		PublicID       string `xml:"publicId,attr"`       // siegfried extension: qualifies the match with a DOCTYPE public ID
 	} `xml:"root-XML"`
 	Magic   []Magic `xml:"magic"`
 	Aliases []struct {
@@ -106,7 +108,7 @@ func (m MIMEType) String() string {
 	}
 	xmlPat := make(stringMaker, len(m.XMLPattern))
 	for i, x := range m.XMLPattern {
-		xmlPat[i] = []string{x.Local, x.NS}
+		xmlPat[i] = []string{x.Local, x.NS, x.SchemaLocation, x.PublicID}
 	}
 	var magic string
 	if len(m.Magic) > 0 {
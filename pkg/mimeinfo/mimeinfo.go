@@ -19,15 +19,19 @@ import (
 	"encoding/hex"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf16"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
+	"github.com/richardlehane/siegfried/internal/ebmlmatcher"
 	"github.com/richardlehane/siegfried/internal/identifier"
+	"github.com/richardlehane/siegfried/internal/mpegmatcher"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/mimeinfo/internal/mappings"
 )
@@ -107,7 +111,11 @@ func (f formatInfo) String() string {
 func infos(m map[string]identifier.FormatInfo) map[string]formatInfo {
 	i := make(map[string]formatInfo, len(m))
 	for k, v := range m {
-		i[k] = v.(formatInfo)
+		fi := v.(formatInfo)
+		if config.Compact() {
+			fi.comment = ""
+		}
+		i[k] = fi
 	}
 	return i
 }
@@ -120,6 +128,7 @@ func textMIMES(m map[string]identifier.FormatInfo) []string {
 			ret = append(ret, k)
 		}
 	}
+	sort.Strings(ret[1:])
 	return ret
 }
 
@@ -192,16 +201,107 @@ func (mi mimeinfo) MIMEs() ([]string, []string) {
 	return mimes, ids
 }
 
+// MPEGs assigns MPEG audio layers to the audio/mpeg MIME-type.
+// If the MIME-info file has an audio/mp2 type (as freedesktop.org does), layer II streams are assigned to that instead.
+func (mi mimeinfo) MPEGs() ([]byte, []string) {
+	var mp2 bool
+	for _, v := range mi.m {
+		if v.MIME == config.MP2MIME() {
+			mp2 = true
+		}
+	}
+	mpegs, ids := make([]byte, 0, 2), make([]string, 0, 2)
+	for _, v := range mi.m {
+		switch v.MIME {
+		case config.MP3MIME():
+			layers := mpegmatcher.LayerI | mpegmatcher.LayerIII
+			if !mp2 {
+				layers |= mpegmatcher.LayerII
+			}
+			mpegs, ids = append(mpegs, layers), append(ids, v.MIME)
+		case config.MP2MIME():
+			mpegs, ids = append(mpegs, mpegmatcher.LayerII), append(ids, v.MIME)
+		}
+	}
+	return mpegs, ids
+}
+
+// EBMLs assigns EBML doctypes to the Matroska and WebM MIME-types.
+// Where the MIME-info file has no audio type for a doctype, the video type matches audio only files too.
+func (mi mimeinfo) EBMLs() ([][2]string, []string) {
+	docs := make(map[[2]string]bool)
+	for _, v := range mi.m {
+		if e, ok := config.EBMLMIME(v.MIME); ok {
+			docs[e] = true
+		}
+	}
+	ebmls, ids := make([][2]string, 0, len(docs)), make([]string, 0, len(docs))
+	for _, v := range mi.m {
+		e, ok := config.EBMLMIME(v.MIME)
+		if !ok {
+			continue
+		}
+		if e[1] == ebmlmatcher.Video && !docs[[2]string{e[0], ebmlmatcher.Audio}] {
+			e[1] = ""
+		}
+		ebmls, ids = append(ebmls, e), append(ids, v.MIME)
+	}
+	return ebmls, ids
+}
+
+// Brands assigns ftyp brands to the MIME-types of ISO base media files (e.g. MP4, QuickTime, 3GPP and HEIF).
+func (mi mimeinfo) Brands() ([][4]byte, []string) {
+	brands, ids := make([][4]byte, 0, len(mi.m)), make([]string, 0, len(mi.m))
+	for _, v := range mi.m {
+		for _, b := range config.BMFFMIME(v.MIME) {
+			var brand [4]byte
+			copy(brand[:], b)
+			brands, ids = append(brands, brand), append(ids, v.MIME)
+		}
+	}
+	return brands, ids
+}
+
+// Structs assigns kinds of structured text to the MIME-types for JSON, YAML, CSV and TSV.
+func (mi mimeinfo) Structs() ([]string, []string) {
+	kinds, ids := make([]string, 0, len(mi.m)), make([]string, 0, len(mi.m))
+	for _, v := range mi.m {
+		for _, k := range config.StructMIME(v.MIME) {
+			kinds, ids = append(kinds, k), append(ids, v.MIME)
+		}
+	}
+	return kinds, ids
+}
+
+// Data assigns variants of scientific data formats to the MIME-types for SQLite, HDF5 and netCDF.
+func (mi mimeinfo) Data() ([]string, []string) {
+	variants, ids := make([]string, 0, len(mi.m)), make([]string, 0, len(mi.m))
+	for _, v := range mi.m {
+		for _, d := range config.DataMIME(v.MIME) {
+			variants, ids = append(variants, d), append(ids, v.MIME)
+		}
+	}
+	return variants, ids
+}
+
 func (mi mimeinfo) Texts() []string {
 	return textMIMES(mi.Infos())
 }
 
-// slice of root/NS
-func (mi mimeinfo) XMLs() ([][2]string, []string) {
-	xmls, ids := make([][2]string, 0, len(mi.m)), make([]string, 0, len(mi.m))
+// slice of root/NS/qualifier: a root-XML element with schemaLocation or publicId attributes gives a qualified signature for each
+func (mi mimeinfo) XMLs() ([][3]string, []string) {
+	xmls, ids := make([][3]string, 0, len(mi.m)), make([]string, 0, len(mi.m))
 	for _, v := range mi.m {
 		for _, w := range v.XMLPattern {
-			xmls, ids = append(xmls, [2]string{w.Local, w.NS}), append(ids, v.MIME)
+			if w.SchemaLocation == "" && w.PublicID == "" {
+				xmls, ids = append(xmls, [3]string{w.Local, w.NS}), append(ids, v.MIME)
+				continue
+			}
+			for _, q := range []string{w.SchemaLocation, w.PublicID} {
+				if q != "" {
+					xmls, ids = append(xmls, [3]string{w.Local, w.NS, q}), append(ids, v.MIME)
+				}
+			}
 		}
 	}
 	return xmls, ids
@@ -220,6 +320,9 @@ func (mi mimeinfo) Signatures() ([]frames.Signature, []string, error) {
 					}
 				}
 				if err != nil {
+					if me, ok := err.(*MagicError); ok {
+						me.MIME = v.MIME
+					}
 					errs = append(errs, err)
 				}
 			}
@@ -268,16 +371,37 @@ func toSigs(m mappings.Match) ([]frames.Signature, error) {
 	return ss, nil
 }
 
+// MagicError reports a magic match that can't be parsed, such as a string value with a bad escape.
+// The MIME-type and the attributes of the match locate it in the source file.
+type MagicError struct {
+	MIME                     string
+	Typ, Offset, Value, Mask string
+	Err                      error
+}
+
+func (me *MagicError) Error() string {
+	return fmt.Sprintf("bad magic for %s (type=%q offset=%q value=%q mask=%q): %v", me.MIME, me.Typ, me.Offset, me.Value, me.Mask, me.Err)
+}
+
+// Unwrap returns the underlying error.
+func (me *MagicError) Unwrap() error { return me.Err }
+
 func toFrames(m mappings.Match) ([]frames.Frame, error) {
 	pat, min, max, err := toPattern(m)
-	if err != nil || pat == nil {
-		return nil, err
+	if err != nil {
+		return nil, &MagicError{Typ: m.Typ, Offset: m.Offset, Value: m.Value, Mask: m.Mask, Err: err}
+	}
+	if pat == nil {
+		return nil, nil
 	}
 	mask, ok := pat.(Mask)
 	if !ok {
 		return []frames.Frame{frames.NewFrame(frames.BOF, pat, min, max)}, nil
 	}
 	pats, ints := unmask(mask)
+	if len(pats) == 0 {
+		return nil, &MagicError{Typ: m.Typ, Offset: m.Offset, Value: m.Value, Mask: m.Mask, Err: errors.New("mask matches none of the value")}
+	}
 	f := []frames.Frame{frames.NewFrame(frames.BOF, pats[0], min+ints[0], max+ints[0])}
 	if len(pats) > 1 {
 		for i, p := range pats[1:] {
@@ -337,11 +461,23 @@ func toPattern(m mappings.Match) (patterns.Pattern, int, int, error) {
 		}
 		pat = Host32(i)
 	case "string", "": // if no type given, assume string
-		pat = patterns.Sequence(unquote(m.Value))
+		byts, err := unquote(m.Value)
+		if err != nil {
+			return nil, min, max, err
+		}
+		pat = patterns.Sequence(byts)
 	case "stringignorecase":
-		pat = IgnoreCase(unquote(m.Value))
+		byts, err := unquote(m.Value)
+		if err != nil {
+			return nil, min, max, err
+		}
+		pat = IgnoreCase(byts)
 	case "unicodeLE":
-		uints := utf16.Encode([]rune(string(unquote(m.Value))))
+		byts, err := unquote(m.Value)
+		if err != nil {
+			return nil, min, max, err
+		}
+		uints := utf16.Encode([]rune(string(byts)))
 		buf := make([]byte, len(uints)*2)
 		for i, u := range uints {
 			binary.LittleEndian.PutUint16(buf[i*2:], u)
@@ -353,7 +489,11 @@ func toPattern(m mappings.Match) (patterns.Pattern, int, int, error) {
 		return nil, min, max, errors.New("unknown magic type: " + m.Typ + " val: " + m.Value)
 	}
 	if len(m.Mask) > 0 {
-		pat = Mask{pat, unquote(m.Mask)}
+		mask, err := unquote(m.Mask)
+		if err != nil {
+			return nil, min, max, err
+		}
+		pat = Mask{pat, mask}
 	}
 	return pat, min, max, err
 }
@@ -385,7 +525,7 @@ var (
 	rgx = regexp.MustCompile(`\\([0-9]{1,3}|x[0-9A-Fa-f]{1,2})`)
 )
 
-func numReplace(b []byte) []byte {
+func numReplace(b []byte) ([]byte, error) {
 	var i uint64
 	var err error
 	if b[1] == 'x' {
@@ -399,20 +539,30 @@ func numReplace(b []byte) []byte {
 		}
 	}
 	if err != nil {
-		panic(b)
+		return nil, fmt.Errorf("bad escape %s, expecting a byte value", b)
 	}
-	return []byte{byte(i)}
+	return []byte{byte(i)}, nil
 }
 
-func unquote(input string) []byte {
+func unquote(input string) ([]byte, error) {
 	// deal with hex first
 	if len(input) > 2 && input[:2] == "0x" {
 		h, err := hex.DecodeString(input[2:])
-		if err == nil {
-			return h
-		} else {
-			panic(input + " " + err.Error())
+		if err != nil {
+			return nil, fmt.Errorf("bad hex value %s: %v", input, err)
+		}
+		return h, nil
+	}
+	var err error
+	byts := rgx.ReplaceAllFunc([]byte(rpl.Replace(input)), func(b []byte) []byte {
+		r, e := numReplace(b)
+		if e != nil && err == nil {
+			err = e
 		}
+		return r
+	})
+	if err != nil {
+		return nil, err
 	}
-	return rgx.ReplaceAllFunc([]byte(rpl.Replace(input)), numReplace)
+	return byts, nil
 }
@@ -16,11 +16,14 @@ package mimeinfo
 
 import (
 	//"fmt"
+	"errors"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/mimeinfo/internal/mappings"
 )
 
 func TestNew(t *testing.T) {
@@ -55,3 +58,22 @@ func TestNew(t *testing.T) {
 		t.Errorf("Load identifier fail: got %s, expect %s", str, id2.String())
 	}
 }
+
+func TestBadMagic(t *testing.T) {
+	mi := mimeinfo{m: []mappings.MIMEType{{
+		MIME:  "application/x-bad",
+		Magic: []mappings.Magic{{Matches: []mappings.Match{{Typ: "string", Offset: "0", Value: "\\x"}, {Typ: "string", Offset: "0", Value: "0xzz"}}}},
+	}}}
+	_, _, err := mi.Signatures()
+	if err == nil {
+		t.Fatal("expected an error for bad magic")
+	}
+	if !strings.Contains(err.Error(), "application/x-bad") {
+		t.Errorf("expected error to name the MIME-type, got %v", err)
+	}
+	_, err = toFrames(mappings.Match{Typ: "string", Offset: "0", Value: "\\400"})
+	var me *MagicError
+	if !errors.As(err, &me) || me.Value != "\\400" {
+		t.Errorf("expected a MagicError for an out of range escape, got %v", err)
+	}
+}
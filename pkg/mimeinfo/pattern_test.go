@@ -15,202 +15,12 @@
 package mimeinfo
 
 import (
-	"encoding/binary"
 	"testing"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
 	"github.com/richardlehane/siegfried/internal/persist"
 )
 
-var (
-	i8  byte  = 8
-	i16 int16 = -5000
-	i32 int32 = 12345678
-
-	b16, l16 = make([]byte, 2), make([]byte, 2)
-	b32, l32 = make([]byte, 4), make([]byte, 4)
-)
-
-func init() {
-	binary.BigEndian.PutUint16(b16, uint16(i16))
-	binary.LittleEndian.PutUint16(l16, uint16(i16))
-	binary.BigEndian.PutUint32(b32, uint32(i32))
-	binary.LittleEndian.PutUint32(l32, uint32(i32))
-}
-
-func TestInt8(t *testing.T) {
-	if !Int8(i8).Equals(Int8(i8)) {
-		t.Error("Int8 fail: Equality")
-	}
-	if r, _ := Int8(i8).Test([]byte{7}); len(r) > 0 {
-		t.Error("Int8 fail: shouldn't match")
-	}
-	if r, _ := Int8(i8).Test([]byte{i8}); len(r) != 1 || r[0] != 1 {
-		t.Error("Int8 fail: should match")
-	}
-	if r, _ := Int8(i8).TestR([]byte{i8}); len(r) != 1 || r[0] != 1 {
-		t.Error("Int8 fail: should match reverse")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Int8(i8).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadInt8(loader)
-	if !p.Equals(Int8(i8)) {
-		t.Errorf("expecting %d, got %s", i8, p)
-	}
-}
-
-func TestBig16(t *testing.T) {
-	if !Big16(i16).Equals(Big16(i16)) {
-		t.Error("Big16 fail: Equality")
-	}
-	if r, _ := Big16(i16).Test(l16); len(r) > 0 {
-		t.Error("Big16 fail: shouldn't match")
-	}
-	if r, _ := Big16(i16).Test(b16); len(r) != 1 || r[0] != 2 {
-		t.Error("Big16 fail: should match")
-	}
-	if r, _ := Big16(i16).TestR(b16); len(r) != 1 || r[0] != 2 {
-		t.Error("Big16 fail: should match reverse")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Big16(i16).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadBig16(loader)
-	if !p.Equals(Big16(i16)) {
-		t.Errorf("expecting %d, got %s", i16, p)
-	}
-}
-
-func TestLittle16(t *testing.T) {
-	if !Little16(i16).Equals(Little16(i16)) {
-		t.Error("Little16 fail: Equality")
-	}
-	if r, _ := Little16(i16).Test(b16); len(r) > 0 {
-		t.Error("Little16 fail: shouldn't match")
-	}
-	if r, _ := Little16(i16).Test(l16); len(r) != 1 || r[0] != 2 {
-		t.Error("Little16 fail: should match")
-	}
-	if r, _ := Little16(i16).TestR(l16); len(r) != 1 || r[0] != 2 {
-		t.Error("Little16 fail: should match reverse")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Little16(i16).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadLittle16(loader)
-	if !p.Equals(Little16(i16)) {
-		t.Errorf("expecting %d, got %s", i16, p)
-	}
-}
-
-func TestHost16(t *testing.T) {
-	if !Host16(i16).Equals(Host16(i16)) {
-		t.Error("Host16 fail: Equality")
-	}
-	if r, _ := Host16(i16).Test(b32); len(r) > 0 {
-		t.Error("Host16 fail: shouldn't match")
-	}
-	if r, _ := Host16(i16).Test(l16); len(r) != 1 || r[0] != 2 {
-		t.Error("Host16 fail: should match")
-	}
-	if r, _ := Host16(i16).Test(b16); len(r) != 1 || r[0] != 2 {
-		t.Error("Host16 fail: should match")
-	}
-	if r, _ := Host16(i16).TestR(l16); len(r) != 1 || r[0] != 2 {
-		t.Error("Host16 fail: should match reverse")
-	}
-	if r, _ := Host16(i16).TestR(b16); len(r) != 1 || r[0] != 2 {
-		t.Error("Host16 fail: should match reverse")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Host16(i16).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadHost16(loader)
-	if !p.Equals(Host16(i16)) {
-		t.Errorf("expecting %d, got %s", i16, p)
-	}
-}
-
-func TestBig32(t *testing.T) {
-	if !Big32(i32).Equals(Big32(i32)) {
-		t.Error("Big32 fail: Equality")
-	}
-	if r, _ := Big32(i32).Test(l32); len(r) > 0 {
-		t.Error("Big32 fail: shouldn't match")
-	}
-	if r, _ := Big32(i32).Test(b32); len(r) != 1 || r[0] != 4 {
-		t.Error("Big32 fail: should match")
-	}
-	if r, _ := Big32(i32).TestR(b32); len(r) != 1 || r[0] != 4 {
-		t.Error("Big32 fail: should match")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Big32(i32).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadBig32(loader)
-	if !p.Equals(Big32(i32)) {
-		t.Errorf("expecting %d, got %s", i32, p)
-	}
-}
-
-func TestLittle32(t *testing.T) {
-	if !Little32(i32).Equals(Little32(i32)) {
-		t.Error("Little32 fail: Equality")
-	}
-	if r, _ := Little32(i32).Test(b32); len(r) > 0 {
-		t.Error("Big32 fail: shouldn't match")
-	}
-	if r, _ := Little32(i32).Test(l32); len(r) != 1 || r[0] != 4 {
-		t.Error("Little32 fail: should match")
-	}
-	if r, _ := Little32(i32).TestR(l32); len(r) != 1 || r[0] != 4 {
-		t.Error("Little32 fail: should match")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Little32(i32).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadLittle32(loader)
-	if !p.Equals(Little32(i32)) {
-		t.Errorf("expecting %d, got %s", i32, p)
-	}
-}
-
-func TestHost32(t *testing.T) {
-	if !Host32(i32).Equals(Host32(i32)) {
-		t.Error("Host32 fail: Equality")
-	}
-	if r, _ := Host32(i32).Test(b16); len(r) > 0 {
-		t.Error("Host32 fail: shouldn't match")
-	}
-	if r, _ := Host32(i32).Test(l32); len(r) != 1 || r[0] != 4 {
-		t.Error("Host32 fail: should match")
-	}
-	if r, _ := Host32(i32).Test(b32); len(r) != 1 || r[0] != 4 {
-		t.Error("Host32 fail: should match")
-	}
-	if r, _ := Host32(i32).TestR(l32); len(r) != 1 || r[0] != 4 {
-		t.Error("Host32 fail: should match reverse")
-	}
-	if r, _ := Host32(i32).TestR(b32); len(r) != 1 || r[0] != 4 {
-		t.Error("Host32 fail: should match reverse")
-	}
-	saver := persist.NewLoadSaver(nil)
-	Host32(i32).Save(saver)
-	loader := persist.NewLoadSaver(saver.Bytes())
-	_ = loader.LoadByte()
-	p := loadHost32(loader)
-	if !p.Equals(Host32(i32)) {
-		t.Errorf("expecting %d, got %s", i32, p)
-	}
-}
-
 func TestIgnoreCase(t *testing.T) {
 	apple := []byte("AppLe")
 	apple2 := []byte("apple")
@@ -0,0 +1,68 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pronom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+
+	"github.com/richardlehane/siegfried/internal/harvest"
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+// CacheFile is the name of the file in the home directory that records the ETag and Last-Modified headers of harvested
+// PRONOM reports. Later harvests send them back in conditional requests so that unchanged reports aren't downloaded again.
+const CacheFile = "pronom-cache.json"
+
+type reportCache struct {
+	mu sync.Mutex
+	v  map[string]harvest.Validator // by puid
+}
+
+// loadCache reads the cache file. If it can't be read, the cache starts empty.
+func loadCache() *reportCache {
+	c := &reportCache{v: make(map[string]harvest.Validator)}
+	if byts, err := ioutil.ReadFile(config.Local(CacheFile)); err == nil {
+		json.Unmarshal(byts, &c.v)
+	}
+	return c
+}
+
+func (c *reportCache) get(puid string) harvest.Validator {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.v[puid]
+}
+
+func (c *reportCache) set(puid string, v harvest.Validator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v.ETag == "" && v.LastModified == "" {
+		delete(c.v, puid)
+		return
+	}
+	c.v[puid] = v
+}
+
+func (c *reportCache) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	byts, err := json.MarshalIndent(c.v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(config.Local(CacheFile), byts, 0666)
+}
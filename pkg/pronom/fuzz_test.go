@@ -0,0 +1,20 @@
+// +build go1.18
+
+package pronom
+
+import "testing"
+
+// FuzzProcess parses arbitrary PRONOM and container hex sequences, as found in edited or malformed signature sources.
+// Bad sequences must return errors rather than panic. Run with `go test -fuzz FuzzProcess ./pkg/pronom`.
+func FuzzProcess(f *testing.F) {
+	for _, v := range good {
+		f.Add(v.pattern, false)
+	}
+	for _, v := range bad {
+		f.Add(v.pattern, true)
+	}
+	f.Add("'PK'0304{26}'mimetype'", false)
+	f.Fuzz(func(t *testing.T, seq string, eof bool) {
+		process("fuzz", seq, eof)
+	})
+}
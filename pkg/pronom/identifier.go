@@ -37,7 +37,13 @@ type Identifier struct {
 func (i *Identifier) Save(ls *persist.LoadSaver) {
 	ls.SaveByte(core.Pronom)
 	ls.SaveSmallInt(len(i.infos))
-	for k, v := range i.infos {
+	keys := make([]string, 0, len(i.infos))
+	for k := range i.infos {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := i.infos[k]
 		ls.SaveString(k)
 		ls.SaveString(v.name)
 		ls.SaveString(v.version)
@@ -75,8 +81,20 @@ func New(opts ...config.Option) (core.Identifier, error) {
 	}, nil
 }
 
+// Describe returns the format information recorded for a PUID.
+func (i *Identifier) Describe(id string) ([][2]string, bool) {
+	inf, ok := i.infos[id]
+	if !ok {
+		return nil, false
+	}
+	return [][2]string{{"format", inf.name}, {"version", inf.version}, {"mime", inf.mimeType}}, true
+}
+
+// fields of an identification, in the order of its values
+var fields = []string{"namespace", "id", "format", "version", "mime", "basis", "warning", "warncode"}
+
 func (i *Identifier) Fields() []string {
-	return []string{"namespace", "id", "format", "version", "mime", "basis", "warning"}
+	return fields
 }
 
 func (i *Identifier) Recorder() core.Recorder {
@@ -100,6 +118,7 @@ const (
 	extScore = 1 << iota
 	mimeScore
 	textScore
+	externalScore // a Tentative result from an External matcher
 	incScore
 )
 
@@ -171,6 +190,17 @@ func (r *Recorder) Record(m core.MatcherType, res core.Result) bool {
 		} else {
 			return false
 		}
+	case core.StructMatcher, core.DataMatcher:
+		if hit, id := r.Hit(m, res.Index()); hit {
+			if r.satisfied {
+				return true
+			}
+			r.cscore += incScore
+			r.ids = add(r.ids, r.Name(), id, r.infos[id], res.Basis(), r.cscore)
+			return true
+		} else {
+			return false
+		}
 	case core.TextMatcher:
 		if hit, id := r.Hit(m, res.Index()); hit {
 			if r.satisfied {
@@ -181,6 +211,21 @@ func (r *Recorder) Record(m core.MatcherType, res core.Result) bool {
 		} else {
 			return false
 		}
+	case core.ExternalMatcher:
+		e, ok := res.(core.ExternalHit)
+		if !ok || !e.For(r.Name()) {
+			return false
+		}
+		if _, ok := r.infos[e.ID]; !ok {
+			return false
+		}
+		if e.Confidence == core.Definite {
+			r.cscore += incScore
+			r.ids = add(r.ids, r.Name(), e.ID, r.infos[e.ID], e.Basis(), r.cscore)
+		} else {
+			r.ids = add(r.ids, r.Name(), e.ID, r.infos[e.ID], e.Basis(), externalScore)
+		}
+		return true
 	}
 }
 
@@ -188,11 +233,14 @@ func (r *Recorder) Satisfied(mt core.MatcherType) (bool, core.Hint) {
 	if r.NoPriority() {
 		return false, core.Hint{}
 	}
+	if mt == core.DataMatcher && r.refinable() {
+		return false, core.Hint{}
+	}
 	if r.cscore < incScore {
 		if len(r.ids) == 0 {
 			return false, core.Hint{}
 		}
-		if mt == core.ContainerMatcher || mt == core.ByteMatcher || mt == core.XMLMatcher || mt == core.RIFFMatcher {
+		if mt == core.ContainerMatcher || mt == core.ByteMatcher || mt == core.XMLMatcher || mt == core.RIFFMatcher || mt == core.MPEGMatcher || mt == core.EBMLMatcher || mt == core.BMFFMatcher || mt == core.StructMatcher {
 			if mt == core.ByteMatcher || mt == core.ContainerMatcher {
 				keys := make([]string, len(r.ids))
 				for i, v := range r.ids {
@@ -216,6 +264,86 @@ func (r *Recorder) Satisfied(mt core.MatcherType) (bool, core.Hint) {
 	return true, core.Hint{}
 }
 
+// refinable reports whether the data matcher can refine the results so far i.e. any byte signature matches are for formats that it validates.
+// The data matcher doesn't run if a more specific format has been matched (e.g. an SQLite-based format rather than SQLite).
+func (r *Recorder) refinable() bool {
+	for _, v := range r.ids {
+		if v.confidence >= incScore && !r.HasSig(v.ID, core.DataMatcher) {
+			return false
+		}
+	}
+	return true
+}
+
+// Refine upgrades byte signature matches for formats like TIFF and JP2 to the more specific formats (e.g. GeoTIFF or JPX) revealed by
+// parsing the file's structure. If no more specific format is in the identifier, or it has already been matched, the basis is just added. A weaker match for the more
+// specific format (e.g. on extension alone) is merged into the upgraded identification.
+func (r *Recorder) Refine(parse func(string) ([]string, string)) {
+	for i := 0; i < len(r.ids); i++ {
+		v := r.ids[i]
+		family := config.RefinePuid(v.ID)
+		if family == "" || v.confidence < incScore {
+			continue
+		}
+		kinds, basis := parse(family)
+		if len(kinds) == 0 {
+			continue
+		}
+		puid, j := r.refined(kinds)
+		if puid == "" {
+			r.ids[i].Basis = append(r.ids[i].Basis, basis)
+			continue
+		}
+		info := r.infos[puid]
+		r.ids[i].ID, r.ids[i].Name, r.ids[i].Version, r.ids[i].MIME, r.ids[i].archive = puid, info.name, info.version, info.mimeType, config.IsArchive(puid)
+		r.ids[i].Basis = append(r.ids[i].Basis, basis+"; refined from "+v.ID)
+		if j > -1 {
+			w := r.ids[j]
+			r.ids[i].confidence |= w.confidence
+			for _, b := range w.Basis {
+				if !contains(r.ids[i].Basis, b) {
+					r.ids[i].Basis = append(r.ids[i].Basis, b)
+				}
+			}
+			r.ids = append(r.ids[:j], r.ids[j+1:]...)
+			if j < i {
+				i--
+			}
+		}
+	}
+}
+
+// refined returns the puid for the most specific kind of format that is in the identifier, and the index of any weaker
+// match for it (or -1). Nothing is returned if the more specific format has already been matched.
+func (r *Recorder) refined(kinds []string) (string, int) {
+	for _, k := range kinds {
+		for _, puid := range config.RefinedPuid(k) {
+			if _, ok := r.infos[puid]; !ok {
+				continue
+			}
+			for j, v := range r.ids {
+				if v.ID == puid {
+					if v.confidence >= incScore {
+						return "", -1
+					}
+					return puid, j
+				}
+			}
+			return puid, -1
+		}
+	}
+	return "", -1
+}
+
+func contains(ss []string, str string) bool {
+	for _, s := range ss {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 func lowConfidence(conf int) string {
 	var ls = make([]string, 0, 1)
 	if conf&extScore == extScore {
@@ -227,6 +355,9 @@ func lowConfidence(conf int) string {
 	if conf&textScore == textScore {
 		ls = append(ls, "text")
 	}
+	if conf&externalScore == externalScore {
+		ls = append(ls, "external matcher")
+	}
 	switch len(ls) {
 	case 0:
 		return ""
@@ -246,6 +377,7 @@ func (r *Recorder) Report() []core.Identification {
 			Namespace: r.Name(),
 			ID:        "UNKNOWN",
 			Warning:   "no match",
+			codes:     []core.WarningCode{core.WarnNoMatch},
 		}}
 	}
 	sort.Sort(r.ids)
@@ -261,7 +393,7 @@ func (r *Recorder) Report() []core.Identification {
 	// if we've only got extension / mime matches, check if those matches are ruled out by lack of byte match
 	// only permit a single extension or mime only match
 	// add warnings too
-	if conf <= textScore {
+	if conf < incScore {
 		nids := make([]Identification, 0, 1)
 		for _, v := range r.ids {
 			// if overall confidence is greater than mime or ext only, then rule out any lesser confident matches
@@ -294,6 +426,7 @@ func (r *Recorder) Report() []core.Identification {
 				Namespace: r.Name(),
 				ID:        "UNKNOWN",
 				Warning:   fmt.Sprintf("no match; possibilities based on %v are %v", lowConfidence(conf), strings.Join(poss, ", ")),
+				codes:     []core.WarningCode{core.WarnNoMatch},
 			}}
 		}
 		r.ids = nids
@@ -311,6 +444,7 @@ func (r *Recorder) Report() []core.Identification {
 			Namespace: r.Name(),
 			ID:        "UNKNOWN",
 			Warning:   fmt.Sprintf("multiple matches %v", strings.Join(poss, ", ")),
+			codes:     []core.WarningCode{core.WarnMultipleMatches},
 		}}
 	}
 	ret := make([]core.Identification, len(r.ids))
@@ -336,22 +470,14 @@ func (r *Recorder) Report() []core.Identification {
 
 func (r *Recorder) updateWarning(i Identification) Identification {
 	// apply low confidence
-	if i.confidence <= textScore {
-		if len(i.Warning) > 0 {
-			i.Warning += "; " + "match on " + lowConfidence(i.confidence) + " only"
-		} else {
-			i.Warning = "match on " + lowConfidence(i.confidence) + " only"
-		}
+	if i.confidence < incScore {
+		i = i.addWarning(core.WarnWeakMatch, "match on "+lowConfidence(i.confidence)+" only")
 	}
 	// apply mismatches
 	if r.extActive && (i.confidence&extScore != extScore) {
 		for _, v := range r.IDs(core.NameMatcher) {
 			if i.ID == v {
-				if len(i.Warning) > 0 {
-					i.Warning += "; extension mismatch"
-				} else {
-					i.Warning = "extension mismatch"
-				}
+				i = i.addWarning(core.WarnExtMismatch, "extension mismatch")
 				break
 			}
 		}
@@ -359,11 +485,7 @@ func (r *Recorder) updateWarning(i Identification) Identification {
 	if r.mimeActive && (i.confidence&mimeScore != mimeScore) {
 		for _, v := range r.IDs(core.MIMEMatcher) {
 			if i.ID == v {
-				if len(i.Warning) > 0 {
-					i.Warning += "; MIME mismatch"
-				} else {
-					i.Warning = "MIME mismatch"
-				}
+				i = i.addWarning(core.WarnMIMEMismatch, "MIME mismatch")
 				break
 			}
 		}
@@ -379,6 +501,7 @@ type Identification struct {
 	MIME       string
 	Basis      []string
 	Warning    string
+	codes      []core.WarningCode // codes for the warnings
 	archive    config.Archive
 	confidence int
 }
@@ -395,6 +518,14 @@ func (id Identification) Warn() string {
 	return id.Warning
 }
 
+func (id Identification) WarnCodes() []core.WarningCode {
+	return id.codes
+}
+
+func (id Identification) Fields() []string {
+	return fields
+}
+
 func (id Identification) Values() []string {
 	var basis string
 	if len(id.Basis) > 0 {
@@ -408,6 +539,7 @@ func (id Identification) Values() []string {
 		id.MIME,
 		basis,
 		id.Warning,
+		core.JoinCodes(id.codes),
 	}
 }
 
@@ -415,6 +547,17 @@ func (id Identification) Archive() config.Archive {
 	return id.archive
 }
 
+func (id Identification) Annotate(basis []string, warn core.Warning) core.Identification {
+	id.Basis = append(id.Basis[:len(id.Basis):len(id.Basis)], basis...)
+	id.Warning, id.codes = core.AddWarning(id.Warning, id.codes, warn)
+	return id
+}
+
+func (id Identification) addWarning(code core.WarningCode, msg string) Identification {
+	id.Warning, id.codes = core.AddWarning(id.Warning, id.codes, core.Warning{Code: code, Msg: msg})
+	return id
+}
+
 type pids []Identification
 
 func (p pids) Len() int { return len(p) }
@@ -431,5 +574,5 @@ func add(p pids, id string, f string, info formatInfo, basis string, c int) pids
 			return p
 		}
 	}
-	return append(p, Identification{id, f, info.name, info.version, info.mimeType, []string{basis}, "", config.IsArchive(f), c})
+	return append(p, Identification{id, f, info.name, info.version, info.mimeType, []string{basis}, "", nil, config.IsArchive(f), c})
 }
@@ -123,12 +123,55 @@ func processPRONOM(puid string, s mappings.Signature) (frames.Signature, error)
 		default:
 			return nil, errors.New("Pronom parse error: invalid ByteSequence position " + bs.Position)
 		}
+		if strings.TrimSpace(bs.IndirectLoc) != "" {
+			if seg, err = indirect(bs, seg); err != nil {
+				return nil, err
+			}
+		}
 		// add the segment to the complete signature
 		sig = appendSig(sig, seg, bs.Position)
 	}
 	return sig, nil
 }
 
+// indirect wraps the BOF or EOF anchored frame of a segment in an Indirect pattern, for byte sequences located by an
+// indirect offset (a pointer stored elsewhere in the file). The frame's offsets become relative to the pointer's value.
+func indirect(bs mappings.ByteSequence, seg frames.Signature) (frames.Signature, error) {
+	loc, err := decodeNum(bs.IndirectLoc)
+	if err != nil {
+		return nil, err
+	}
+	l, err := decodeNum(bs.IndirectLen)
+	if err != nil {
+		return nil, err
+	}
+	if loc < 0 || l < 1 || l > 8 {
+		return nil, errors.New("Pronom parse error: invalid indirect offset location " + bs.IndirectLoc + " and length " + bs.IndirectLen)
+	}
+	var big bool
+	switch strings.TrimSpace(bs.Endianness) {
+	case "Big-endian":
+		big = true
+	case "Little-endian", "":
+	default:
+		return nil, errors.New("Pronom parse error: invalid indirect offset endianness " + bs.Endianness)
+	}
+	idx, typ := 0, frames.BOF
+	switch bs.Position {
+	case pronombof:
+	case pronomeof:
+		idx, typ = len(seg)-1, frames.EOF
+	default:
+		return nil, errors.New("Pronom parse error: indirect offsets must be relative to the BOF or EOF, got " + bs.Position)
+	}
+	f := seg[idx]
+	if f.Max < 0 {
+		return nil, errors.New("Pronom parse error: indirect offsets must have a maximum offset")
+	}
+	seg[idx] = frames.NewFrame(typ, Indirect{loc, l, big, f.Min, f.Max, f.Pattern}, 0, 0)
+	return seg, nil
+}
+
 // merge two segments into a signature. Provide s2's pos
 func appendSig(s1, s2 frames.Signature, pos string) frames.Signature {
 	if len(s1) == 0 {
@@ -2,10 +2,14 @@ package pronom
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
+	"github.com/richardlehane/siegfried/internal/bytematcher"
+	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
 	"github.com/richardlehane/siegfried/internal/identifier"
+	"github.com/richardlehane/siegfried/internal/siegreader"
 	"github.com/richardlehane/siegfried/pkg/pronom/internal/mappings"
 )
 
@@ -152,6 +156,46 @@ func TestProcessGroup(t *testing.T) {
 	}
 }
 
+func TestProcessIndirect(t *testing.T) {
+	sig, err := FormatPRONOM("test", []PROCompatSequence{
+		{Position: pronombof, Hex: "4D4D"},
+		{Position: pronombof, IndirectLoc: "4", IndirectLen: "2", Endianness: "Big-endian", MaxOffset: "2", Hex: "'cats'"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sig[1].Pattern.(Indirect); !ok {
+		t.Fatalf("expecting an indirect pattern, got %v", sig)
+	}
+	bm, _, err := bytematcher.Add(nil, bytematcher.SignatureSet([]frames.Signature{sig}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bufs := siegreader.New()
+	for _, c := range []struct {
+		byts  []byte
+		match bool
+	}{
+		{[]byte("MM\x00\x00\x00\x08\x00\x00\x00cats"), true},
+		{[]byte("MM\x00\x00\x00\x07\x00\x00\x00cats"), true},
+		{[]byte("MM\x00\x00\x00\x06\x00\x00\x00cats"), false},
+		{[]byte("MM\x00\x00\x00\x08\x00\x00\x00dogs"), false},
+	} {
+		buf, err := bufs.Get(bytes.NewBuffer(c.byts))
+		if err != nil && err != io.EOF {
+			t.Fatal(err)
+		}
+		res, _ := bm.Identify("", buf)
+		var got bool
+		for range res {
+			got = true
+		}
+		if got != c.match {
+			t.Errorf("indirect offset: expecting match %v for %q", c.match, c.byts)
+		}
+	}
+}
+
 func TestParseHex(t *testing.T) {
 	ts, _, _, err := process("x-fmt/8", bsStub1.Hex, false)
 	if err != nil {
@@ -38,7 +38,11 @@ func (f formatInfo) String() string {
 func infos(m map[string]identifier.FormatInfo) map[string]formatInfo {
 	i := make(map[string]formatInfo, len(m))
 	for k, v := range m {
-		i[k] = v.(formatInfo)
+		fi := v.(formatInfo)
+		if config.Compact() {
+			fi.name, fi.version = "", ""
+		}
+		i[k] = fi
 	}
 	return i
 }
@@ -155,10 +159,20 @@ func (r *reports) MIMEs() ([]string, []string) {
 	return mimes, puids
 }
 
-func (r *reports) XMLs() ([][2]string, []string) {
+func (r *reports) XMLs() ([][3]string, []string) {
 	return nil, nil
 }
 
+// Structs assigns kinds of structured text to the PRONOM formats for JSON, YAML, CSV and TSV.
+func (r *reports) Structs() ([]string, []string) {
+	return structs(r.p)
+}
+
+// Data assigns variants of scientific data formats to the PRONOM formats for SQLite, HDF5 and netCDF.
+func (r *reports) Data() ([]string, []string) {
+	return data(r.p)
+}
+
 func (r *reports) Texts() []string {
 	return []string{config.TextPuid()}
 }
@@ -253,10 +267,40 @@ func (d *droid) MIMEs() ([]string, []string) {
 	return mimes, puids
 }
 
-func (d *droid) XMLs() ([][2]string, []string) {
+func (d *droid) XMLs() ([][3]string, []string) {
 	return nil, nil
 }
 
+// Structs assigns kinds of structured text to the PRONOM formats for JSON, YAML, CSV and TSV.
+func (d *droid) Structs() ([]string, []string) {
+	return structs(d.IDs())
+}
+
+func structs(puids []string) ([]string, []string) {
+	kinds, ids := make([]string, 0, 6), make([]string, 0, 6)
+	for _, p := range puids {
+		for _, k := range config.StructPuid(p) {
+			kinds, ids = append(kinds, k), append(ids, p)
+		}
+	}
+	return kinds, ids
+}
+
+// Data assigns variants of scientific data formats to the PRONOM formats for SQLite, HDF5 and netCDF.
+func (d *droid) Data() ([]string, []string) {
+	return data(d.IDs())
+}
+
+func data(puids []string) ([]string, []string) {
+	variants, ids := make([]string, 0, 6), make([]string, 0, 6)
+	for _, p := range puids {
+		for _, v := range config.DataPuid(p) {
+			variants, ids = append(variants, v), append(ids, p)
+		}
+	}
+	return variants, ids
+}
+
 func (d *droid) Texts() []string {
 	return []string{config.TextPuid()}
 }
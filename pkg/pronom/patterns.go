@@ -17,6 +17,7 @@ package pronom
 
 import (
 	"bytes"
+	"strconv"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
 	"github.com/richardlehane/siegfried/internal/persist"
@@ -24,10 +25,12 @@ import (
 
 func init() {
 	patterns.Register(rangeLoader, loadRange)
+	patterns.Register(indirectLoader, loadIndirect)
 }
 
 const (
 	rangeLoader byte = iota + 8
+	indirectLoader
 )
 
 type Range struct {
@@ -133,3 +136,137 @@ func loadRange(ls *persist.LoadSaver) patterns.Pattern {
 		ls.LoadBytes(),
 	}
 }
+
+// Indirect is a pattern located by an indirect offset: a pointer, stored elsewhere in the file, to the position of the pattern.
+// The pointer is an unsigned integer, PointerLen bytes long, stored at Location. The enclosed Pattern is tested
+// between Min and Max bytes from the position the pointer gives.
+// Indirect patterns are anchored to the BOF (when tested with Test) or the EOF (when tested with TestR): for TestR, both
+// Location and the pointer are counted back from the EOF.
+type Indirect struct {
+	Location   int
+	PointerLen int // 1 to 8 bytes
+	BigEndian  bool
+	Min, Max   int
+	Pattern    patterns.Pattern
+}
+
+// pointer reads the pointer stored at offset at, reporting false if it runs past the end of the slice.
+func (in Indirect) pointer(b []byte, at int) (int, bool) {
+	if in.PointerLen < 1 || in.PointerLen > 8 || at < 0 || len(b) < at+in.PointerLen {
+		return 0, false
+	}
+	var ptr uint64
+	for i := 0; i < in.PointerLen; i++ {
+		idx := i
+		if !in.BigEndian {
+			idx = in.PointerLen - 1 - i
+		}
+		ptr = ptr<<8 | uint64(b[at+idx])
+	}
+	if ptr > uint64(len(b)) {
+		return 0, false
+	}
+	return int(ptr), true
+}
+
+// Test bytes against the pattern. The byte slice must begin at the BOF.
+// Lengths returned are measured from the start of the slice i.e. they include the indirect offset.
+func (in Indirect) Test(b []byte) ([]int, int) {
+	ptr, ok := in.pointer(b, in.Location)
+	if !ok {
+		return nil, 0
+	}
+	var ret []int
+	for off := ptr + in.Min; off <= ptr+in.Max && off <= len(b); off++ {
+		lengths, _ := in.Pattern.Test(b[off:])
+		for _, l := range lengths {
+			ret = append(ret, off+l)
+		}
+	}
+	return ret, 0
+}
+
+// TestR tests bytes against the pattern in reverse. The byte slice must end at the EOF.
+func (in Indirect) TestR(b []byte) ([]int, int) {
+	ptr, ok := in.pointer(b, len(b)-in.Location-in.PointerLen)
+	if !ok {
+		return nil, 0
+	}
+	var ret []int
+	for off := ptr + in.Min; off <= ptr+in.Max && off <= len(b); off++ {
+		lengths, _ := in.Pattern.TestR(b[:len(b)-off])
+		for _, l := range lengths {
+			ret = append(ret, off+l)
+		}
+	}
+	return ret, 0
+}
+
+// Equals reports whether a pattern is identical to another pattern.
+func (in Indirect) Equals(pat patterns.Pattern) bool {
+	in2, ok := pat.(Indirect)
+	if !ok {
+		return false
+	}
+	return in.Location == in2.Location && in.PointerLen == in2.PointerLen && in.BigEndian == in2.BigEndian &&
+		in.Min == in2.Min && in.Max == in2.Max && in.Pattern.Equals(in2.Pattern)
+}
+
+// Length returns a minimum and maximum length for the pattern.
+// The maximum length is the furthest the pattern can reach from the BOF (or EOF), given the largest pointer value.
+func (in Indirect) Length() (int, int) {
+	min, max := in.Pattern.Length()
+	if in.PointerLen >= 4 {
+		return min, maxIndirect
+	}
+	reach := 1<<(8*uint(in.PointerLen)) - 1 + in.Max + max
+	if reach < in.Location+in.PointerLen {
+		reach = in.Location + in.PointerLen
+	}
+	return min, reach
+}
+
+// maxIndirect is the maximum length for indirect patterns with pointers of 4 bytes or more:
+// patterns beyond this distance from the BOF (or EOF) won't match.
+const maxIndirect = 1 << 30
+
+// NumSequences reports how many plain sequences are needed to represent this pattern.
+// Indirect patterns can't be represented as sequences, so they are always tested as frames.
+func (in Indirect) NumSequences() int {
+	return 0
+}
+
+// Sequences converts the pattern into a slice of plain sequences.
+func (in Indirect) Sequences() []patterns.Sequence {
+	return nil
+}
+
+func (in Indirect) String() string {
+	end := "le"
+	if in.BigEndian {
+		end = "be"
+	}
+	return "indirect[" + strconv.Itoa(in.Location) + ":" + strconv.Itoa(in.PointerLen) + end + " {" + strconv.Itoa(in.Min) + "-" + strconv.Itoa(in.Max) + "} " + in.Pattern.String() + "]"
+}
+
+// Save persists the pattern.
+func (in Indirect) Save(ls *persist.LoadSaver) {
+	ls.SaveByte(indirectLoader)
+	ls.SaveInt(in.Location)
+	ls.SaveTinyInt(in.PointerLen)
+	ls.SaveBool(in.BigEndian)
+	ls.SaveInt(in.Min)
+	ls.SaveInt(in.Max)
+	in.Pattern.Save(ls)
+}
+
+func loadIndirect(ls *persist.LoadSaver) patterns.Pattern {
+	return Indirect{
+		Location:   ls.LoadInt(),
+		PointerLen: ls.LoadTinyInt(),
+		BigEndian:  ls.LoadBool(),
+		Min:        ls.LoadInt(),
+		Max:        ls.LoadInt(),
+		Pattern:    patterns.Load(ls),
+	}
+}
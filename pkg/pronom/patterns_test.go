@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/patterns"
+	"github.com/richardlehane/siegfried/internal/persist"
 )
 
 func TestRange(t *testing.T) {
@@ -62,3 +63,30 @@ func TestNotRange(t *testing.T) {
 		t.Error("Not Range fail: Sequences")
 	}
 }
+
+func TestIndirect(t *testing.T) {
+	ind := Indirect{4, 2, false, 0, 2, patterns.Sequence("ABC")}
+	byts := []byte{0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 'A', 'B', 'C', 0}
+	if r, _ := ind.Test(byts); len(r) != 1 || r[0] != 14 {
+		t.Errorf("Indirect fail: Test, expecting [14] got %v", r)
+	}
+	if r, _ := ind.Test(byts[:12]); len(r) > 0 {
+		t.Error("Indirect fail: Test should fail when the pattern is truncated")
+	}
+	ind.BigEndian = true // pointer is now 2560
+	if r, _ := ind.Test(byts); len(r) > 0 {
+		t.Error("Indirect fail: Test should fail with big endian pointer")
+	}
+	// reverse, the pointer is at 4 bytes from EOF
+	rev := Indirect{4, 2, true, 0, 0, patterns.Sequence("ABC")}
+	byts = []byte{0, 0, 0, 'A', 'B', 'C', 0, 0, 0, 0, 9, 0, 0, 0, 0}
+	if r, _ := rev.TestR(byts); len(r) != 1 || r[0] != 12 {
+		t.Errorf("Indirect fail: TestR, expecting [12] got %v", r)
+	}
+	saver := persist.NewLoadSaver(nil)
+	rev.Save(saver)
+	loader := persist.NewLoadSaver(saver.Bytes())
+	if pat := patterns.Load(loader); !rev.Equals(pat) {
+		t.Errorf("Indirect fail: Save and Load, expecting %v got %v", rev, pat)
+	}
+}
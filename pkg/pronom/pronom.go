@@ -20,7 +20,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
-	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
@@ -30,6 +29,7 @@ import (
 	"time"
 
 	"github.com/richardlehane/siegfried/internal/bytematcher/frames"
+	"github.com/richardlehane/siegfried/internal/harvest"
 	"github.com/richardlehane/siegfried/internal/identifier"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/pronom/internal/mappings"
@@ -139,16 +139,11 @@ func newReports(reps []string, idsPuids map[int]string) (*reports, error) {
 	if len(reps) == 0 {
 		return r, nil // empty signatures
 	}
-	indexes := make(map[string]int)
-	for i, v := range reps {
-		indexes[v] = i
+	apply := func(i int) error {
+		r.r[i] = &mappings.Report{}
+		return openXML(reportPath(reps[i]), r.r[i])
 	}
-	apply := func(puid string) error {
-		idx := indexes[puid]
-		r.r[idx] = &mappings.Report{}
-		return openXML(reportPath(puid), r.r[idx])
-	}
-	errs := applyAll(200, reps, apply)
+	errs := harvest.ApplyAll(200, len(reps), apply)
 	if len(errs) > 0 {
 		strs := make([]string, len(errs))
 		for i, v := range errs {
@@ -190,11 +185,42 @@ func Harvest() []error {
 	if err != nil {
 		return []error{err}
 	}
-	apply := func(puid string) error {
-		url, _, _, _ := config.HarvestOptions()
-		return save(puid, url, config.Reports())
+	return harvestReports(d.IDs())
+}
+
+// harvestReports saves the PRONOM reports for a list of puids, reporting progress if a progress function has been set.
+// When resuming, reports already saved aren't fetched again. Unless caching is disabled, reports are fetched with conditional
+// requests and unchanged reports aren't downloaded.
+func harvestReports(puids []string) []error {
+	url, _, _, _ := config.HarvestOptions()
+	progress := config.HarvestProgress()
+	var cache *reportCache
+	if !config.HarvestNoCache() {
+		cache = loadCache()
+	}
+	var mu sync.Mutex
+	var done int
+	apply := func(i int) error {
+		puid := puids[i]
+		var err error
+		if fi, e := os.Stat(reportPath(puid)); !config.HarvestResume() || e != nil || fi.Size() == 0 {
+			err = save(puid, url, config.Reports(), cache)
+		}
+		if progress != nil {
+			mu.Lock()
+			done++
+			progress(done, len(puids))
+			mu.Unlock()
+		}
+		return err
+	}
+	errs := harvest.ApplyAll(5, len(puids), apply)
+	if cache != nil {
+		if err := cache.save(); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return applyAll(5, d.IDs(), apply)
+	return errs
 }
 
 func nameType(in string) string {
@@ -218,7 +244,7 @@ func checkType(in string) bool {
 }
 
 func GetReleases(path string) error {
-	byts, err := getHttp(config.ChangesURL())
+	byts, err := harvest.Get(config.ChangesURL())
 	if err != nil {
 		return err
 	}
@@ -231,6 +257,31 @@ func LoadReleases(path string) (*mappings.Releases, error) {
 	return releases, err
 }
 
+// SourceDate returns the date the DROID signature file was created (the DateCreated attribute of its root element).
+// It is used as the build time of reproducible builds.
+func SourceDate() (time.Time, error) {
+	f, err := os.Open(config.Droid())
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+	dec := xml.NewDecoder(f)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("pronom: no DateCreated in %s: %v", config.Droid(), err)
+		}
+		if el, ok := tok.(xml.StartElement); ok {
+			for _, a := range el.Attr {
+				if a.Name.Local == "DateCreated" {
+					return time.Parse("2006-01-02T15:04:05", a.Value)
+				}
+			}
+			return time.Time{}, fmt.Errorf("pronom: no DateCreated in %s", config.Droid())
+		}
+	}
+}
+
 func Releases(releases *mappings.Releases) ([]string, []string, map[string]map[string]int) {
 	changes := make(map[string]map[string]int)
 	fields := []string{"number releases", "new records", "updated records", "new signatures"}
@@ -374,65 +425,29 @@ func openXML(path string, els interface{}) error {
 	return xml.Unmarshal(buf, els)
 }
 
-func applyAll(max int, reps []string, apply func(puid string) error) []error {
-	ch := make(chan error, len(reps))
-	wg := sync.WaitGroup{}
-	queue := make(chan struct{}, max) // to avoid hammering TNA
-	_, _, tf, _ := config.HarvestOptions()
-	var throttle *time.Ticker
-	if tf > 0 {
-		throttle = time.NewTicker(tf)
-		defer throttle.Stop()
-	}
-	for _, puid := range reps {
-		if tf > 0 {
-			<-throttle.C
-		}
-		wg.Add(1)
-		go func(puid string) {
-			queue <- struct{}{}
-			defer wg.Done()
-			if err := apply(puid); err != nil {
-				ch <- err
-			}
-			<-queue
-		}(puid)
+// save fetches a PRONOM report. If a cache is given and the report has already been saved, it is fetched with a conditional request.
+func save(puid, url, path string, cache *reportCache) error {
+	name := filepath.Join(path, strings.Replace(puid, "/", "", 1)+".xml")
+	var v harvest.Validator
+	if _, err := os.Stat(name); err == nil && cache != nil {
+		v = cache.get(puid)
 	}
-	wg.Wait()
-	close(ch)
-	var errors []error
-	for err := range ch {
-		errors = append(errors, err)
+	b, err := harvest.Fetch(url+puid+".xml", &v)
+	if err == harvest.ErrNotModified {
+		return nil
 	}
-	return errors
-}
-
-func getHttp(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return nil, err
-	}
-	_, timeout, _, transport := config.HarvestOptions()
-	req.Header.Add("User-Agent", "siegfried/roybot (+https://github.com/richardlehane/siegfried)")
-	timer := time.AfterFunc(timeout, func() {
-		transport.CancelRequest(req)
-	})
-	defer timer.Stop()
-	client := http.Client{
-		Transport: transport,
+		return err
 	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	// write to a temporary file first so that an interrupted harvest doesn't leave partial reports
+	if err := ioutil.WriteFile(name+".part", b, os.ModePerm); err != nil {
+		return err
 	}
-	defer resp.Body.Close()
-	return ioutil.ReadAll(resp.Body)
-}
-
-func save(puid, url, path string) error {
-	b, err := getHttp(url + puid + ".xml")
-	if err != nil {
+	if err := os.Rename(name+".part", name); err != nil {
 		return err
 	}
-	return ioutil.WriteFile(filepath.Join(path, strings.Replace(puid, "/", "", 1)+".xml"), b, os.ModePerm)
+	if cache != nil {
+		cache.set(puid, v)
+	}
+	return nil
 }
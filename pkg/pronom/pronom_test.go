@@ -1,10 +1,19 @@
 package pronom
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/richardlehane/siegfried/internal/harvest"
 	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/core"
 )
 
 var p *pronom
@@ -17,6 +26,142 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestHarvestRetryResume(t *testing.T) {
+	var mu sync.Mutex
+	hits := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		n := hits[r.URL.Path]
+		mu.Unlock()
+		if r.URL.Path == "/fmt/2.xml" && n < 3 { // fails twice before succeeding
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/fmt/3.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("<report/>"))
+	}))
+	defer srv.Close()
+	home, err := ioutil.TempDir("", "harvest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	oldHome, oldBackoff := config.Home(), harvest.Backoff
+	oldHarvest, _, _, _ := config.HarvestOptions()
+	defer func() {
+		config.SetHome(oldHome)
+		config.SetHarvestURL(oldHarvest)
+		config.SetHarvestResume(false)
+		config.SetHarvestProgress(nil)
+		harvest.Backoff = oldBackoff
+	}()
+	config.SetHome(home)
+	config.SetHarvestURL(srv.URL + "/")
+	config.SetHarvestResume(true)
+	harvest.Backoff = time.Millisecond
+	os.Mkdir(config.Reports(), os.ModePerm)
+	if err := ioutil.WriteFile(reportPath("fmt/1"), []byte("<report/>"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	var done int
+	config.SetHarvestProgress(func(d, total int) { done = d })
+	errs := harvestReports([]string{"fmt/1", "fmt/2", "fmt/3"})
+	if len(errs) != 1 {
+		t.Errorf("expecting a single error (for fmt/3), got %v", errs)
+	}
+	if hits["/fmt/1.xml"] != 0 || hits["/fmt/2.xml"] != 3 || hits["/fmt/3.xml"] != 1 {
+		t.Errorf("expecting fmt/1 to be skipped, fmt/2 to be retried and fmt/3 not to be retried, got %v", hits)
+	}
+	if _, err := os.Stat(reportPath("fmt/2")); err != nil {
+		t.Error(err)
+	}
+	if done != 3 {
+		t.Errorf("expecting progress to reach 3, got %d", done)
+	}
+}
+
+func TestHarvestConditional(t *testing.T) {
+	var notModified int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("<report/>"))
+	}))
+	defer srv.Close()
+	home, err := ioutil.TempDir("", "harvest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	oldHome := config.Home()
+	oldHarvest, _, _, _ := config.HarvestOptions()
+	defer func() {
+		config.SetHome(oldHome)
+		config.SetHarvestURL(oldHarvest)
+	}()
+	config.SetHome(home)
+	config.SetHarvestURL(srv.URL + "/")
+	os.Mkdir(config.Reports(), os.ModePerm)
+	puids := []string{"fmt/1", "x-fmt/2"}
+	for i := 0; i < 2; i++ {
+		if errs := harvestReports(puids); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	if notModified != 2 {
+		t.Errorf("expecting conditional requests for both reports on the second harvest, got %d", notModified)
+	}
+	for _, puid := range puids {
+		if byts, err := ioutil.ReadFile(reportPath(puid)); err != nil || string(byts) != "<report/>" {
+			t.Errorf("expecting %s to be kept, got %q (%v)", puid, byts, err)
+		}
+	}
+	// a missing report is fetched in full, even if in the cache
+	os.Remove(reportPath("fmt/1"))
+	if errs := harvestReports(puids); len(errs) > 0 || notModified != 3 {
+		t.Errorf("expecting fmt/1 to be fetched in full, got %d conditional requests (%v)", notModified, errs)
+	}
+	if _, err := os.Stat(reportPath("fmt/1")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWarnCodes(t *testing.T) {
+	config.SetHome(filepath.Join("..", "..", "cmd", "roy", "data"))
+	i, err := New(config.SetMulti("single"))
+	config.SetMulti("")()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := i.Recorder().(*Recorder)
+	ids := r.Report()
+	if len(ids) != 1 || ids[0].Warn() != "no match" || core.JoinCodes(core.WarnCodes(ids[0])) != "W001" {
+		t.Errorf("expecting a W001 no match, got %v", ids)
+	}
+	r.ids = pids{{ID: "fmt/1", confidence: incScore}, {ID: "fmt/2", confidence: incScore}}
+	ids = r.Report()
+	if len(ids) != 1 || core.JoinCodes(core.WarnCodes(ids[0])) != "W002" {
+		t.Fatalf("expecting a W002 multiple match, got %v", ids)
+	}
+	// annotations add their codes once, in order, and without changing the original
+	id := ids[0].(core.Annotator).Annotate(nil, core.Warning{Code: core.WarnEncrypted, Msg: "pdf is encrypted"})
+	id = id.(core.Annotator).Annotate(nil, core.Warning{Code: core.WarnEncrypted, Msg: "pdf is encrypted"})
+	if vals := id.Values(); vals[len(vals)-1] != "W002; W009" || !strings.HasSuffix(id.Warn(), "; pdf is encrypted") {
+		t.Errorf("expecting W002; W009, got %v", vals)
+	}
+	if core.JoinCodes(core.WarnCodes(ids[0])) != "W002" {
+		t.Errorf("expecting annotation to leave the original's codes alone, got %v", core.WarnCodes(ids[0]))
+	}
+}
+
 // These work but take a while, so left out of routine testing
 /*
 func TestSaveReports(t *testing.T) {
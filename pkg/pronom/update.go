@@ -0,0 +1,265 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pronom
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/siegfried/internal/harvest"
+	"github.com/richardlehane/siegfried/pkg/config"
+	"github.com/richardlehane/siegfried/pkg/pronom/internal/mappings"
+)
+
+// UpdateFile is the name of the summary written to the home directory by UpdateData.
+const UpdateFile = "pronom-update.json"
+
+var signatureLink = regexp.MustCompile(`href="([^"]*?(DROID_SignatureFile_V(\d+)\.xml|container-signature-(\d{8})\.xml))"`)
+
+// Update summarises a PRONOM release fetched by UpdateData.
+type Update struct {
+	Released   string            `json:"released"`  // release date, as given in the release notes
+	Previous   string            `json:"previous"`  // the DROID signature file in use before the update
+	Droid      string            `json:"droid"`     // the latest DROID signature file
+	Container  string            `json:"container"` // the latest container signature file
+	SHA256     map[string]string `json:"sha256"`    // hashes of the signature files
+	New        []string          `json:"new"`
+	Updated    []string          `json:"updated"`
+	Signatures []string          `json:"signatures"`
+}
+
+func (u *Update) String() string {
+	buf := &bytes.Buffer{}
+	if u.Previous == u.Droid {
+		fmt.Fprintf(buf, "%s is up to date (released %s)\n", u.Droid, u.Released)
+	} else if u.Previous == "" {
+		fmt.Fprintf(buf, "fetched %s (released %s)\n", u.Droid, u.Released)
+	} else {
+		fmt.Fprintf(buf, "updated %s to %s (released %s)\n", u.Previous, u.Droid, u.Released)
+	}
+	fmt.Fprintf(buf, "container signatures: %s\n", u.Container)
+	for _, l := range []struct {
+		name  string
+		puids []string
+	}{{"new", u.New}, {"updated", u.Updated}, {"signatures", u.Signatures}} {
+		if len(l.puids) > 0 {
+			fmt.Fprintf(buf, "%s (%d): %s\n", l.name, len(l.puids), strings.Join(l.puids, ", "))
+		}
+	}
+	return buf.String()
+}
+
+// UpdateData fetches the PRONOM release notes and downloads the latest DROID and container signature files to the home directory
+// (if not already there). Downloads are verified: they must parse and the DROID file's version must match its name. Their SHA256
+// hashes are recorded in the pronom-update.json summary and files already in the home directory are checked against the hashes
+// recorded by earlier updates. The summary lists the PUIDs new, updated or with new signatures in the releases since
+// the DROID file previously in use. If reports is true, the PRONOM reports for those PUIDs are harvested too.
+//
+// Because the latest signature files are inferred from the home directory, roy build uses them once downloaded.
+func UpdateData(reports bool) (*Update, error) {
+	u := &Update{SHA256: make(map[string]string)}
+	if prev := config.DroidBase(); prev != "" {
+		u.Previous = filepath.Base(prev)
+	}
+	recorded := make(map[string]string)
+	if prev, err := LoadUpdate(); err == nil {
+		recorded = prev.SHA256
+	}
+	byts, err := harvest.Get(config.ChangesURL())
+	if err != nil {
+		return nil, err
+	}
+	releases := &mappings.Releases{}
+	if err := xml.Unmarshal(byts, releases); err != nil {
+		return nil, fmt.Errorf("pronom: error parsing release notes from %s: %v", config.ChangesURL(), err)
+	}
+	if err := ioutil.WriteFile(config.Local("release-notes.xml"), byts, os.ModePerm); err != nil {
+		return nil, err
+	}
+	var latest int
+	for _, r := range releases.Releases {
+		if v := droidVersion(r.SignatureName); v > latest {
+			latest, u.Droid, u.Released = v, r.SignatureName, r.ReleaseDate
+		}
+	}
+	if latest == 0 {
+		return nil, fmt.Errorf("pronom: no signature files listed in release notes %s", config.ChangesURL())
+	}
+	droidURL, containerURL, err := signatureFiles(u.Droid)
+	if err != nil {
+		return nil, err
+	}
+	u.Container = containerURL[strings.LastIndex(containerURL, "/")+1:]
+	verifyDroid := func(byts []byte) error {
+		d := &mappings.Droid{}
+		if err := xml.Unmarshal(byts, d); err != nil {
+			return err
+		}
+		if d.Version != latest {
+			return fmt.Errorf("expecting version %d, got %d", latest, d.Version)
+		}
+		return nil
+	}
+	verifyContainer := func(byts []byte) error {
+		return xml.Unmarshal(byts, &mappings.Container{})
+	}
+	for _, f := range []struct {
+		name, url string
+		verify    func([]byte) error
+	}{{u.Droid, droidURL, verifyDroid}, {u.Container, containerURL, verifyContainer}} {
+		hash, err := fetchSignatureFile(f.name, f.url, recorded[f.name], f.verify)
+		if err != nil {
+			return nil, err
+		}
+		u.SHA256[f.name] = hash
+	}
+	prev := droidVersion(u.Previous)
+	for _, r := range releases.Releases {
+		if v := droidVersion(r.SignatureName); v <= prev || (prev == 0 && v != latest) {
+			continue
+		}
+		for _, o := range r.Outlines {
+			switch nameType(o.Typ) {
+			case "new":
+				u.New = append(u.New, makePuids(o.Puids)...)
+			case "updated":
+				u.Updated = append(u.Updated, makePuids(o.Puids)...)
+			case "signatures":
+				u.Signatures = append(u.Signatures, makePuids(o.Puids)...)
+			}
+		}
+	}
+	u.New, u.Updated, u.Signatures = dedupe(u.New), dedupe(u.Updated), dedupe(u.Signatures)
+	out, err := json.MarshalIndent(u, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(config.Local(UpdateFile), out, 0666); err != nil {
+		return nil, err
+	}
+	if !reports {
+		return u, nil
+	}
+	if err := os.MkdirAll(config.Reports(), os.ModePerm); err != nil {
+		return nil, err
+	}
+	errs := harvestReports(dedupe(append(append(append([]string{}, u.New...), u.Updated...), u.Signatures...)))
+	if len(errs) > 0 {
+		return u, fmt.Errorf("pronom: errors saving reports to disk %s", errs)
+	}
+	return u, nil
+}
+
+// LoadUpdate reads the summary written by the last UpdateData.
+func LoadUpdate() (*Update, error) {
+	byts, err := ioutil.ReadFile(config.Local(UpdateFile))
+	if err != nil {
+		return nil, err
+	}
+	u := &Update{}
+	return u, json.Unmarshal(byts, u)
+}
+
+// droidVersion returns the version number in the name of a DROID signature file e.g. 97 for DROID_SignatureFile_V97.xml (or 0)
+func droidVersion(name string) int {
+	v, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), "DROID_SignatureFile_V"), ".xml"))
+	return v
+}
+
+// signatureFiles returns the URLs of the named DROID signature file and of the latest container signature file
+// linked from the signature files page
+func signatureFiles(droid string) (string, string, error) {
+	page := config.SignatureFilesURL()
+	base, err := url.Parse(page)
+	if err != nil {
+		return "", "", err
+	}
+	byts, err := harvest.Get(page)
+	if err != nil {
+		return "", "", err
+	}
+	var droidURL, containerURL, containerDate string
+	for _, m := range signatureLink.FindAllSubmatch(byts, -1) {
+		ref, err := url.Parse(string(m[1]))
+		if err != nil {
+			continue
+		}
+		switch {
+		case string(m[2]) == droid:
+			droidURL = base.ResolveReference(ref).String()
+		case len(m[4]) > 0 && string(m[4]) > containerDate:
+			containerURL, containerDate = base.ResolveReference(ref).String(), string(m[4])
+		}
+	}
+	if droidURL == "" {
+		return "", "", fmt.Errorf("pronom: %s isn't linked from %s", droid, page)
+	}
+	if containerURL == "" {
+		return "", "", fmt.Errorf("pronom: no container signature file linked from %s", page)
+	}
+	return droidURL, containerURL, nil
+}
+
+// fetchSignatureFile downloads a signature file to the home directory, unless it is already there, and returns its SHA256 hash.
+// A file already in the home directory must match the hash recorded when it was downloaded (if any).
+func fetchSignatureFile(name, src, recorded string, verify func([]byte) error) (string, error) {
+	path := filepath.Join(config.Home(), name)
+	if byts, err := ioutil.ReadFile(path); err == nil {
+		hash := sha(byts)
+		if recorded != "" && hash != recorded {
+			return "", fmt.Errorf("pronom: %s has changed since it was downloaded (SHA256 %s, expecting %s)", path, hash, recorded)
+		}
+		return hash, nil
+	}
+	byts, err := harvest.Get(src)
+	if err != nil {
+		return "", err
+	}
+	if err := verify(byts); err != nil {
+		return "", fmt.Errorf("pronom: error verifying %s downloaded from %s: %v", name, src, err)
+	}
+	return sha(byts), ioutil.WriteFile(path, byts, os.ModePerm)
+}
+
+func sha(byts []byte) string {
+	h := sha256.Sum256(byts)
+	return hex.EncodeToString(h[:])
+}
+
+func dedupe(puids []string) []string {
+	if len(puids) == 0 {
+		return puids
+	}
+	sort.Strings(puids)
+	out := puids[:1]
+	for _, p := range puids[1:] {
+		if p != out[len(out)-1] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package pronom
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/richardlehane/siegfried/pkg/config"
+)
+
+const testReleaseNotes = `<?xml version="1.0" encoding="UTF-8"?>
+<release_notes>
+  <release_note>
+    <release_date>01 March 2020</release_date>
+    <signature_filename>DROID_SignatureFile_V97.xml</signature_filename>
+    <release_outline name="New Records"><format><puid type="fmt">1200</puid></format></release_outline>
+    <release_outline name="Updated Records"><format><puid type="fmt">40</puid></format><format><puid type="x-fmt">111</puid></format></release_outline>
+  </release_note>
+  <release_note>
+    <release_date>21 January 2020</release_date>
+    <signature_filename>DROID_SignatureFile_V96.xml</signature_filename>
+    <release_outline name="New Signatures"><format><puid type="fmt">1100</puid></format></release_outline>
+  </release_note>
+</release_notes>`
+
+const testSignaturesPage = `<ul>
+<li><a href="/documents/DROID_SignatureFile_V96.xml">V96</a></li>
+<li><a href="/documents/DROID_SignatureFile_V97.xml">V97</a></li>
+<li><a href="/documents/container-signature-20200121.xml">20200121</a></li>
+<li><a href="https://example.com/documents/container-signature-20190101.xml">20190101</a></li>
+</ul>`
+
+func TestUpdateData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/release-notes.xml", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(testReleaseNotes)) })
+	mux.HandleFunc("/signatures.htm", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(testSignaturesPage)) })
+	mux.HandleFunc("/documents/DROID_SignatureFile_V97.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<FFSignatureFile Version="97"></FFSignatureFile>`))
+	})
+	mux.HandleFunc("/documents/container-signature-20200121.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<ContainerSignatureMapping></ContainerSignatureMapping>`))
+	})
+	mux.HandleFunc("/pronom/", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(`<report/>`)) })
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	home, err := ioutil.TempDir("", "update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	oldHome, oldChanges, oldSigs := config.Home(), config.ChangesURL(), config.SignatureFilesURL()
+	oldHarvest, _, _, _ := config.HarvestOptions()
+	defer func() {
+		config.SetHome(oldHome)
+		config.SetChangesURL(oldChanges)
+		config.SetSignatureFilesURL(oldSigs)
+		config.SetHarvestURL(oldHarvest)
+	}()
+	config.SetHome(home)
+	config.SetChangesURL(srv.URL + "/release-notes.xml")
+	config.SetSignatureFilesURL(srv.URL + "/signatures.htm")
+	config.SetHarvestURL(srv.URL + "/pronom/")
+	if err := ioutil.WriteFile(filepath.Join(home, "DROID_SignatureFile_V96.xml"), []byte(`<FFSignatureFile Version="96"></FFSignatureFile>`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	u, err := UpdateData(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Previous != "DROID_SignatureFile_V96.xml" || u.Droid != "DROID_SignatureFile_V97.xml" || u.Container != "container-signature-20200121.xml" {
+		t.Errorf("unexpected signature files: %+v", u)
+	}
+	if !reflect.DeepEqual(u.New, []string{"fmt/1200"}) || !reflect.DeepEqual(u.Updated, []string{"fmt/40", "x-fmt/111"}) || len(u.Signatures) != 0 {
+		t.Errorf("unexpected PUIDs: %+v", u)
+	}
+	if config.DroidBase() != "DROID_SignatureFile_V97.xml" || config.ContainerBase() != "container-signature-20200121.xml" {
+		t.Errorf("expecting the downloaded signature files to be the latest, got %s and %s", config.DroidBase(), config.ContainerBase())
+	}
+	for _, rep := range []string{"fmt1200.xml", "fmt40.xml", "x-fmt111.xml"} {
+		if _, err := os.Stat(filepath.Join(config.Reports(), rep)); err != nil {
+			t.Errorf("expecting report %s: %v", rep, err)
+		}
+	}
+	// a second update finds the files in place; if one has been altered since it was downloaded, the update fails
+	if u, err = UpdateData(false); err != nil || u.Previous != u.Droid || len(u.New) != 0 {
+		t.Errorf("expecting no changes, got %+v (%v)", u, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(home, "container-signature-20200121.xml"), []byte(`<ContainerSignatureMapping/>`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UpdateData(false); err == nil {
+		t.Error("expecting an error for an altered signature file")
+	}
+}
@@ -18,11 +18,14 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"strings"
 )
 
 type sfCSV struct {
 	rdr         *csv.Reader
+	raw         bool
 	hh          string
+	hashes      int // number of hash columns
 	path        string
 	fields      [][]string
 	identifiers [][2]string
@@ -45,10 +48,16 @@ func newCSV(r io.Reader, path string) (Reader, error) {
 		fieldIdx   = -1
 		fields     = make([][]string, 0, 1)
 	)
-	if rec[fieldStart] != "namespace" {
-		sfc.hh = rec[4]
+	if rec[fieldStart] == "rawname" {
+		sfc.raw = true
+		fieldStart++
+	}
+	var hh []string
+	for fieldStart < len(rec)-1 && rec[fieldStart] != "namespace" {
+		hh = append(hh, rec[fieldStart])
 		fieldStart++
 	}
+	sfc.hh, sfc.hashes = strings.Join(hh, ","), len(hh)
 	if rec[fieldStart] != "namespace" {
 		return nil, fmt.Errorf("bad CSV, expecting field 'namespace' got %s", rec[fieldStart])
 	}
@@ -87,12 +96,14 @@ func (sfc *sfCSV) Next() (File, error) {
 		return File{}, sfc.err
 	}
 	fieldStart := 4
-	var hash string
-	if sfc.hh != "" {
-		hash = sfc.peek[fieldStart]
+	path := sfc.peek[0]
+	if sfc.raw {
+		path = rawName(path, sfc.peek[fieldStart])
 		fieldStart++
 	}
-	file, err := newFile(sfc.peek[0], sfc.peek[1], sfc.peek[2], hash, sfc.peek[3])
+	hash := strings.Join(sfc.peek[fieldStart:fieldStart+sfc.hashes], "")
+	fieldStart += sfc.hashes
+	file, err := newFile(path, sfc.peek[1], sfc.peek[2], hash, sfc.peek[3])
 	if err != nil {
 		return file, err
 	}
@@ -24,14 +24,15 @@ import (
 	"strings"
 
 	"github.com/richardlehane/siegfried/internal/checksum"
+	"github.com/richardlehane/siegfried/pkg/core"
 )
 
 const droidTime = "2006-01-02T15:04:05"
 
 var (
 	droidIDs      = [][2]string{{"droid", ""}}
-	droidFields   = [][]string{{"ns", "id", "format", "version", "mime", "basis", "warning"}}
-	droidNpFields = [][]string{{"ns", "id", "warning"}}
+	droidFields   = [][]string{{"ns", "id", "format", "version", "mime", "basis", "warning", "warncode"}}
+	droidNpFields = [][]string{{"ns", "id", "warning", "warncode"}}
 )
 
 type droid struct {
@@ -83,7 +84,7 @@ func (dr *droid) Head() Head {
 }
 
 func didVals(puid, format, version, mime, basis, mismatch string) []string {
-	var warn string
+	var warn core.Warning
 	if mismatch == "true" {
 		warn = extMismatch
 	} else if basis == "Extension" {
@@ -94,7 +95,7 @@ func didVals(puid, format, version, mime, basis, mismatch string) []string {
 	if puid == "" {
 		puid = "UNKNOWN"
 	}
-	return []string{droidIDs[0][0], puid, format, version, mime, strings.ToLower(basis), warn}
+	return []string{droidIDs[0][0], puid, format, version, mime, strings.ToLower(basis), warn.Msg, string(warn.Code)}
 }
 
 func (dr *droid) Next() (File, error) {
@@ -204,14 +205,14 @@ func (dnp *droidNp) Next() (File, error) {
 	file, err := newFile(dnp.peek[0], "", "", "", "")
 	fn := dnp.peek[0]
 	for {
-		var puid, warn string
-		puid = dnp.peek[1]
+		var warn core.Warning
+		puid := dnp.peek[1]
 		if puid == "Unknown" {
 			puid = "UNKNOWN"
 			warn = unknownWarn
 		}
 		file.IDs = append(file.IDs, newDefaultID(droidNpFields[0],
-			[]string{droidIDs[0][0], puid, warn}))
+			[]string{droidIDs[0][0], puid, warn.Msg, string(warn.Code)}))
 		// multi line multi ids
 		dnp.advance()
 		if dnp.err != nil || fn != dnp.peek[0] {
@@ -18,11 +18,13 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+
+	"github.com/richardlehane/siegfried/pkg/core"
 )
 
 var (
 	fidoIDs    = [][2]string{{"fido", ""}}
-	fidoFields = [][]string{{"ns", "id", "format", "full", "mime", "basis", "warning", "time"}}
+	fidoFields = [][]string{{"ns", "id", "format", "full", "mime", "basis", "warning", "warncode", "time"}}
 )
 
 type fido struct {
@@ -53,7 +55,7 @@ func (fi *fido) Head() Head {
 }
 
 func idVals(known, puid, format, full, mime, basis, time string) []string {
-	var warn string
+	var warn core.Warning
 	if known == "KO" {
 		puid = "UNKNOWN"
 		warn = unknownWarn
@@ -63,7 +65,7 @@ func idVals(known, puid, format, full, mime, basis, time string) []string {
 	if mime == "None" {
 		mime = ""
 	}
-	return []string{"fido", puid, format, full, mime, basis, warn, time}
+	return []string{"fido", puid, format, full, mime, basis, warn.Msg, string(warn.Code), time}
 }
 
 func (fi *fido) Next() (File, error) {
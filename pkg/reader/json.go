@@ -60,6 +60,10 @@ func jsonRecord(dec *json.Decoder) (record, error) {
 	if err != nil {
 		return record{}, err
 	}
+	// the end of the files, which may be followed by other fields such as a summary (or, in JSON Lines, a summary line)
+	if len(keys) == 0 || keys[0] == "summary" {
+		return record{}, io.EOF
+	}
 	m := make(map[string]string)
 	for i, v := range vals {
 		m[keys[i]] = v
@@ -77,6 +81,17 @@ func newJSON(r io.Reader, path string) (Reader, error) {
 	if err != nil {
 		return nil, err
 	}
+	// JSON Lines results (sf -jsonl) have no header: the first line is the first file
+	if _, ok := rec.attributes["filename"]; ok {
+		sfj.peek = rec
+		sfj.head = Head{
+			ResultsPath: path,
+			Identifiers: jsonlIdentifiers(rec.listFields, rec.listValues),
+			Fields:      getFields(rec.listFields, rec.listValues),
+			HashHeader:  getHash(rec.attributes),
+		}
+		return sfj, nil
+	}
 	rec.attributes["results"] = path
 	sfj.head, err = getHead(rec)
 	if err != nil {
@@ -89,6 +104,17 @@ func newJSON(r io.Reader, path string) (Reader, error) {
 	return sfj, nil
 }
 
+// jsonlIdentifiers lists the namespaces of the matches of a file, for JSON Lines results which don't list their identifiers
+func jsonlIdentifiers(keys, vals []string) [][2]string {
+	var ret [][2]string
+	for i, k := range keys {
+		if k == "ns" && i < len(vals) && (len(ret) == 0 || ret[len(ret)-1][0] != vals[i]) {
+			ret = append(ret, [2]string{vals[i], ""})
+		}
+	}
+	return ret
+}
+
 func (sfj *sfJSON) Head() Head {
 	return sfj.head
 }
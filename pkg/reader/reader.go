@@ -15,8 +15,10 @@
 package reader
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,10 +28,10 @@ import (
 	"github.com/richardlehane/siegfried/pkg/core"
 )
 
-const (
-	unknownWarn = "no match"
-	extWarn     = "match on extension only"
-	extMismatch = "extension mismatch"
+var (
+	unknownWarn = core.Warning{Code: core.WarnNoMatch, Msg: "no match"}
+	extWarn     = core.Warning{Code: core.WarnWeakMatch, Msg: "match on extension only"}
+	extMismatch = core.Warning{Code: core.WarnExtMismatch, Msg: "extension mismatch"}
 )
 
 type Reader interface {
@@ -46,6 +48,11 @@ type Head struct {
 	Identifiers   [][2]string
 	Fields        [][]string
 	HashHeader    string
+	// provenance fields (YAML and JSON results only)
+	ScanID    string
+	Hostname  string
+	Flags     string
+	SigSHA256 string
 }
 
 type File struct {
@@ -85,6 +92,7 @@ func toVersion(str string) ([3]int, error) {
 func getHead(rec record) (Head, error) {
 	head, err := newHeadMap(rec.attributes)
 	head.Identifiers = getIdentifiers(rec.listValues)
+	head.ScanID, head.Hostname, head.Flags, head.SigSHA256 = rec.attributes["scanid"], rec.attributes["hostname"], rec.attributes["flags"], rec.attributes["sigsha256"]
 	return head, err
 }
 
@@ -140,18 +148,29 @@ func newFile(path, sz, mod, hash, e string) (File, error) {
 	return file, err
 }
 
+// rawName restores the original bytes of a filename from a hex encoded rawname field (see sf -raw-names).
+func rawName(name, raw string) string {
+	if raw == "" {
+		return name
+	}
+	byts, err := hex.DecodeString(raw)
+	if err != nil {
+		return name
+	}
+	return string(byts)
+}
+
 func getFile(rec record) (File, error) {
-	var hh string
-	for k := range rec.attributes {
-		if h := checksum.GetHash(k); h >= 0 {
-			hh = k
-			break
+	var hash string
+	if hh := getHash(rec.attributes); hh != "" {
+		for _, h := range strings.Split(hh, ",") {
+			hash += rec.attributes[h]
 		}
 	}
-	f, err := newFile(rec.attributes["filename"],
+	f, err := newFile(rawName(rec.attributes["filename"], rec.attributes["rawname"]),
 		rec.attributes["filesize"],
 		rec.attributes["modified"],
-		rec.attributes[hh],
+		hash,
 		rec.attributes["errors"],
 	)
 	if err != nil {
@@ -183,13 +202,17 @@ func getIdentifiers(vals []string) [][2]string {
 	return ret
 }
 
+// getHash returns the names of the hashes in a record as a comma-separated list.
+// The order in which the hashes were written isn't kept, so they are listed in the order of checksum.HashChoices.
 func getHash(m map[string]string) string {
+	var hs checksum.HashTyps
 	for k := range m {
 		if h := checksum.GetHash(k); h >= 0 {
-			return h.String()
+			hs = append(hs, h)
 		}
 	}
-	return ""
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	return hs.String()
 }
 
 func getFields(keys, vals []string) [][]string {
@@ -262,7 +285,9 @@ func New(rdr io.Reader, path string) (Reader, error) {
 type defaultID struct {
 	id     int
 	warn   int
+	code   int
 	known  bool
+	fields []string
 	values []string
 }
 
@@ -274,11 +299,29 @@ func (did *defaultID) Warn() string {
 	}
 	return ""
 }
+
+// WarnCodes returns the codes in the warncode field, if the results have one
+func (did *defaultID) WarnCodes() []core.WarningCode {
+	if did.code > 0 && did.values[did.code] != "" {
+		strs := strings.Split(did.values[did.code], "; ")
+		codes := make([]core.WarningCode, len(strs))
+		for i, s := range strs {
+			codes[i] = core.WarningCode(s)
+		}
+		return codes
+	}
+	if did.Warn() != "" {
+		return []core.WarningCode{core.WarnOther}
+	}
+	return nil
+}
+
 func (did *defaultID) Values() []string        { return did.values }
+func (did *defaultID) Fields() []string        { return did.fields }
 func (did *defaultID) Archive() config.Archive { return config.None }
 
 func newDefaultID(fields, values []string) *defaultID {
-	did := &defaultID{values: values}
+	did := &defaultID{fields: fields, values: values}
 	for i, v := range fields {
 		switch v {
 		case "id", "identifier", "ID":
@@ -290,6 +333,8 @@ func newDefaultID(fields, values []string) *defaultID {
 			}
 		case "warn", "warning":
 			did.warn = i
+		case "warncode":
+			did.code = i
 		}
 	}
 	return did
@@ -2,8 +2,13 @@ package reader
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/richardlehane/siegfried/pkg/core"
 )
 
 const (
@@ -47,6 +52,30 @@ func TestSF(t *testing.T) {
 	}
 }
 
+// Results with a summary (sf -summary) can be read back
+func TestJSONSummary(t *testing.T) {
+	byts, err := ioutil.ReadFile("examples/multi/multi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := strings.TrimSpace(string(byts))
+	doc = doc[:len(doc)-1] + `,"summary":{"files":1,"bytes":2,"errors":0,"identifiers":[],"directories":[{"path":"a","files":1,"bytes":2,"errors":0,"identifiers":[]}]}}`
+	count := func(r io.Reader) int {
+		rdr, err := New(r, "multi.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var i int
+		for _, e := rdr.Next(); e == nil; _, e = rdr.Next() {
+			i++
+		}
+		return i
+	}
+	if expect, got := count(bytes.NewReader(byts)), count(strings.NewReader(doc)); got != expect {
+		t.Errorf("expecting %d files, got %d", expect, got)
+	}
+}
+
 func testRdr(t *testing.T, path string, expectFiles, expectIDs int) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -79,6 +108,25 @@ func TestDroid(t *testing.T) {
 	testRdr(t, "examples/ipresShowcase/droid-np.csv", ipresFiles, ipresDroidNpIDs)
 }
 
+func TestWarnCodes(t *testing.T) {
+	for _, c := range []struct {
+		id   *defaultID
+		code string
+	}{
+		{newDefaultID(droidFields[0], didVals("fmt/1", "", "", "", "Signature", "false")), ""},
+		{newDefaultID(droidFields[0], didVals("", "", "", "", "", "false")), "W001"},
+		{newDefaultID(droidFields[0], didVals("fmt/1", "", "", "", "Extension", "false")), "W003"},
+		{newDefaultID(droidFields[0], didVals("fmt/1", "", "", "", "Signature", "true")), "W005"},
+		{newDefaultID(fidoFields[0], idVals("KO", "", "", "", "None", "", "")), "W001"},
+		{newDefaultID(fidoFields[0], idVals("OK", "fmt/1", "", "", "None", "extension", "")), "W003"},
+		{newDefaultID([]string{"ns", "id", "warning"}, []string{"test", "UNKNOWN", "no match"}), "W000"}, // results without a warncode field
+	} {
+		if got := core.JoinCodes(core.WarnCodes(c.id)); got != c.code {
+			t.Errorf("%v: expecting %q, got %q", c.id.values, c.code, got)
+		}
+	}
+}
+
 func TestCompare(t *testing.T) {
 	w := &bytes.Buffer{}
 	if err := Compare(w, 0, "examples/ipresShowcase/droid-gui-m.csv", "examples/ipresShowcase/droid-gui-s.csv"); err != nil {
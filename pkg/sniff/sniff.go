@@ -0,0 +1,92 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sniff adapts siegfried for Go web servers: it detects content types with a loaded signature file where a server
+// would otherwise use http.DetectContentType.
+//
+// Example:
+//
+//	s, err := siegfried.Load("tika.sig")
+//	sn := sniff.New(s)
+//	ct := sn.DetectContentType(data) // in place of http.DetectContentType(data)
+//	ct, body, err := sn.Sniff("upload.pdf", req.Body) // read the body from body afterwards
+package sniff
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/richardlehane/siegfried"
+)
+
+// Sniffer detects content types using a Siegfried. It is safe for concurrent use.
+type Sniffer struct {
+	sf *siegfried.Siegfried
+}
+
+// New returns a Sniffer for a loaded signature file.
+// Signature files with a MIME-info identifier (e.g. tika.sig or pronom-tika-loc.sig) give the most MIME-types.
+func New(s *siegfried.Siegfried) *Sniffer {
+	return &Sniffer{sf: s}
+}
+
+// DetectContentType is a drop-in replacement for http.DetectContentType: it always returns a valid MIME-type.
+// Unlike http.DetectContentType, it considers all of the data, not just the first 512 bytes, so pass the whole file if you have it.
+// If siegfried can't identify the data, the result of http.DetectContentType is returned.
+func (sn *Sniffer) DetectContentType(data []byte) string {
+	ct, _ := sn.detect("", bytes.NewReader(data))
+	if ct == "" {
+		return http.DetectContentType(data)
+	}
+	return ct
+}
+
+// Sniff detects the content type of a stream, such as a request body. The name (e.g. of an uploaded file) may be empty.
+// Only the bytes that siegfried reads to identify the stream are buffered: the returned reader replays those bytes and then
+// reads the rest of the stream, so use it in place of r afterwards.
+// If siegfried can't identify the stream, the result of http.DetectContentType for the buffered bytes is returned.
+func (sn *Sniffer) Sniff(name string, r io.Reader) (string, io.Reader, error) {
+	rec := &recorder{r: r}
+	ct, err := sn.detect(name, rec)
+	body := io.MultiReader(bytes.NewReader(rec.buf.Bytes()), r)
+	if err != nil {
+		return "", body, err
+	}
+	if ct == "" {
+		ct = http.DetectContentType(rec.buf.Bytes())
+	}
+	return ct, body, nil
+}
+
+// detect returns the MIME-type siegfried gives, or an empty string if it has none
+func (sn *Sniffer) detect(name string, r io.Reader) (string, error) {
+	ct, err := sn.sf.DetectMIME(name, r)
+	if ct == siegfried.DefaultMIME {
+		ct = ""
+	}
+	return ct, err
+}
+
+// recorder keeps a copy of the bytes read from a stream
+type recorder struct {
+	r   io.Reader
+	buf bytes.Buffer
+}
+
+func (rec *recorder) Read(p []byte) (int, error) {
+	n, err := rec.r.Read(p)
+	rec.buf.Write(p[:n])
+	return n, err
+}
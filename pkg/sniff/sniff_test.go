@@ -0,0 +1,56 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sniff
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/richardlehane/siegfried"
+)
+
+func TestDetectContentType(t *testing.T) {
+	s, err := siegfried.Load("../../cmd/roy/data/pronom-tika-loc.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sn := New(s)
+	for _, v := range []struct{ data, ct string }{
+		{"%PDF-1.4\n%%EOF\n", "application/pdf"},
+		{"\x00\x01\x02\x03", "application/octet-stream"},
+		{"", "text/plain; charset=utf-8"}, // from http.DetectContentType
+	} {
+		if ct := sn.DetectContentType([]byte(v.data)); ct != v.ct {
+			t.Errorf("expecting %s for %q, got %s", v.ct, v.data, ct)
+		}
+	}
+}
+
+func TestSniff(t *testing.T) {
+	s, err := siegfried.Load("../../cmd/roy/data/pronom-tika-loc.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := "%PDF-1.4\n" + strings.Repeat("x", 100000) + "\n%%EOF\n"
+	ct, body, err := New(s).Sniff("", strings.NewReader(data))
+	if err != nil || ct != "application/pdf" {
+		t.Fatalf("expecting application/pdf, got %s (%v)", ct, err)
+	}
+	byts, err := ioutil.ReadAll(body)
+	if err != nil || string(byts) != data {
+		t.Errorf("expecting the body to be replayed, got %d bytes (%v)", len(byts), err)
+	}
+}
@@ -0,0 +1,51 @@
+// +build ignore
+
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// gen.go writes roy's default signature file into sig.go
+// invoke using `go generate` (after updating the signature files in cmd/roy)
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const lineLen = 76
+
+func main() {
+	sig, err := ioutil.ReadFile(filepath.Join("..", "..", "cmd", "roy", "data", "default.sig"))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	enc := base64.StdEncoding.EncodeToString(sig)
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n// +build static\n\npackage static\n\n")
+	buf.WriteString("// the default signature file, base64 encoded\nconst defaultSig = `\n")
+	for len(enc) > lineLen {
+		buf.WriteString(enc[:lineLen] + "\n")
+		enc = enc[lineLen:]
+	}
+	buf.WriteString(enc + "\n`\n")
+	if err := ioutil.WriteFile("sig.go", buf.Bytes(), 0644); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,2570 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+// +build static
+
+package static
+
+// the default signature file, base64 encoded
+const defaultSig = `
+c2YA/wEJ7L0FnNzG+T7+vPMK1hTbsROH4zhJ09Td3QNDsE1iO+zEgSZp0zSdFeyNTzCWdLuySykz
+MzNTyszM3H7LzMzc+39Gu7pbXxzXTfuF37997qPTI72aV+/MvBqWlgAc9Pm/XPj2o3qTD0I5+3KL
+MROEhI/YFqQKPcLDwVB5SjjDEtiVE/5mM+JAEd7lWJj045Bxgnui+yWX4ekuYU1DwC8JV5GDoOP5
+yiN8TDCyXpfwNcGI+pOEl7gMPTZB+I3N0L4UuBFBg5HkIeNp9Ax6FjH6nWnGBgHrOTajG8QWTsGv
+8BDroRZDZh7jd/grey5Dj40Tfm0z4iIifMdlzMwEBOUyPNkhvEFY6AcdzbjRepX1aosx5WcCn7Uu
+chg6Twl3bTD02AbC72wXYSCLqSAj7HAYHT1BOBiM/sYJwtfBKHdPEn4Kxh6lCbcjhgw9wjQxZKgI
+7yWGn08QfuowYh0RtrmMjp4krIZAp094NBjSnxBY4qxwGDuTgnArl5F2dhK+7VqYiWSf8BUwwqwg
+vIBsxFNFHBE+LBgzkz7BtwX0bsITHYaf54SfOAxVhIRfOYxgYoxwMDF6XkF4ITF6Xmjju6ycnc60
+EzmMXcojdC1Gnk0QHugy/J09gUm+NzN6/bKBW1snWxfbO+wnuE90n+Q+2bXQy/OY8DCHkXZjwg0u
+o/RjgYB+yhakTrqExzkMbyYnHAtGML6BsJQsnJL3SsJTmeF7MeEtFkNvjgm3sy14kVaE9a7AlE94
+HTN6yiN8kRneTEb4MjPCTkn4mcPIC0XY3WD0/JJwNDNKLyT83mL0dUG4vMHIS4+wWzCUlzLeIq53
+3+oy+n7nYPxK/Fr8Rqzl43gdH88n8Inc5jEe5w28iTfzKXwqn8YWytjzCY9ybGS+LKTAF9z/chmy
+9AnnOYxdeUG41GkgnsmVV8YR4c4Ooz+dW9iDX4rT+QxmSH9SYKmz0mEkfd/C8c6JzknOyY5A7BOe
+59qQufJywpsbjKC3W+DCxkUNRh75hASMtNCMJ4k78rXMiPU44fnMSIuQkFsMlXYIX3EZeRwRfukK
+xB1GQuud2zoN5DLf3PFlsRg/sn5i/cb5nfMH50/OX5y/OXCFK6B6AnDIYeTTISEiRj+fJHyQLEwV
+cbQYP6Kf0M/oF/RL+g39js6y3mc9wmUESUR4MFvQOu4Q/sSMKJ4hvKrB2Km7i7BSHCwOEYeLo8Q6
+cYK4pzjKZugsdfBb8Tvxe/EH8UfxJ8HQRUR4Oxi+lwn8VeywGEWWEJoWQ6e+wIz1LttC4Kce4ZAG
+I9I+4aUNC4HOQ8YkjqHjiFH2fcaPEdJPmNHVpUCTH+Uyoj1TBNdm+DIgfMtl9NOMcESD0c0k4YIG
+Iw4KgXNwIzF85Qk8BA+FQKgF7kMPIEbU1wLPoacxI0gywsPZgc7DSHUIr3cYwfhmwnJiKL9PeBQx
+/CAmfIAY4aljhNs7jI7ShEUNxpSXEXSDobo54Xlg7OoXhA+CEauEcDdiTHY14UZm5FlB+I3L8IoO
+4Rgw/M4ugbWIiREnGWEbGOGGHmExM+KdE4QnMKOc9C2803qX9W7rPRZD+12B1+AuDYZfZozn0W6+
+FzP0xDjhrjbD74eEFhjl7j7hw8TYqWPCZsvCHi13EXyX4XmThDs0GFFQCGSNezYY/XwD4VRykcm8
+CLI+4T3M6MU54R4uo4ykwL1xXzCyInTwLfo2fYfuI17B73YYxe6Y8GUwdBIT/sIMrycJ33UtdPzU
+E3hT4y0Nhq8140/2Mc6xDqPfmSCww/BUQng8GH5aEl5OjLzsE0rB8GRBuI3FiJMu4W6WhTzyY8LB
+NiMvfMKZDUYZdQkPBMP3SoGn4hXE8JUvMGZttBhSlYRFjo2un8k+4WxXIOoLvMj9ssvwpgrCI0hg
+Z5/wWGJIr0c4zWbEUhNmHAvKS3LCh13GhjImPN9l6Cwg3BmMfCYhfBQCvkcoiOF3SsaLyXFch5Er
+38IRfDe+B3+LGXEZEa5zGFrHAm/DH9lCrIOuBVu44lB7nW0jT9JUE95pL4aK054KdJbuFPik8zmH
+4cWlwJE4Cgy/HxG+KBhpWBA+KgTClLCkwdC5T7geNsJYj08Q3AZDZgXhVJshkw7hGsdCHnR3E97A
+jE6+i7C4wdCyQ7gIjGD8FMIKsqC1jAkH2Yyd/ZzwANdCHORThI+5jD2TPuPT7mfcz7qMYHwjYRkx
+yt2a8BFihFkp8En+FDOi/ibCy11GJ0sJ3QajPz1B6ENA5oTfgxF4fYGPsN1gJKoj8CnnMw5Dx5OE
+VxEjzkPCryyGryThs2RBd+MO4bfMiHcrwuddhvYKgR14OjP6viZ82mJksU84wmUE/YjgNATkDOFR
+sKB1HhOW2AyvP70Gj7IfbT/Gfqz9OPvx9hPsJ9pPsp9sP8V+qv00++n2M+xn2s+yn20f4hzqrHEO
+cw53GLLTJ1zmMHRWEo51GfHkDOFSYhQqXISjxbHiBvFS8XLxSvEq8RrxUmbkuke4PTP0npjwVosR
+9ccJL3QZMlWEn7iMSCWE08EI4z2ELwlGPFEQPmIx4iQibIWFKd2NCNcSQ/a6hD3EiMuc8FxXQKqV
+WAbHcq2GtchabC2xllnPsJ5lPcd6nvUC60XWS6yXWa+wGLt0sRQfwkn2bexL7cc1nth4SuPpjWc1
+ntt4QePFDUZa5IwniOv4embkfkaYtRhK7SJscBmBSgk7GoxQbyTcjxhTRbwYP6Qf00/p5/Qr+jX9
+lm5vvd96uMuIki7hfcJCvxNrwnWWA51JrTzC61zGrrC0oTnjgntcMkOGmnCRzUhUSPiAw9DKs3EF
+Xodn8uesN7qM3E8I3xQWsqkiJtzTYnSmJwgPsxlJWhCKBkPmmvBICGQ9ge20khm9IiR8wWGUkcd4
+AJ5iPdViSM8n/AaMnf2C8DhixFmfcKbN6KUR4UKH4fsdwp0Eoy9Dwl0tC37qxYSexdAyZKx1jnPW
+OQKxJDQdRj/KCVc0GHEyQzgfjDLqEO4PRiZjwofBkH4o8Fu0XUZfxYTfWg0UQawjWQSEj7qMYGyC
+AHKQJoGe7hK+6FgIVZEThBAoY8ID2UW+K1JFMEl4kM0IVVfgq/ZfbIFkkrDZYURBSvi1ywjGxwgN
+Yuhun/A2trA7SDxC12VkoSIc2mCEnWmBTzY+3WDImZLwY5fh93NCGwytSoHX4y4uY1dZ2Hg3HtR4
+aOMRjUc3GNovLZxgnWjdyjrJsiA7KiR8wrLQ8eOewPnuhS4jyXuEd7kM7WuCbDDCKCY8Cww9pQVe
+i16DUfjThC+BkRcZYQ07UJ00nc4JF1sMvzNJeKDN6IY54XiXkReRwC60HUbejQlfACMYGxeYxRdt
+Ad0nvIYYiVICn7Oly4hlwfiK/Wf73S5DdgrCbS0H+a5IFYHA/e1Hu4yOmiY8xBaQsY2+s8e5h3OD
+c1+H0c+mCd93LcQ79QThiczQmUf4PjPS6YJwD5sRTm0kbABD93zCO8DQubZxBB1Jd6UOfZIYemdB
+eBFZyGMVEQ5mhr9rN2E9LBQqDBfhGLFW3Fu8TLxC3CheLV4rXsaMvNAC663rbUZfloQPWgJFTvio
+xUhyRTjSZkz1tcBl7uUuIwxDwmtcRl92CIc3GtC5VrHsBjYkefQJegG/kBnl7g2EjxIjiCOBLfwS
+h+HHmvB2m+EXOeH9DqPMI8JlxAj7E4SHEKOTloRnEyMpQsYb+U38ZmZIlTGe4DzVeZpjIZ+S44Sv
+Og5U6JVxRNhkM0I/I7zTYcg8tPEIvIU2is3iFMHQfqeBV0BZm91T3FPdi93t7jUNxk4dWFghVolD
+xWGCEetJwrhgxDM54QfMKItQoGn/wnERlF404weEa12G1hsIbwFDeR2Bd9HPmZH3w2Pwe/oD/ZH+
+RH+mv9BfybcCK7ReYL/QfpH9Yvsl9kvtl9kvt19hv9K+0X6V/Wr7NfZr7dfZr7ffYL/RfpNtQesi
+JlxmMbzMJxzWYPi6FPibeLAlkHUIl1qMvC8Jz3EFkh7hTy5Dex3CnRqMeEOP0GCGDkPCA5jhSW3h
+zfZb7LfZEy4jGJ8kLKEGYpUonYfjhDc6jJ25JrwAjFAXhOcTI+34AifxbdiC3w9LwocdRh5OE77q
+Mvr5ZsLptAix9mQho7RLWGs3kPdl4U0FOeHNLiNIeoSoYSHvdfcQMjBkERJOtxnSmyQ80mbslDHh
+gw6j43mEqxsMzy8Ia8DQUUG4EozcDwmfA6OMIwc/oAeKB4lrnZ87H3cZXhwRHsOMuIwJz3IFMilw
+Cf2aGTt1SHixYETKI7xXMHZ2+4S3MiPXpcAznRc6jCzxCWtdRl9HAlfTpyxGrDcIuPxVixFP9Ahr
+bEbPV4R724zQ7xDe0RDIYsKnwIh114IjGuIQ+zhbYComrLBsxHk/jgnvdRidaJqwEoxs125CCEY/
+30j4Lmx0oiDxBUKn6zDCsBC4kn7mMqbjiNBiRlzEhD02o9fJCXdyGZ7MBHY27tFgxH6fcFSD4atQ
+YB3eT4wk30B4JgkkHYFX0jJm9DeOE1LB8Dse4fPsIpmJO0GWE/5gM8pOSPiyzYgjTXifY6EbS004
+x2V4/kbCKTZD+uMCi53lDqOM+jYehJPFbURTtATDmykI9yQLHRX1Ce9iganSxm7n7s69nPs493cE
+yj2E0GWkfmGB8FhxJV/FDN1XBB+MTtEnvJcZ/c4kwXIY3lRG+KhjIe12Zwj3dRn9TWMCJ+Nqh5FH
+HUIMRqljwo/AmPJDC9v5cv66fZTLKLKNhCtcRqBzGxM4mtbSUuuZFqOvNzK+hZ0iFhZ26qDbwHKx
+WqwRR4jjxYniXuJom5HrkHCkYPhlh3B/wfCjiPEocVfHcxhenhP+DEaoQsKDiFGEJeF1grFLhYSY
+GbnqEO5sOwj2+JnsC3zC+bzD8MvwCFwoLhIXi+3iEnGp2CEuE5eLK8SV4g7iKnG1uEbcUWQiF4WY
+ET3RFw8Qa6zDrRssRppJwrcdhhdrgdc1Xt9g9HsR4WtghFMbCDvJgr+zNyMwwTcwI8pCwi6LEfuK
+sM2x0PVTj3B7l5GXvsDdxJccRt4pCfdlRs8rCZ9hRiRzF6fhffwB/hB/nb/B32SGloXAxdjpWggj
+6RG2MiP2Q0LLZezxNeFlDUYvLxjvoV/yU11GRyWEQ9hC1u0EhM9bDC9OCV9zGVHsE17dYHRi7eJc
+ulbcWVwvpOgIXxBCgXOc77kMLwwJP3UZeVAQZl0LMvdKwqUuY5cfCvwcCTPivCvwEfFM14LuxB3C
+n9mGimU3INi2hTIqYkLDZuS9UODRzmMcho43Et4IRk/1CNdbDF2UhPvZDG+PIjzdZUjvBMKRDUYY
+F4QnkAVPq5Sw0mbs9EPCnRyG1jnhGljIu3FE+DwYwfgmwkHE0DMdB2M0ThM0SRtoIzGKIiR8TzBy
+f/Ni/Nj6qfVb5/fOH50/O391Zh1y2WXs8nzCe8HwC59wD7LRU57MCF9ihu7GAr/jPzCj2FMSvutY
+mAqUR5hyGdJXhIeB4U0pAU0hM1Tiu/iWEPwo6zHW46wnWE+yGMVUQng8WwinxscIj7QY/elJQgmB
+zh7CFmJ0VSjwZfoKMcpYC7zB+i+HkfsR4a6uhZlCRwJnN7Y0GLm3uYHvWz+0bNd1F7lL3GXucpfR
+3bSB8HObke7aTbgQjCwKCJ8EQ3ulja/S1+jr9A36JjFkTxEgGLHuE2xm5J1pwu0sxh4VEu7iuOjm
+kfKDnHA7lyFlIPBx57MOw9Ml4Wgw+nqTwEm4g8Pw466AsM90GcHGzYQjbIa3KyJM2IwyDgmFzdhV
+RoT3gREl04T3C4afh4SngZH6mvFEcQ3fiRl+0rXxEnGEdaR1lPVgm9EpC8JrG4x8urMC3xFHN9Y2
+1jVOaNyqcevGbRq3bbQaY42JxobGpsYpjdMajHi6R3iNZcFPi5LwNUugnzfwHXwf6+h4OoVOozPo
+Q8Twu4nAy6hjMVK/y3iyuCt32EJeBJpwF5uhQo8xaUu7YzNiz7fwSOex7uPcx7sW0m48Q7ify8in
+csINDYbnZ8txKO5Id6KP0cfpU/RpWmkdbK2yVlunWKdap1mnW4z+9C6Be+IGMPJSEfYIRhH2CW9h
+hvYDgmczgrFTCS4xYq0d3IbW022pScdYN9iMzowmvE1Y0LooBY7iO1oWclWUhB8xI1a+wF9wKFnQ
+3VgSfs+MKC8Iuy2G6nmEY21GmMWECcdCuXtPQPiCJTC1gXC0y+joiLAKjF1BRPgFGP24JHzAYiRx
+Tvim20A3mYmDTHmE37oM38sJT4GFQoV9wjuYEU6dQni4xQii0MLl9pX2VfY1NqMjC8LrhYNuHkaq
+Q3izw+ioiNBoMDKZMW5Pf+PfWAy/GCP0iaHjDYRXEyPypgWO5cexDS27QU74vc3Y1fdX4AO4tb3e
+vsR+cONhjUc2HtN4fONJjac2ntF4duN5jRc2XtJgyDgUuM5+q8PY40eElzcs9GXmCVzB2xqMnUmX
+cHeLURZdwom2hV5exISHOy5ilSidh4Q3OIyoP0F4scvwy5IwDkYWFi7uQF8R72m8v/HBxocbH28w
+Yj1BsNhGPw3DCcLbXIG+InwVjGBsE8EiC7HyFWENMbypPmGFbWFPUGrC8+1FkLHKZBzkUzZK527O
+PZ17O/dzGP04JNwNDO15Ai/HnRsMPysIdyFGt9ux8Ufrz9ZfrL9af7MWY1ep12aBTrOCcILNyHsZ
+4RE2oyOnCU9xLKR65wzhPi7Dy3uEJWDovk/wIOB3XDwJL+IX80v4QvsB9mNcRjC2kcDE8MtI4I3W
+mywLZT+WhAc6jKi/gfBSl+F1YxePQ5enWPFOnuaIBcJ4KabovvRA+rO4t3Uf677W/az7Ww+wHmiN
+u4x8d854tJCO7zByf5pwpMMoiohwisPQWhKuho049YOI8FnB6Hoe4ecuI5QlIW4w+rvGCfcAQ/uR
+wKtwfYNRyC7hv8DwvQlCTozczwkftxilzAS+aT3AYfSnxwk9MOJcC/wS2mHs1BOEMcGIpyXhdZaF
+fhqGhD9YjLzYTXiNw9hZaMJJrgXlxxHhQy5D68LGHXEnXIufip8JRtmJBX4In5bAk0UsiyBTMiJ8
+XjB0XBDOcBj9TRsIL3UYuZaEdzgWJrtaE17FDN/3BdrWBosxHewmHGMvxa5SnzgxlgU6zQrCrWxG
+HvYJ066FblSEAvdr3L/B6E9vIOwGI1Yh40U4jB7hMKTvEd5AjLToMp4iJHu8GP2gIzNvSvUCwpjD
+0FFJeIbL0Nkewq9cRlwqwrNdRuIXhDe4DL/fXYXTxOniDHGmuJ24vThLnC3OEVvEVrFNnCvOE+eL
+C8QPxGHWvSxGEoSEg9hCGRUl4esWQ0WK0bJ/6fzaYeRFTChtxi7dJ9gOQ/sF4XIw4tS3sEh8Rvxc
+9G0LcdpRhO8xI05zwiaXERaThL+5jDCNCOc3GHma21jRWNk4uLGqsbohoGLCamKUuU/YQQxdxITM
+YiR9z8IJzq2cWzu3cSzoPBwnvM5hxP5Gga8573Bt6EjlhcDHnE87DO0FhOsaDO3lhJeCIVVIEBbD
+74WEcyxG3o8Jv7MYOtpNmLVdxCpR3TwkvMVhpFlIOMO2kIWbNliI7MTeZec2o8h7hEVghHGPsAkM
+TxeEJ4Ahdca4Fz2JHs0MlSjCO8RieLLQWerPeAXhvwRDxz7hdIcxVXiECxyGnkoJnYaAKgg9m5H3
+Jwl/tBkbSkl4gcvoZ0pAoAFGnHiELWCEkRJ4JgJmSF0QnkyMKFKEM8CIN0iBRfwViyETRfgKM6aL
+kvAeh5F7HuH0BsPP+hZuizvTdXQ9MVTcdfB8/Bdb9lVu5P7AZWSTvsAnrcNdG3mi/ZAw5tpQXprk
+hDe5jF1lh/B+MIKxDQRBjKwILHxVvLvxvsZHG4wkTglfdxk6SwjXgRF1YoF3iyezC08WWmYF4QvC
+Qjg1Pk74MTOkv0FgmXOww0i7fcK9XYbnlzYOwyf40zzp3K7B0FoJvBV+gxGMjRH+CobydxFezYxS
+Fxa+ZX3b+o71XYvhyZBgW4ydZUb4jMXwomnGETjEOtRiaN+3cFe8DBPWJosR9icJDyWGjBThtcQI
+kj7hYcwIspzwUWZkfkg41bHgF4FP6BEjjxWBbYYMNOEztgUvkzEjdhIndSyoxO8QPukyJv2csBSM
+TIWEj4Ph7ykIr2dGkIWEw2wXntTFTBYQJl0bMldeQHhrgyH7BeFyx0Kso5xwrsuIk4JwLhjamyRc
+AobWGwlXgbGr9G28Cw9sPKTx8MajGgwZZ4RXsoOdeZpEPmGrw+hr38Wt8E1Mi0Rc4bzYuazB8PsF
+YQyMrt8hPJosaJ2XhMU2oyikwMudVziMLFaEVzk2umGaxYStLiMJU8Yr3Fe6N7qMbhwJrKJ1LsNX
+mcBz6Z5soYzyUuBIvsay4KdFTPiGxYhlh/BLi7ErjwmXOIyxsTHCO12GFxaEtMEoo2nCCjD0po0C
+78RpDiPrTxA22gydZoRvOQxvapJwOBiy4xN+BkaoJwk3kA0vknlOeKNg7Cr1UrwHJ9u3tbfbj208
+ofHkxtMaz2w8p/H8xosajM6eCcJWYoR6M2FWWNBpEROW2Qzpl4SGw5B9n/BelyH9jQIHOascB918
+KggKwlkuw/NDgXP5fGakYWkj5V2c8wz32UIxFZeEvsXwsj7hixbD00rgPvZtXEa/M04QDkMXucDb
+Gm9vWFDdICc8F4zc9228ib5tf8f+vv0Dm5GrgkA2I4w04eG2Bd9PPQtoUEM0uMEodES4fUOgr23c
+Gt+AElpc5TC8jk/4AxjaD2+Fz9Hn6Qv0X/RF+hJ9nx4sniOeK54nni9eID4gPijO5pfzudZ51vnW
+BdaF1kXW5dYV1pXWHayrrKutZ1vPtZ5vvdB6sfVS6+XWK62HuIww6hH+Ri50lu4MvILwOWGhl/sl
+4UEOI+97hE+4jKzbITwGDNnPCW8mCztlTxLeKRh5GBI8i6G1XoLb22fZZ9vn2FvsrfY2+1z7PPt8
++wKbobsh4cEuI/cKwmfB6Oic8FJidLOOwAV8ETPyTkK4DzPKKGbcB0+znm5ZiKcnfcJrLUY83iMc
+bzPCYoLwV5fhFxOE3dSAn1+fF2kWEL7DjHxqmnCEw/AmfcJDXUbWzwnfEAyZ7ST83GIkMwXhhzYj
+9XPG48Wd+S5soS97gcAzxbOFBT/1SoFZvpPF8LyA8FhY6AedmPBmizHp+4QvO4winyL0G4x+0CGU
+DYaOOoQ3gOH3JwgzxFBhSnirYExnkvAdx0La7c8Q7u8yZJILHITLiRHqkrAZjGBskkDEkGqKcH92
+oD2pky7hHTYj1TsJ93QZ/dwnfA+MYOwUgkOMQmaEs4iRFgXjceIOfDUz4umc8HrLQq6iVOA093SX
+oZKQ8XZxOD+KGd3AJxztWEj1TC7wLOd5DsNPvVU4HifgRDwYF9CFdBFdTI+hCTEpQtEVW6yfWc+1
+r3TObZzXYPhpIXAbPM9m6DwUkHitw8iLDkGDofUewq9hYbeMI8KPbUYmc8JHwNgpM8JjBKPUOWHc
+ZuhgmnCyy5gMM8JGl9HLY8LTXIb0OoQuMXbqkvAiYUN6nt8hnMWMyTgk/NVm9PNNhBNIoLuHcDYx
+4qmC8CHBUF7O+Bq/3XqHxejuDAl3dBhRJyLsajC0pwUuhddghFOTBEUO8ik5sXET4SsOwwsiwp/A
+yP0JwqHMSLs9gWc4z3YYfk8JvJ3eQYx+nApcyBezhTLKY8LxFqMX9Ql3txndDeOEn9k2dCZlQXi9
+y/A74RIwLNhw4OJkOo/Op8/Qx/jjLCD7hEcSo+dLgXfTL9hCoGc6hO0Wo6O7hMtshoxnCK9sMLpR
+h/CABiPJJwlPJ4avOjauE3cRdxWeCISAniY8khm78oiw3WEUyidc5FrQSgeEv7iMMioIi9FALvPN
+HU8WDXzP+oFluY7bcBe7S92DXEZc+oSew/BmAsIZLqPjxwLnuRe4DC/ICNMNRjB+KmElCYR9woOJ
+kfe6jO/S94gsAR0RNjiMWHqEF4IRxpOEJ5KFuJOWhLvZDD/KCTc6Fma8PCT80RXYmRPeJSxkcdcj
+vKHBiHXJOJyexc9mhqc9wn1thp9PE65xGXkkCXd2GUk/J+QNhox9wo0NRhlFhPvBQuSrkPALi6Fl
+xID9QfcjLiORmvBiMDLZt/ExtO0x+27u3V1G2tkjcDLflhm9tENYbjFyGRM+AxvdXBUBYYsr0OsQ
+7ugyukFCeDYY/V5J+JDF6MeSsEkwOrpPeDszysmQsMFm7Mp9wsUOw0s7Aruc3GH00pLwIceCVl4h
+cBmewYypSBO2WoxOuZtwlMMIxyYJZzuMsOgLfKLxqQZD7ywFPsufY0YoM8I9bUY+VQpEOIcZwfg4
+YRExYlk6uDt9xPmd+3v3D+4bG4wknyA8lRhppxC4Na9nRqZjxsfsT9ifshm6XxACMHLPF5jCda6A
+VxCeCEac9whnW4TUwhX2Heyr7TvaFmQuS8IlLiPS04S7WAwvKwi/tBlFWQjkuNJl9LNIYLFYIhi5
+Lwn3E4ypoiS8WTDCDZKwhBlpt+tiBT/deY7zXOf5zguce7kWpJfHhI0OI+8WhFc7jF2eJrzdYfTy
+lPAUl9FRewhTDYb2fMJhzChKj3Bny8LOPE0EfmTfu8HQaUG4Ayxkca9D+DQY/Y1jhEgwtIwJv2GG
+CkMLz8GT+Cn8Fpchw5Cw2mFE/Y2El7kC5W7CT8BI/Q7jqeIga53FKLyMELgMv1MIHIeMGLoTE14C
+hk4KwpvBCMYnCIuJUUb5ItxKnCRuLdaL24q2+JH4sfiJYMRxSHiPy9B+LvBq3LFhQadFSVhqM3Tu
+Ec5oMPKpPuPhdKZzO4exsxMSjmGBqY2MS/gy/prNKDZtIPzUZpRRRggcho43Ed4ECzpXvsAevjsz
+Yq9vAbg9zsLzmJHvigir2IJUYUhgi9GLI8J7LUauuhbOdX7hJo17NRg6kISOy+jvmiDcC4Q9hHOI
+oZKSwMwoOtOEMy2Gl00Q/suy0FHTE4SH2ow8niZYDUaQhISHMkOHMWHKYvi9DqEJhtq1m3AqGLmX
+ERQYWSQJnwDD86YYu+g+jfs2GPm0XoHvimMaxzaOaxzfOLFxUuPkxvpGs9FujDcmGxsbmxunNiyk
+flASrrUZ4akbCWc5jKDMCN9zGDrpWlgqlomDxGMdRtYrLHxdvLPx3sZHGow49wjbLIY3FROW24xc
+FoQuLEz54UbGpbyDv2EzgiIk/JAZ3TwkvMlhhP0NhIeRhTBSIWGTY6GXFyXhIQ4j9js2zsaJdCs6
+iW5NDJV0CYcQY0+aErYRY2eUE14oLMQTRS7wMavp2uhMJzIn3NplRGlX4ExMOTZyJTPfwsfFD8U3
+nM+5FuIyDgVm7Lc5DE9GDg7BW+lt9CtWjbs3GD0vJ3yVGf3IFxi3Ji1GmgSEVTZDj40RfmXbUHp3
+0iHscRkdv0N4CTHSaJpwARi+JwlPBiOWOeEpxEiyPmE5W0iyzLfxfecHzg+dHzk/dhhSB4Q7uIww
+8gg+M3xZMH5t/cT+s8vwg5JwJ9uCSvyI8CmXEcaasRH3pvsTo69zB3fHL8SteCOfybdjRu73HVxD
+9xXftb9nv8p9tcvo6w2EFzkM2e8QXukIhCHhkS4jTnLCeWBo3xN4Ja5tMDxfEv4IRl/21mCWSBwn
+psTdxT3E08TTxTPEs8QeK7BDu2tP2creaU/bsZ3a2s7slzkMHXYIpcXIZEg43GZoLyPMNBgzfkQo
+YEElvu/i24L40dZjrcdbT7SebFnYqbt9wrtZYPMewiUWww9Cwmtdxp4wtbC0saxxUGN5g9GRiUDY
+uFvDgow6MeEVDYHEE9jG5zHDU6HAY/laizEd7yHcy7bg5b2c8Hmb0d08TviFzUh7GeGHLqPoSoF3
+0s+Y0dddgV3iGJeR6pCwmhlZ6RO+zYzcSwQyp3AY+ZQWmMZRxMhzX+B19Hpi6LwQaFGbGH48SXiY
+y+hM+gLvdz/gCujcwV1wLD1EPFQ8TEiLUY6NEf4GhsxLwoctRjQlCY7N2KlCwtcdRjC2mWATY2pX
+SXiTYHRVTgAzYlUKfMl6vGOhjPIO4cuWhV6el4QHO4w8miakENDaxU5r2oqs2Eqs1NKWjWyqiCPC
+PSxGN0gJf7IsqCRMCV90GfnUJGEnGP28L/ADnEkNhJmMg9jfSHi7y8gyn/AjlyGzvsBfxINcht7t
+LceTnCc7sZu4qavdXW7m5m7hzrg9t++W7m6XEcbjhMcTIwuljS+LdzU+0PhQ42MNRpDsITyEGX0d
+E74NC73cjwkPdRg67xC+6TAiuYfxfv4gf5gZKsgJFzcYXjBJuLLBiOOYUFgMP/MIx7kCfo/wdDCC
+MrDxcPEI8UhxvdNxGL5XCHyBtzYYnXKG8JoGQ3sThO1gRKpDeI9ghFOnEh5hWdCe1IS32g50rrN0
+J2FPg6GznYT7MSNIcsKDmOFt8BmW9SDrfS4jLnLCbV1GXIaLsZTfbb/Hfq/9Pvv99gfsD9ofsj9s
+M1QsCVe7Djq5r8KQcLTFmPRjG58QnxSfEp8WLYcRa0lwmJHLHmG1zdBjk4Tf2owwzQhbHEZH5oTz
+HYZfjAuU9Fpm9OOewKniWMvCjOeXAmc1zmkwwihlbG9c0ri0wejlvo0ZbKLNtNY6zmIEcch4Bp7D
+z2VG7nuMN9I37W/ZjEJqgS/Y/2VbkHlcEna4jF6cEr7hMsKpzYSHWQyZewLLcQUxolwTbgfGriIm
+WILR7WSEzTbDL+QirHBXuge7q9zV7iHuoe4a9zCXMZNFhO8LRifqE97JjI4uCe8jho48Qsti6D0h
+4W2WhTKSMWGRzYjGxglfshlx2hNg8VmbkU/GhN02w/M2Eq5qMIpORPgiLORTMiM8ghmRKgjjDkPn
+fUIHjE6RCaT0Gmb4fk440hVo4DatqV2tmSBchNu08sxrZToWYIiP2i7Ex+1P2p+2f45lKIKyaHsy
+ChJfZgSPlkDFshu0y+bOpEt4Ao1Dah0pTxYqTdq9xG/FysvSPA2LVl8lftrPW8XUTNxJpIqanvSm
+AsIn6RioWHaDdi/xWz0Z9YJWns5kXtAqgrKYyQLCJrEEKpbdoF02gzIjnCbakFpHypOFSpN2L/Fb
+qcxV3kp1kPipNxMHSdHSWZAHSSELlSaMP2CSNtBBiIM8l92gnYXeKRMTjIPotnSSWIk49YOo3Y2K
+sNlRicx2E6Q4DlLrSHmyUGnS7iV+Ky9k5queylWatPxM9gk/wUpIrSPlyUKlSbtspmFp4yw6m86h
+LbSVNkJqHSlPFipN2r3Eb8V5Myi9IGrlU0FQtDoqkdnuViy9LN2WyE4U+K3xCcJraAnkjK/S9i4v
+iDShKRYjTv0gaqtukBNug0PRU36QtnuJ38qSZhbIqKf8ICVchmMhtY6UJwuVJu1e4reCIlctmSuv
+Gazfo7TAnYUWKyG1jpQnC5Um7bLp95RAF1NYjp7yg7RdNuO8p/wgJTwcR0FqHSlPFipN2r3Eb+Uz
+SauMo5afyT7hu2ggTJOinRYh4dHkogjKop1MEhaLRSiCsmhPFXHUwHq0cA/cE/fCDbgv9tBxkFpH
+ypOFSpN2L/Fbl83IbLp12YzMpq/ZkQV5vhJj+Ah9lD5Gn6aO8IQvAhGKrpgSSuwU0yISsTgGUutI
+ebJQadLuJX4rn0laZRy1+pkqgozwLSyCnPFV2k67XQu3ouPFCeJEsQJS60h5slBp0o47aUn4Ki1B
+EZRFu5jJiiggLBGL0FN+kLbTbpewTiyBnPFV2u7IXHmEE7EaKpbdoN1L/NaeTjaTT7W0V9rYhQw5
+CszgSEitI+XJQqVJu2wGiZ+kRdDMi91RQLiIFqEIyqKdd+OIsBErIbWOlCcLlSbtsun5IeE0nA6p
+daQ8Wag0afcSvyX9tBO0pMqaUutIebJQadJUSV7IKAqyppbetOwG6/cozThGHCvWikVQsewG7emi
+JEyIQyC1jpQnC5Um7bKps3Rn4BWM2+FyuoJWQWodKU8WKk3afuA1/bJFuAbLIbWOlCcLlSZtlYSE
+xXQI4tQPonYv8VteGkXSl+vLOCJcLJZDah0pTxYqTdp+PyQsxSqoWHaDdtks+yrx074/E2vCtTgM
+KpbdoN1L/FasvCzN07BoKS9NCItoNVQsu0Hb68anr+0FWa7S5Mxxwum0GCqW3aAdRiokLBK3gdQ6
+Up4sVJq0e4nfivNmT+UqbRVBrCNZBK1YqmR9GUeEQ8VKSK0j5clCpUm7bBYyI1yAQyG1jpQnC5Um
+7V7it+K8KfOQ8AoshoplN2gXKgyX4ul4Bn4IJotscsila2gXZfRNOhJS60h5slBp0u4lfqsXeEWa
+9dNsOl+CPpX0KfoMnSXOFueILWKr2CbOFQdDxbIbtHuJ38qkr2TiBYRX0WZIrSPlyUKlSbuX+K04
+b/ZUrtJWEcQ6kkXQiqWXpdsS2YkCvxVLlawv44hwuDgcRVAW7UJ2mnmgZSaLwG/2ZDQT5ASBIyC1
+jpQnC5Um7V7it8JMxkEsp4NsEe6M4+jJ9BR6Kj2Nnk7PoGfSYZBaR8qThUqTduoVQdHMiyyQ8UFY
+TitoJR1Mq2g1HUKH0ho6jA6nI+hIOpoWoaf8IG3HOycIl9ImSK0j5clCpUm7l/itOG/qtB9kOlVJ
+0SqCWEeyCFqx9LJ0WyI7UeC3xicI76PlULHsBu2yWXZUEUstsBl3whLIGV+l7bLZl71VeBgegWfi
+i/gevo8/4c/4Cx5Hn6XP0efpC/Rf9EX6En2ZvkJL0FN+kLbLZhj1CA/FIqhYdoN2Pw4Jx2EZiqAs
+2r3Eb+VprmxcLa4RdxR3EteKFqTWkfJkodKk3Uv8Vpw3g9ILopb0fZW0Yull6bZEdqLAb41PEN5F
+yyG1jpQnC5Um7T1KE87DIqhYdoO2TrqMx+MJeCJWQGodKU8WKk3aO/M0seCIu4jrxV3FaqhYdoO2
+141PX9sLslylyZkbCLejFZBaR8qThUqTdtnMpwiX0EHwpoJYeTJql03PLwl3pWVQsewG7V7iN/Ne
+KHCEOFIsh9Q6Up4sVJq0026XcJw4GCqW3aBdNvOZpJnJvAgywgSWQ2odKU8WKk3a2g+PgcJOTCNC
+jAR3wy/wV/wNswARLaVltJ7uSHeju9M96J50L7qB7kf3pwfQA+lB9GC6vTgUKpbdoN1L/Fac7lFR
+JFtSJ13CUeJQyBlfpe1e4reypJkFMpIzvkoFxnE5FkPFshu0d+qguxhPwpPxFDwVT8Oz8Gw8Bz/A
+h+lISK0j5clCpUm7bAaJn6RF0MyCMMgI22kV5Iyv0nYv8Vt+GnV2t+JIEybFMqhYdoN2L/FboS4J
+B2EZVCy7QTvvddeXccQ4HLuxB0vQU36QtstmnHQJj6dlULHsBu1e4rf8fncVXoVX4zV4LV6H1+MN
+eCPehDfjLXgr3oa34x14J9ZSSk+kYyG1jpQnC5Um7V7it/KZpFXGUUvFOgvynPAdLEYRlEW758nM
+J/h0NKTWkfJkodKkXTal1lHQ9FU+rWLZDQhniPWQWkfKk4VKk3Yv8VvSTztBSyV+kKtu0lR+HDW1
+9KZlNyBcJI6E1DpSnixUmrR7id+K0mImbyZpEeQMiQ48rIaKZTdoe9349LW9IMtVmpw5QTiDjoPU
+OlKeLFSatHuJ34rzpic7TS+NdRbkeeATGnQ2pNaR8mSh0qTdS/xWqoOkjKMwzWJZ5M00DJUX+Kk3
+EwdJ0cp1Fkg/nwqCIo5a+VQQFIST6DBIrSPlyUKlSXun7MmmzLwp1QsIv8V6SK0j5clCpUm7l/it
+VOYqb6U6SPzUm4mDpGh1M6mnlJcz/ojNdAqNQ2odKU8WKk3avcRvxXkzKL0gahVBrCNZBK1Yelm6
+LZGdKPBb4xOEd9JySK0j5clCpUk7j3zCYTgIKpbdoF02865qdvqEW2EFVCy7QTvVQZLJvAgywili
+KeSMr9J22ZQqDAknYBWk1pHyZKHSpN2No/VlHAmcgdPFGKTWkfJkodKk3Uv8Vpw3+2nmt/zUm4mD
+pGjF0svSbYnsRIHfGp8gPIbWQmodKU8WKk3avcRvddO0GwXNQGbFVGs63kP4Gh0CqXWkPFmoNGmX
+U0UcrS/jSODeuA8WIUyTot1Pw5DwJloHqXWkPFmoNGn3Er+VBV6a+TILWvFMrrwyjggrxSKoWHaD
+dhCHjJNxJd2BVkBqHSlPFipN2t09ShMuxDKoWHaDdi/xW7EqBV5NR4uVkFpHypOFSpO235F5QCAc
+hJ7yg7RdNuO82Y97hBsxCal1pDxZqDRp9xK/FedNnfaDTKcqKVrS91XSiqWXpdsS2YkCvzU+QXg/
+HQOpdaQ8Wag0afcSvxXnzck49FNvJg6SguCKpZAzvkrbMs6a/Q7hNuIwSK0j5clCpUm7l/itOG8G
+pRdEy+BgHZ6L5+H5eAFeiBfhxXgWPZueQ8+lQxDPRIXSMivaUuso8NOZThQIPJxa4jxIrSPlyUKl
+SbuX+K1UB0kZR2GaxbLIm2kYKi/wU28mDpKi1U8zX2epF+S5Srpx1CqCWEeyCAivpcVQsewG7alA
+hYTbiUVQsewG7Z26JAhaBjnjq7Sts7yVK5+xjc6l82gxesoP0nY/6MSEl9NJkFpHypOFSpN2L/Fb
+qcxV3kp1kPipNxMHSdEqgrJg/A7jNEFHQ2odKU8WKk3aZTNI/CQtgqaXJkngFYSLaRuk1pHyZKHS
+pN1L/Faqg6SMozDNYlnkzTQMlRf4qTcTB0nR0lmQB0khC5UmcdQqglhHsggI76VVkFpHypOFSpN2
+2ZzOVCEJp4oGiqAs2l6eE07HIqhYdoP2zjIj3EiL0VN+kLZjHXQFHo3HYDHi1A+idi+LI4HH4nFY
+BDnjq7RdFFLg1uJksQJyxldpO047KgqaZRwSbivWQGodKU8WKk3avcRv5V6mOjM54UzRQE/5Qdr2
+e4RbYxWk1pHyZKHSpF02O3uUniBchGMgtY6UJwuVJu1e4rfivJmGofKCYiqIA8Jj6RBIrSPlyUKl
+STvO0yRI0iIgfIiOhdQ6Up4sVJq0e4nfkn7aCVrSD3SxvowjQkOcDKl1pDxZqDRp9xK/FefNnspV
+2vIz2VdJtxVLlawv44iwWiyBimU3aJdN3ysJIVZAah0pTxYqTdqhKnLCI3EUpNaR8mSh0qTdS/xW
+PpO0yjhqeTLyCN/GeZBaR8qThUqTdi/xW6kOkjKOwjSLZZE30zBUXuCn3kwcJEWrn2a+zlIvyHOV
+dOOo5afeTBwkBSGkkyG1jpQnC5Um7V7it+K82VO5Slt5ESSeilqxVMn6Mo4Ih4jVkFpHypOFSpN2
+lBYz+fjEpMAxOBbLoWLZDdpls9SqjKUmnIJDIbWOlCcLlSbtnbIncy9TuiAsoSMhtY6UJwuVJu1e
+4rf6aebrIAsDr1iEJdiEL+HLWC5uJS4VO8RlYg2k1pHyZKHSpN1L/NaUbp6/47yLBe6IC8RiFEFZ
+tHUkVbIUDAs2FmExjsCROB5X4WocRcfSIZBaR8qThUqTttQ6CkIVBQJtMSYOQk/5QdruJX6zp3op
+4aF0JKTWkfJkodKk3Uv8Vpw3p4o4mgoiTfgArYSKZTdo9xK/FefNOPUV4SBxHKTWkfJkodKk3Uv8
+1m4ZyynZymMZNuWMr1LCheJQSK0j5clCpUm7bHaTmTjIlEe4RKyB1DpSnixUmrTLZqiiIJbTQUa4
+DodCah0pTxYqTdpl01dZ4BVpJhDgfDoUUutIebJQadLuJX4rzpt9HRFeSVshtY6UJwuVJu1e4rdS
+HSRlHIVpFssib6ZhqLzAT72ZOEiKVq6zQPr5VBAUcdQqglhHsggIr6OjILWOlCcLlSbtXuK3Ck/7
+M7FuaU9qgT/Rn+lgSK0j5clCpUk7CQrPDwVa1KbVkFpHypOFSpN2oGc66/coTbgPHYue8oO03Uv8
+Vib9royDokjTKG/lsfSmg4xwuVgOqXWkPFmoNGnHegPheFoBqXWkPFmoNGmnpc4JH6eVkFpHypOF
+SpO2r7w0JryC1kDFshu0y6ZOs0J2oqDZUUUsNaGJYyC1jpQnC5Um7bKpkiLIkqBoysybUr2AcCqW
+oQjKoh3LbNpP+wnhPNGAnPFV2i5jwoV0GKTWkfJkodKkXTZVJ02n8/V7lCbcmy6A1DpSnixUmrR7
+id9KdZCUcRSmWSyLvJmGofICP/Vm4iApWjoL8iApZKHSJI5aOgvyIClkodKEcGtqQ2odKU8WKk3a
+vcRv5WlY9GUWbBqbaIUqioKsFaZZ3CzjqLlHaQtXiCvFHcRV4nCoWHaDdi/xW9JPO0FLT6VFmk+l
+WmANNosGwjQp2kUREo6hRVCx7AbtnTom7KbDIbWOlCcLlSbtXuK3tOwGsZwOMsIycQSk1pHyZKHS
+pN1L/FY8kyuvmagwJGwUh0BqHSlPFipN2r3Eb/VUrlIHR2EbzsXJVNAMHQ2pdaQ8Wag0aZfNfCr1
+pvuyFzTDSOZTx+B+uD8egAfiQXgwHoJH0CPpUfQ9+j79gH5IP6If00/op/Qz+jn9gn5Jv6Jf02/o
+t/Q7+j39gf5Iy9FTfpC2d80ob7pQcUB4FFZDxbIbtL1ufPraXpDlKk3OnCScSadBah0pTxYqTdq9
+xG/FeVOn/SDTqUqKls6CPEgKWag0acXSy9JtiexEgd8anyDcl8YgtY6UJwuVJu1e4rfivNlPM79V
+BLGOZBG0Yull6bZEdqLAb41PEF5PmyG1jpQnC5Um7V7it+K8qdN+kOlUJUUrj5Qf5FNpvxVLL0u3
+JbITBX5rfILwHlqMME2Kdj8NwwnC+eJoSK0j5clCpUm7l/itOG/qtB9kOlVJYeFgrMJqHIIjILWO
+lCcLlSbtXuK34ryps3Rn4BUOzsY52IKtyOnr1ITUOlKeLFSatHuJ34qVl6V5GhYtnWaF7ERBMygD
+b6aQnShg/AYHi1ViMeSMr9J2GEmP0KRDIbWOlCcLlSbtXuK3ukHWCTLCJ2gTpNaR8mSh0qTdS/xW
+nDd7KldpKy+CxFNRK5Zelm5LZCcK/FYsVbK+jCPCYeJQSK0j5clCpUlbp3mRe5nSxeFoYBkOxe1x
+Fn6JX+HX6NIUKdpJ0xRRTA+j59Hz6QX0QnoRvZheQi+ll1EDRVAW7TKOBH6Gn+MgqFh2g3Yv8Vv+
+zt6MwG3oAloOqXWkPFmoNGnvUiHh9rQYcsZXaXuyqzXhznQrSK0j5clCpUm7l/itLPDSzJdZ0Ipn
+cuWVcbS+jCPCCnEwpNaR8mSh0qQd5/0085cDWI4V2I5LcCl24GV4Ob6A/8JV9Ab6Dn2XjoLUOlKe
+LFSatHuJ38qSZhbIKA58JQmTWAQ546u0LeOMcC0thpzxVdqOddC1cAVeinV0Na2A1DpSnixUmrT7
+MvMExug6sRhyxldpO1a+IpyJcyG1jpQnC5Um7V7it1IdJGUchWkWyyJvpmGovMBPvZk4SIqWzoI8
+SApZqDSJo1YeKT/Ip9I+4d20ClLrSHmyUGnSzvxwfRlHhKViKVQsu0G7bGrlFQIrcRkdDal1pDxZ
+qDRp9xK/FaXFTN7sp5mvs5SwAYugYtkN2jv1BOElWAQ546u0Lb1JwrfpYKhYdoN22eyrpNlRRSw1
+QdLRkFpHypOFSpN2L/Fb3SDtBp1MtkIVBQ7eTG+ht9Lb6O30DloOqXWkPFmoNGmXccS4EnfA3bEG
+UutIebJQadKOZTEVxLJQniScSA0UQVm0vbxHcLEcUutIebJQadKO+lrAxw46BFLrSHmyUGnSLpv5
+rkgVwSThG7QMcsZXabuX+C2/yAkbxDKoWHaDdi/xW34ZHoF34d14D96L9+H9+AA+iA/hw/gIPoqP
+4eP4BD6Jr+Cr+Bq+jm/gm/gpEtL0JDoeUutIebJQadLuJX4rL2Tmq57KVZq0+pkqgozwYxwJqXWk
+PFmoNGn3Er8VpcVM3hxvTjQnBY7GWiyCnPFV2vajnLBeLEcRlEXby3vN3JsKYkmwxUGQM75K22Uz
+zpv9WBJeiRUogrJol83eFhkFiS8zQoc2QWodKU8WKk3avcRvxXmzp3KVtvxM9lXSbcXSy9JtiexE
+gd+KpUrWl3FEWCMOg9Q6Up4sVJq0e4nf6vip1xxvjREScQyk1pHyZKHSpN1L/FaUFjN5U2qdpdKb
+ErgLrsexkFpHypOFSpN2L/FbQZGrlsyV18zX71GakIrlkFpHypOFSpN2XIaLcQL9hf5Kf6NZgiAh
+BAtLTEJqHSlPFipN2r3Eb8V5U6f9INOpSopWHik/aMXSy9JtiexEgd8anyB8kI6F1DpSnixUmrR7
+id+K0mImb4ZZEEQy8QKBu2K7WAQVy27Q7qpQIIVGC1LrSHmyUGnS7iV+K86bQekFUSufCoKiFUsv
+S7clshMFfmt8gtCjFqTWkfJkodKk3Uv8VipzlbdSHSR+6s3EQVK0cp0F0s+ngqBg/B4baROthtQ6
+Up4sVJq0e4nfSlQRMq6ju9D1dBR6yg/Sdi/xW5n0uzIOiiJNo7zVUck04Vu0CCqW3aDtdWPGSTiV
+TqNVkFpHypOFSpN2L/FbsQoJt8UiqFh2g3Yn1i4uxqfwaXwGn8Xn8Hksh9Q6Up4sVJq0syJ00EMf
+JX6EO9G4OBgqlt2g3Uv8Zl/qZr8Ta8JDaA1ULLtBu2zqNCtkJwqaWpWx1IQ2VkFqHSlPFipN2r3E
+b2UyY5yPgN5Is0IAwKFYAi9NiiApWmUcAcuBw4848qijjzl27XHrjj/hxFvNHgQBAMANANZgDQDg
+BgBrsAYEALOzs7OrsRprAMzOzs6uxmoIAABuALAGawAANwBYgzUgAJidnZ1djdVYA2B2dnZ2NVaD
+AAC4AcAarAEA3ABgDdZAAABwA4A1WAMAuAHAGqwBAcDs7OzsaqzGGgCzs7Ozq7EaAgCAGwCswRoA
+wA0A1mANCABmZ2dnV2M11gCYnZ2dXY3VEAAA3ABgDdYAAG4AsAZrQAAwOzs7uxqrsQbA7Ozs7Gqs
+BgEAcAOANVgDALgBwBqsgQAA4AYAa7AGAHADgDVYA/N1TczOzs6uxmqswTwXqHADgDWVAHP8ZsNU
+gvnrDOrwt0TdLQnzLzbBhmNiUam1ANgAGgCWVGdHYa6j6s8CwQahAcKSKuQorCpa5upFAJaOiipY
+YAAugMUAllXnRmHkBBeExSAsu4n+Ggwb4gbMzgoQ1iANQ+UFp/mpN2PWMjS9NCmCpLCwuhb1gixX
+aXLmuvHW2LqbnD1pn2fNtSft89qTBOgGkx43e+tbbt7EPs3bx9l1462JfZo3cZLJTGFSGk8cpph5
+CAwVwEHGi2juzGHYvu3Ks5sXXHJuO1fdRBYzWZCPtco4EsCFVW6FaRa3wlQAFwNYh3Sm0DPFVSro
+B9nYHK6fCqSvkq4JCnOjzbMmiQZ5SMBhOMzsqhMDbgy5ORAYAmQ0HIYyjlq5zvOWl8ZtQ9q96ubD
+ZwiHDTXVewET0typPuPCT73WdDyw7JR5y86qfNF4G0b4UN8+QUb9WcawxZiOo7VlHCX5mbUpJsUM
+6v28KfWZddgeFNKXhWyfM6Mi/6qBa56v8iLNdrd0pPKiSr/TB1ZWsIDrcb15OGH4GMYqG4d2Vlnb
+rqTDMxZA1jpcGcQ6kkVw2tpzIplMr731xNj4hubY5ubYqWvHx08b33jyOvyzfw721rBy7nhRxRoQ
+WIF11dO8DouxDi64ksyHWzPC58/uzY5bcDwvPWTIjxjujWQVDsWxWI11OKY6Xocjh3sj/c82nwLm
+MalQ+cwybN/Y2tiaaE6MT4w1x5fhv+/PVCLLIKpC39xlUfXfsAPblh7gdQem7T9X7TsF6oJtgEGx
+bJnSbwzrpopCn9Zu9/v9ltQ6CqryeeuVW/P2jizVQVbsvljlRXO8NdbyC39drWrM1E/A3H6+jKwl
+R81XSmdfcHnbKFeeLFSaDGoXCzjjzNvNsinuJgDsANBGey9O2Otw9LL9iCqVN4GJ70JU9cDNwNRd
+2GFCtU3lleRn1mmV5C3pp51BUkmVjcatbZoh4pYF3HjLAk60xtaZ3KAqb+41jE69FwCbCoXmzqzC
+tVsGjavrr9ytg/y6QX4cZ0oRwZbtuI1Fi5csXXbQ8hUrD161+pBD1xx265Nvs/62zbHZtSbZK9wA
+3AV3qWjNzV0MbgDeircaVokMPwDRCmArtg5CDXkdasXIvYZ8RHRx1aIBjMjwoeispwHX4BojmOND
+kbHwRtxYiWp+YKJzcM5cKMPrUCuAbdg2EA15LbKAa3HtQDTkBNzanGgMQo1yAvyhyOgf5UOFphV4
+V9zVXDTHR0TvxDvnRIYfmOgSXDIXyvBhKBMXk6QVVpim48VzohuA63BdJan5SKiLcNFAtAIVHxHd
+GXeeExk+IrqwbmCtMM3WC+dENwDn4bxBqCGvQ1nA2Th7IBryWrQC2IzNA9GQH5joFJwyF8rwkVD7
+cdH9iPbjG3OhrIH/zz9hozCl0ELsq+wyRftCkFG9AKb/txBU9fn2hunHLYSp8heCqr7i3jD9v4Uw
+/c2FoH30MU2/cSEIBy08BcLyfZxbsY9zK/dx7uB9nFu1j3Or93HukH2cO3Qf58yIxd4gHLb3CVPu
+4nCz2wuEI/Y6NiAcaXZ7gXDUXscGhKPNbi8Qjtnr2IBwrNntE2vBYFRd5LtgWGGY+uLMdSNVebuX
++K1UB0kZR6aLKYu8OejJ1x35Vj/NfJ2lXpDnKunGUWtOEkuVrC/jaN3cjd6KLUiRoEBQ/b8Su6ER
+4Eysg4SGRgQFDxIFVHVlGz0k8NFCWl2ZoESMCCFSZIirK3M0kSJEWIUN4COFhxnEw7u00K+u9qGR
+VbIAOXIoJOhW2lr7CGN0myvWD++4bj693or/l+NhGj4rTImzdf/ZHudNk7ej+ell6cIl1/Pq/rVe
+VAy7xaNeJAZ2X3xgdo9omLe77m2PquWznmaS45r9q93/U5DrLJCDIfU4auVTQVDsdYvBg3bj/5Lf
+5JXnB5DwkWMKAQIUQ8+fP27tz+dv/N/y+Vts+1ypc87+MzbOm0HpBfO5Nu8to1k4dL5t/5Cyjkpk
+trsVL9RZPTVVF+3a/ev7R7xuxOHnCl6BhvHtA7V6RMXNpUKl70CTVPq+Sm4a/apWEEA1enbXfyYF
+dBbkQVJU/c442utwPvPqO73zf+nxM9WOqXIGld585WYqstbNSvf1OM7H5P/1qNQxuWT/uR/nTZ32
+g0ynKikWZu/NuWhVu/2dWmL/D1YxFcTBdpnIbpBVzjpXmFy3f3v3r3aBt+aR8oN8Ku3Pu+pc5XzR
+/u+zd7qMKtpvotx5/1r/IetHyoqR4qZK+wv3f5u9jR/Rs2/bh5Xnef+I0v0VPCaNq7L37H9EY5XG
+exdl4xOVrirKm/+urp7KVdryM9k3MzAjjeNhzXKgGvIiSDwV7UPDKQdow0iKL8y5v98mXBiNfefZ
+ME4Hqm4+TvtV9/ersYF1IxG8OX2VA/x987T0pmU3aE36sUxmQukVM5lKus1JP079IKrKhv2NrB1+
+xJFHHX1M1QszIzs1TI9MAGvN0MP8PN8h80OqsUxUGOSFGbsTwHnLgaGqtcetO/6EE281e9DcuIUZ
+acUZRmU18WS40WtgAZOYNKwSGT4iMldWGGo4MNEWbKkCmVCGj4Qax/icyPARkbmygmWqri0HKFo4
+fDKi8JaJblmUF4ZyqjnyagZwyEZhGlwLwSbe1QiMmVnfG/sarWEzeliNuphZ+L3B1ey/mbm/6ex8
+DWOhGDj5Gaid6bQ48JVsFrv1vnr5Mld5VQPMdeCLoCzmCsvJA1OTz1Tj/61+poogmwu95cBC78OI
+kZ7VnLbxA9NW2+LJyJsLu/XAwu7DktHae07dLU/djswD4wzVoLzJt61mlB+Y288vBKglDcQqDkz+
+wYyWtdpnnHm7228797zzL7jwolmzFGPwfFiDmfwKQ177pAWYhTYVhnwQpsLpOL3aGxh+S0Vz4dZj
+vdFVwfDajGo4f34cbwVWjIpWjQzTGT6nDjh2ZETL8BGRWbBQw/ARkbmyhuG3TLRoNNwcRqI0B+Md
+g5JhmKUj2NfTfqBjs4NSYfE+1//se4zWlBL7xqLKyqoaPAyjQ35lU3XSdDpfv0dpE5HqktV7XRLo
+mY4RmxkpE//T95KaMcPBBJpUWXNEdVMleSGjKMjqenVUyfqbUaISPzDLXprKj6M6YH3nFVCx7AZt
+03LNZF4E1YITY9OqvdSVzelMFbIOduxeQmNwUOSqJXPlNfcyau+kMRd2/NQz06UHoqpKQ5Nd1TPu
+uNXyj7oNcNjQNwSwzDgkoT6zFN0g7QadTJrqv6o2xicmN2ycNau6qvkksyTmYBy8Fx9OO/2PiCpD
+bgKT7Avxz0xj1LBNGlXLeQ6eS5m1g+HoM9dNCNysbHw/ssn9yDbsR7bRLJ0z+Wmsu9z8A+b2ArAH
+eVlLjp1v05kx86bx5MECruYV4yZ7TesOpsCeCqQfZO1cycwfroVrT7TG2wLVvOkq5DNxLLPd7eHe
+BK6aIBdXwyhzWFQ1GwYwfF+5Mo9B/phrFsEkqZf6wdxKKbNC0KDez68CqM8ciXNVGfhbh8v6rgh2
+zQSJF7RCPw92CeDUau2WeYTLltTlwODT5g3+IIArcaW5yRw3xtw8jMH0QXPNlYN5+9Omg91zc/d+
+0Asis65hZLVDIuMg19IL8vZ0sDtJi2Bi3drpYPdpw1Q+c92pE2Njp4yPTWwYG1tnnmuDo0w+AnP7
++bjXkiXwZCGjdH453VmzlXUmmMGhI1NGFTezRWaRwwQm9uJmieRNYfSYbV8YzAROmICH4ozbl3G0
+di42462xdWtvfzvCBM7I+95w9Vu9uqFaCTK3vCGMZD7VzvveMCrG2ypUU9MYTlcPmrpkjKE5yara
+Y+PhWrmBQzJwzpZZY1gVVRMD03w20a75cG2H2S8UVeFuApPbNwdR1WPVKsQzBg6xV1Q7MmtJP1ZJ
+y5tqV+sAB0+YWcgxYnnuV135f1zNROsmaqqIAWeY5BrZC0CYUzQnWT1fNmw/+5ILzt12xZWt7edW
+z/RJs3vFeeXIBKfhewlvAuOExgjCSmwfdiSbwzWMp9X+Xc+Y1vt5/67PHHEzq0gH+SyATQMjTaTM
+v7lG19xK0kpyMzAe/C9cPXoEtl597k2KIb8fDsohs4TgsPnkni+F6zL4AsAskqCbg9gL+7xKiLnz
+TEKQEMB2wnbzSFg2o/43SBAbMKtw9t4NRAYNYLg4Z1/MXLEQlhkiGM4VW8DRx6w1VzBw3PFDcsKt
+qi7l4UccfcxxJ5x4K3N2/2BgzKTcAWA+qxl7lGkZbqdJLMYO2Q0ulrvTmUKYpSLGgC1bNt9B+7Iw
+jbg7AViFHWaId4cZ4l1bZ6EYrD9fheuvN+W38q8flm/jLdP0MimwBFurhmGQbU29fT01S6qO8gCG
+H+hTswRbt11xwXmXbLt866Vb6uelXnFf7+efl/qMja3d5J7ni0ETzcJ2qfNhpJfjStmJguGSOxXk
+FnDRxdurxfxbZSEvSMK0isEVg0eqhukY1TD8QGOwwmTdXJ6YvKmjUXe66v18NOozK7BFRmaFc3q9
+WZC9Jw6FqaNNM9TYn58iUHUr3MHhuGmAmbAH4xJZqF6wZbj4bPvZF1xSxencQZxMg2mwWcO9GUIy
+LysY+24OVVlWdUEsXLbj6lPreFRN6Sr9BpiPRy1ZXKXseZnUU3U22LjCC5KAgSrtl5oY+DNekJk8
+EFUBYAqSujEzaG+ZvMniaobJGIxLd1w2O78E0QKW16tUhryOjnkI64UaQz4iWhhqXuUo9pXhVZLc
+DMw8xXDcZzkm/XztdlmuHRb9c525ozFZTQSvxXZIlFiLqxAgq5ZEpJi/bqAhntdgsm+wDPFoQ4G5
+/fwyxFri4GzPrAYVwNVVTp+TptNVp9hpDJoHNZyqRzuAMHXCgBrsSzT0gNnqsmokdZDjg/PGLrIs
+wLarxDC+ZjazTMrs/7P9O6XAwCcM5vzCWegXZnX7v1Oa/Ceu1StwVfExwKBANUWtwKJG7TP72A/b
+77VkGa4yc3p1fVHVDocfceRI7YCR4dYhH7nvkSPL/cyo7H5FA+GwfzRbmVAVfmYI2VSzZljCbGQR
+HBOX/2z/zilQOUjlELVTLPl3To7/xN2kgGkkm3JogPlib/WgIFt76yu3n7x262CFwrLFAxcyJdgA
+9Trp+bZefcbFlsFImCmIcNygvV/DHRZRBqbuMRaYzbzrbf5VL8HWZ8y4RYPM4az5V4NM9f0f/Ntj
+6Dc36yWV5xhP/8/275sCdbk1wHwpZ2EF3DlpXaLUe1M2GvcyHcUBlowMIFxZjxk0MOxNbxDmBTTz
+NYbhgEduA6sPOfR6B7jmjne683WztnHXagKWzGWNvbi51RA2hkOCMN93qELV2I9o6UBdBcNHFJrD
+GobvpXIOxkEWYpBYe8MM1C7EfCLdFKZ/NRzYbWDLlrnBv+qGNl69DIMxBHN/G28YPVyK48/eeieT
+GrOzwjL5aC7a66xdNWcEV9lmagqDej8//VafWYPrr9eDF11VkN90DHEprih2R8F51az07rlRucWY
+3Lr27ERGu3MzEmkG6hycY6axzPyymcZZgW2Jl+3WReDvGKwRM+u5AByMHUEWBl5xafXFi+EwmAVs
+O+/8vXsGTtU0G8BwE98hTD1Zo+JmiMNsZnzH7M1m+IGEMQrM9WYz/B9xB5P+e2PfLjLsl+wDZtRp
+kPcm5wTcFabCb5gAFmav3rHF+Nfy5dW3MPY6a4EWC2HymUyIKrhB9URVXZ76zdf6TAPbZVd5V8qO
+AK6qvm5ydVykaZRfJSPlDwf4XJxbjeflLnD9XWXHM1NdDeyQWXF2kcbVEFXHHxmislDNuVQY8vph
+Gx5WGPI6fepLbgoT4eEY3Urs9bZVFsq5+ceVJubA3H5++qiWNHDJ2du3tS7Zeg0DQTiIb5rNTcYa
+BWQSkC174HlmKYLZTK6avdkMp8Fguzk0A3Bmb7ahyIxvm80cmr3ZDDdZaGB43ZgbxHrw34CcwXjh
+XLLsC+aeWEy4wagik6s2wSUsItNpWUZYTlhJWEU4hLCGcDjhSMLRhGMJxxGOJ5xIOIlwMmE9oUlo
+E8YJk4SNhM2EUwmnE84k3J5wNmELYRvhPMIFhIvITNFcSriMcAXhDoSrCXckXEu4jnA9QRI8QkDo
+EhRhmhATUsIuQk6YIfQJuwl3I9yDcC/CvQn3Jdyf8EDCgwkPJTyc8EjCowmPJTye8ETCkwlPJTyd
+8EzCswnPJTyf8ELCiwkvJbyc8ErCqwivIbyO8AbCmwhvIbyN8A7CuwjvIbyP8AHChwgfIXyM8AnC
+pwifIXyO8AXCFwlfJnyV8HXCNwnfJnyX8H3CDwk/JvyU8HPCLwm/JvyW8HvCHwl/JvyVMCuAJYT/
+5ANeSv+r+WAKvOohqou2ej/f96rPNHB1mk13qsF1G3AXHXPsrJlUmcO+xtCHWIENQ2Y6btXzbO5q
+vpnVqD4fVb37XfNaZGYuRrkxdlgPjQzKT1SazXXVuNhgUP4/g69VDfzv/d/4ysAvTHuJrMXV5w6+
+CYEbrcUQWAzG4v9n/xb9H7fcvLf/35u69j+l3/TD9t1cMO2mm8N8K3MwhD/49lsD269Ya4rHvP4a
+3HJsxxVYi6urN8KnYSbiB90RPHKou94LmHlnY0p9ZjEuuHLb9rGxsXO3X2kKTKzbe6TLPNY1DB82
+jG4Gg4aiucbMRxxv1iNWMM02g3pvEsQ8LvN9roNxRSGzq6sV9ntNPmw+5dRBQ6+G6VbUMNzoGcIc
+1jB8P6J/1dT04ME3i52vuHry/K2Xjx5vWHC88fytl5sUGDQfTdfBoN7PV4P1GQvmY3dV4/3i7YPG
+ewOm6jLrS1btxave+L5FVRV4E5g8ujlUbdfqndhVuAJe9Y5ngLXoQSFAX6AWnocIKTqjQlNxVsle
+f/Wh3s+39eszi3GuioLzqyWiletdvrfrmUVhNQzfn8nGpeukX4nzr94xt+hmrbmJERnUy8Dq/bwf
+1meWYsf2q+cCV2Zdu7dZh498+MLwAzXrcOzIVFJsl2ZF99rz0sifu01tXv1FjXo/b1595hCcHXVm
+4rOLIlOdmSLIryiyQMaVnXfZ207ztNYw/EDttDCGtWtrk4y/GtT7eZPqMy62pLG+tLMTg7qPLXvJ
+suUrzVeYjjravNZ12unmlY6zzj5ny9Y7zB5rvKP6fNDwVY1RPlzu/T8tMov8ajMMHzFjFVbNiQwf
+im5tDR5BE6rmIyIz8VmLDP/7IhPlevF7zYeh6kOjsOb7F1UL5oYWjnLC3KFZxFOLDKfB+iez0H45
+llcN8ZqPiE7ACXMiw2mQ5abJcyJOrPY1HxGdhJOq00Zk+NC1TIvcjOQZjPLqwCwMMzACw6siZd+i
+EXXGdAMTqubVgVFhYASGj4Spp3KMqObVwULbDsyEhaEqGN3GDwxqPmLCvzRMHW9zH8P3Y/aIaKEJ
++xdVrijMt7ZW7MWrh/smGPTcTHxN5VAXG/P4Z94e+nf7spPAKhAOqfNnDjQ3bzkPc91C0Mjy/Rrm
+3DC/52A+b7wQDLNC1dRE+/rK0/AruyMwX4i6qd4j9nGubhHM45/5QlSNY6sWtGXapKur1ZxmILFV
+Ny5bm/Z5dnMdyKSoWQK6d6BT5sWHVUm+/YodWboz8AqjOFRR0Nowf8mqahXl/CXbd+yoFNy6Wvy5
+D+mpJuxAuvqmYccn9i8eufPB5l1Kc+eZTqTyqSBrjZuwJjVoH8JK8c0JJ+dDVp8a2668LM3TsBh0
+Q+al1Qe8LptRUXTqprVXFGm2e+15WTqj126JZJ6bEqB64Wk5zjVvaOxQ5dpLOybp6jeTTsDGTZvG
+N20eG2tuGd+4oTk+vmVb85SNGyebY2Nnnz02dvb4uaduPEdU75gTThwxZMdUWqRmkr9+Y2Tt+Nod
+yrzYbpp+a03T56TRyweitRcUx+0rwKCjZaZRZFYpnWyN1Z2tFVU/xZytHWleYr6DcL3pxhjx9ZNz
+YZZXYS6Izcu2+doN88pcmCtV0q11jNxz4/xlKxZo3jin2az2nf9YTK1ldXU/o7q20TwSNy8dNWlv
+W/cywsRsi4y8WmmtcGC0kYwqGjm7sTVm+rLCZMMKbBqbuDIoq+el+my6aRbQzXzEc/B9AGP9GcN6
+o94LmJFy0+yvzzhm5bPOzWpq89W9pSMd26ojuq5aqG/W7m+X09Wcl3k/YhnOkbnyzlVRYBZFV1My
+1QTKYKqzwvB94wpDXpdrlmkBrR3YdlORaUxWmBOZGrBqxTXRrCRGZHg9EGChOqww5FV3rBrNNNGt
+7lwtMmlUt62OKzVkNdBChhCy+vq1Ge7879nc/ya9e1tr6vbBGfOO8IDt/d9ecLy39N/7yLS3TLfQ
+eFzlKPuEcbthwX8YLkdQ9fELrMU5mIFCBB+nVapGLmxiWfUtwx4CXI61mEaOwRcKC8zgbKzFaTDf
+JJwaHJseo3kLuGkeAuPSljHLTIcZd67P1G9emCUI5iOXB+OmryowcOfrVgLVl31b7bFx8wLxpguv
+njVv2g9gDRqlFYZ8+IDUhxX+B0RHDfpX1X0NPzAzzDhHhbmZ1wGGhxWGfEThfkIdUTfWLPMayHzj
+a5gCFYZ8RKHpI1U4cFEDVZewwpAbhWYbKhnl8zk2ClNBLIRpvi2EccaFmB9KnMf/n3sWNcxrUcMn
+eAWuCDIVrjV13I5oJl+7wVS7VVvzJqKNNy/adPOizTcvOmVOdNRCM3ZsPXftNl+ZN2dGLruJSafO
+aVi5UMN41YCoYnJT2fh+wk3MyY5YqPP8NA7agwUtcxfVNpm2U5WCkzcvumnizoWqErcaQD0BZyOB
+RITdyFHgJORYi0uQVt+L7SBFiun5FnT1nlqQ+DJbuyULZJFmZlxyQevI5PwJZiAYmNubzrh51Gju
+zGKct+2SbZeffXFr6znVEHIQDoaQaxQoTIgK9t4LaBaKTF0yvLZqeaTVQfWveqrJSnFuVV2YYeJz
+q3kI861ZU5HcGltwMtZW3/bT2I0MCt2qbliLcZyKUzEJU91IJEiqV7bMUYSZStcVMF/BLdCHRFad
+uQAJPLRSUJV4JgFv2dZYEO4qXL7gzGlIsWHk3KYRPrjnuior0+pTuymc4dmBbPD/sOF+cLT3/4OQ
+QiDFYqzGOTgLWyv5WtwFFg7FhUixFCkOxjFIsQIpzq/ke+v49z0yTmkwrDT+45ijrvAfxxxNjf9Z
+XjvmAIPem2mdmDWgAA12YrDjwW64LnTJ8Bp7cNYZ7NzBrjHYLRrsFg92Swa7pYOdefMewEGDo+Wm
+6V8V0oNjsxJ3gPm5uFoyBVRv98+97T+AMCcHlIjm2d+BbTtz11YazD8hWLAQAmbZWRdVw9j8dIdJ
+E7ZsMzVddQwsB3Abi4451gLm/g/svnkwcMRNRxH/1+BgMIjAgDQLNztVzMyiTBNb828Es8IZcZl6
+JN5gMG1n1iHOzs765JNZIGX4nGCQjLfBbsOqJDUN/pE7LJr7rI35TMFen/sylX4Nw0dCNUZCGb6X
+aNj0qKabG/+tIhPNGoaPmGEOaxg+IjLzaDUMH4rM07AK1QjmHB+KDMx6gRqGj4juhDvPHQ2nvQxM
+pt2IG4X52ZDtAtUXyOvrVpj5wYMH/e4VqH7jyFw+hDG4huHGNNy9yr9BkJoPw5hx6IOwrJIZbkY2
+qjDm8uWwyXy6aNYyg1fOnAkAnoVnmV2FtXv/Tsd+RF/Bd6oQBgtC/QOiytgqSrgtbmtYZe0cN258
+G9ymEtS8Sj1zYCbijKjmcwITc/M9TiMwvBIM8A/YdmChnovnDjQDC9NhP6J/uRn7UWjWPNUwfvQ/
+nubmU0c1zNL9kYSNEdeS/03RyTh5zgzDRyw0hzUMp8pBq0Q0rmUSc7BZRlTjgmrYeADDh4+ogcmC
+GoYPHtFqFG7+KtMeGDHC3KmG4SMio6KG4SOipdU0/AALROajMDUMHwl1K8x/H8fwEZEp/2sYfmAi
+U07WMHwk1Jlm7fgQptSdTwAcMvJLNIZXyWSKtep7YtVnNFHxEXWjETZ8RJ2Jfw3DK3UGJqVrGD6i
+bvTBMXxENDf0VZVXe2W9ubKG4SOhzI1rGD4iMrlaw/ARNzvbPtseuJjpRg87MgOYNKth+IjCExHU
+kiotRkRzEw7V1/cOM8m0zHyL0WymEjJ7s1W/ijlsVxgnOcJ8un52tuL/ZNKaKNYwfMQ6c1jD8BHR
+wlTfjxFkUgyPQqXCtEWsIR9RZ+JUw/ARkfGeGoaPiMyVNQwfMcIc1pjjJsWMCoOaj4SpRQZzvL7O
+oOYjYYzv1Jjj9XUGNa/NtqrvxzbMjNdBSwf+sB91B/JsmJypYTiBf0OA2UwszN5shhtXqrcDda/a
+cAC3M6+YDGHWD42IzI1rGP6/JDJW1TB8xIx9PGo15jJuWIT8vVQ3Dn14tTxqUPsYPryTWcWzYvgr
+WDUfigwOqUf3gYqPiI4ZhjIwfES00PQR0SZsMgEqmGiMiEw21FggOqiacR3AlGL/pAca7TUMvxkj
+jEEjok31D+cBFR8pZ01z0pQUZjM1wYh9B2Pl3NFcmW4eMlMLmomomo+oMzc2eWY2w/cvqjFaxJlE
+GjHdRLKG4SOiuamdyjf2qo7MlTUMn4vHoB9Qw1TMf88DDRb2FEfUjZpueKXOxN4UAgY1HwljLKph
+eBXGwGRADcNHImuuq2H4iMiULzUM30+ajxix1LxsN4TJqcoIIzfaDWo+cqeVI2EW2GeSsobhI6HM
+YQ3Djcikipn0NOumhnyBwtoMA8NNqCHMYQ3D/2mRKQRqmLT4pxUaq2oY/v+UQtP+rbEcy0eNr/Jr
+iAUJdTMi03g9H+dXYWo+khorRhbdmaLoZkQL7rUSK80LqxUWJO9+RObKGoYbBzdYMWgvVRjy6mEw
+jmmKugrmE3N7zwQtEUtrDZXvzh3ArH2fi4i500DdcFSoxoI4HY/qu50VDJ9TMGLdkI+IToX56PUA
+C/LK/PpyjXVYNxrK3LqG4SMKzWENw/cvqtpWGAzimL3ZhkVQDVNz1Bg+4uaqwTaoHWtO1c8czB0O
+Tpvj+R8/GRb1NUzijlg4ei/DR0TGuWoYPiJajHNrSd0dN3lpNjNiaPZmM3wk1NLB8t4KC8ww6VbD
+8JFQRkmNYWrUGH2AFiis6xIDw0cUzrW4q18JWHILRSZ+Q5hb1zB84LsNVGvhKwz5LQxjxuMvWm4G
+3O29+NyBuWl9keEEfBaDzSTmKCfgGxhspu41Y3NmW5BExvlrDNN8VMko34/COrpV7WqqrKpjWlk4
+AA1GkSsM+S0JM5K3Jk41DB8RmZSoYfiIyPwKc41h+tUwQwo1TJtmJJRJsxoLQu0j/cxgu9nM6h2z
+H2xLjcIBNa9THDXHF/TSjf4aho+YMfjB+AHMA1Gn4LDkq2GiPHBMDJ7ZGsORtn0ZYWI4cqe1aJg2
+b4UFRpjDGoaPhDI3rmH4iMjor7EglDmsYfhIKFPU1xg6p5GarS6mRvkQ5tY1DDeXDGEcpcaCe5kr
+axg+Emo/xpsrawwtrFG/uGFg+EhuHQ5pzlYwjb4qt8w/EyuDmo8YsY+0qGF8oYbhI6HMYQ3DR0Qm
+/jUMHxGZyryG4QcmMvprLFBYx8vA8BGF5rCG4SbqQ4zmluFVKNOvMtpNe6nmc2FGc8rwOcHI8r4h
+/19WVt1+8LMALTzVGFV9JWAumgOYxK9h+DCUwajnGV69KGyed1QtdnOlib1pvRs+hKn4axg+Ilpp
+vvk+hEngEZFJyhoHLjJX1jB8RKExuIbhI6LjcW0tGS0azScUDsc9KpHhpn9bP1GWaQbtqQ4XWyYJ
+hi/pGvdo4NqqrWT46ANqJolxx0qd4eYpHBphUq0eUa75UGRgqoYaho+ITObVMPyfFm01E8BDjMxI
+GlwEZXYVFogOq961GcDwOpn20TysYJLGXGdQ8xHTRxtRC7LxlolMWtcwfORe5rCG4QcmGjSUB1iQ
+JcbgGoaPKDSHNQwfEY36u+EHJqrT0MDwfyLdjd9disuqeRfzq2HDaSYDI7oSV82JWmjV9v0d0T1w
+r7lQV+PqOpTBlFnIMYRJixHTT6zmTQcwjmZuUsFcV8PwkUQyvytWw/ChyPRZjsNxwizdWC1QffN3
+KDIwaVbD8BEjjP4ac7z2VYOa70fdUPSs5eYrnoOvddZ8KFq9A9VorUHNR0QmEwyMyPARUT0qYESG
+D0WmH97AhurIcGN6dTD4xtQqbK0mJU6rXpWtGogG5sp/QDSXIYOCv4YpViuYpDE2GYzy6mAMY+b8
+HP/71hmYh6yG4cNQJsNMG9Og5v+UyGAtbhzqGNThcwdmXfr8vORIZWbcy/SzDWo+Esq8SlzDNKr+
+/yIyLlljQbz+AZHJtyHOwTlDZtx18SChjAd9jD5Gdx3OAprvdo+k4D9wp386VJ3HBoaPKFxuPmM2
+xGitD/MLFZfOXbhAZB7PGobPXbffUabR2sfwkVDmsIbhI4k795OtCxP3UfQo6uwjcS3ArYqGqhqv
+eH2natHQYQPlK+byyqztMRMR5skY5VX323ybcXDlzYhM8VGLDB8JZWr9WmR4bUZl2LDzP+R/X2RK
+L1PFGtR8JJRp9NYwfBDJCjfrniYFb8Y9jYoahu/nTiOi0cktw0dEpvSrYbiJwhCmBVjD8CqUeXyM
+ax1Q72UkstuwrdZVZeicMvMsenu7i+nfmzeuTbaN8irMAKZtVcPwEZFJlhqG/98TmS5NjbmEHcAY
+XMPweW+sps5qzE2jmeww2WYiOcqrA+OWpi42AsPn8tYUGzUM/++5izGyhuH/PXep62sDw01KDLGf
+/vzoWJlx56GnmlXBh+MOVaqZ38oySVulmhGYOBjU/JaEGbHOeHcNw8lMNFR9uerQaDcpZrzgwERm
+8OGfD2U0DLHQT6uJdeNMO7Bjjg8d2AQz5pqY1HyB6f8a+0bS4l+ucD/G70e0HzP2I9qPwv2IbpnC
+f1moGuaRqWG4yfEhbtZpzLpI80a7caCh09RYitvOPRgm8nMHg0ehxoGLTJVQw/ARhcYpaxg+IjrS
+fIl2iAUWGiU1DB+JsCl0ahhelRcG19Cnq+/FGBj+fznMSCq8ld5ofui1guH/SyIz4lzD8KEZZi1Q
+XXjXfC7FzS/vmobDAHPFv/G4dVhXTQgYbj5eVsEcmGfDoOYjuWRWudYwXl6ZYK4zMx7mGwo1Hwlz
+GS6rg9w0jBHtI4zx6xqGD+IznJOuMOQj96kH+Awq28zYhNFtDk4YtqcM31+Y0Uac6YnW4fcXZvS6
+M/Z9H/NY1ZjjRvdxWDfXcBwd/9zHc17BhDlm+CPxhpuHcsQ2k1Y15ri57tjhmjLDDzTMLbnPPxDm
+ZvO0cqoB1lQfox/AtNdGRCvM2MEQC3LVHNYwvLrTb5ajUmFQ8/8+dYf+A9aZRsIfhtaN8hHr/sXq
+VuAv1avDBiaBRjzIHNYwvLLOXG3cqSovVow8xgMsx58PWJ1pKv5tuensulWzseYjJpg71ZjjxnGP
+rx7jQUveFIAHEuYfcMgK5j71BHDN93OfyrVMrs091TctmEx/oobhgzBVX33+F3FNWu+V4YeaKyqY
+MCMmmBZ4DcPn1BkVNQwfCTOXjMNyZRBmaGqFIb8lYUbMNgMTNYwJ/0dEg+hWmMRktTeYs9BktDkw
+oppXptcHm4deZy76J5PIlL41DK/uM8DCtVwjZpsb1zC8GmQ232U0zmEeS+ss01kS84IlcKsa0Llh
+sPZk5D6mJK1RKasP5ktIg6HIVKNmO1wcLpYNl3ibZT0joY7DcSZABdOUGEkiD/efc3JjK5lvHb3d
+GUzpPQrAltUDw/8vhxnJCNOerWG4qONjIvpzAI9YN4gPmybHY2zgTLylcT2Aiw4aCurggzSo8f83
+ZYOXeE25ggbOr/amchs61aAQNwdmOKt+zEZ8YJ8+aq5b+O7rSJgDLuVGXLczMh9rxnONjUMY62oY
+XoUyJhg/NtfVvBJ88iFmAPZgmOkJIzATa5Xg9ucsr16OM81wIzAxM6GHMJprGF6FMdf9z9zl/2Pv
+7H40Oar7f/qZZ3Zmvbve2Tf8gg3LGPOzsdgXg/VbDLbXsU1sWNvB3rxgWQ4RWNiKsQ2Y2JEAGcQl
+ipDIC4qQgqKAhJILLlCuEYoIF5HyN/AvRCES5GKizzn1rTldM9PbO/PM7K73+Uo98+3n9DlVdepU
+dXV1dfeWqfA8n8pClj1flIWCcReBPMJ3VJbRqaRWg5cEuOeM3GCM3MB7WT5uR5dzlvfcGOvGlDO4
+v/Gc7nvRFiesgyXL9ABjcoaBUcbcMYEH7cEg5YmAJKojF9twolhYXuDc4mge/11N652O+ZtIKzir
+CfBxaQ1kg+kFAZ4MnjM+lx+g+Rb/8Y9cAXHXws/c4ODZb3EO8G4E24AuBe4aAXYFeBIxEhXogZKI
+IwX4gIg0C+pVer56J7PtFXvSIWEB7mUiShb1eUof4C3lLOQVAqSp3E3Nm6VjanbWzlbRij9i5hJ4
+eV00ICBJGIjLYIk5IXtihXU+jC78VrBXobRWuEjg7BCipfW76YCGL8Cl5fPFZCCAaAs3IQo3zUgn
+ZYFxuQAfIZr6MvpQKlxaK2b32xP7aIr7V2KMJtEmrk3F3XLqhZjV1As8mWt1tmmOKmD7ln3L/8M5
+16SU6McEYiKlxK4A93rChO44iCdzHCfAk4hbyQLczQU27XZobPQSXKzCmRELnWUeqIpWNFmO/jIE
+jrmxeG3N3GcbQoPYF+AenfiJbojIF49w8vff8LP3Ta4RAgcGBPg7yVgpNJ8coBUD8VpMegGNTeEu
+COgcBNCP78f7qfBoWSKGc+kFXAu3H7d3+bIKOM2+Cui6NbsLrwJSOV0uYuBVwLBTkwXwKsDuI0UD
+3hM81QoC5F+AX3NlSRnmLOMo3/qo5WfkwdI9nA+vArzEy2IQwKuASSb5GF4FDAC5R40GvAqo7aeL
+j+E9wfNJoDY2NdMLacSrDo+d6YU08CogSe4TKfmZGvNUAgScAB8nIndCo4XbBbqjZJBdAZ5E+bYm
+kz5JRLAKcNqqmX0/jVbEXQuPce7kOPEqILN8kxMBvAqwrHE8vArIKCMpNOBeEfQr9JVA3DUCF+xC
+EIuuO4lIVIAnkRZhAm7fJFENeP/E/PGRopX69aFY3pIMDohqrJYXzCStXEu1FQVaEV4xs7/33pIB
+1jp3g7hz0fa5CD61xazD5StAH151OI4qQGdh/bn17y6ZMaNPst9bMp9EdJ0AISXAk4jKFuBJxFWq
+0NTVigdLoHbmAXpqAV4N8mmBO4skeBKtp9U5ryL6jPAfIAKTKDe2XlpcUXH7MdBocR0tNCI8IMBT
+Wvf4SvMAnA/hTszYOJL/bPCktd/uD5VSkHGiz/pnDgON5/PCyCbzWWtANBOD2BfgqVyfs8Msf3TQ
+SeymSHeXADylxa4ATyLqSICXRT80nnN2zv/DS7mgbC/YC/4ffmmRwJECPGWDXQFOuzZ/BY/XEWBS
+n/py0Ob1njdxN8fOHfashxr8hH1wp8aeq8OFZAwcTrcn6MD8g9skerfdVwd+vZHEkp2wj5VxAdyz
+HMD5Atyfj6XFx4U57Z4Nc1ywm9lnDzGTEWMhcTdIFvAUInGHjgLiVQPL0oA7dBQQrxp0M9KAO3QU
+EK8ah22lajBwcEHgA90H6h4RUHe4MuYEHiiuEdp2XuKG12Bq3YZ4rR0EOo3DXedv/fU2J9yueMrC
+ZMoXwQNknMA3s/NdvEYMVP72QfONm1nfP2i+0fiHdFZuNN/QuetG8w2dlIW37C2Scezvv2Yzi+rQ
+MZBFzXn65fRcWOPxAREeE5ocDmgxoS+QVvEFOJbOn3CvDpB14MkXavqguVCbuej49DjXEY4mre/Y
+d/xnMF6UPQhP5RowyJFC4/cBrVfsFSm1OTw7WX/lRZP5O9M7GZpQW5ms1LojG7W24i6VUJsp/Q9Z
+13pE71l41oA3l0rwnmksVU/Gan/ifWB5KRHGuM5i9hbOQe49dhjKcLsYTu+XjNEmBHjVOWCHjCUm
+6NTJOLpZUgHiMzW23pu5CwXcWXPGZQuOJmfwKrjZPlSva2uD5ijUmUIXrxoI5Bh4T6CbRAjoy1nI
+4WMAEvevsI7MlwxsSP6Y3ekXHwg2ZJgPRCJAu5cvxcsGAd9ykga1U0CNC/BqDOdxwkQHXgU4kq/M
+Iejli6MYnCOAhyccdF0CvIYFOwLcUwkolAC8iFAl5IB4Som5I4HccYiXl/YIKDu8465KbJSbhYJs
+8GGRkJ/Dgpf8gTN2jn8OrpVS/rAvwD1/gEs0AZ506BQEuOtQDrofIJ50GIQK5M51eNSCe0RAPGU7
+65CF9C0XziMCPGmxK3CKTyL6GQGe8kd8CnDXInJutAMdeYNTWBcEDtizQcpSjSSiRgXiKKXEJa4A
+d0/gMcIDwGsWAq25lNJgJriHEwj/VShiwYb8eY4caJU9Pq6jUgTXXr4NWXp513n7wehzgHjSuc/+
+E4mDonuZ8DLVfl8ZXMNdEODzZwJF8HQCDDkEuGth7ogdmdxUzNV2i4ByswhH3DUCBIfAYZcvwkLS
+OusTj4EmKB+3V+nXHKxnTVqP22tbiZ6zTxInjsbg83ZhK9FlaKWawgNC5XiNlHQZxErlXtb/XSqe
+v2SO7AqYK5UYQRUInsz9g/GO/wD6RcTMP6K/MrOnmo9dmbmI/wCtlIk820MmHJTp1wu/9gzBCbGk
+kyfE4H4coLIFdEruAKMZgXTGifJyDLqClAnKIWCwZCI8FgieUmrNJdFl5K+4EsdwEgHibpAdrnt0
+TQavAlq4lk/CS7bXpwUAJyDXCLArwFMGjttN7hTSdI+jxs4Ju9nXhMPRqQK+TcBaPAT04wtokIvF
+8iJ8OP0dvxdc3WvIr63czditqUXQCgQ4FVmX8INNlvPnGXHCJJkb3cCSDr2B4C1Kw972Ea6kozYE
+4J5t4AYK4EmHN2sI8KpDkxLgu6WTByLwlM76dHd8A2FM3rbUwbYAT9Gz7Hd7AvAkujM9Ms39le2J
+UpnoDwR4LRM7Ajzp+JVxAbzq0JEL8JS72z1gAk31vZDmzXvXvJh4oYxr4CkLnzI+NBKA1yzQ8Qnw
+pJPXaMOrDmUQ4GN0sC3Ax+hgW4AnnfN+og/Aa96wLcCTS9/2h90C9ApJREAJ8CRiACZwVk8imqLQ
+GBwQ0bkI8GQwv5gQEV8/fduMjctV/rM5d7XzMYW69LbZvp8H998DmBDgSUR2BbhE/hYtJnF82N7z
+xdSM2W/unzLyYoZHWqV9C7ThJGLELnA9OBcJc2/QooQch2a9sCGEahPfOEJ0MIzTFLG4hxo7d9in
+N7txBGgZQuXozI3tos+8ZgJ00wI8iej1hSY8BiKnFaXzRq3gHEVUNhEGxMfo8P1RgA58t3Tu99CN
+B2Dgu5XOR+wjFMfLA08V0fotiZ6177kWYKYtiThHCPCOB5hiY0KGFs0GHxBxSEFbs54W7ud8y70c
+OHmtAjQ0Fd1E0IprBHTJV4AJAe7mAvliAF5E/5YCSryIAEYEeKpCciXAa3EZwgnwZI7jBPjVbS5l
+fGAM9qa9qSKpuPeY+cboU5yaTgb/vy/sC6TqAFe/iEgQ4KlctAkBnmo4T1TB6+3vu+1eH57RCOg5
+k7mV8oo/QMAk0c/t5/zqKB5kUREbb5DjPxs8aeVFSU1wUkMCPGWd1ijUbJDdgWf0MCHAa/vAYwI8
+pcNxAnxLnSrgnXDcSgt4d4CMvOFWhsTw6lbtaLKzCgKcxwT4lJu00fMxUYVp7hVumKjSI3+Up8Pd
+pMNE1anS/2Ns3EQVlRbAA9gqYFeAXyWiVH0EvADHXQ4GzAI8ZX3T0ApsT0QVCPCUFrsCfJyIgBfg
+SYtdAT5OxOdEBXjSoscUmEoaJ6IsQpON7Rkc0BpIa+YibgioXHRxKdRyWuv863GngJsIN37TfKHu
+gI43VOn8Hd8fLDrJ6+u2udk73Za57WThkjo/POzu8Wz/0+FxRf3hYfuhnaw6jPoumc7X+aJs0flm
+LCnZjk5yKX2X0ETrNkR+r+gs/+z90/heS0qrrb4k+ozeIG7mPIku2H/wq4MsJdGn071Ourgkesi+
+4RqgmbM85DcsAk2Rv23fjt/NnCeDOfPwcSJWpwvwpMWuAE+iAW+QtAAfpzVgcAsRr7TS+33FS1r8
+O1zu0cM5M/C/4M7FO3mAz9GcXsiwAE9anJIFeFrfyZH0PLGVtU4BvYALwJNBalaAJxFTwQI8iSiL
+AE8i+jwB3nvTwnLJXZpWiUHHH7gKvMkE1oVGRPkF+MT8aRmemDlot/l/OCI/qT9UPo0ExJPOAXu3
+PzX+pbJWdIzOlungL0A68DHpcByQTnLqwKeVZy5ihC3AUzaoAAGeRHQeAjyJCEwBnkTMLgvwJGJq
+XIAnEUkL8CSi5xPgiOj6JxFxU6KRrd8+GFALcLQKMCIULW77s1FM/rOVcpWkvCyZeyzFZMfhMhNA
+rBPcnhY7VL9WEcJdBym2gbgLwtjB8nQ6+jXj7OAxrW+EF52YthdoGRgtoHgCPImIAwGeRNlB8CRS
+xgF8QESnVcrEEBJQDLhraUd393sCvMUnOjgIXgtLogLcTQVutfXvxTWFfb5b/4p+I6KAAjwZxL4A
+r5nY4skZMkv9c+kkXnRiBUUgeBEAkhVq7mRglDGPf0aGbJjgP9s03qdGXazYg26M93JwyJAOi4TY
+VuyDHttcPqKTnNNmmiT2tLov2Kdojw68XvLGb39jfz1lhdbNU7Nv2DckAk/aBY5wFC32yT1VLF56
+Lu1yoS0+XrSJQUDNP2fP+2sc7+nM0lwpafzUfjrhbuuTE7MX7cWaeX8Vxg/IKOHnJ+JSZD6by9M7
+QHxARCIFN9lNxJejeCNmGvbbDT6ZcGv5XmMk60FJOwUEKNxT0s6OG/MzZr790n5p+zrzjQsETyVA
+IArwJKIMAjyJ3usn4UAzYqaDEeBJC/sCPImoXQG+YxGBJcDH+JwlUfI53DNBZVCOy1keRRislDEb
+nBLtaQaISkpN62AU3ZyTNlmJgQZZJKviWPBV6nR+8RRUIxI2MShQ0QLcnRqgIxDgAyKydqkOkSqb
+SfUB8iPAB/KWRBRQgO+miJoSmrQGRAx2BHjK4fvtH+te06hZmSvACYOCmfZ6O6/CMTlbsuXaH19i
+jEcDKqALFKq/6R3a+XJ3IgJGnK930dGjMcYYOhqYolONkQpvFcUwgjHG0NnUGKnMcybgzp6f5z67
+vKC9euOMQN+0NsnyrjWnvTlbjekBrkz5r96ceTMPtNdc6czRilxLHS8DOnEXBOhEBPiw6NzUfOPI
+zDu+hRcbZ2eWUbDBO75SHBszKyykYMPCQFp7E4opA3nsAU8isirAO3+Jps8Pscs8ERt8QItXYPjr
+t7mFu+BPprHPOl8HdcPKHCBe6jYfl3k9DkgnZSHHA3zPndo+TNDLG9IAnitFBewKcG+WWm4PxLej
+k7KAbQG+YxHhLsCTQa5kBHgSEW3CwB2DRtR1ccsQXEK059V+GXlLfhjQOtQdqgc2hd1L0U3dTVtl
+oxUln5/wq7torHA3QWt9l93q75QQr4L9dsDXKiGAewMA+3xCLgB3jUCbgZmLLtXYAJ2MAB+jQ2sQ
+4CnjNEoBfnWbG8h4igaW7gDqFu5a7PCMGedM8Spg6c7dJX7gHg24ghVGQJz/BXhLgLsOwMMC3FMJ
+cJwAHxDNU6rrCwU8JsDn3gN4Yh57zEP2btzjFgG+d+1p3hNNhprmvNUCQnLGrXbAsQOivWsXO0yJ
+6VgB76UyDYg4UoAnLXYF+N6025QK64lADEdORN7Y4SEM3oQm7plmhyuee8o4BV4jKHsA7hqBTSen
+AyNFHJYM8hlxAZ5E7UdXrn5Rqg5usgIcDffMs7PzWw8plb2p9IGK+DNfqhVglZkXM8CUoABPIm74
+CfBxIkJRgCctgkqg9aXleMxh4bHY/Kz+J535xpGZl5/5aRORkC//4dz3LKCpCXDPIZXOgknNAsOr
+gLvk/6+0QXgVYFmviYbXxom/BLhrBPLzGUxTJVF2EDyJsC7Ax4lwkABPWvl5n6bi6WYE+NXpO/yY
+yoO/BIp69WQaFwrwlGl2BXgS5RftctGfRPRUQhMLekMXgCettsLHOIgbv2oRcDdHU6G96uoZXgWU
+4oHSVOC1RdAXCHDXCFAGAZ5Ex/2tTwF4T8T5MsBd6HEikhbgSYtdAZ5EnPME+IDoCrs1jbioF6FO
+SqytxapG5kSOWecFfafppNqh5QjwJOIFHQI8iVjiIcCTiPgU4En0W/utJO106q6LrnDUJS/QcgR4
+EdEPHLGjU56t2z81O9D/BBRrqgR40QLsCvBxojPpsUp40nrFviZ7/gnRcaLcfXJ+SY0mf4cUTlmZ
+nDAePXdMg6eUCEYBc0nEiU1oRPkkB09avGJIgCfR7Xag5raJWXYFeNI67V9FCdTuI8CIRoAnrdZN
+SfTPtsTNdwePnyURRgR4ElHlQuONgbQ4UoAXgzx0wrkCiBcR4EgBnkS5UuBJxJECvPqaGGBAG0AU
+kbGJIJkbKNWP7ccoO+BJ60f2I/8ZwHlBjZl903hG7gn/xOxBH2+XnoLSc88HiKNQQCwI8HGic3am
+HliHCwGMCPDkJIJLgHc8ZPXfv+n8jffk7X9+07n3qm3b4KQdmmOkIsBTSuwK8CSiHAI8ibhKEOC7
+KaLBCPAaZOaVINQKYfxGMPK+IHHPn3ZY3y3uggC2Bfg4Ea8cEkg1aeU+ED5OlIc18KSlC20ATyLa
+rABPojychq83WfeZMM5/9C7yH9zTkTO3eIOpHokDcNcJ8PCwAE8iIk6AJxHOFEb6vGPhwErY6HpL
+4AEncgGemhtuEeBuggKzbF4hBndBABMCfECUgjmXCu5apEQR8zqcqsMpUoC7RgAdAZ5EDAYFeBJx
+pABPIsohwJPok/ZJSVrRj+1ftxIxkhAag8+WByVBI3rdXudXB6Lqi3ikSyBgHPhv9OuUyrmDdTnM
+jzjOc4I54tQFuMVxPp/+yuvQHJn3dloBU1WO8xsCMvcqNRYCNQPlNJsqYkBE1y7AkxaRI2BhN0XY
+F+BqaP7F1+L+wqNmp7ES31G4dJbjBf+OwkNnOdzpKDyViWQFeBJlT8C3EDVanP8EeNI6aRclcS1l
+fZNTl1+xHp/w2pl7eryY06t9gHgRgRrwpSGME7FMRIBLy9+4Vz4NMjX/gohE5dsxQnET5xSe+sI1
+mSctRAI8iTAiNCJ2BfgWWlhIojN2V92rnba3F7t763I9Y79XQjDOjNWE2SP24Sqiz0wV2aYVT8Dx
+/JEvtonvHcDH6NDW8SP68JSFgRrGMQI8abErwJOI9+oI8CR6n50kF46s5d8a/Ev/Gc5dhKRFIQV4
+Eh30dckBeBL9yn4Vv5s5TyKSFuBJpD4dMM6/pkSURYCnzB9Ps37wJGIuX4AnUT1b+eXQkRxq2Bfg
+0UX6ctIa0bZgi9kcEwoCPEXuPn3Rw58ejq8L+0n2PfY7f7EQJ9w6MAww/S7AU8YHgnpARHAJ8GSQ
+kBQaEbsCPGm195Z6BWZKJ4BW9R/OFODb0UlZyMXFXBLRYIRGhDuFRpRfH9tM8vllaAFlSmnxRKnA
+xWotbjzGLtTrI6qbKQxiCU5KoXP9fn46uTPXKnzbIl6JwfazhZ8t8J+N8WN5YpHg2+QDesLBGw6y
+AtxRtIR8H6bJYRaV4OLczsbnofjPRpikchEaArwze9xi+4w9VzmPCSctkhbgScR5UGDoSlkL+GaJ
+APfI+4Qx/XqAAPSvFTc3ITl9C/CUEgkL8CTKbZACXyoTOIZ2AeDoJHM0EwG+YxF3bYRSV0I+VY4X
+MZIVmszjGmFAxGHJTbkaS1zEmYMeSJe38OSLj/lb7gLwJDpvX6hDlCakMSLAkxZ5Ehpf5HlJ8pey
+ftr+pdrAgu/Q21HD+rY23OMvQKQK8KpDzPEBT/ThrhMh4rRy1wjky8Em2+RHgCctdgX4gCgVdvS3
+cJLObf5wTgAeBWm+30ZEpSzgYwGeRBRRgCdRO9JJonwuJCxT/ogCAV7zt5Re4N8MI8/ZW9X4MTuW
+zTH6EuDVXO4l4NVAvHZMIKcld3TLx+wmHx7fWh47dy3Cg55BU0zwogPQEar3rqSOZzow8FFeJsoE
+eNJiV4An0Uv2kiTt5GbbapNWK0reY4ZUqBzvHfVvCEa/xCh2t3RWbdV7L9K8xW4ZlU6rk4pKrAlN
+gxkQtQ4qYUxfhBEgPrnHzP53jSuFBf981O/W+ALagi3wflIEaGSectd+KSuJfmA/IBlH0/44bQvw
+pMWuAE8impaAaFImVvzifxoTK4WX4vZGw95xJHN0ZgJ9ShIRo8J4UT4ZNwX+qn1V9toz2vZE9HQC
+PGWeM5IAL3GOS+jrgDj/Xb7fDvF75clcPrcTCEmUBybwJBrQGhDlgIYng8xiCI0o1xY8abErwIsv
+wKr9Of8c9QxKm121h2y19BOcAZI57tkIVSeQSwVPWnhNgCcRd3oEQjqJOCkI8CTKc+iNwZv9TBOA
+J607JnfU4jM4GSfCvgBPWnWJQGl0ScSRAnycCL8JjRbeFuDJILsCPIn4KI3QGMzDLnjSyh1Do7V/
+sr/6sGnit0xu2Up0ZHJkKxH2BXjKxkDmGW4JzQB5ExE22XAv/9lKrwZlQ0QW2ZruhEkiAc7hBWgJ
+TeYHRLRDAZ4MsivAk2jA4ICIXAnwZPAn9hNJ/LtavsKAO01syyucHTmNo1XeiabdLHKD9BrLtrzC
+AfDt6vSzUNMhqPcqC3uVjvstkL+YUDwntKIOD7NCbcW+6y++OW6xvIK4LcCEAPdzHGc2zhqBifMZ
+6/hw/4Zim6G/+EA6veNG6nh5WAqp8ogPpLMdHc+PbJM38YF0/Lj91nne0BGnyRQo24D68Uig1TC8
+Y5EVvCdgFK8P3dEWqgZH8Q5CaXgxA5v2CRxH8pqRgLuOPhsLxF0QuILGCFz66gBssv2cbWbMnRnI
+s8q4Jok29UBgQIQRgbramyjYm1S2HR50X9RjYIdv/VSwAvGB9umZVjsG4jPW6bX93A8MpNM7Luuk
+MGzv5SQRwyABvmPReh1Fs0kGb+W98wXNTMKmWvQ7CCh95gWIBLinxHG0GenAXRDI98IaEbsCPGnd
+ZXdJ4hzjBXncWEeAZIJLIPW+dNHJXG7fcImmfJmTBWN+YeBXTUl0pz99cVkif5EQ1/YBLsNSKz/s
+b/IO9ObL2NGLluGeCcpEpekFi70zClcCmvTFI64RYAmbAN8NEXd+2FaWV5bPL5uxkY1UT1zRC3DP
+BmWiAjT3DB+jQ9mlA3djAUwIRNEYc0yTyxw8meMcLsCTKJ9A4ElEdQjwznyE8B3z8N3PaAFO/pIW
+HhPgSUQhBfg4UfZFcylO0gI8GWRXgA+IknM5Uqic+j3qb75en75M5miWAjpJxBWbAE8iWpHQXMsO
+iA5O1l+nXfuJwICondr3E1Egf9OYKnFQYO1k7jvKHQJ4KlM7BZccy0lBgNcsbLrsIUA/IcBTSsS3
+AE8iuhoBfnIfc6uOtV9w3NrtJL7GL7qgoTtj66ZT++OH/+gxqvFKb5PROWC++Ern9p2Xvlnn8Uuw
+8PLVX3T8vZ0PdXnsfJZYsgV7oTPfOj544E2ZUcR8u349AHJUxFVG/L1+vXK9l5xOJEeF/DGPC3ni
+evwfYyR/bYXZ2n8xCFn7nf+d+sI/+pI/5Y+fWXjUxvxMc/r0bsfNbtu/Hmt7tmUmJoSOwcqCPXrx
+UVuwz7/x+Z5s2tnJuMaY/71+PaCQ4N3IHi5Te/zikxdsai+98cVXYnVcoJsu2smPnDpzluunnW3T
+Af2JsTY+7LNOPtj879XkAb8EquD6+OS9p87s/GqPNcVbWYmbinxPdasj5r9faQ+UtwQUeFx8+NS9
+O8/VmLiYR8bO/bxbFiZlRBvopgucSFhbtbON265jLDAVNua4+TF76wGNPYCPPSZ25iR/Tk+YQ/OL
+jRbUZQtODS06f0SqD15P1aJLD9gJXZkCzuj8Lkofnd8T7aNLK9wF1raYfY1Ltx66ssKzjy4+K+oX
+eed8OtH/0Ij8Iu+hB2d7wXD51uYXgZfvs9lq0DYcHTGxYh+/+MzDTz37iceeOfnWF1959SsPcM9u
+Z9u+beofrnoHC+Nt+P28HCj7S3aD3Vh4/4it9xgKTKq8q2yxsq11rydJjRAY0wCdrVrHa0c7fnJ0
+01V76Y03Xr//9Ok333zz1MuvvvHil195+SunPvfS6SeeuvjYMxeeePbeU/eu+rJPln7uxkZ/id2J
+/4XFdnv5H3v6u1TY1P8v2qrtd7Zq+2zBlgvX0f3/h2zVjvlvRCayI7ZqJ2zV3mOrdtT4NjS/3ux/
+YfMtPLBVLOkysIsVHfUrvyXCGPUtTs0esgev7OB8wUcIuzW4ndsd44EcQ5Mt+qPJ4gGzP7SL9gn7
+kJ3zxbrcxt+NjQcRN9rlnSwbf41fFsv/2Ov/JcD6v8z3ZuGBcUFzgqVtL9qr9jl7zT5vL9ur9gV7
+wI7bxJ/l4Xme7W3dNvVO2LFta27MKaG6YCfsiJ2wQ1W+aPsr36gz/wUPjAufJbOzdsrO2D6b+FIy
+lpPNfluwJZ/Znr3lucVZeWBcwNxmH7eH7C37or1iJ+0v7EX7sn3FXrbX7FV7wG6zzp9W44m17W03
+bFPvNrvVbrPJtrU35vbG8ttx/79s++xme5fzFTvq/w/abTZ1tlH7ev1Fw2KiicdaYiqHkTC/+OB4
+ymX7oj359KOPXbh6buzw0aV+brhRN78J1/fJ7PciYLr62st18JsHTA+dP/k/D6tdqIp3ks0IK/qc
+owSRTyj7H1/uYP7aeu7RPfL0o1fF2mYuI7mS4n+7jV/33GrO98d5oB8VC/bYU4/u7d2g4dQ23uvj
+TtCwzly6Uw+oC7nB34tka/6815p/KGeND/nYGi8ntzUe3LI1Xqhka+/mXLX2JVbIr33Z//ID79Dl
+HtaSv3SJ4U9n916/y67mJQ8P0Ou8YeYb56JHH7748Lj+anePivMNN5uG0uHG85B8LtueB+h2pnYu
+3gDvcXHu1JkZrCMayg013tbnpep/yN5cNnsPcEfJenHx0SsSF9N5ZOxC7W7fZrwh51zqLz566syH
+t29vjOZm/cU8LsZ4bu+OmfCBlDYuzu5u+vO42F3/zsL6hPeJ9S5FzsZwdP73+vXA/FJkFk3rnWaD
+RxrmlyLvtFrdeXlihXoz5LwCl6jzIefO63KWFiZ8uKWNi/mlyEx9fC1am/h4s+kv5pci12RdzjLX
+E+PBos7fnNvHxG62zu7g1m0P/D6xO9Dsgd8X7A5/NVEfy/G5kLXb+XmNFwfa2nudn/S/7/O/r/m9
+mtf9Lz/M79hcxxdDW5e9f5m0ZI88fPHhC0///qyW4O3EDksseXNc30Ys6uQ5v/h9Wv7H3vzvrDzA
+TZsFu8/MHiBIplMmYecj4pmeLa5Fa3HTpomL+Yj4mqzLWeY6bto0cTEfEc/Ux9eitbhpk9cJzSfn
+B8Zj14dsPuqc2TjtnWWJ+fn5qPNa7Od3N88xP9+MLuajzl32+tVvP+bnm7iYjzo3qbn/Y+/auuS4
+rvK3q6uvc/VII49EZLfaFiGDMhfJYCxkexRJdkQcaSwris1NjGZacpsZtZgZWbK5hWvCPSQ4IYGE
+hFyI84BhsWCxePFDgAd4yQN/gCey4IU3koVxs759qrrrdPdcNF2tme46u9a57ao6VbX7631O7XNq
+n2TxjH2e3QyuM2m+k8nQDBp4kqLzJrpMTUh/Kxm9yp09JQ1dxMEjCg+bxKyxowb0J3SX6KAPB34c
+fHYo8P46z8CHADpKgChUXmEOggqgwUGlz370nT1PAyrfT4A4qOxMjEk4qwGV9zqoJOEH3/kzNqCi
+Kw86rbJzUfb7mQ2ovM9plX7/sTt7PgMVwSSBYpHgB62yIcExZlT7cGkbdn9ZBwNXTbt0PF6Xud2q
+zTlD7pZkWS9J8H4mFokuMm7TQ5iC4CQE5yB4HoKfguA6BLdpxEkJsilBnqkvyPuCAV8w4gvGfMG4
+L5jwBd/nCx7yBUd8waO+4L2+YNIXTPmCWV/wmC943Bec9AVP+4IP+M0OekSXq7VJMGMzlPKYZVr7
+H42/q/H3NP4y/xK1b9HqVPtHjf9J4//QmIdwoVXOHeSBDJxwIwf49RmnhDd/ybyRMc8cv9Fex+9F
+CVCDmoV7DC5SkGMePYG0+lAht9Pg/LB0KsH7cz5HSpthkeKlHSwohaQGMz2PmoKBa7PIMZ/CcLCg
+FJIazPg5IcFAWIyMyFbCcE3BVhLq9f1m0iY7oQyERYFL9bT74bujP3pdgP15/80v7yy7F2O+wCY5
+UEdQTTAYVSH079n6F3CqolUm/cqhauAbKgPtfCw7VZFkNcFn51wrdjQZjCXLWLBMvB0rjLNkbUdK
+vXWMmZRHTDAoLgqe0Csj2xH7Wdzvb8ujn0vGf5fgOA3hFglOWGWS4DEmFolOAbZJ8MM2Q2kAjzOt
+fZtm/tq8fh7/FY3f1vgtQrP2PY3/V+P/0/hdjV9k30ft9dPM6WdNwJwu21iALtZ48sbK8pMF7Mbm
+d+2qqXrNbNsz9VK6nsvXc7vx5HvvmuwHhWQv2MiekiHxH0ZkwcbqrfLNG5W1qZvl9ekbK8vTJ6aO
+lx5GNzcqWdbP932mYThUz4WcONICHkYWD2FYa0sjgwHsw5iWhpDXdFzjOK7WL3U04EIS/9AmkDmE
+bm5ZpNrWP96W2+mdpHEIHg4iH9TE1Z6HMaSlHHxNRzXu9Er9dL4HWvDYts1RBfnDOHWlslapnq0u
+3l4p31wfxm5tQ01XzjaV293XYFsuj0xjGJ7mhpEPUnYiM0GeKxH7QZ7HuxBKwIwbNhAyhlNHzl48
+c/ml+XPFpYX1hWsLa+UxdLrldEmxe6/lAeucIYwgb3FMjcMoBLnBIB3DQD1njqERagzZoGR4zTFX
+lW/muTIlwO5LAyOTOBVCI1iRvBTptyxX12+vTS1WV6aX7i6XJtHpVsQkDm5QCzV/u/r3YxLvw6G2
++9odvznvETyqrd0RPW5C40lFy3uQwbiWubhsAZMYgaflzWtM0l4zBE2iPzLxB1FZq16dnTkxc+Lq
+8R8ZRLc3eoRij7Y71/Ha1DsQ4eWDfCFIu3MXvVyrGYumdmFEM9CphZXru2fgoAEqnqvzfapdTdKW
+2+7IJPMIB6LiY8z5AzhVvfZKeXG9WFl6svPVp1M7XMG6sMPz2t1xTlcM5msv99KRHVMGNiZMB+AH
+qSm5OJSAmamQY6ui1rbD0dfgldtrlcW7K8tT1dUb00vrS2vTh9HNLY902/on2nI7uZMheHp+Bu/B
+sOYIyMPI4SAOI6vt3CgOYx8GdW8n1+qvc41niAZgxnHq1nJlbb34anl1rVK9+WRpdmqm9BQXFu8s
+DGN8w6XludA7G4V2V9ivXcl2e3bKGwzONEvJj2EcWeR08foBFDBiLTi/02v033k+kMnlxUBFV3I+
+hIVbt5bLU/MvV9erz1WurS6svvZCefXVymJ5rbvGov0dGKOGg3P3aZrVuP3dsmvq4RBE948FZr0D
+WhrBgzikxjca14yZLad72teVPK4ZxTR+IGwijx2YKEngNyJK/KqlmQQ/2sxiNwinmOiIk/lChF1J
+M+1b/BzmBaM0EOfUPJpCbsPN33DPxufEuYeaKM76XF22BIgCdqDprNcmwVM2Q497mkkTCWg0Nmib
+0/FNwduABrqweLazTwrd2b0vAULMQaIPfsj4nsFBIkZh9kddDhJ98kPG9xwOEjEKsz/qcpDokx8y
+vudwkIhRmP1RV/hie7rpZZUk+AATNY+8xFxgfuFrrPgHgQWsYhEvo4JXUUYR11HBMsqY0KllBzvY
+8h2c28l1G+c+iIPYh4O6FNJBPIC07ktpbI4aD9KhIDVcF1MCIazOKGyiJDgbLSqR12rZO6f7oiR4
+JlpUEjyraZQG8BkBPi/AFwV42gO+7gHf9IC3POC/UsDfpYF/SAP/kgaG8EGeXPuUxp/W+Apvp/ZR
+jd/Q+HMaf0Hjv9H4a3zI2psa/6XG/6lz3FnBOyOMOd7YsCaeP3/+/Jk7C5dsy9LulaL2zHu1X9Ke
+v90797Z95HZr7PXjCJaAOF/Q9/HCxQsv7e3JCfwywdxhfBM6TH0uDiVA5wBKOUD8PPBXZjxC8joA
+kUcevsbMuRCnBLKx1hbnnZm6qCcUGmgGR8qBw4ioe3EPgCNsUpo0h+fA0T1YmJp7ABw50wnlN5bi
+j+LE2WemZmZmjhdnHlsqLz4xO4pOt+wmNQxiFBmMIocRpOvHST0XvfYwRjFkcaJ7t8pzKlBqy6O2
+qiWJ+82cYeKDQfwhnKmulpfPfPD0pcvFKz80hE43r+Ma0hhCtl5Lpp5r3JmPIaQwBInwGnvDXL6e
+Iyen8YDGBY3JdaFZAmZ+KOm/R9g3HcTYPwd9UwEG9e2Ak+azGjPXGqLT+Fv3Ok5cEuD8s7jq2m49
+Zjool4AK8XHgUwYfNDoTH+bDjc3wEV4rqTjZjd8tlHm3U7NMLRsXgw8fb328YUcgUsJ34CSn7MDE
++fwNCcdZa5x1cUiB77UafJpPdVylX915uefajgTMFGCSURaRzgZMZyOlCmuzxiSpjUi3FXlz/bvR
+aHn05K/Ugg8vxAe/I3X4aP617n95l/BRUHi04iMV4mNQPxDcWB5Of2wsmzj37BI+BjbAh9/ABxGy
+8ZM6fGwsmzj37BI+BtkPPW9AEiHBee2qRsnDjwH4cJSl5OFDAC5oPkqC56JFpRQ+DMEFCC621E9e
+KwnmAdRqwzkYj8HmEB/w0gUUi5cvnT7zoeLMbOeu22QL92+5LZ3T0XFew5WduaOGkzuWUyjA0xxL
+LsQpASJZSbHh45nzz52L8xWu07rCl1RjBIj7FbjTu+vX8814YaA1iJGcYEb9fLbwnqgjiMf5gpP9
+MfXRPcXOJWBBwkDn8Ta8J0KYPU/sWCS4ZJVJgheYWCS4bJVJ5DVgGpLgI8zq/Dudv6fPh2C6agZL
+1cWrlSU6xsz08OYF955SF5/mSei80eQy8Ou5kONSWwIhLowjnDTKKwuV5TR6f/OCZ/CDlP73+FSE
+ClMXNpOADYsBrJbXqrdXF8tXK0uhl5xeTb3gzun9KPTUJAGPz5TVOI0cBur+k8h3IZRA2I5dYQtj
+keCjVpkkeJGJRRJ8QhElwY9Hi0qCn9A0SoKfjBaVuF5rMwl+upkFwdU2vJ9pw1tow7vWwgM8LJJb
+W9KYkfEs7+mqrVy5VSTNw76TgodvZ+Hh7wfg4Uv05fiJfUaUjBm2OlSbeUGZl9FrDTEXtOzcyQ7p
+J3fekXFn9ocECAkafRlEOAtSH8zT10p+rcAJZ4KieuEQfIzY4znXFU5REtyIFpUEL2saJUElWgxI
+8IraZTjUrfBUlPNqXpoxb4tLuLiQXAmQiA1DusqXIoQk+FkmquqyBIwiRrUuxM8icK+WRf9s6eBZ
+6LExfCrRHNfmJiejMXMuhBJQbChcGlAyJFg2mQgJViKlkAQ3mVWw6QdrEOR1nXSCjbO55i+eRXrP
+vFNG33Mb73R0Rd4ouVz8EgixViVamkhwixzFUNg3a2Aohdfnn9/OnI/dPYb+rOO+A8Iy7jp7tT4q
+lpCC5Xq0IxQC6+fCnXUSrNbzIZHXquzWwt11EqzX8yGR13ru7XB3nQSv1vMhCe6E2ToJ7tbzDRK8
+xoL+GVJ6OY2U2ppJfUGRknAhyRLg30OBor6XBYUkC8M9OyUQasbXiIkmErxOjmqZfIgbQGcEcJHY
+ocJm71Z8I91sv9vXDxKgSiGpPcQM0DWN43o4VuzekzqUdU+2cda8NU4Ex6iQXEiyBEKYkERSCDQK
+O/CC91ulqaAUnqIayGmbWP+1vVqbhYkmnJD4PuS0TaI1DZ8+hAnpHrWNmdThuT5wz+qIOO+cJPh5
+JhYJfsEqGxL8IjP6XnWAOQgngmtgC4YUhxPjvD9XV69JgJjgwAiDpDI02ChSSGy9VH9Rh7mQVAmE
+pptfIiYsEvyyVSaJNlk2CX7FZiil8KtMaw8ScbV3uIKIpar2AxrE9/Burdf+WO5+45QAzS86BUX9
+xv0acWOR4NetMknwG0wsEvymVSYJPs7EIsEnrDJJ8FtMLBL8tlUmCX6HSRMJfpccRfiDzOmMReiX
+eyIZFhmoZNgqc3VyX2e8WmPYHmrfiVOurq7ekwD/C4YEv8eMYmqUOZ0Zph4Vw+G5WfJC2ugVdZqo
+cyHJEmgDkxNteHUrGIn9QwedRMOGT78lTALDmOlH8ujfJ3qc1qLwXOhEAgRTSAHMnNbqRKDJObcN
+dOzGzdJagj8IcRYhwSdZUk0WzjfNAjpvkG+tj2w6ceFeep7s893L8e7YvSMBQ4I/ZEaxMsyczgkP
+seLmSCVI9Wz0rKaDJPgjhUeUBG9Ei0o5vEHzgwg+LYKviuAbInhTBH8tgm/Rj20TCT5DjkKwoBcT
+HRfg2ID4HNcOiXcYp88OV1evSYDq6d8FGjhkGfgo+AiE34yyOTLf+Ojs5zTmL56/cBkZnL5w+rmL
+zyq2tIrPMlHAjdPKq1+vGeftzjtmz85+j/fOCYqQFE0EChWQfh4DMzJl7L77IRgnnwDzOFbl+zh6
+9OhR42snHt82cdYVzx25WraWABFBZHxW4RMlwR9Hi0qCz2lqk4fPk1F7hyMNqrQe5aCULvhl1J34
+Jby8vn7r5PT0nTt3ptart6o3VheuV+5OLVZXpp+df3F6dnq2VEKpixv9+JUgLVc43MIpBVs2SMNy
+CZlInty0xsyFYRQlDGhpBGM4gmHNl5BHCQXsRwkPoIQDyp3AkKbhuS41aMwRNaqlxnHq1nJlbb0Y
+fO76ZGl2aqb01Dg63YYxrjqxXT0pjKv7sXb79iPTlt/u2O3xBoPj8pqOYRxZ5LAP4xhAASMYx5Du
+2V5tSTmKKkth4vML1RGcW3j9tbOrC3eulFfXKtWbI9grWwHDW95Lpu0RXCeHT5HWmD4zWDJBNOVn
+0aacxYh6IjUlF1Na1CCM/oTaRFul8LNU01EicAZRXFpYX7i2sFYuTk4OduyxlN6mm2vh3LFmXvfK
+dGs2iELgGb39dbjIZ/s9Seea9kfwp8SMRYIvWGWS4ItMLBL8mVU2lMKXmKlN8AK1ssZkDDOHlPbV
+dSaZLxD24V1IsgSotkIKjAgUB+e15BQxClTmCBjdx/0uJFUCxAAHUBg4gMIybU17x5jv7uR+S4AY
+oIpgcJi439Lfm9drwYTHOZN7817dXd0fCRATfJVmEL4RFefm5orA3Nzc3CD8Dt8JMh2ef3/fnpL+
+/tP6/DpvnLEGjhvQkzZ7olvblt0R/SsBao3vCjTQH6EyOEJJF1qhbzqXJk8CBEbDwEZP0uRMIKeO
+0HItG63drVxy6K2VqQv9IQFP2xDBl6ksLBL8uVUmCb7CxCLBV60ySfA1Jk0k+Do5amQ7w5wOT4dr
+QLj1RWOei9Cb9fHNmMD4CwWITYJvkKEAepS5AEBFnuH7WLm7tt6/rTtHlhpP15jZ4VZlakiFuRBB
+bypCbMrhm2TU/lbjtzX+V43/TeM1zpGorWt8W2OyjzDStbDYmeIYFSdVEH2NX4FXdiFpEiAeiAUG
+aqBB5WyEinA1taRJKWnPS81ARDDQymus/ia+P/Yjd5W9JgFiIiThUrZmWIxGmxwEE9Z+nxOtgiNc
+mlQJmI4MlQgDm5cf2LR52amadc3STiW3O+dRVXBggME1L3tN0e/O/agZJyBdtssozUbzwuWV1AZo
+yDUxgYSSnVqQkJQpGpGYQaaA3AdOPWpOi/u+LVSEnziNtmqXNO0lfDs265y6VU43W920n/eZAQZ2
+YBnYieUCWGyXdqfz1O2rus709iTMpoYdWAbXid2dTuNeuyoxEVLgRpB9ESoL2kjYzEy0NjVb2kr+
+n70rC46j6s7f7ekZjSRLMhZhB8s2AUMFe4RlSzgyIGlkWyBZjiTLpvISIyuWy1pcGmMTqDykKnlI
+8kIVVXkmb6msQFIJZCtIyEY2SCp7gLDkX/j3jYd/01/fud3qZXpGrdk9c4/q7rfvvX3m0+lzd5Zh
+THNzIAgdbkCTKL40V1M6mq0mfoRITIwnrEyu5uSAJVoqFRMa4oJHj1PeNOf7bvVWRnnRHKI0oWJC
+Y5STRlMT6tMeYsIlo5zIx9PY8TgQAR1G8WGtnHDBAAdKAgO0/CQxholaLBm7FTlgcRhNVpZwdYkz
+Ssu+UAYKjxMhLplR2oqPd96YJRIU1F1ojP5SH32h8WpF0idGbNusJmjJz0n4rTlzTDlBo2yOs90m
+vWH69D6QRMy9DsmY+Zpj50Tzv4Ul5xUSFzQaG9xJxjs5qK2mYyOj+XnVam9oQc61QC9+i9rnxktc
+rLbx62K/Lfbfiz3BbT0bT4j9pNiXxP55sS+zj7Pxttgfiv2R2B+L/YnYn4r9oizG/w2xWSHv02Lt
+TKQhOgfOTc1PTszO1e630PIxTn2W5OI0uJdb76XjwU8pX6yXbnylcoD6L1FBQ03n5PhINvzdq0fY
+7crrLTtmtLW2vwEFkvSlHVykMTYyM3ptPbdaKs4q+VwK9hbypFz9imNNbHFcfY55W8XodU2UFzT8
+moyNzIw1DjbS8p0o/GsYbBTmTbkp4SVMaYxlZw7XGhvUEwq9SVtRDcJgoxDfyo8PzyALNgZqi41i
+yEgbbFTgVy6tDD1wz+8JDb8pZ8dHT8+fHuorrbxyntJ9jWAJWi5E9z10f0XnT2yhl+hcxt4OBywZ
+jSUuaHzYmNxOKZXJa7BRGT5WqhQ97kZc0Piwca5SNcQvx2AjPq9qkVOPuxEXNBzbODsyP17bfnR0
+bXpMIzotGMsx5GCMCZXLAYuD8oIJjYs0To9Myjn65aOyuH4Zp/xoHUPrH9RQtypDayNbjVtEyaqt
+Sm6FdAto92PDxlj23Ei5iKvU84nYksMdPa1Uza1ejgV0+HGRxrmzUyM/u3K1Dv2T/P/DQlLHlRr+
+J1JFeyhBuaFlifu0kRkuJ4KuBXT6sWEjOznb1zj/MeG5k6D2YSRFtX4pS5YQUcegof45MzXREHNr
+4Td2MeAhI4yZ8BMmXDoHLLn8mZigIS6yU7MnSi+v0k/m//YeLlp3v0+luZxfngVeEE5M0Di4mMvP
+V68Yg4v6cN4CeoL6xU9Njk3Vpy35tbrfDi/Fkxb5iPFyGV+5HLCAnX5cyBqNkdrOpwU1YX+IfZLi
+a76ieif+ErbymzUahTlkATeFsJGd6asMNvjLFq45boqZh4/LqUrns4BdXPX321wcGiCF3wmESQq/
+SydACr8XCJMsvAzgFXoDpPCyb6ueJoVXIuJe1Yk+Ung1It/v+3K4pPAHADZEcyLxAFmu1gesJL9H
+lBWNt8vEtKiWHNCk8IfaEyCFPzL4qemvcePVpsnCawBe1wEfKbwWIatej4j7Y99TmhT+RHsCpPCn
+DMsa/nP0QWEOEMPe4emJsYbpHfp7AZS3nm5r+gAeL6rh0yu1iY0/I0YELdz8zBiOI9BwXcTs9fNX
+F5YWc5XWJqpVXrG+A3sOnKnQMxLBWQqvPVpPjZ5P9XK1ns9FjIU/F8T8GiHiww3/f3XfgVJmbGKq
+YcYm/bKEreT/k1/yMGxMNTigMUOg0Cgl6zWnzv/cediYGnlqhNLGJaLm+PTMQDXaUXqZfuzoUjSC
+DH40N6ph650i7Ie9QXBs/JXYtHbSgoUuyGSIHMcxdOBwdY+Q4GKqcA1WXkw4hwnXkgNa0Phh8ciB
+THUbEAULrrSpbq2m9O1wwJUkCm9ScogMaacPCinI5nuRIQYs22Jrc+bWMkThLwQgQVL4S0YIgO6g
+D0qkDT9Iyu7AvqXzuaWjfVPZwx0o9y8hJbSLHbcsOyKnyouz8mKiy28LxCcDIfeJ1KbPjTGu5oCL
+o7cEJ35SeCtvYIdI+mtmEmzZPAdEX1Yly42pFJ+anc9VQ82qXJmekmyU48pxNViSi6q/IVYCpPC3
+gbAmhb+jR1AloJIui6+DfmpuIlhD/UIefvxt0FjSMaa7pflQadtF1dvESoAU/iEQJin8I50QKfwT
+YwRp7hAiEUcjZynamBs/NwsbozNn6Jyazo67Ff8zHw2RwjuMkQJZSAC6ag8xPDE2fQo2JsZO7YON
+SwsrjjOgQ0Pi5HRkTkfmJDJ36GHYyA2tXJYsyzptWdKWJW3ZTVvSjy/pLEuSZUmyLDlZrkroqhNa
+uKJzXjksRV85Ik5mUDvy+ELmEQn1Z7TTrx026dJC/yFyanqsTyJX5zWL+PLvbrKDSitjOJxCw6MH
+OwpMinAy88abLjEtLo0DJIV/oRMihX9ljPw7/TR9jg7yOJFkd2I498zTK4u5pcXco52o119HBWtu
+k7IssRNi861SYtPXCYVOpB2/jjG25oALDspdzv+44KjffEexGZ3ttkrP7YRnfjgn5JbEuSHOE7lh
+47oc0J8jChNe/OEnhX/zB4UU/l1cPyn8hz8opPCf4vpJ4b/8QYcU/ps+EWP6bDqFXjmhjmM45ooa
+Oey8tW0Xov9DoARI4X8DYZLCe3QCxLgwvJN4Dxbeh4UPYOGEsvCurWvySOF9L+AQ48JlKXzgpHrE
+uHA+iuH/YxaB+6/S5+S5wDS7G8PZmemJ7Pzieu7S2uqj3ajXX1eo5vRmuH3T140O8XeK3Y02x41u
+c8qJTzhut2iyzJuELS79xgQ5QHwQRR302T0YPhgASA+q+9dVsPzuUEp6M9y+6etBh/g7xe5Bm+NG
+tznlxCcct0cAwrwECF1j8jmgRZbChyJM/KTwkT8opPCxuH5S+MQfdEjh/+kTQfUEfVBycK10L3hP
+WAfmRubmZiZGz8yNI4W5EzPTZ07TMzWdHZ9EGnOnpyefOjF9SrcQaMPnWM7G58W+n9Eb+8X+ZbF/
+Rew9myfV6kGHNukQ606xjcmz06OVHiypXHl6wCs8qOUf8KpcXa1cEoHC4SJtOJg+l8020F7aMALC
+v5XGSTjWhMvlgJ4XpqygYT93YnJ0anTqZNbt7dTXDfZIdQ84lddvrW8bm7X2/LNIh2bnz82fzM7U
+/o31L59fL0cpGBseyWBcGnrtKjGkxzt0rLHL50D4LFIbIxPHj5crjSrxvPel0N8Uo0tUgqtxy5B1
+rfIt0d8TG1NjAzXUM/RvXqi1UVjw8FLoKRNfPgfCZ5CKvBgrv9zyS/B+f42dKIyUX4spIZoD+vxR
+hS+whxsghS8GwpoUPqVH+tgn6RMNALqPbacxPDY9OTmSHSn/C1dqCZ6mQp0kJe3zl0VtJG1mVgI8
+qUZI93iJjy8RJ4KYZfpkcBDO8HEazsDxsWq0oXpleiiLqkPrvf47s7gEgjk5LkjXmHwOeIj5Mvcy
+b3AAeUUQROI8MPfCX+srbVmC/yn+Gv6w8d9oHCBxkkGOtYSyFfpae8bRvD3hQCPLlaHSChmFR9zP
+DYkwOWAY1eocCEAiBBN+cwgTI00MTIw0MSDYmgMuTEhhaSIzz7ZCx9bFmBzNzQF/50bxpC8AT9Fi
+yAER4dPv01m4uJDwMR+jJgfH1u9nYBKDSSZPMZjsZ6r5GBmQcPRLk8JX6JGx2Vn6nI/RaUJFJXjA
+yZPnYeHJC7RybjKJSDLfpZZHk1ZrFL5KTARI4WuBMEnh63QCpPCNQJik8E06IbLwLcZsfFtsWmdp
+ydgl0EfM2hkMX1xdOXp2bf3y02trl/ueXVlezR29uLpybO/S1atXjh48eP369QMXV59ZWVy/tHBg
+bf1iBuX+7UUGtyK6lAR2RqbsQgYHcVtkWnRJbuyDmz43hu59sJFBGzLoxC1IIoN92I20bMnsRQYK
+d+NmtCODLuyRJ/iUMeSAxjBtjaFBDF9cWc/D0Mp6CENrK4sE0EEXTQcHUe7fAxjEPQVK6UJ7ZMrt
+GMQR7I5MK96e/sj0h6DArcKDSONuWBjEg7gPSbRhB+7AIBK4F3cihUHcjP2RJRSvtZlT9XJOhe+I
+YPKTjc8AJBTQpYAXibYAKXzmfHw9UviuF3BI4XuOzyOF73uBTVL4Af0iKLlCnV9vLgqi4dfbMl+v
+VueARqHCDwmUACmNmwDJzsYQKSg95+IjRWiFSCGRn5ErfmQshF0Tki6KreJM72OP1ndWknpyfVtg
+aidGXLJ4aqfCXijcH4hPJnFm7vhDQ0lU7y8RWbYdGatbYTmuDgVtFQoHU02oVA4EYFEQLh3oW1xd
+WLtwafXiMX1SVn3s7Z2sVryNxKdCh6j83oloXExZ/DmTGg8zCfTX4hxPc5xrYx/pGA8s3Rh+7NmV
+5b5relP8seAG6VJCyZKeYk3cFq/Ex1D5xt0yrzfS20hgh7OdPu1stOe2easCNZXf1sYpgTqtJoWk
+0XmNZluEA/FkjNF5S9YSm+vJeHAxOq/RdD0OxMOM0XkbXButTfvigcXovEbnDerbfp03ZXTeIhqf
+SYsnY4zO22S6a6nvEw8uRuf1ND7ji4cZo/PWSKts7HrigcXovEbnLazzthmd12i2RTgQT8YYnbdk
+LbG5nowHF6PzGk3X40A8zBidt8G10dq0Lx5YjM5rdN7COm+aS3hlyTmvhyCifgYQwzOvSVxzHX0W
+nYltDQ648wIK7Zto2U1oOOu/eYq+snuxsL6Wy11fW7/Qd+WZ555bXuzq+MleRP3x+p1eWJFp4fzt
+eTHhHLUI70IHEoGabAntQBq9spyqF23oRZfEJsWuRbsavw4NHkKH2PGTHLORRwqd0g1P6QcdiLEY
+K5nCGydHR6YGU6jtXzKiPl7Y7rWCl4LrkO24OmTs6nDAAQcUdoRhxfu1I7DWHZGvJyJuZ0TcTRFx
+u/LiWHMvY+VzmqHPQe9PcCeObWN2erZhbs4t9OniCnSm6cN96TOmshxwsWvjZkq6jR8T+xaxbxOb
+2OHxmQIhQY+VtHFyOjte2ZaUUxoXvuc/b1CTz5PKxnBnqLIS+sx46KCdwEAtNju43Vr+9q7fuI3D
+AVey+GHRX93mKd/rG1hUl9ellq43uZNEeIi0OFxqYfGeM7CIx6d65tLXFZFcWAzVUlq4r26khsuJ
+xnD1TUUKt0Z0om7lFt0AKdyutVQfKdwREXdnRNxdeXHsMt3NWOlEvUSfowa/IJ2onfiFh3/xzV9K
+vnPTqy/gNzH82bWdcmbPTvnrEFv7/XY6EPKnxPV3R+S0IuLillfZfD1SXhc6xQ2XncqLCefID9uh
+uLZQOPjEjlA4mNoeCgdTtxdSEfldtUfhHsWLCAjFu2g5yEkRVXYae5yrK/JvMth+TPH7JLZfXuEn
+3LsponO4N87z7hQ3h77jjTs93RjjBjmQh4yxpfPrucWrwVylhSqBjOK/efF2uTf3aRS4+PA/w6FL
+f9j4PQ5oWaKwm5IkRD08QBHY2MO0jb1i7xP7XrF/XOz7xL5f7P1iPyD2g2JzKBAbD4n/gNgHxWZV
+lFOQO6k1PqmpP4/+RECbbgwloRatMIqhy2UXlH5YPGxg4bKnVV3dr6f4EGMn8DwOGVi0Khzc99b9
+eoEEJYbAYsDAwmVPq7puv97/ETlsYNGqcHDfW19ALJKC0BBpccTAwmVPq7r6/mGBxCYsBg0sWhUO
+7nu71w/7PyJDBhYue1rVtYA2BxKEhp3kuAUOJXl4WpV39RQ7tbXadRcqv9gps4Weac54Sy73FFD4
+gDFggNGcP3f8t7KA9nyJcdgAIz4LmzOnJTdEi7TwSYwjBhhN+XP/iL1rfZLjuuq/09Pz2MfMrtde
+P5KUvagWaVPKrrS7JHokTiztSrYjr7XoHRyjjFcjacw+ht2RLZEnBFAMSUhMSFyQColDsCEJFuQB
+TiC4+EAVxQf4zh8AFFTBBz6QKpuhfud2T0/P9My+ZrSr7Xu6zul7z+2+3X3mN+fe7vtax1M5QE+j
+xzhggWGBwRFI6i1qPMZBCwwLDGQbPcYhCwwLDOTY4O5iL11G5YDKoyofU8nU91JwlAbgrew7jIXi
+QqF8o1TIl0rzxbl8ubi0uO+5xUtjK9cWx64vzI9dWs4/P4zhDm7smDXc8U0wjHuRwDu8K92te46D
+68IAHsAwHPTgHnSjD8NI40FN7/x93RlXMO31AW52r4qb55eL5cLybnRyS2Ggo/mbe78Pu3G/dmIx
+8YTu2SmKeHm7LnyYhYNe3IUkujCk6eZYK02TfoCc1T3OXH5+rrP/itvjcRwMI4FsncfhuFrf4wwr
+gqzHifq1TZt/gJs9q3qc4kJpubCysged3DgkvpP5m7zvxx48gH44Xjyl+wyS6MUgXOwBWyjuQxYD
+2INu/Iymm3OtNP0CWNHZK4JK5YDKoyofU/kggC+x930VYE6yG7MnEi4Bx+81SZ3CiB32gqmMokJu
+pDbqyHbouLxWO/KxeaxmAR1AxEGpDj4pUBZ3EEuXLxfnCof9VZV2jY/t3/X+QbRjkya5JDCoQ9jr
+r5FTTUplfdrG4v0YRC8G9OwulcyHFR3ue9Ct+6xKaiz7FkhwYV2A71tkixS1jEWKj49gnwA44ky8
+2TTCJBivvqvPMKTfAIFHWFS5WUzODM0uFxfLK3P5UiGLzW7da8qhd01Hhe+lR+NdKsMp4ZiDrFaR
+fW26Gkohy2lPkNGmZz/d7mstYF7KgQQmCJbKURZalSmVBJaBEH2ThVDIcLVGjHfYQIjrsZPFTcFB
+N4CUvqfy61jtbEeJUKw2xYZ3mgX8sockpsjiO6dLPV0KWVwHDz+8lYuUb+W1W02sHbc0VlToQcgW
+FXH79Zs9L4sXwSQrJyES/FyE7t0RuvdE6A426Ai/Q9RqtSenhRqjMHcgLlvHzh85NTV6qjC3tHxp
+9PFp5DC1tFguLJZHnygsXilfRZc54syNUsEPT+fLBc0MgsNehgEJ3huhe1+E7uEI3fsjdB+I0LHu
+HybBkYjjpiJ00xG6YxG64xG6RyN19UrB4/UqCD4YoTsRoXsiQmcqrbUkeDLiuJMRutkGHT8C/jy1
+lY+oLKv8Hn9UgxW9kLC7hTJ9lzjEsy1ZaIW4Mt+h9POf4BQhE6IETgtwRoCzkWmCMyI42/Bn4XkO
+zoiDs6xQhUhwWlilqiXm06g726Cjl7ug0yx+SOX7APSrUyTxi7aTFHyU015bjrMF6JtJRDchcTjO
+xrDPTguEIZHGrvzKSqG8K4V0G7b25LLROxHvTNfbR+XDsTZReqvzgWGLD/2fWMkJMBUUps7DMkRc
+sWWIRQbrnyQDiSS0Vbw9Iwab5UK/TUDGvd/vdn5+vmQbqgJjYmz/bQFGEoltbZrt/LN1/t5Ml1AH
+F0TwoYa3ZMEvmBImRH14itrKh1X+osq8yjmVl1VeVVlUOa9yQeWSyl9W+Xt8pa68pPI1lbwQA5yp
+jqAls2g7ZF173C1AKJjijSGC4gNxN4l9ft9/GWAQFEesUeJuAdNfnV6CTFAcjbtJ7PObnugEBJmg
+mLZGibsFzPx0BASZoDgWd5PY5zez0xEQZILiUWuUuFvAzE1HQJAJihNxN4l9fjMzHQFBJiiesEaJ
+uwXMrHQEBJmgmIm7SezzmxnpCAgyQfGkNUrcLWBmoyMgyATFybibxD6/mYmOgCATFB+0Rom7BRzt
+Ek1AkAmKqbibxD6/ozPQERBkguK4NUrcLeBwfA0ontYm9osqn1F5SWVB5RWVz6r8JZWLKksql1V+
+mS3xla+ovKWSORNszJ+HKLtiW2LjDjsO0SEpINg30dWhUdYs8baAaZ5XQHigsC2xMYeEegqDCwMM
+V2xLrAWFaZ5XL+F5CtsSG3tYmOZ5BYQHCtsSa0EBdmJXQHigsC2xFhScJykECtsSa0FRt3Cc2JZY
+CwrTPK9Fh1d82JbY2MPCNM8rIDxQ2JZYCwpOPh4ChW2JtaCoWyhObEusBYVpnteiwys+bEts7GFh
+muc5JfRHOLC6Ulb5PYfzgrGp7GkKOMhAlzGGuP145GvF/5156p/uevUtfOrih6f39iOJ/lW2zCrp
+q53fOr3PSxdvHxzdUxMOtCbUDUdDCZVG58tsNeRrWu25PlCr9FZpuYjUdISuVR6t07pWSW99dlRq
+b1Wb0hArILsBZZEEAZNnE8ozFHNiGuNrIfTGfz3w15uF0Pp+oqjHiNK1D0JRuTfXbQZCUbmuFUL+
+T+n/haLy6t80hKJA7l/Jh5CDI4CyuAmcnzmXwPbdOJdM/d1x8Z96nY1vxgL0Kz6pXxGMQzDBSYJN
+62wAGRfnz02Nu9gem6zpPoiY7XG/O+cuzLh6wYr4yAlIcI1ardkMM6TVYWBI10txcXTmhz/eHpZI
+RP4iTqR2e9zxxu5ibf+TjeW90bNMdUXwnCKklgTPR+iuR+huROj+lWv54FeYogjsYchDIHfi9uB4
+fqE4f+NM/pn5Qg+209bd4m7cpmkJXSQ3eI60hgUZ3Qd6P5SshnxNnPc+ED+qQKklwcekXpnBxwT4
+uACf8Bag+xsBfiLA3wrwRv3hEHy8IQ/BJyJ0n4zQfSoiv1+N0P1ahO7TEbpfj9D9RoTuNxt0CdwU
+4EoSuMp54+rIxU3efeVfVF5J8sX2qkoe2MWXEs/OfEHhgjb7cRBj4MI2nVuihYu62sVyOmffduTM
+f4hPktEF2A6FdC5rDbH/ghR3C4Qg4cGEwxCM867xKoe2xKuk7CJcbfEG7colCi4hnfUqcfcofP4Q
+JAKvIvoKcVN85xKQg8+wZlR5QSX1MxRw8DZAWdy9WCguFMo3SoV8qTRfnMuXi0uL+55bvDS2lF8p
+rowtlQqLl5bmri0UFstje9HJLYv70Mn8Td53YS9SyCDhxft0/xBcOPoqwqNyECRxr4Z/ViW1lvd6
+JVgAn8n1wOfKcr50tTi3MolObhk81NH8zb0nMantAj2YRFrfaanfg150ow8uJpHCvbp67YOaMqqS
+x1ieJIyEfugzIniBb2EhEvwWPVYdJfDb1FY+q/JzKnnIAIV+BuNCXWyOzOH8kXPHLi+Uh8wKRjk4
+EORabMkWaTwvpZKhjXKvd2a3t2+eD9c9bp6aQ1coNROKpUOxVrnEK82UjVxYkixupoqQDLZ+Y3HE
+u3BVMtSMk7qSfrNUFmq1aWyiD+L8whbEbMi3AH2HT8LlaftZ0eLrGln4ufL/KqZlieAhc4U/6uK6
+sp19bhYotAI9CwsmwWdF8LmIouzzLKjqSPB5EVRwl6c3h1A6yX7se/TM6Yuz08cvnCssrxSXFv1G
+8a3fs1k+rdWe8L2Yvj78p5huAeGePbXdGLq9M4OOGn0wHQF64Hc7COdtY/0EmVISvyPANwR4WYBv
+CvAqQVNHPIqftL+g8osqX1T5skoezoqRDkdjhYns5jAyO31834XR8cMT+/ePv7P9FYRstdLBakqX
+/ofcqs5cL6oi1oNcZLXJr1KZM3N1VSFfa/cbtYCpNJEMQvqqCMkTIpPv7EO7t1w1xwz6tDNeGi6k
+quX1kioZCrgXfeipifsp2WrIaLq8vYlRplQyZHn9FjDVIpKBSNWJTBiEbBR6zc9rdCI5RUjtGdaJ
+1Fpja8Om6w2pDiGTFiFb+8tsl6ubCTRIUQiZuE0+hK3vtRaxPqTWGlsbNrNpuPiCAF8U4EUB/oRg
+CZHgdxt0/CL4JWoru1mbqfyDyr9jnxCtBvdqDuwUxiP57TCNy+UbpWdL13fW6r3+Ksps4/CfzF/b
+mB+VjK7VKsfmiDhJYsXAwnzzySg0Fp4tTfifjnbGPu09h/lcmNCY/7HQ/zSZQVL1O+OJN/8UpuJL
+XJCl6jYWdtYfxLqN9f+epsZLWJADaEysP6vtfIaFxvp/HVPVFXyZ3+FC1IOXBPiqAF8X4BEHeNUB
+vuMAtxzgPxPAD5LA60ngH5NABl/RSs0fqPxDla+wuKp8W+V3Vb7A9ovKv6vkxd7so9TerfjvPlZ2
+evH3A1rrdnlDvTrFYS964apkqJG7I3SNR1nNZi3A7+GbzWO95xM2Oh9VFR8v3uvhA5zInSPcmGcr
+fDCdHFecbMXvRnvfDg5qPcZ/pHHzNW0lZaMQ3WFCJUPxZr9wbJcVgle0duXY7nxMrYdkoFFTtKzZ
+dcTVZdyOv27tNbbCRZmqD6kBHzoJCYsWx1Y9Qr/TVsW2CB98XUJQ9ahWTRNB1YODXZtbxfqP5rZp
+Z8oW4SMJ7BfD4o7g2Ozpk08OTZ+eGpotzpWvLRdw+tjjJ04OGf3UyVOzYzg1Oj0+Ahcjm9q6m57t
+INk0rf6aXdqObbR3YwQDGu5BBimMIIGsxk16lHw7RnCfl/IObx8cdz9G8DY8UKMJ0uIeMnPskhrK
+HjfwLb1a/jT7n1jf0swy7dVvkW9Js8/nS/wKEiLB70fovtqg49lfo1Y/tJiCLBiyw7lr9BBWcjYz
+94k99862gPm+Ivg6oVJHgm9QqwDq1zS+OBFY/FJX1/dq/Lb0veJEHH2hzfa9CttjK2M+mL5J2IRI
+8EcGOzUk+FaDjuD6lrAjcpd3pDmEX4ydpMChC7McZwsQIizOyITET+NsDPvstIDxOyTBH9NhaJF1
+DxUQHY3HEXkcIvPPDodIWN7OFuBsnJ29P5LgFSIlRAm8IoIHk4KHOPdOHQn+lGcotlKaRuwRYawO
+Cd7FqOU4W4BgYFWFzCkyLCRiDQc+vV82Cb5tvQcNYrmZBaz3aGqauKYE3uM71nvEFQRre27rPdZo
+qPgcF3iP71rvEZ+ffSNPar3Hhsy2k88KvMefWe+xk3/ozT+b9R5tMOLOyiPwHq9Z77Gzftp2P431
+Hm036Z2eY+A9blW9xz6vzSWY6nUQT00tLZYLi+WLZ26UCitPj11fmB96eRCb3aRFDskWaeHr3l0X
+D6cy1qOSIfI9GEQKg+jVGDWDyHh7E+NKTDkMoEvjaWR1PwgX3V7IHBd3aeAjuBXREeUWe/eHSPDn
+BFmIBH8Roft+pK5BCcH3eRVtKuQildSwvwJ7s7AZ+q1/62wDp819O1vAb+A25OAHBFDlXWw0VMSY
+8bAcWGDmehDXxbnxsf2cFKRzzK51nHG8XVeIXhusXbnHMx/j1+hJyIqLiduDi/Yhw+Ki/dg1o2A5
+9esP1ZeMWl/SASvvrDytL9lZv2e7nsb3JYK/FKCCCj2K1mB/KlB2kim88djRIzMH2rXc0FrzqV+M
+judxjmfuDXPSPBNyvb2JWdkZCxgvQkrhr4iUyru17HmPyoMqdaGxymEN80hTv01pHUbrMXxXcrXT
+nMaRMLkyjeylM+gJg1EqyF46p9DTOJJm6D/TyF669tlkHCkz9Jthspee5r0xjrQ/vZ/Jz0vX29b0
+jONNVC14XZ+ZZ346S8moIa6H1C/4D770jQL4SYrdRB1U3tzO7yb23jpvAWKEbMjipPMWvzOvQIyQ
+Dfk4Mb6RXuV1fnlS32OOMpLUeOyPmKjHrtlPvXVnWs3edbssQMiQDfmYIvJC5ZnFSdssfmfmRIyQ
+Dfk4CfzUj9bhp37MjNbnp/7nzrSavet2WYCQIRvy8dfgpyxONm7x/2fvyprrOKrwd+bOXaUrK5uT
+ShVgBBUnRUVKIj+AeQCXhZNQODa2kwBviq3YqkKWylIWqvKQt7znLf+CV4oESAEhCVuxZGULBJKw
+hJB9cyzqOz2je2e7vtf3CqTpc251z8yZnp7uo09fn+np7inFlcQIg5MYJx2e+u4APPUwMxqMp972
+3f6+15+QYXAS4y/DU4YTz5FCjDA4iXHS4amHB+CpR9i3pjz1He2/yuabwZ/1Txn+CnDCLtK3hP2Y
+7MzVUWbbe/kjK/1wFuiQ0iMbpNR3B5MRjRFNAdEkOpgMJ4aTHJx0uOd7gzs51mlpmMrBFHXm5Azp
+FJTt+m6i0SExQP9OjhGNEU0B0SScHMOJ4SQHJx3u+f7gTo698TBM5WCKOnNySuemDFejbqIZ2Mkx
+ojGiKSCahJNjODGc5OCkwz0/GNzJsdelhqkcTFFnTs6QTkHZru8mmoGdHCMaI5oCokk4OYYTw0kO
+Tjrc8ygH4eiYnDl2JUdpv8g5Hfz6W4illT03uM31CLG4urzkNje4PASPkr0SIvghc01JCz+idv0g
+p62t36LxIY0Pa/xVjY9ofFTj2zS+XWNmdowRWjgAaJBwArWJz+wJRaQtgYhMYEK/IjaBJibQBo/j
+UMX4xn6s63c7lknZymj6zcvSbaYFHCoLERLw3vzOnCGElvAxuPm6hQip0CaGEFrB1+BmbBchJNBW
+JoAYh/iMkIrzQYiStB8SaCtjCPEXHxPkkLAHQrSVMYR4jpBqMUIqUStTgdizjKc4cR9mZwvDkG5l
+KlErYwjxFR/aytR7cEjUyhhCvEZIoxsh7bjHLGCP2WQbbV0Cto0G2hgHj+MQYmxjP9b1u21lUjYz
+mn7zsnSbaYEA/Ly54MfsnE0IdWklda6LrVuorXB9PgDXMYLg04AGCRsQvrsDpA5BHQ2EaNjPSwsQ
+GbHoUnbaGxfggYcSZ7ions7o9P31je/1pwgeS9OQ6tJKpsvjpsd6cFMTqAj9I6Chvb5NVNG0n5cW
+IKxiichJuI71Qw8kzoScdK7sZLG/FnBMI/hJmoZUl1GqNjAfyUuvZ7BaEyuxRDSkz2jmI/nuDeXX
+n0Ie0mVQukTweIaGqMsoVVvMTeYjeekN5deaYIklIifzkfz1gnrXPPaRHs/hpieyNATBE2QnHexn
+/UiDeQ1+pSZWYoloyHykAv/A9I6HiJAnJCC96PKDPKacqwNBNcCD9/lrqfgzgP5aIK65E8GTue3T
+kz3aJ/OTC3xGH/UxuVCiBsr85N7eor9nO+3Tkz3ap/sejDnKtr0sUO62zIngp5n2ibqMUrX0eYII
+ZFEKjnE3n6cnjvw560TwswgcHaEuo1RtMaaMp/pDjh889VQI1PBzgmj9aZ3K+YzGz2r8nMbPa0zU
+fZsRargc0CBhC3cvn7ljcZUnWhC0tv2vlqhBteuooft1hLptarz96zvKGpB7LgE08JPjh06ePDqq
+j81uVj6DfmTcffx+s0pTxnyda7MT0EDOuH/t1MLymflKIA595Fq3tx3jSlRqxxz16MjVxPEHGcOx
+h9N2x77zCDljF6DBOKOM//8XUicdeKp8Qd7gKOU7F1dPLcyfKMWblEBrwWnsbrx1TY+T74iqqePk
+WZ+PyBifBXAT98KaehkIal0fdN+cD5gPl2v8Qfqgz3wqfaYbrlRlupq4IFswmJfR7WO0zMuAqIdB
+T8O8jAtpkct4DbtZk17GoZW7Vm9amD+x9drXSqLNp99wvjJKlMJ5G+nUzufgXC/zNNK24TEZYwzQ
+YIxRxv/+C6lTAC4t0+n/DHHgK/v2X0hOo76m0y/V3aMVjPw+oy53OfIjW0wDGowtyvE3Hb4WAbjM
+EJ9HGNiLcXRlYeHeXbt2sYUZLsSt+3C5nO/qfvwM+hDZfJx/0dEn/ZeO3tc9MgZ7MBiMMYb/XytH
+DgG47JTgF3wjmxLBL6nVN7EvcsF6CF6oQ4OEFRzeP8eVHLZCYNdmfjmCAn1+atMOagH3sk3wK0Il
+IYJf5+h+k6P7bUZHqD1FrYJvSm/CBgicgA0Jp3BqbW1l78zMPffcM722vLJ88sz8nYv3Th9fXpq5
+8fDXZq6fuW5qCpv5G9f1+ipI3+NjGU2cor6xF2tqG3tOU4227ojxJKYwpns7cDE+iQndn0ITU2jh
+UkzhIkxhp2qvQFu3vM4CLaCwgeBpIikh1KWV1LkrOiJ4Jp1MsfkstYrNcSr0YUh9Lze6t4G5L992
+cO7mIwfd7q0333LgkMtb8ByvTYjg+Rzd73J0v8/VZZQI8Acq1++oIppkfljvyIe2qJxhiFcq3Kcu
+tAe3kjToYeJxvJ9aES1c7Z+BqABwQxihop/rLU0ZLeDYiphgiHAhhosy/q0HqZMbKMEW74/awpAu
+xhgpY7A9oX8mmPZ3/obV3FkgCYkA7VavkdAck9frvJ0rhwWcCP60QR+uqSFmiBijDzWFxTEsXiBQ
+EiL4c47uLzm6F3N0f83R/S2jo1f8ErXrL2vMAnBeAvVcWoeBa7+J/aF8t4ADKgHBQFAEvpvE6u/8
+5AAvieDlTA+N4BWySkIEf8/R/SOjYyP5T34idL0JYE+NxwQe2SmoVnH7viP7Z6rY3F/YR/6CKio9
+0wU9z25uDcqeu2Mlh542QWMeeEk86OHqQbpQIUOFLaWLa+fm1xb2JkfwjvbIzUs6f56ctVTT+UtF
+aTvznfJmMsVzo3rPDA0Kcy+6qx96YoPNCN8a0I+51tpx3y1ASLApYTBIeA8HWiANiWNUWvDZAmlI
+7PXZGFZ3WsAgoWawuGOBJCQq+Ea71Wu4WfGgvl5X/S/O2aDC0VmZoFAJ+eYnRLvF94R5r6Td3BI3
+XCnvvOnKYwE+bhAZ/3IdWAkRvEqtvpI5qGcEVwIaJJzF0uLSwtq3VhbmV1a+uXh8fm1x+fTM3adP
+TC/Pry6uTi+vLJw+sXz8rqWF02vTJ8/Mr5xaPL46i838NfAJbGb+Lu8qZiGYxBhmUUcFgep3Yxwt
+7ECIWdSwUz+8+HE9c63G7lqLY8C9mumrJwz/bYDLQsQAl7VJ/xoHOILrNQNX1mwGrqxN+tc4cAle
+y2Wz/2wA7v6o+ayByyzSARM0Og677flpAbLSvYAGdvq+7qcZrNYdCzhGITBe32APTjKmhucYCJWu
+SzoX255PFiAGOCCFgaMOeGyje4d7a7/dryYGSBEMRhNe0UFRbQkJDjRgMJrY7v/goyk/MUGKYDBM
+jMam2z8XiuAN+p0JCfCGCO4O0icEb6ZVELyV0dXwtgDvCPC+AB8IcFUV2F1N3ESFKTnk9h2N39f4
+A42vqlK/W2Mm5fAp3i0W/dqT4BAEyzn6WyA4zTW+WDgNoWC2iDJN74sF1Hd2eIlRsceXuls9iyxQ
+AYJKEhXXF6U1vS8W4Coe2maxleKH29/V+H2NP9T4qir1V2vMlon+lcORi9nqfN4Xe1k9iyzARb4k
+qMRoekex857GH2h8VuPdiqNrNDY0FVrT9zNdaKrhXQHeE+BDAc4KcHUVuEZZKylMSY/6PY0/1Pis
+xlerj32NxrzGPG3vETYKC5inPQorli0P87TL9hcdRX0iT1vwER/KE0JdWhniIwlwjh9Hpd8Uf3N3
+QwTn0peoLq0UrKdVqksr9ZPiKaEum47FSYpAMul4Fy20trfxMwNz0xBqB8Uo7Gp5bF8LOFgQKhXu
+KlTo+VFDoVLCKub2Hdu3dytMuueyBNXzLmBgSxNszt+KcKAIKuQbhUu8fhyF5yVs4uiX9t965OZj
+X9/bxNb41dBEPSpLJdp2SsY16QkZp5Fo644sHs4ChATZpKJtUbcQRPTfu4U692jYEerSz5rUcdhj
+twhCd7OECNjT4aBqzLZJvLD9842RQ7iwB4MYMmYb8n+/3Nc7yBAwbq8j1GWZrZrDbNUcZqvmMFst
+fQt+v5RATYigllOWWk5ZajllqeWUpZZTlnpOWeo5ZannlKWRc20j59pGzrXNnGtbGR0tM0at/gu7
+NoKzDuPFbXlKICUYWLH9h4b8P2vgJMA4AbH+psbUXcpIZ6vGiJnE7OduO7gyv7R4haILmKT/gckB
+fvUB0nby3dG1P9G130mR3RvP6NoZDa9qacw9FxrRtqnbMY3dGYuzFiBcHD7orXKB9YNzNx4It8wc
+3uxs6+7v/G2dcpatJFyWjS1Q2wEkIYIJapVl3PMM10jg8DE3dUubJTZNFny1ADEQizQUSULMUL/D
+7XSJYDJXJ11pKEyXTkhd1kWdzHELJzNuIUtzETNUKN/IW0DwKUADe5bt+wHeQjiuOSExBWggJGyp
+7Q3T+LpHyiAsLnY7CRFcQq0yymV6RtQVpztO+Fzpq9Gs3rEFiA/C51K30yWCyzI6PsPtpHb9co2Z
+fJKRPvvzLOImdgeP6MczEGzn4lva1lcLECYU+lKExBd8NYTVO7YAIaFw4J4Nn4jN4vPWIOH1nz+v
+9gaJXLP4rKX/2d1ZrCMCfTaIQCAQyH/ZO7cmOYpjj/+rp2d39gKcOHAizjmAJQRYxmCxkgCbiwjv
+SrsgeW9IKy6ybLyswCgCkMLw5Ec/EfYnwH52hL+Yww9E8ETYfnB4HL+sqZmeC9KutrXTM505kTU1
+Od3VVdn/zqrKrkuNtYCdgFJrwhuYNQZDLDuQMDgQ8wZmVEq9Q4dEzQEwXH6HxAil1FsW3zoDjP+j
+rWm+zodpXDASTbK3zSG3sVDfV3OGcWIq65OVllJZOfJ07qYBcAFQ4IiLdrvdZue2u53p/0+vBhgT
+GSno/4HGne1Iw+3I9GJhfyVzO7I/PdXrqGRHgh7FigwQ0jTBgo4uGErEcmutoKWgl1tBy0HXYgJB
+j8VIHyE9aEpc7XFW02E2sFEvD1kz05NPlDEmGQ2UkY6nMR4NRAr63hDqgo4NycDUcaRWXzJGHAmD
+62B35NS8cxbL3w8JOmUmitOZJ3/6nJfg3jQALBh1BIe8Zf0wkNFSUMs/tdVAbn1zKOiJbt0yj8Ba
+F/F1Ygg5ka3t1U3lunX7w8+U6+L5LX7d3Lv1GcdCtHGoh85FW+RhfTUAloDFiRgpUNCTI2RPjZA9
+PUL2zAjZD0fInh2SMQHmOaTtH1n4PpWjGcGXLHfcK+kMuWYuRNBzCnqVsxIBbV9f1xRV5xBYJypA
+5VSfPLfuZZ3V5GWne16kkDd09tRSebvijkqJuX5R7vsPRz1UL0zv0SIBi9NHAwuHRPXA0MtRxvwQ
+q15OWQuF+LsECjouGdN5O766sYaspeyQ3bfZ0jo+vNQrdqOafb+K/3i8PA2AgkXJmMUBIOrdaZvu
+7uU5iAZAxWOSsaPiIJqb5mOpUkDGt00G6jzPz/aShWcsPGvh6W6v+DrGREFPScZe85Rntyc/JTDE
+NFcYG3PDXIUsgDUNz5AvYnNvdxETIj3XYcfFvWlx+s6KHV5sBQwuPpkqezF4x9x+DGpk9O/Y4y3a
+C8fFaE3VS5rZaxdsBYy9wA+ST1z7wu1AubjNeA1tvZjTNDXcQzYFPYn7WwZ6sO4hK/cpnPzUQIV7
+yCb/PpZbAqoUkPFCjPRR0ItIrcZ5YI6/GOwUiRU0/yvoa859nBTYfTfP1P77eIYV+1WrogEwAkdy
+nFTlvlQtH2AEjuQ4qdr9qUp+wAgcKeGEigkKeonoweqof1SlbJ6P8WgAPMGREqaG2jKOkzHdn6pc
+F4zAkRwnVbkvVcsHGIEjJZxQMUFBPyZ6sDrq31Uro+fnaDUAnuBICVNDdZTj5IjvS9WuB0bgSI6T
+qt2fquQHjMCREk6omKCgnxA9WB3VZrZHVcrn+Th6DYAnOFLC1FAd5TgZw72p0jXBCBzJcVKle1Ol
+vIAROFLCCRUTNuXlGClQ0CsjZK8OyZhk9BrS9jkLSeIagTIdk4wZcQP5XJJy3zBOXmpgjTFYMBhs
+dHe/PysZI20GzXFkbyM2FhpBMi0jwifvzlUjx3HkL7bldbM2p7rzS5LN6eIorrowr8CG+MGxVJE7
+WJ18AKBoc8AMSywsUkE511kDVDJe7VTnIa1GTmK1k2k5k1ai3ShQruUsaCUL+joL+obVPQeIIzK1
+tcKGxu2vLf6Nhc9IWrIKDXqAmI330gkFndSDeu352zc+2r1545Xbu7/54nXgmShrBp2u87PqZUcD
+fZAAO5nOnSA4qaABwCyoAKYFjeMzU+pVG5YaTbzMYrmFqVyzWtCcFjTflaR//BsN9CzZwt0NT+9g
+DM8ZoOdcZw244XHDY2bk4GGcqJkIe3K2zk+Slx0NuD1xe3IPtoRz4gTf+HrjEYCUV6Cl298WJZuH
+4di+bWpBLUunvx1NO5hW8GGuMM3nFm1LuGMnCT+g96rNINc5HALMd3lkomuIw8/TQ7L3n49ayyaa
+o4f4Ly54vvvZ5zfV0Ke7e8p0e08N3d7b5cxErIl1naP2bv/6/s5jvp+p91aBnLE1JXvXanTjrOcY
+twrIu7LecR5DA8AJCjqPS9GQ9SwCezBZMUsK+byuf/7F5x9/9MmtvY/nNamfub6cB/uVa15Ni82r
+Yd+ZhfOa0WwnNqnlvV/5Ho2Nj29OIzYaXTwUtenYKGqjGO/HRqtjNz64ia2ZTO7VNL2NZzK1CvVM
+sVy51TpFicejBmJNQz0TYz0KujAoMhytIrUaiQjISsT4HVs1vtP+gWhTW6VV5xallz1BZS1GChS0
+RhOnj5ANHoiM3emKhCwOD+sRMkYGFQmZ7SXdR0hnNQxlGu/A9mm/bXXXQAJh0BtEzeqdAB8KrNNi
+TM+NdaFYe7BXF0Xr2guL9VVPuv9Yr/+0/3P8yKPXAMjAJMFsVM425crK3Xi6XpvzTkNpmQ0ABb2J
+HRmgoItIzbqktdKxKLCPn6/GMLDx56LfsgAYameQ5VxXDYAJkACzThy/ffZoXdEQyx0xQCjrkS8H
+rfCrJRmHnNekw4Q1ecQ/NdYAKPG1TMdf01crB6ACxwuMh++nneYq8ktUPH0UdGmEO+fSkDuHs3/G
+2dbq/ZbEFbQjGXOd00EvI0uEbOkOMgbQwzb7K9faxfVV5Tq/vnyFVH7fkLH9y3WVqd22iILWY6SP
+kAa19b8dKfUqZ0lZw3xE0dVpeulJeOcLZ02SxKZGq+xhXTUQKWgDQAwQUsdYXZFRXrkTBW2CMrOp
+t0wYbL51mnO9FJjwaA5MozvY1DPYt0R3OO7F0o7Lg65hMJ3rrAHgRGsAvgPsrGmwLRmH0OS08wq6
+rKAr1pIIusqzAAVtEbXH4iYCG2YizXO1lu2vfhZZoo7MrpGoI/PHos7gHF/ZgSePBNyB4ih4mmz/
+j8V297H41HAe9KBkbO3koNMK+4a8XTvRyDzGdRmWLN391UKVeyz3q9uG3jLdXrZwx0J08yGBGjZU
+HT8MXjt6Fuy/XV57wFOaNA3wPGxKxjw7CvoK0MhQ0kVKACvwpJXP81uWBoDGCKSEAaRkXM+Rghbq
+yt+BFDMrQVf4tjoJ68Kx9BJhf+dcLQ/s+HIDRKBOX8rQQgsRtOBFMM7Zm1L4RBv2ySyMcQ/rowFA
+AWBgmrX89gqorpVPLDcYAA+wVSsNhbT5bjZGwzY+k+pXzg0NIONtYNFHQW8PvT6kbnmHI9vXLfzA
+Qk77A4E5ZQjjcIcQGDdHxRSJzlQIrEXb6L3cDplagZER11pBu0G/5Xy8gXAI8/xcYVYEsyAYn8fb
+QN7W4GFX0HbHw/geWeHgDuc2ynx37vEZNYYGLoYhSbWHNmYVz1+1tQci/kcyDvmitteXd9a2Lm9I
+Or8oQLo41s9c9+qz3dii5pVr0RYYWFRTi2oV/htvfqfr6qCDbhcMOq5uXnzr6urFC1VBR9R2rplO
+DCxE2bx9J1wUsRP/9/DwGgAdL0jGoGNr5dLOe9urVGibVbAd2AlK2UNFz5osdDGTMLLoKDFtlRXG
+ZSeYYALT/Q75l3QzY8c71KjLSamdowYwGsUGx+byhlkMSRtVMBqx4hhuUrBKZ1mPhqfzXRoAHTQ2
+YKqUVJ9Uq8ExjI5YnlS9LHR+pwoo/uvhYTUwhI6LG6tXdpY3tlWNBkdqaKQmRbIldFYW1bDyz3Yb
+HofVhp/fr4G0ZGdT7+LvaP/Cwvct3LVwz0KMyRKxfbtkmkflkvly/q+jXDL1npdbNwcPuCy2kNwl
+0/+c1/sX6KB1BNNCcpdMvfHQX3rQ4S6Zfp34r6SB6JLpzZ10d3/SjH+jAawH9QrsdQsace5pAHQU
+6xbmrF6hM1URzx3el57nJeU79cHjb38RFPVQfhh737j6Ydz9f8l/F12+hO7uRwuH5/jy5PDpHFUK
+GI1iZ9bd/eU/epObIuigsQHT4HB3/+Tey/JzPogOG3cSqSLjCyhzr4Ex3Pjgf+f7o4FBdPjLoPuj
+58lMNe63RFMUpjn6g+zPsdFTZlN00ppjUQP1DTEa3eZoacOrSfVfQcass3yccd2BdeyYWHKY1X0P
+ux7zYa7t5x5eAyAj0TDeMpl1Uogj9O3NdHH/HINRKzAxYJGjEnVkx5B1vbmlodlejVvOrxGtWK5y
+/dxy9RWr57f/aOGfLCTPD9OTsFUK0A27D3Ew62HEiT4e1lMDoKG3sii7NI3WA/Z69D8unTYNgIlE
+gXnBJvlbkDH2lFakBYFjsSQw06fMMtsUsUmbLlXt6UiTljtwYaD4b+qaoAZVjXOdNQAkWP4KpjPA
+1hpQ3Ezy8E1KT2EyNRCrDCoQmJUH2m1vbkxbo+Jg5RnGxEMP3Q0Td/v/YDnwo6uoAYj6A8Z3aZVK
+mX7L+noAJ7fkNCwSpf5Kpn8GGTtMJvjWlpn3Ppi0Us+VVWyitzFCJw4FTcS793QidVLTjM1kvvnx
+XJehgT4UmXMkvlFrZTJ2c1PqQzu5qfUBpWtu8I0NmBuzOL+k7zNAQb9Cav57c98XdwPIeX2LhBR9
+Uar6agCIQEE3iBpajiFQsJ1s2M0GdwuLFy3vnDs5TidBcvX0dsPtf4+bq6UZc2M01bL94MeZ22m9
+NshIFMIc5ujN5fU15Vp5b4dNl9aurq8r1+XVZb4ubF1dYSemrY1tzYqv9dV3leudrcsXlGt9a/ON
+vhTzoJNV9XP+h72rfZLjOOu/7pl9uVfdi4Pk8yksSs5yAr7cniT7TqRCne90zlGyrNypLJsPUQlp
+Ix0+3Yq7kxyKBBtwCFUkQIAEAsSuSlRApVIFhDixMdhVFB/gE0X5G5/8kb+AD3zZ1O/p6d2Zndm9
+l93b3dP0M9U93U/39PQ8+9unn+7p6Xb16pQEqItICl9gUNQSN8QlVNh7pyNUOlahTj24u89+JWAw
+QN+8K9p3Afu9ocvf6xIwGKDvINHzP1ZnakgwsNGgcw1Hh4Te2/ehYUGijcFtGI19QaAwRZzbxrMn
+3+N0ulZRTHCV5ydkGKbdk5TStiTPUZuWVF9f6ghD1CEaFadDOv7fPCp3dDqk/t/j4pRAWIfcYkTG
+OjipmojhMD0dB+wXFp+/1AsD1dzwh+89k+riXickSaWdPEKEpHCLe2UIWvhumWixVH2zKMScbJsM
+ppiPiKLTGR8XV9autLN+7SorCUn8LLBd5btywhKwqNK4rYF1Gw0RUxRQWRefCbSDzSxt41NHnS9O
+z4TLbX/Y4CKuf6iV2n83V+JBJFCDD0OCi9nO4IK7C0Vr7HARlUc3YzJXQTSJxromNsKk8Gv1LOGZ
+1i1MzMkW7VTA5DgT94kCtPKAMVx8/tnCwtriykph7crCpaWF1SUyy7csc+f65s3rWzfh4eLCGu9i
+SWcyWLx6ca2QQauH33IJyTXQEb4XxFRwTr7GcfcvAQsLjhjrjMJ5osy5NEvAQoIaSWc8FKdnD3eG
+mZ2bTsP3cO/kSj+oBGybxUaJuiJM5LGjFSby+GF9mBRetsVUiTwCLUzkxTIK10MFE0FW/kVtc+jv
+pzkcw8ae2kjFpTh4F+fSKwHizpJWMo2ZxpQv9pMFKQEb/wO8nPgHMP3JGils2GKqRF79n4IV2Who
+D+oGfwBn+u3fJErLFUQUiercmX4p1nG1Z7eQoPKh6XdmeuagBsPerqPp58y+vcmqW7ls88RWiboi
+TAp3bHKVyCOAwqSwmZCvHOMRgHfJlbHXASmC8DSGHqfov3BhdW3l+Uvne2niuZmOnw9N2ed31qxh
+Rqbr5+um8DPFuVYlQJgYvMTR9usmMUQKWwm87RiPJe6QKwi0H4nwDrTclJ/D52+Xrt8sbeVwlI+s
+1N4TX0PJOSP+UX6qztadUCEwaPorfxifnykWTi2vb17fKD2x+olhtHr0Yxh9oVJ0KMyyBzEgZ4Z3
+c/kGOVQD/pDwM+IPwwvOOTn7GEZWQrvdNd3p1CLEyD0TCJHCvQSldT+Wj1e/Qq4oI36lRg4VEZ3y
++zAzM6mWkZ+dYeuTQV/o0KFwmF8fzsY49TlMPIc+qCBsOI19v0la46tcSisSIE4Ij1di0CL3i0wW
+GJ0TIBqriuqLbRqAXDDZM6xDTfsQ5rjwwy0BQsWS4mtGQYigxbzXDMh9wt+1/lhv3TkCGLPccIE8
+fmdA54DSYz9Yt+pDUFiS9VGtZpGAIQeWrv08vXXnCChMM5SXlT2U0S6WHGB67IfrVn2igAlMFdot
+Trt06yfp3ftGQOG0S+/+UL1SsyhguFy36BYOnHJ5M18mJvI7SQ6kcgEfjpMo9ENhAAqDUBiSoTYu
+N6QwAoUTtinj6CGda8p65ufubk0iYDOLoskX2uT/Bkdx6sgD94JB5Q3Ot6q8KT6zEFR8b8ZL6Lhq
+dHG6E18S8u1XJ+7j7nEwCZCICDqi4mxHUGHq6rBh5NB7Pi1lIoKOqDjXQVSwJe09ibgamY25FX6T
+oIiQwpdiPELoS7LKSIaDOxKXGSMK8s3J1ZWlK5/tnel19hsTY77F62W/OomnOE4rEqjhRuHLjIi5
+cpl4IVL4gROh4yt8zNjZzk+vBCwklhnyFfrTKwr35EYCFhIkpYYYnYFCEQqzUDgDhbNQOAeFp6Dw
+NBTmoDAvPW7TK5eLLLky2iMPam2Swm8xKFqdvVH+QJacrNspa4VXKek60nhVjLBfzHDBt74gNbDI
+2Lq24T/jyoj+jk4eGKKpH6BM4TUC06mAQ2x+KGCSwm8zKLJ2RnRgI7hzWAK2BXZGdEQsaY5ZSJCc
+URZtzLspD6pyksLvMCha/RIZQdNKJvvBbmgk1X9fPr2FhNPqIg7n0/imM9RNLWahSepmPagtSQq/
+y6BoU3ZTeqV+7agHH4zlvG4CIcrhda3wTa3whlb4oVb4mqfxdU/jP4IZBWFS+ApLEBnZrdTIoFP+
+GC7fLu+Ut2+X7xbOTM9g7pmV58bgY6ylI9/k6izGkJH0nPi8kyc+QzWnQ+ExqEhsNBKrXdMoNBBJ
+6UefxAcxLOdGV6WXT2xwb6yvaIX3MhrvZzT+MwFbGr/HrJXPcTxQEPbvAj3qbOB14td36+2IONLt
+U5GxCaOSJiSm0y0O9/QEA6XAmWxmnyMHCRFImn2nJVL98yc9fT0kCkmZHC9NEiAkhgFx3KS+sFP6
+4s6n7m5cX98sDLS80XnzErItl7/7RuxekKcvOGs5+/K5wSAyEhtELjjng/Pu5aYjB5fopnExQpz4
+GfQXC8UGC2LvZ5FrO6mw8QQ5t4h1Y9l0P4XdVCoOhIEx0wFgcCm87j++q0EjCZhvSjmQofA5O785
+RBpfJXYqd6pDHI9LKj94oK4xnZftNDXA7lmTJEAwcE8HOg5xnEzK5HhpkgAVBwQQFhSPpOnx3bMm
+ScA0OAq/b9ARIXI5udMP0mjIimKR3c4+vX3/Vjd3wDmMezfa4ajRTlmHUYejVWYADcGKDVtS+IN6
+FhT+K8N3OV9jSuWPxP9j8Z+tmjQvyfZ6/GDK2sd9mJpa3Cpd3ylvtbJ4Xf21XGywnrfX+F4XNEwq
+zwu4SQsY8qP+Pllkca9LKybd4WHmEUSWgjWezkPjvKwIZkn5o1i4Wf7VUmFlY+Pe9s4WsTOKVo+B
+lksYqSuhvy6+Ww09DGEUxzAYyZmXmBLfRxYaOQnvVlra0mPYyeGJK899ojA3hxwKc3OMUBsZxUPt
+pvwJTE0tlW/cu1Pa3LlUKt0s3VwtbZfvbd0obZ+fQPuOEy2VdTzh6mMBry84N66rh37ouvRxjCIr
+PKriEWQk3LiMdKYQUWIY0ZLyT2Jq6ucLd7fKN7ZLOwVRQNdCCugkWj1GqyU8Vg2dxEQovNsdRupy
+9NfFm10/gJOigD4iCiicMy8xrjhzsqqAwukubCRAuFiSxsvHtYWVM8hggWeCKKx7JkO6Z+3e3bsb
+62HtM4n2HRMtlfVok6v7MNoklU9gtA9DkxiU8xA+gnFZ/3tStA8naZl054clQDg57eO0T6BddjsT
+LpaStY8G+gHM5DmSPB7SPpe3yjfWSjvb58fRvmO0pbJGGlydF74vfuO6ZjAOry59EP3ICi8nQ+pD
+Em5cRjpTYijSKCxAYSGS4I+D/a+w+XOtON0Jial9/i77+ZW1NEtE3hiORe4zIDGDOnbwM+gXTiee
++CjdIwISsy5mdWEF2fpQhhi/zl5Y5Q/FJ+dFGRPSIeV0Okk5xSzua3Nzp9G+Y2pPZSmcxiORnOMS
++7j4rM2Q+AzRTYp/QnzGo07jNLIYwaMCuXDaAAr4KHzhWciF013YSIAoCrdrU0nQiffWptDqMVot
+4WPV0G5ljkVyjEjslPi8sl98hugmxP8Z8RmPugFMSW/tuPTWwml5fBSTUMKzw0XhdBc2EojqKtFO
+1+bmoDE3Z3pqCv+dAbL4BiFW+RPx/0z8b4n/F+L/Eieaixr7jKyXyX+zaDk3uoQJ6d+50SVKIu4I
+QNe/c/27XXt2JgfhYim5fxcZ2XajS250KTyaVB8mnJz2cdon0C67nQkXS8naR5ksrzGrP197qR8Y
+3+F3tXwTV/hCeatwdX3zZvmV7V8o3C9tba+XNwtnp2fm0erhVUt4WkKTeFTOg7KCX610HQp/KhSe
+x5OYx1MhzukgPBGcTRmPyXlcfMOxfgGfxDxG0IfjwsuKP4V5mQnOXNzR92fxcdmik3HnahIwawYT
+SPBpRo9iamHl3LXl9Y3ScnnrzvWdQrH1d9vmfTrLqX9rT17Y8Z18OG7Dx6oh8z5+uBq3ORqf+Ybf
+C9LN+/3RYLZAH3x5N8trMxiFlhBjzoUlIB22piA5E85+sLADycHk1itXadEgzTTJ2dar6kDSugy7
+WYIGOD0RCn8qU2I/KeM4jFv7WGc615tSMUu93nK38ceqIcuZhA6Fa1wTGkN/EDLxuM+WJxfwj8mZ
++3RzY2i+uT+OR2T2Yvw6xyFa+B0gwaQzw5j6uctrT8oLiyfPTM+0YxfzRvus82vN4WC39Wa7udud
+2JPrYnaGt7u0J+fhDvCcGRtPNbu6x/mOYyXAoWJDHr7JSOUN8X8oPlP4Zp5LVJBBx021f3l5ZVl2
+BOPuJr2ybbTZ0ptvFnqlRg9/PaheLAXvVcniRx/BzHxChq4GG5VzsEkBNJo9IzFiKQE2SpqrOtho
+B5tmIk1D2i6woZqBwp9roILhqrFse1nO/LGNvjtbCQhkhDS+zUjln9jlEsNHzGZocBLQALHn8426
+4rao3d1G0929uxKgkrGkFEeC8S4U/hUK70HhQ+IIAptB5vWpidS7vlg9R+tDS1fbdkrAzN4her6t
+FdsoAP9DnQKFDzyIo5kMqPe9/1c5ZOCDVk8a2vbee0bTn+x0vbjPtr2nUSQKf2kCESKX38mzuSKx
+y0Ugme/k9eOb8Bt+WX4QUJuv0Q9y5eFcow+p3MOpbXdKrcGmH3/FSOWvxf8yQVN5VfzXxP+W+N8R
+/3viPxD/b8Unvp6lJy8B5dUFlJ/H4tLq4v2t7c08euPg2E/jmmSapDW+qpbCsS7GPPEZcs5KgLAy
+uBBjx2BjqZewkYNfra2tde3ssFGTRbtDxuwhLugCbDzVeWw0/v1zyDZ5aoeNdiOiVp6ZUUNc0AXY
+eLrz2Mg3RIfDRu3X6mzITKQhLugCbMx1AxuN0OGw0VlE1O5m5s8QF3QcYll87sVid6zs+F2TVvRK
+7q/0Wq8q/ixHi8Px2s8A4rgCZZHdYufSLAEziyoMitk0i8M9OyWg+f2ktB2m/ZCxjPnu2BbJ1oWz
+LWqtfWdDGsjFsLHQLWzUPzt7sQ4b9VLpVFzLez3qDLqgT/JMr2CDmsRho1NYqL+P5vC4WKDf4XBo
+5U3xyfk0PSjMcJEjvqXxPayuLHf3LXby3blXQzRF18WjqS7WigQICkvy8aIC59W+yG4MFQyddG6X
+Vi/0SkfMa9CVNfVzHVojh8PwqU/ChonPF3DLh3Gng5TpcHEQqbXjGvOCReG7BiAhIk++FQoRefUZ
+h/Bd7eGrnodveB7e9Dx83/PwA8/DW56Hf/M8fOB5+NDz8L+eh//zPFyRyXBhUvhefbGi3x5wOmaF
+01yGJM76MKPOKMywL+ZcmiVAKLAZtLCgbVQozLbSqppr45aM4Tv/aEggCoosPnthYenCahbdPzyp
+A6eDsC4Z8RlyrhMSMAqD6HjACXANWpb5NOtU9+yUgAEKQ+xOccaah0Kh2Lr2cy1L6zLsZgnUHTVz
+I4uFxeLM7NnD110cSVFNdSTbEk5iPPy6uDvEJWAUBtHxQGtUwD4LqaZCXJ9FhJF23wKF7coD7aGC
+Ux59B5jUQyNZAgQGWxwo/E3CgMzfJfC+n8D7QQLv7xN4/xDj8c7/SNjK+whuxUnOCUAc+9zP/Mrt
+brbJtXuzlazFdg85a2x3Ge01B0FBnIrzsyguvPT42kvxlrIVjk68mpZPs1L3YhWFbSvTR29Wokvb
+uwSoOYiNH5lAiBR+FFM2Cm/F8nl4Syv8WCu8Hcuv8WMN/CR2jcZPtMI7Cfnf1sA7sfwK/xzjsdbv
+kiuKryg1J7q5og27hFm8cLU4O31u77I4SE6DTL4hOcjV8Wv4YWic6zitSIBIIZ1kyB/AC6UbO+Wt
+q+Wtl7cHcJSOfvgN6pvHAPpCaSoIe3LWGEBOQkfpaTtTV2oQksK/MOiUSUt/tYf/aqdMelCZ/JS9
+63xu47jivz0cQLCATXKJrbEgWo5cZFHFjkeKrAiiyFgTtViMJvZoJgOTlMiJRDIi3TL+YH9J+wvy
+KX9EvseOS8qHxOlV6dVx7NhxbEuuyPze4nAHYAGCAsjD4fZhdu/h7d7e7sMPb/ve+piKlZ9CC0JS
++BbZesbkrh071/bP4rVM+BRzy5whjTvbMmlcV43GtMbEGpOaZoUWhAB5WjMBUni6quuk8Iwh3jNV
+8ZjitxlTjNMGSVV3m+grdxg9Uwvnz89MLc8tzH8u/9DCw8v7hmH6DBmlpphhy1Lywl+2nYfRV8xN
+t1wzGEYXEsIzlwqOXMlb52uAgCEpPEtWsPNpCuQd3d5YYwYjPUdzpyazY0fHc8ezGZg+vUapKWYG
+KWTQVyPMfEel1EEGSWTkxXV+mIsMVOk7X4zhh1mudRogPEjatCiMmAfRrTQ+GiAk/NmJQc9inDg5
+eeTYkQfHs4MwffqNUlNMvgFnEAM1wsx3VEr7ihJVvCbkmoGLQTEWjN8rr10kR+eIT866ZjVAhJCs
+0YiRWahfVrY5CItnq5qzCs/pwAAl8Jyj8IKj8F1D/Oer4is8b4j3giHed4wyAjVIfG7wuyaF71Eq
+TacnRKRk+xi3kHGaduvWra2b8GxdSo1OMNp4rdAAQb4XEMftYVu3nm3JRhK9GcV8UokOWy/fbiNb
+vaZpN4iM72smQAo/qJIx5g8pFVMzKbEVjgHieAztkwcV8OTBpADCO5I0jGsylKf6JQ0eyepL48MR
+JYTLi5oJkMKLhnrtR4Z4P66SMcWfUCoA7JZUdR0pu1754nMX900eOwoXs8sXzjO+Rzza50D9xoAN
+7XwNED0khZ9ykyE284sAi0QYOYRRCqceODU5fgwpLD2+tDxzwYNSKYYRaKXQLhw+MTb5wMlxdGF6
+YWr58cUZLwWSk3Swf4uD5j8cV2w+FZtCWBrQpPAzD5gB6sLPKdX7lQoCroJDiBUS4stbAgpJ4Xnb
+tWILHdwFiGPjHykpmt+EtA14Xxdx4YgivXpNVhW7Lg6dOHSiHRrtbp1me3vkb/WN6ujcQevQryCO
+7aMY1P+d38JproT6YIggKFRzCdq7o68BfeR2EBRO9AtlS9CcBvRZ20FQJJpL0N4dfQ3oQ7aDoHCj
+XyhbguY0oI9TDoIi2VyC9u7oa0Afp8wuxy/YPZUBjHs4igElgxccxJABDL7NrnyDpx3CiMvAhVdO
+IoSk8EuytcGSrhjtYgIWLtRCfBwRQlL4FdnaYOk2gMW+qjc+QGFJiRCSwq/J1gZLjxEsFi5UYlwc
+EUJS+A3Z2mDprQEWC5e4QIUb9zUp/LY+WPpqgsXCJS5wIUJICpfI1rYsmTpgsXCJB1yIEJLC78jW
+Bkt/XbBYuMQBLkQISeH3ZGuDZWAFsFi4dD5ciBCSgz+QLbzB9SIByFSsLdKLz+z4SucDo3YJiRO7
+tig6a37WK6d6IQnfb/VHQqTwPzElX5LFil8W/yvif1X8r4l/OQmgcEV8mqEr9ODKXEBpPkCv1LS1
+Ue2/ZOeHEFHW6KzXXzk6z6HRSeJ1QJxd0tgB88TNl0GvXguCwi5pbIFao52GXr0WBIVd0hjxn7T5
+/OvVa0FQ2CWNLVBrtNPQq9eCoLBLGiP+kzaff716jd0QOpVWbFNs4to1j4qyzZQxDh1lMlxW3LVM
+4q7ltEJO4cG0Ql7hiwztAcQpleDXMSgch8JR/aJ5pkUn6+MYbofgOr/PW6+EGgP00RqQpQlNB38i
+ygoflAZk9ALNilFgTnXrBZoWhvV+pE4OI06K8ONV28M+LdFUlIk93ASIW8n2JZkAbd9h2j45a0Th
+ODv5TLMLf+ZjC2/KyOJb4l8W/4r474jfXQKvNroJGUksjSa6Mp6ofxoLX62HuPlEkR1NjM4o33rl
+FEjKFASnIexYYgtazdFPQ9c9BAQdQWHHEjvgZ22uDHqAmYCgIyjsWGKTKo3+/XqAmYCgIyjsWGIH
+/KzNlUEPMBMQdASFHUtsUqXRv18PMLNLK24t+s4Txf4z+84p9p1T+At7PYV3pY/8nvjvi/+B+B+K
+z5h9zFTlkA8P+NRDPuwrKvHJWRcfDRA/tte8Xn3R6DyHvWZXQRxruA6w0NGvY8Itge41B0Fhe80h
+/yThP1/3moOgsL3mNvhZws2D7jUHQWF7zSH/JOE/X/eag6CwveY2+FnCzYPuNbN1+Vf2RKSvqpcn
+VJwftTEww7tS78z2ZFfSUDTDiRCSwt/I1gbLYANgsSCJJggazTURQlL4O9naYBlqACx2n1qjao9m
+PCKEpPAPsrXBMtwQWCxcogmDxnJNhJAU/km2Nlg2NAgWC5fGFB/FWEQISeElsgIWWZYrr8zgtmjI
+m/wKhULBvvsuOuPja5FTHsRBUvgXsVJGGbzsAP92gFcc4FUH+I8DvOYAbzrAWw7wtgNcdoArDvCO
+A1ziZvwySuBlR+FVR+ESu+EVxFAHBbwq/iWX/LZiHJk9RC8AR29lGIHCNvRj/+ji9Nn83PS+xfzF
+5QN6IkmTk1TYHW7n0j49fA3Qznkk2HFw7wi9bVA4UBaa7EUATL0I45Nq6VMTkpqSgyRZGld8cnRd
+6EU3etEj3yixLqiBchtIe7InfDzbHISrgTKLwbrI2hPvP2PtiacJ81XPX3tEe7IrXCzbp4evAWtP
+bPukhr1YSa6XPnBV5SuOwmtV78PWIexGvSb+qjtTfgOIxsp2ptrAXISbB2usrLFa0SyZY/i2hER7
+YjtTIf+bw3++tSfWntSwFyvJvc6UwuvlpkVI4b+UymyH7FQpjm1TyBeXH//M6RNrMYLeujQTZWk5
+MlvTutRtStQA0UBy8QbZwhnOWBTeFv/rpQM/rmcUiU9fz5ZNfPLwMabQHo7zdjw+pz1yE59cEDQa
+E4QM7cr4uXNb2qn85Vbk6nJGdF3dnXG9S9dNxAQdcXH8yMREe2lD/6pO6TdqBVLaq4Ttlxs9YENM
+0Cl3I46d3rtn7kJ+EZBqhtPc2zfKb7NxFZ/0KuL66Q4G+KEA78eo5jZUyYarJLwrIz457QaK1365
+9omvQ6xfrQG9fUXhzaohPYW3DLK3DbLLBtkVg+wdg+xdXbGVkcJ7lEqL+ikJIViBg9IiymH/5MX8
+/NJnx2bz8+dmso8tze1bmpqduZA/ujCVX55bmL93ZHZ5eXHf6Oijjz66Y/lifn7psalZxt2xcPHc
+joc/P5pDs589yGFzjVQSuN4YcgNyOIhsIGxvgNc5urV41d9y+ESR21e8evIcepFDBjmkkYOLPtyM
+HBRyuB09SOFG5JDEgCzLy+Ea9Afu9NOIL0d8saP2vmYCpPCBQfZhlYx3FygVmJqWueVOH8m2Q8VQ
+Wd3q6tiUM7+KNoVa2dVogBAhKRQchUIRKUQPK2Y6J+ni6JFTk1eT+lrfY8IKj9FY6+fGM30PK0RH
+wXFQ27ZcWHhkrpN1RJtVbo1MSOxkDTRSNg8vSi9GlZqon8aGEkERh5AUzoQ//G5zEK4GPEiQlHI5
+lJSfX5pDAhfyU3CwOIUEFqfyZfHcNM4w1tTiuTSi+ukq5TyFNJzStzQSJT6JNJR8c8WPalnXMt/a
+2ChwIWI5KTg8fKuMFBIGmWuQJQ2ylEHWZZClDbJug6ynSkac91IqRvMJybvCxwBxPOYbDnKn4OAL
+EvcQIE651AP/y479xFoDmhT6Sih6WERKxin0WEUaT6EH29V2lZZz2yv/n7Q7lbLVftd2a7V3hRHf
+t8RhPD3sZ9Li3AGIoxW5qcl/D3dQxfsf2Aml16SQKVmRIREpDADiiJX8y51QVovY5n5FTQr9Jazc
+JiKFDCBOuZswOjq9MH0++8jMxaW5hflsfn46e3bu/Ex2bjrTswmban5UzZDgXW7Zt2AI+RvEJ7d2
+rk/SdqTu5FMS4qewCb0YEH4YQ3LdKD7jWOdpgK1XksKARZFFUQkXq+N8FA1aFFkUrRI9XnwfRUMW
+RRZFJVysjvNRNGxRZFG0SvR48X0UbbAosigq4WJ1nI+ijRZFFkWrRI8XX6NI4RpiqIwUrjXIrquS
+cazxuoQqLiC4VdLgnAWn/Tgl3IMzS8tLs2fPL0zN9iCqn+6ynCv55qIHSeF6kJCrIz4XxXUVuaiW
+d63yTbRwhSUXI6kSNmbnOhEb3LtSrceUxYZBK5SVYyNdtBsPzYU9m3L1zw/OQnlzZ1wV4K8GCKbt
+yjhjUGJ5rQFdSxEf17P+kXn07cWahsthWetwP8rYxERbLIPlgiouK3PtUjLRxfr6Plo+UkLLN4to
++QYgjrtUCsVZdGAIjwB4KfFSYiNc2bngiF+9h4GSbvHJRdsNoSviJWhE/6qhWF5KPFuYpHADsVNB
+CjdSKvbnWgnjUlGvpTuMW7acPHVnbnrhoZmJhfnlO3ft2DmM5j+DxTSGilcvRZ5vTN4Rn5zZ9ZXk
+XSXOjzkgfL/4vrSc68YwepGukA4jiVRJ5pa48nvj/k1bI4VNRE4ZKdxklLF9HCSFzYZ4m6visX7M
+Mqbg80ZJQqfF+X27ElO3Jfy2F1dE6VaZXYmpdVPta/RqZNk+Pnspto9PLdRzRAvtju3j19NSPMPK
+sWH7+NUWN64SXdMoZBOa80lhC1s1FaQwkgAKBQZxTZoSm8N7eQrbTn61Ls4aIBQ8c0NeuQlks7ub
+f9uJfcVZ8zoMM4VyUKRw33ju8Pj9KYT/SUgeeCoa88LeNa/WrY8GaCRIrFlUzZplb5xtqi07NaCB
+Qo479zjJzZplV/M2zdYszeswzBQqa5bc2K6du+9Ze+vFYzJUXSvJusStG2PtcxnfJ2iDQXSMJHhw
+PfssJN+E2D6LKCPuvg+Umz2MlEhhq0F2i0H2UYNsm0F2q0F2m0F2u1HGIb5yUriDMWX6gdPyBDwH
+AemK0w8n75+4Gy4On/gUL2O5SV4mxk7frUuusIMplJHCqEG20yDbZZDtNsj2GGR3Vcl40Mk9lBY+
+Lv694jNrLA9DmWc6bpvU78auv+nObkqsr5/oh5KICDqiItuCMlnURB0XHJkkIuiIioNtUCKLqrBR
+JXBAAvulXjkg/iHxaUVMdYy1JmH/Zu3wfBItCZ21Ju3wi7RDHirrmHVoj/6fvSsNjuO4zl/Pzi4u
+EqYgUmZoKtnIimRLIQ1SRyQLIEiRIMGYkGTxkuVYEgiAJCRg4WBJirIZiZJlKRVadpykUpXLyn04
+TiqHlTixk0qUxNZ9RUduO4ddrsr9x/kXuL7XMzs7O2+BxR7gEtNva97MfNPdM/P2m9c93T3dbXHf
+7WD79r0GOgmD7cxVKoQox4jttRkNDM4yhA942d6yjoybrtnc34vGf6ZKGhxPuher5WiPaP1c2Sq4
+De3JOiPaIkndiV7k0KUc6VCwZPw0I8xrKAY7VC7tcFwK+OG4FFqi2jri0k6VSzsdlwLbOS6Flqi2
+jri0S+XSLselwHaOS6Elqq0jLg2rXBp2XAps57gUWqLaOuLSbpVLux2XAts5LoWWqLaOuLRH5dIe
+x6XAdo5LoSWqrSMujahcGnFcCmznuBRaoto65BLrjvaxKz4uZxWB7FPy7B2X9bFh+Ku/tLwfgC98
+Nn6yu3CI9j1qBxVY/uuLz49Tz/kjtngYpe+Zz5emIrZVkKxFlQpLadVw9de0R9oXCpnDxfieawFN
+PSNoAbZ1GdxCL1IhBrcSlRb0L8kx20uNeZFMtGJwGQyulCQCMb7BtrR3PHT3H1JCqOJfhIHC7InJ
+qcLR2bxsTAxehEZ/HXWmsKbOeNWul58ZdMlRTzTD5ZCVNbd90dxyS7kFQopQNG8i1DEyTXrMywju
+Z3GyMDUxmMVK+ZnSnXCGBHtXmWBt9ziimd1yOrLAQjQiVbiU04iFH4rB+0uZ270ExGuHc1WvwcDE
+7PjJmcnCifzpmelCcXANGv3l6kzhbXXG0693FXrRFTvG1yAPqwPMoAM9sp0VraeSRpQMCaWcUTHc
+X4/j0Rzm07MnThY3j8/OvGfi9PR6tPLXIdOXJ89wsYIlQy0FycELwr89WK+Cj/XIYq3sd6IbXViP
+XhjZX0raKztsjCkmytoin3R7ySeFMwRRxIf5Xbj5kUfvnzwyc/PjXWiHn2nwKjIS3xcd3Y9Xth2i
+2dJWiHDdgS7pg8TttC2WMgb7SZiYGBxQsIMKdkjBDivYHQr2AQW7U8E+qGA/oGAfSmB8WO4iKu+f
+e+QeTUnzPXPQvWel3QIkCYU8ISWuSbtB3P2HlLATubraKEeJILMkMe6mo5AM5XI2mMDgi4As9B57
+nLHSbgFHidRToNICjhIJk6QdcZRIPQUqLeAokTBJ2hFHidRToNICjhIJk6QdCWty7+GraUwMxhIY
+CXSEqLzE2r5+9gWX6bBX1+1b2Kun/Rdedftf5YV6hRSDcTIlJgYTCjapYEcV7JiCHU9g5Ohxjmgm
+HN0n57c19yM85vsYmx4br6ffa7vF0Xs/N96/t93uszXXQ49FrtyrMMjDfUTnZ0STQuEUUZQ1jOfn
+sH/H/hs3b2ntUJj6UKBexQCctQwHagekbu3VpjN1ksSwu/t9GYNpup4K8TAtH1UURK8LjlqnRDJ5
+2Sz2nyzcur/dO1mFXcLqu86oaxk7UEVdzrjnFmuBgEtk00zGoJBgk0GRYWJicELBTirYKQW7X8FO
+K9gDCvaRBEaX+lHhuS0ecp+uVpash67OZKHGFcaSNlnZiBWDMyp/HiQq2e4qCciZ3SDD0MrQs3Sc
+9r2N0ytkyLBQjMlyl3hf8IHA5TD4SYKh8DXFMs7qlW1pd3fVLUAxeIhsqxCDs0SFg+HwxxQ223Ly
+ma2ruxcanJ698hc6XvsxDgdfe2gXcjktwCyNjuWskkmfzdij5WLwcIlUNnOkJ2NWb7+T3Lq6OlWb
+ecS5vWZas9lpUcgUy41IiFVyyuARMiomBh9TsEcV7OMK9piCPa5iyet7PHF95PYPM/b8j4rmhXbK
+TXDqfNKezOezYJ7yJdduzbu+S/VCsAB9aS7Oii84VlwI/1wrr1HchRTCWBBj/S3geNFKi18YacsH
+LDD4EeYsMTE4l8AY8hNEJQ8KXyvJLS7G78bw6amjwOgoruqGaZspfbsqriScsrr2KYdzErZDdLVY
+/Giq2rE04uSEZUxlKYfoEzXwaO/eq9ANvwXWq4+bIY+Wzp/w/3c8Ci1R+zrk0RMJHhl8kiyqEKLh
+1PzvlWO26ut68s730b/1uqnWe2f7SS7Po7cw8ohbzp8FQlZ9SmXQp0oMuqKMQZeFDLqmfxkYZHnj
+WqLPH0cWPnPIoE8nGGTwafInJsRsjHLx8GOMPf8TonlkuwRi3bu8+MP4Q7htbvbeyfET+eNjxfyR
+yclCvjh2anIif+SB/Injk/mjs9PTs/dPFY7lT03OFadmC8X87NH8+0+Ozd13x21zk8XiEIYwhEZ0
+T4PxFzs3s/jyMNnYHo904krciHfDwyqsxzvRh5uQw6UYwhqslhAZTgiILlyCd2AIVwjGmG4ZKlE1
+pNR27AqHxqiTU9vR6K+n4RTsFQwFa7sXaXIq2tuObGyPR8ipwRintgunbhJOMUQ5p7bjCsGIu4VT
+ttmad4Mfl5bqS1gDyQzSwjJxSgbYJnW7y9miED9X81qR4um6vdotEDBFxLODZvTDYEsMz/ZhAKew
+BZtxPfqxDQOYlPbnvpb9cuiT98vwDJ2lrT70og+ryvbDMPWv1ywa11s0RP1nv1BjSnFIxOCnxNG8
+K6hOiFbsEjO0rdltSEtLz7UHLs1erQgd+hNyJvAzsXHnKOwmePDA7k032K5irdFhF7946gt1+It3
+64vHc5384vZo1l5IF0p1unQjP1kYn52YKhwbrL3Sqvkhw8rHZqRMfrIKlFXWtkqSqbKqgWu3VLdA
+bZzJYMvm/trLR/WEZLnW9Wuqx3LLF6c2svRiYOj0zHQ+qL0ZrDa/QO34wnNDLpQO552sNjPlQvGq
+HQtnjeiWED4yWBXMatkZzDyZQ28wW2W1NNKHs/xixeCnpcz7vgAKyrweZyX1gSFsY9+OhespW3mU
+FSStTN+lvbgFavMyPcBBHMBubMIN6IaHnhb9OPZsMu0uBQtDZUtbIRKtSbBoz201ywK1kWYdkMck
+ChjHLCYwhQKOYRBr4WFdQz9Td+yL646ZvGJSNYN1uAjrsLp0nCOVJsM6pNwCtdGnA1Kd1y/jDXe0
+7JdBB7Iy6nXrzuFSbswCtRFmIwYwhNOYwTTyOIVJzKGIKcyigEFshMHGhn7ddcfegI3w6o69MfHr
+DZC1su5EDutxiWyzmpjhV2EjfNninltogaAULGz6mVKT+Sel5Slqd+LUEqO37hre16yKosbTYXt+
+PBVWBrIaJo66veZagG6H70x2JFkPOw60ot55qWm6toKlWqyZ4eOc6MCh4dv37731lsbyt+bGzgbp
+ecGaqXNWjw6pQuFeTjS33NIsC9jcxeBnmbPExODnEhhp9Bmi0m2rh0NQiKsBOByF8Xuwe+++4bv3
+7xwZHt3RrNe2RtPx0SO9l3vAmRuYWieMrLndU/VVPBcct6GcXroFLLlIkc/InMLRPoV5lJc1bNtm
+OcYt6bVARIwn6V1iYvCk0rH0yUwYJxKDn5eK488HkMHvALJ4WR/7Du++ZfGKxeUMwbbH8vOx+3z5
+vh90h45jbq8RC4SsMfgFhWm/qGC/rGC/omC/qmC/pmC/rmC/oWCfVTE6zXIx+Kz6JPwmY0suzcHr
+6IIp9pWgE1c9NvLY/602HZ2SFVInF35onURrQTpqCqWnlK2C66Gbi/pNTq+5V9eM1DKJVOzzYPA5
+8iUmxJJs+5zKtt8SvxsmwVc9fsDPBjuDO02KMzZ377SAJRnF4LfJEnFMWwhIKRBCHY6MeOjw1v7N
+/a0dDZAjI3IcxGaNa5itaRTF1t7TSks95MWl3PJ7cGhy/MTs3OHZufuKS38NOZ8x+Cmvfv5O9MRa
+iMNX0oyEZ1s1m+r0uOlGQ3di8LuLOJMt1y2HM8mVXAndSmOPonMmjdlPi+2cCXqcM6niNK0zMfg9
+upKYGPy+gn1ewZ5SsWTh+amMPVskBn+gxP1DBfuCgv2Rgv2xgn0xgfGR+BJRKYlJW6L0rg1LYhxe
+S4puTqfXAiFZDf7EUSW9NKjlzi1VDP6URImJwZ8lMPqaPycq3sc1I1XJmhxuLWDJZUljMF+2T2E2
+65qRanpKV3qoiChP07vExOBppUT2dCaME4nBXzC280326XN6IQuE9CFpnG9a8R6m/juMiPKX9C4V
+0oW/Ijr/FdHPin5e9IuiXxb9mug3RL8lmslwdFNmjnyL48Lx3g7vODTcSDNps+JWNnFXT5ffdlY/
+6o7UY4Fu8sLL+Nlch5CELOMogV8R/azo50W/KPpl0a+JfkP0W6LJsntYzkIGdxnIwskwyDo29jWz
+p6JL68KyAD1OJmc5QW6QF5YTVl9Yd+OutlkW8DmMX0ecF2HajhmhJdK3ljHSSrmRB5Z5qJ8V/bzo
+F0W/LPo10W+Ifks0cyMZ7V86zIEpOq+TQiZp98zciIOtcc2FudFtakgt9vnDnEdsre19IONnra8I
+efH/8609p0u9vS3AZ84HSu9GX5Y36mdEPyf6BdEviX5F9KuiXxf9pmjmRpKdSWdtyzD3Bl7P2+pK
+jJN8A/+yvFE/I/o50S+Ifkn0K6JfFf266DdFk2WuzNPe/uR8XZ3kZ571PLLte67Mc97+jfY5syvz
+tM9/0S5Xkizz8N37GdHPiX5B9EuiXxH9qujXRb8pmrmRqw9ul/+0va6DOZCrD26v/6QdrsbVB7fD
+v9B+1xDWBxv8Nd+nY0JMmh3LhFjYlh6Jwd8wtuRM4RyN9ERcjN8p7eETxeuvbcZHlLWnwTnYkqFz
+Abb456YcEiUZ3yKZYG33KnX9H+9WprTS9kPuGPytY0zZv+sYU26N8m3LGPIl6Yv+jhyKicHfK9g/
+KNg/JjDWIf4TUfFjMroOjPgz+jTWKu7feftou9STLdy3x/Xnad3/FDLyq+RKTAy+pmJJ5n4tkYsa
+/LMS918U7F8V7N8U7OsJzMPXMwbfUK7nG4mwBt9UseS9fFO5l39Xwv2Hgv2nco7/UrD/VrD/UbD/
+VbBvqVjyPr6l3IdhESYmBobfZ8WEmGVFJMQqT2yQU9LLKel1KOE6FaxLwboVrEfBVinYagXrVbGk
+/XoTNsig189gk5/BHsUWb1PSXaNgFylYn4JdrGBrFWydgl2iYG9XsPUK9h0KtkHB3qFgGxXsUgX7
+TgX7LgXLK9h3K9hlCvZOBbtcwb5Hwa5QsCsV7F0K9m4Fu0rBrlaw71WwTSqW5OumBF8NNitx36Ng
+/Qq2RcG2Ktg1Cnatgl2nYNcr2Pcp2A0KdqOCvVfBblKwAQUbVLBtCjakYNsVbIeC3ZzAWCbbSVRK
+adbrGvkMif8wfbPhrlvSbAFLC1Jl14JU8dJsJHfvtIClisEwiRITg90KtkfF6HvKxWCPkruMKHFH
+lLgjibgeRvwMDiZKURzoay9Tnf9+0bwG2x+G74e2VZpvrhuuHqjzzTU5LGLlmxXPVInF9+288nHM
+7S2/BSzXSRQu5MXowNUbWnUdi//rizNncfa16urTlK7HnBIG7yMtYmKwT8EOqFjSBx5I+DGDg0rc
+g0rcg0rcO5S4H1CwOxXsgwmMd/whouI1tfq+qfEPH10pPCh/1uJP1eLP6UqxQX33Yb2mwV3kSkwM
+7lawexRsTMWST8yYwvqxRK6fwZjvY8r3Me0nEobBEYLC6nVyvQZrAVn4fjTPYo9b0myBkNLjJEpM
+DCYUbFLBjiYwUu8YUaHeKUnXYA6QxQS9JM3eURlbe3RveBXHGScmBlMqlnxgppQHZirxwBjcq6Q3
+rWLJc0wr55hWzjGjpFdIYLTSLFGx0rVy3wb9gCwslO0c2Xtbfa6q+bGirIITCjU/fZdiNQvYp4Ns
++XCJLRcFbGFhhQvdeaodWZqdeHTvlioGP0iiVIjBHFFxNtVcsicu2StzyUXGiYlBUXGNRcU1FhOu
+MYOi7+OM7+NcorxicEI518kERp6fIip3Yus4aAHi/ALVIM9dt6TZApYMVqS8kcVYcXxqCjkcmSqM
+zT1gnxQGu99xKc1MWfzeSZJQFuCSwWkyKSYGDyjYRxTsowp2RsWSxdIziu89k/C9QBY/xBTnHxT9
+kOizoh8WzUvvo0JWChW2YOEhU+qvyu8W2q/vqrui5bAAhYzgYnzWIkVndbyIbJGuLTa2kxFcyIps
+7P4dL9LFhvBu+SJCRnAhK/zO8AgrD6Jtt5UmC3B6ITKCi7CiK7p75ykiW6Rri03QLJkaPOgbPOQb
+nPUNHlbKuY+wlBoTg48p2KMK9nEFO6diyfL1OaV8fS5Rvjb4RCI9Zo9P+MB8qb9vkLp8bpVDf35k
+eMcubbKUpWHfZu/Kg+S4zvrvm+k5dmdXu3YU37FHVqAI8kq7kiNZRk6sIMsW8dourWyXLRwz2h1J
+g+WZZXZ02A43+QequCmOcBWEcBmKEK5AuMGE4iYUf5njHxyoYMAVB6hUkaV+3+s3Mz39RsrOsTs7
+/b6ur7vn169f9/vm1987+vV7nEtrY2d0hg4UyYRT6KT0V2cY/3tYFmi1Rnx7jEOC73Bg3+nAviuG
+MSP+bqLr36NrPjwnuAqvaDzxYex+ePnxpUa9XHp+7omFvQtF00ZSXKldPHOhXKyXL5RLa+XixcbZ
+e4rV0qXK2mXOAHbvwsLdh9HvskNjuEfX0bj2Yo8iomt7bAaHkW7+smh8eyiC3Y3DeCfmIpg9Zwq3
+4yB2YSdmFZvEYWRwE/ZhGjlFsghwHa5HAXkcxm7F7NlJ31rq6tTjkN7JtH++f1Nem0wsf7au48nU
+ssUo7Jn+R8w1v7fpr96v/kpwBFD1r7q+gDbL8Q9DkvCzKqq2ypokWwapeKqEVkn2llShO6FKcFCp
+sXGhDQ/6JcEWMEUdwfeRSBEhFtbs2kTw/QyppW72uyMP+QU5VV1WgMXSCyVujj51lEetsPPP8UdP
+3tOtS8jW4K0uQPb6fAsQ+H6jTXsMfs9y7gfIpIgIftCBrXOAH/wQjyjv0hoB2weANzmdZDCDJ8tn
+FkuV6snyWu1ifbk8g9FdCs17yzX3djT3Wvc9iSkIZpANsYlwO4M8At1PIYMZpHW/dZ7fowWUIxB8
+kLTpEMEPE1UyzekxDimhbbm+fCV+0RZN0f7l7GPOIneKoNckW4DeZBpQlcAMmUyMGdGwGlF9vKNv
+AZvT/AjzlA4R/ChRzWl8o0+i3Ue31NOFsMGHqjUoE9A3+hg7+HXLAqQK3QnVN/okudmmv7SbHIts
++jFSSXOn1wrMugQZAPfzWLALV56/UF27787zjcbqvfv2Vdf2llZqZ8p7l2vP7yutlFcbd+7CrgEs
+t3SJYxJZ55G3Knqrrnf1vWQwhQkESGtMN+t6F25HDtcjj5T+vg6i210ohtv+rzseMZBEzLkMZaZw
+ZKWy1qhXzlxs1OpT2KqlMMArB5jSphiTFrLF7LFxhnscc5f9ybnvNWoBy45v4B7ZUVst10uNWv3x
+kw9HQ27mr42wQ/TOUrqO32M2xPLhtj1EgAlMIY2pCGfaQyR937Kj6Tsa9VJ1rbTcqNSqW2ebjbDj
+6ndJX0HvYUO1WJQLeTWBrDLEhvDblgWi7CjgSPnKaqVeIjmuNmv+cI9NDiz+IIxpItymwi3vX5BD
+ARkUkNbf7KNF3Ku1gCUHi7ISTODI8+VGaaXUKE1gq5b8wK6c0pjSyOq2lZ5c2/4EJPKrFSrpe4Bo
+H1B+Ri3BNI5cqCyXq2vlU7XnytVpbNUytcErB1cNP4FpSBhiEtPqPdizMx1i2XC7Vakd3euauq/g
+x1nz7RDBTxDV+rBvrW1rePL71gL0LqzvUn1rbdMsfi9uAVKF7oTqW2v7a7FM8tk2x/pJEikigg85
+sJ9yYB92YD/twH7Gibm6Af4sQ/qcMv7Ye8RYgO6PuSTV55ShUfzWZQFShe6E6nPKJOd1/aXd5pQ/
+RyJFRPDzMYyse5kfMq9/G4CP6W8KX1akJM/uoEcXF5DGY4sHkMXSqUfnDjyIDA7M7V+w23PI4ODc
+Af3N7TkUULp4bm55eW71UuNFe0PMQBmt5AXz/PZTESPsXbjX9VR4LEkWiFCigyY38WgHRvHUSRRF
+uqXWU6erafyRq1vARR2bab0cq/cJfiGWiwp+0YH9UgxL4SMB8NEYzlc4H+GV1j+qa/q1p7lCSj8w
+40dmHFinIN2GkPHD7HSzzLjhZOt7AFXJk6cpzIalPvKEqlxJdUu550o3y4wb3pUrLItD8Mv0ROpt
+Ym96OJY/QwmKDMkGDKpImj+pdKraQZu9Jal6Tq9fYeMgij0JnwDezq8wLRER/KoD+zUH9usO7Dcc
+2Mcc2G86sN9yYB93YjRxuwg+HpgUtUTw245zfyeG0Qq/S1T/0ds0AsENgKr/Wmf0v6LZrDs0DBP8
+HtkSEcHvO7A/cGB/6MD+yIH9sQN7xYnFn4VXHM/CK44B2v7EEd8nHNifOrA/i2F8jv6cqD5HKb0t
+XfGA5q33Tm5NTuFz7q2xu/uqFMFfkCkREfylEws51BTBXznC/bUD+xsnFo/vbx3hPunA/s6BverE
+4td41fFMvup4Jl+NTQTBx+cfeBV9rjhEHRFO9ECVII/LpUvlz7z8yevy2P4Lu8QzFYGuuddNM8h1
+PdbtnF7xVM9nuq4oTtQV0o2lu+D85IQi+EfyJSKCf3Jg/+zA/sWBvebE4iz/lCPcpxzh/tUR7t8c
+2KdjGLn/70T1aXhM08iO9vbLo1kcr1RLF4rH6qWzjbuKJ6rLs+h3SXeJIeiCd7veBGYxo0dZ9+wW
+yoXnmmhW93bouoBZ7bDIM/KY1WkKuO81agFbbnudvImI4HUHO//DEe4/Hdh/ObA3HNhnHNibDuyz
+Dux/HNj/OrD/c6Tj8w5Mq5wREQiroRERpGIYW9XSRNf/Xtc84TZmQZFpFFNIN1vbfLnLXQJKAgph
+QwfCrtRZ1KrlRm15s2pqm3EddrKPXodDf1nEDxltLRHdWl+coQ+JiCDrwHIOLB/D6IMmiapX2qPx
+Gv4Jj+WFdJxiKCshdgcxtvlQ2RKXFxwVPJ0XlAQv8ugkoCp0k4Ivh+AY58DnHNAQPGJSxJCFLb+D
+qaHfQZYJ7bTBYtQO0yNxFztG4i5mtvwuBLO8h4gIrnNg1zuwtziwnU6MT1q7CN7qCHdDDCOhbuDZ
++uy+rlGYuD7BY8Ekahcb5XrxQq22Ool+l6DvGPq9A3t+trlHJK1r0XUGk1q3Je41agGyhWLe1+Vw
+qVxvlK8UcxifJdU1LQFySCOHrH4FPD4pHkRKTDYsuDHmYAQ3ObCbHdgtMYx0u5WoOieSjggb4agc
+l5bfUgY6ikN0tFPp+B096n+NlwUs+24jVzokwNuIrl/W9RVdv6BrBjzHFVI4CagKp5ckQGmC2nrM
+Em0S6o4+jd0swI7FnJKUyh6cSgnSwmtSLaB9SZQQlhRBUk3h020tkNIMhISg0lPk7CG/TaoFUsw9
+ILidZY+ICO6IYQx5J1EtpZghi1mm1QkKtTvHY6e6ZVOjg/tXEMP+L4wIdje5wpoR2UOAKqabAx+7
+tF8SbAGSwoqINseTFJyDLq2+hUOIckh0DmIluJE1dwhuhuAWCG7l+Xz1SfWkSjCR2tNuat6kxtvp
+bDS3+py2H7fnVpzqRcnm18m1AEliJeyRT2MY3wPR3kTsUcTysh7hUa9JtQA5YEVEJ5IiRHOYLAuC
+VYGqBPtt0A0Io9rvlwRboJV7fVEz9zJMYs1Fa/Hqjt5BqnhNsgXobtizhcoXT2V8vfDF07i8SIlP
+p3jtlLGId+1Q4xzCOBDBl9J9RESwx4HdFcPIqzmiWnT+MD9X1OeMazof4442JnxOk+zWfdrJnloa
+quxXp116SAuzMj99tYwWSboaMhgJq2Uc5D4Pwbpxbwyxr+miPj/BsFrFVxFW4DTMG2mocr4IvkZf
+D2VKZ1ngcOmtMeL9XnIsQK7wKSOPmKWlIevrbETi5Cvcek2eBQwp6GCo7GEzM8NK19VemFzr+NXO
+9ce2gwXoIj4tUOU3AvwYgJgV8gSTbP/ZDqnx9zgsC5AT/y1QJSf2DO1K3ucM6z8cdLzkhBX6jgKn
+4Nd8xogELMNij/YXzgxh4fvTYcTr4xy0BZQWLHew+16z/mLFV4y1uJ70tZIkFBdJJtCs4U4McA6p
+pM8CtR3Tz0KCEcE8cxltzD2ikI5Fx/HodLCY4gOLx0ksDhbS30Akgxt+Jdsx3Eum6wAn7gFRPNqL
+BcgC9tWj8oUShS53nF+X+LRdywIslFAE+5t+5FsJaM88aE9glk/4Tb3Sxa+TagFSguPLUyU4rSQZ
+ktDEp/2SYAuQbOz4S2WDy+feGHT13ce3HSzAkq69T3KC+RWVAyFaobfopUTkzxkXC5ARZMcBlmE6
+RHA3Ua0hsbsvwz0EqGo/zzyWzJja+7k7r8LdBw26gDweVHB+wV7nnYyxQwQHiep1cnqM3ZXs91Np
+vKu4u/+3btLHmzv/9rN/+/cbgyXQIVKlQwSHmuPi6EB77W3JZjSqeQgWtIbPoWM4rXMSwgSCSfp4
+r0m2gH107ok9OoLDMYwPxr1E1R9zUDci7EpEJaXWk2xMn3ZawFLqy0iUiAiOOLD7HNi7YhiJ9m6i
+Sr0bNV42vergmP5Lxn6z0HE5nzQhS6j+89ax+Vv7SwlJYXqqeU/RpynH53yTTZEa99NZaLbyJWG2
+8nZAlW903v3a2bPXau8f9vFePuvpfk+sQXc/Ov5HUj2kscWWo54tPdhv+57TO1sEx8iVDhE8QFT9
+TUGPcbQO+04wg9NrjReeGXRXqc2KL9jglfiBib033/HOWoJb43BIFpZc2kVwnASKiOChGMas7QRR
+pRpf+RDhp4lU9q28X3DcXIdHvqIZ9iWNW3AFUGVB+mvxNnaxG5/8f2Mp8c28tFeLLO9tkuVDyk9W
+/IHbSaQgh2cvV1Ya57f9gNIcItoMq8wBo7nHohO3nWqGm+ZsWp1Hkv2bfoX8MN2j8ti9Uj5bqZaL
+g3pFNrjuc73eUVY5wbNbM4oNdqYzxj1+aplhXEYez54vV86dbwyMGVtlsXZGsgsmSzjuefjYerlV
+dznK17XMuIvjjQU5rDVKjcryts9MmBFw7gGOy8d9qsk2uNfSbDOzaWF+jxYgMazocAgBls+X6pjG
+xepa5Vy1vFLU35rjGOErqSLLJl6TbAFTbhW811GZepgl2YgIHnaGM7G0i2CRZ2sFi5NfkXp+0vPo
+ZGX+V7bJv0fIlogIHnVgjzmwk06Mhet2ESw5wp1yYI/HMLL3CaLK51hnGZZv7xfOJ2WGVGPwJxm8
+QwRPZgTrYfcAhqLw4Ull0njg0SXWJEdZbXcwX+cf1r8UJ8WJYV2qn3jbCWBJ0U98/tyrWYAegiJ4
+KuZViHW6OpLoaYaMuCu2c1L56m7xVP25UX7xEH2t18uLBps6tkvZfb+NWqDFq9MZYF3boih83wDt
+uJYSfkGORyCoklVkGjXET0BQIW4lxJcgWHPgJyGoO/AHIChH8IzgiSSXy33aaYF2gpJ2URF8ZdPJ
+cSIaEoihVDuIy4DaE9PgnGJ2meGthPhDEJx34EchKDnwGKHDeGIPQIjHiB7ipyBomNQyVUxBVATP
+MAnq0Mcprc840/q+sUzr+2JpFXwVUxoRQSmGkXnLRPX/9y8hY6Wl9vJo/GhSEONASJYVT5buf7on
+C21jyJJCOQOcJV06JI0y/dX6WV2/qWsGybAOoR0lzMdm7AIxf3Avoxyutv9tvs45XFv3Ejv9jhX2
+oZkXHHZhhngqnPUiSGP+0CbTJ8n9dXr5azfjHBdVXJhWSFWKPIf02b9J9PFeZzOI0Ns1XFRxYWbu
+UmZgFWZ661+t68/qmqwyjftsf7KfUqewtGiHBBj1bfvwBaN+r9vr/iJcYiNCCjv4tmcmLEixVERl
+6+rS4o0dLW3RdjfXr81sp2xvTyXTXffjsX4tYDIqcoLKQWWOvmcUOO99xNb+CyQGvclzzTzHN+jE
+Mv32yl78aFIQU1USXCBVIkLMHG0JMVfLcZVna+HmgxpYm7jZzG2raYdIRyusuhWlA/NT6fB1RNKV
+NCGXqKTJfCdN8uKpk3SSuNPvqdPFMB6/lgVIHQ6oQ6WHiXkd1saY7+lI1ByoCl9wWHoyqjPePLvZ
+d2SEbdcy2a+gxggiIliNYUzF1xDVjNgO2GZum2MvZLFw9KkvXnpqsJ1CU85Ophkn2rpy0LbfQqN7
+/DTXIunmnkX8tncLWF7VyZaICOrOAt4lhlRecfZWMq3JK46jLJgiZiXE7iDGrtlU8jovOh1RXlAS
+vMijfOKo4Whw7DRxjH3A2bsbgkfsnb7I60dE8JIDe3/s7gVf5wj3jRle/Zt4RFNlxzTi17lUCXbi
+yUp1pXZ5rXisXrqsq0r13E70u+T6jsHcQTbcml/d1m9xHAkUm9U1z0vpmnuc7oXbDKZ1uxPpcEvU
+Ky1gGfnN5E5EBN8Sw8iyDxBVli1reOZG/nNd/aqIXxZ5NRYgVehU/ee6o/yB5Fbcm2WG/1x3K6w/
+ytc0mRH58QEWfDSTeUcskyng2TOVxtrpZ4r3FV8qoN9Feo4h1fOZBWRQQIACJjUOToXcSke+bd+i
+HD/C7m+37f+zd+4/klRVHD+3unq6d5yRJWrij+Nmf1BMyLKwRElIHIeJdsLukp0Jy0/qsDuwmJ2d
+zTqS5R9QFHwRjb+Y+Eg08YE8AoIuj91fdkHBGIUovlARsooKQmIiEJp8z+3bXY9bMzXTr3O7z53c
+mqpTt26dOufTp27dqrrVX3070Hym3TKZakGD+0jIeOiBudIHEsblToD/OBFb0CrhHOPSiNuv/sIq
+HQ8LgAGXcF1v6SD0XPG6zyKupJKhW3MybPY5SPm05V45wNMxyHjKgbbTdnypeP375MlnDdYvqWtD
+tAA44fCDubhK2z9EVK/yK2QYrK0ob3Y4vKJ6iuSVwjVFW6i8lxawzRhDn0cIySRDt0HKgWU/r8PI
+L8Sd4q2AdRDj+wEtdJQjt+R4EXEBcnx7EhlnvasWBL0jvf6DC9pg69c52AF3O9DKpIi+AGnzizzF
+yndy8YguiIgzItc1J1ZnLu3+N4CXZ7uvpbiG7iJnxDVrdCy2L5PBUAAOB8ae4g3KrlEwylpKZjn7
+PKehLyGKZJKhL0PKsWUPrzN0GRFnPDx1GrcVNI+zBWxcMfQVgJJKhu7wyL7qkX3NI/u6R/aNnAxt
+pm9Byoi+nfePR85bLzkYvIlFF5Ch7WTo3e5RClzqIbduANf5HmSijD0m1P1tDCDRxEUAv2XP9SNh
+IIexdvs4I985dgsKxvVsfZXIfl+uAChD3wGqqWToux7Z9zyy7+dkIPAHkDL8t3G9hnYTcTbxNvpw
+Y/GaxnUzM1d2/3V+04Mv/NdTdcSppWpraaL1v9L6373mWgMsAFjeQcTZmAk8rj/zAYro/ZjswuSS
+yymiS3dTRJdfhtJJkPbNXtfA5z1FgFTjA8JB2ezQ2ZYArAOXA8qW1Wm3FrAxD4D8sB17Yjw/wP1l
+hP5TMqaCM/DKzUcOY8zWldWbKaYTKyeW7MaGfoRNMwlSg/GacPbebIV3eiu8c+sV/jhXoaG7cjIc
+9F1b38nd3grv3nqF93grvGfrFd7rrfDerVYIe92HKvmUxU5unT5xM5C738mcimnjDvgQO5VV57IW
+sHECbNzXRu12BIYWLbdiXcw3je9XWspadVTLWVoM3Q9WUsnQTzyyBxCBUsnQA55yD3rKPegp91OP
+7Gce2SlPfac85R7ylHsoVw4v+z+Mks2zPD3HUxwWrm3xS8HvAxm/lIOz185L8H/5G6e4qSBB49HS
+gVp3zCv0MIhqnuXpOZ6CnQlMUn0oFSzimrdGhi7EgkvoAuQ1WKt5XC3AIYZjMKCKqNk8y9NzPEUw
+Qu50y0XVmK5uLCxK/GFBy6xeuPeZlelybyxgwxFG68AFI94Yi2nX7kt2QdKbPRTVgsGyyp+MimpR
+eb8swAGFYwvHF+Zi90C4iL1RoF/HqfVuzgL2fiWYQLZc7BoAFzgzaLzYnK8GWdqOgIYWSIXOVit0
+rsoPDqeSoUfaF0nocELrFXEG2cSTNH/yphuI9u6liyb5jetJEX/bMlpEmeWNtZzgMjWeFpWuF64p
+2mK05WACfDyCS6NMMvRoCY4ajYtokuI+2AmjAWze+o6jzfPj9qUcOUuU/+84etTL0ekSHGk8Km/t
+0S3pODqd4yii09WIzuQGzkL0OlOCL41To0tN+SNzfJ3J8QWOHivBkcap8tYe3ZKOo8e8HD1egiON
+R6NLR/kjcxw9nuPI0M89sl94ZE+AtlQy9ISn3JOeck/myiEO/hIl+UbJItdr6AARZ4MPf+OxKiRD
+v0LBVDL065wMVf4GUq7yOJfHbQSiw1gXT9GO6clr5+cW9x9YmJ6cIt/fNpZiNCTf2rzsbR5ZvtR6
+kgmaopqnRMwy2KFKU1TnpfXq0XWbtYD7UTwFZlLJ0FM5YA097Sn3tKfcbz3lfpeTgdZnIGVaXQcK
+ulmQ9W4g/3THfQpIXMKYbGwQ92wg1uqgu+OOCI4fSOB9Cs6KBEwy7hlI4ESCrEiMPQ6wgG3vAIxn
++BPzts2BZazhzN1etuxwP4Cgex+mBdJM4Cm29/IjJckBfbc+eG1nS//Qw531OifLAogRSIZ+jyuX
+TKrQHyBt/pGnf+IpirwLk9a2roVyJYKM5nG2AIIMEqBCC2XnOBtDjx0WcEgg8Wg+NZo7ctOhpRtX
+MffRxtzsR/anysRGsYHhxjs7JOzJJaLpyfUaT/pts/WsMzrrXHJXwNrkGPMwweGBqcAUH1vcsbM3
+uGtI6Y0dh1GLO3kgtdocBxv7rtp/cIFq7gsi7uoFycQ1mtm3uHPH9KQd9t8/xXCF/jVJKca4jqhG
+lZQ0WWLr88kPNMRd1LN1DUZ/S/v8vEuMxgf3DBoN59ykw0ff9NKP0D5Cb+jP6AfJJEPPQsq9I0d5
+Hc4g7jmFOi18+vpPLh9au0LylwuSutVSS8k1mK9SRHWq8DyWY55irpPRs9hZ0jlnAXSO4Az1bO45
+AyuNqMkfp0NC+w5S4pdC9zb2inhH3b224l5CxKs/kOnrP7BCv7PlBwkxp0LN1rh+4IRbwUxLlRZv
+Ob58xXDG1OxusNoinf3D19qhbZPb9HvE1eS+5M+nsajR3OqxteVja9LPtZvRz7WWOttgfCS31I+G
+uKs75P8ujhj6C1ouqWTorx7Z3zyy53IyAPd3Hhf05RpxbDJ0vkaco2qd5mYXG7MzMzPufLj1/9Uu
+trV7xdCUds5OOy0au2ynaOvYOZ320gKWQLQTicdVMXGdDsxfPT+7MN8DOrrX1NGQpiRJXU3Z6IGd
+/XU4Op7PRRhDz+fazoZe8JR7wVPuvKfceU+5f3jK/dMjezEnQwT8F6R8NbgTQyNzy4xX8Cv5h1aW
+jve7nTiI+tHyd1cByf3plUDSGkXzWSpuPHrLDUVlQ5V36ChmAhSFeny91ztLxZHlpcO938vga3Re
+thx0uBi8JiHuMUfFkeWlEI+jSGdLh2WjqIzKsxbIUbGydjJbJvzlTqxI82GZCf/4en0EWSqOrh4a
+mViR97mlI01Gry06CvVlqVhZOjkS1yBFnrdcZD2X5ydbYryWs1QcW1pZHgULFFGhoyaX8W6WiuOr
+n1ors13IZdwwthohirzoeuT+jb6tVDL0H4/spZwMXL0MKfeMYQYS3LHkHBu6GLe8NY+zBYAEUEMG
+Eu8bZ2PoscMCQAFY/NeGjEQy9IpH9qpH9r+cDDX+H1KORhO8E0NxRJzxNPGFpszzq3jSq0w5LROm
+BWwy9JqyEqgHB6c3kqHXQUoqGXrDc4/xDX47P5kMvenZ9k3Ptk1POb6NnkqG8DHDdMLb3600Nckz
+FXxsfxrx0CUMTbPd0ItxRM3XB2c+3ZNEC+Ci2SY00y8mom9WiYwCI9FZEnRSYES4IRwtFJiAnCVB
+VwVGhBvC0UKBCchZEnRVYES4IRwtFJiAnCVBVwVGhBvC0UKBCchZEnRNA9NOpXp9X5VwAKrD8CyQ
+hmfjXl8FZojOkrBvBUaEG8LRQoEJyFkSdFVgRLghHC0UmICcJUFXBUaEG8LRQoEJyFkSdFVgRLgh
+HC0UmICcJUHXNDDtVKrX9zUJB6A6DM8CaXg27vVVYIboLAn7VmBEuCEcLRSYgJwlQVcFRoQbwtFC
+gQnIWRJ0VWBEuCEcLRSYgJwlQVcFRoQbwtFCgQnIWRJ0TQPTTqV6fV+RcACqw/AskIZn415fBWaI
+zpKwbwVGhBvC0UKBCchZEnRVYES4IRwtFJiAnCVBVwVGhBvC0UKBCchZEnRVYES4IRwtFJiAnCVB
+1zQw7VSq1/c5CQegOgzPAml4Nu71VWCG6CwJ+1ZgRLghHC0UmICcJUFXBUaEG8LRQoEJyFkSdFVg
+RLghHC0UmICcJUFXBUaEG8LRQoEJyFkSdE0D0051Q+8x9NI0RR8zHzefMEvmenNHNW6vr0zV2r18
+kffrXVX93JsE/w5Hh853TCstZJKfe1NghuQWuftVYAQ7R6JuCoxIt8jVSgwwb7H39sGNZdl92MED
+yAbRZA97pre3p3e0+5ozvZzBLMGHb4BL9gyIDza6CRIDoEnO7MgtEHgksQ0CXAD9NbO1HqVS1m7i
+OLLiSlxJypVUHMWxXLIt/aEqx4pWqcT+Q5FWipyqzUdVrMSJXXI2UlyWVVG0Q9fvvPfuu+8BaLJ3
+OLK8S3b1wTm/e+6555533333vXfvfX96Q3TumRyB8wbjCMe5dFIERjeYRSL+KLb3/C7ppAD+qKWP
+bjDW3+lusc8/lfIj0mzsxuKu8fPdYp83mKEA/nAi5w3mh/TAflr1Om8wn1pofzgtnzeYH9ID+2nV
+67zBfGqh/eG0fLoGc/It9vmg94e0gbjrdboGY/2d7hb7fF/6oTD/KCByU3q+W+zzBvMj0UDctTxv
+MEMhOUeeFYHzBvPM8JynuiNw3mCGQnKOPCsC5w3mmeE5T3VHYFyDOfkW+3zQOxTMHwVkXIOx/k53
+i32+CfCPXGNx1/j5brHPG8xQAH8YEbl3cdfvvMEMheQcOW8w543guSJw3mCeK1zn2ucN5rwRPFcE
+TttgTr7FPh/0Plfg/3XVPm2DmSLlXc9XPO97ftzzsz6Mho8V8pCPKsVCAb+7+pOBlxTyechP12n5
+Rm4zW3u3nFdv10rravne6noxq84tLC4irdltDJ4e6SPTrHwHg8P2uHyj0qx8XN7Rw912q6GOLM+V
+ZuVjm640y093mkJEHvLQFL23U6sf6Teueyxogh4thj2KISo0RRuVSk7TQlrYb7Ja2EhEoIxELTKE
+RIeQ2BAStxAfKc1+35a8zb6w+CLdrT9t1nt1tbC6o662OvXeU4XIi2P2Ei2/9eSwrT7Se/1Wt7My
+Fw5pc25wfhQIzflRmvOWF5P0ZS7RkGfptVFlqXqn0W22Ovsrc/dqhYXUnFuLCz9Ri705UQu2TtaC
+LanE+VP5NUYLtqQSx2i5/BqjBVuSX0a85k8VryEt2JL8eoatk7VgS/LL8H6oRNTxZC3Ykkp8hq1h
+LS8pJQWdmU7LVKMKZWiDqlSgPFVIpSd0SG3qUJ9WaI4OaEADOqIlWqRFesz/QtSiDg1Ipx61qUV9
+ClGDDmiRirRBNbazTkWqUoRCFDm5mPmzKAZd6iy6mhkqbtTylfViVY2EIl82TiucxNXsRk0hQ8lP
+tUpmo1rIV6x0leS//+Z3f/InfvHK5e/88s/4aervh//8tS/MXkYJPpTwJanT5g5P6rS3o9nFxVwt
+p+5w9xoOaT6i4+NjL3koSMus/uSw3emvzB0MBkdLi4uPHz8OPY6Gur39xXA6nV58Ap05D12g5UFr
+0NZvoftbXjT4sd423N4amgr56MCD2h8o2FOELzue56uB2Qsr5KWWx+OllmL24UA6QDoy0gXSlZGv
+AfmajPSA9GSkD6RvIR56g5b7g/qgfr85qN9aPtDrTb13a7mnt/V6X78VDieXFy3BqOnJWVKOLHwk
+fVTJ58QVoEgjevmh3lLtD+qdZr3d7egrc0/1/txbtwL2RbySz+0UC8InHxW2q2a4cBxzuar6dSKy
+fPbRJe9LIt1Hx9vlrJV2kY4/XqYyVWiHhMEvUubhoFs9qDd1taJ3mnqv1dlXq+1WU58JbPdag4He
+UXefLinmhXeCaqvbZVEexNqOECcpV8lkN00HfOS+UKGfOavr3Gdoea20XtDrg4c9Pduu9/vrrf5A
+VGyaormSPqjvtdq6+roVA46fOUbwUAA6BSKaJdNltOyN1aCoUIBW725kqipOPNvG5dqr+5bkp9u5
+0pYW1pDOjcBDa1biS1TdyJTVUr3VUZv1QV2FN1biNFXpIfXoEen0VJR4zchS7nUber+vN+18E6TM
+KMc8+puh26uZUkTTwqXMu6mYhy5S/kK511UjIa1wwyqAK+u1JD+VSqVSpf4YnRYPovxULBaLtcd1
+0Wn5qFgM0gQeuvp+2hhmlkoUREsr361V0QYscxfpxvKt5XJu9dbyLVHiNN1YBrZULN6yUagyGjXA
+Yw95aJru91vtbru12+rsdS2rPvrW7VzBS8qlaQ81aZqKG7n8jqqFVU1bkqSwQ4o4pKhDijmkuENK
+OKSkQ0o5pLRD0sIOXxxSxCFFHVLMIcUdUsIhJR1SyiGlHZIWcfjikCIOKeqQYg4p7pASDinpkFIO
+Ke2QtKjDF4cUcUhRhxRzSHGHlHBISYeUckhph6TFHL44pIhDijqkmEOKO6SEQ0o6pJRDSjskLe7w
+xSFFHFLUIcUcUtwhJRxS0iGlHFLaIWkJhy8OKeKQog4p5pDiDinhkJIOKeWQ0g5JSzp8cUgRhxR1
+SDGHFHdICYeUdEgph5R2SFrK4YtDijikqEOKOaS4Q0o4pKRDSjmktEPS0g5fHFLEIUUdUswhxR1S
+wiElHVLKIaXT4mrPVxCf1Tn76U736Y0jfW/vNJeisHwpukkfzj3steeWVB6m9pcWF5vdRj+03+3u
+t/VQo3u42D3SO3ZJpfJ61byomtcqRiJ8mbWQzdU7Tp3iRm7HidSKTuQmlVqNXrff3Ruo2cXsm2+q
+R73ufq9+yNfZ3XpfxzUU13Iu5NqQeqlaUJMhW4NDNKEQrv0T9Dlavvm2mjk6arca9UGr21Gz3aa+
+qh+0Os0VdUTi4VGrrfc2j6DbX3llSCOn9xu9FievvDyUWuwc6L3WoD8iab3e2X9Y39dXFPJ85KGX
+yLCd7XYGvW5bxagv/0jvDLZbPf3h0cpVtm2l8nBqo36or3zWiduV+cxQQqHV1lc+PxJerfeNIZpZ
+Q1EO158DVeo29ZGpdnRedNjO6bsP91euuTA7WtcdKflOfbet1w70Qzz0cMbCSNtq6Y+rg/pAd1Ut
+/wQHszVwwSL0zkCIsDsdW2919HKvvn9Y76/MOhyr9horLzmRQa/VGJiH1IpUrd7b1wfVxoF+WHcl
+bdd7nVZnf11/pLdXjDPIh9HxzbfVbX23qvcetRq6cUjNoMiw+4hKaXKEJVhU0SjLQ5+n29tlNddt
+PDzUOwMV7UDdioY0Tb3uUbw+nBzmyfSVWqVY4jrpTwZqRd/Te3qnof+4ZclHpUzWfHKFQeI/tRMK
+29VJW8pKkp+Wq9lKcfVe1Uj3UuJUN15v3ZqxMt6rFVIb+e3T5gu48iVPW547o0LYghMPT+iaeLQb
+oFy+UNwo1oqbGx6aoNJmLr9udDAYiHdaEZoJXA9IyJsC4ah56HeNSOBuJtPLijG7j5IPqgMjDXfy
+H5Tf8fhAxR3RJH0/+cHOe2To4DnrjRuphdXW4IYY48/St8oba/CAiGaKt3PS3UNhu3rByurDQRLS
+DK083dX3Wx213eqgU0J/OUl+2hs8PTps7YVNrm9xB3qrYWAHeuuJxT0S2KMnRkFvk58Mw5pmcWHB
+RQQXFVxMcHHBJQSXFBweHBuWw2HLckRwUcHFBBcXXEJwScFpEctKWHARwUUFFxNcXHAJwSUFp0WF
+PcFFBBcVXExwccElBJcUnBYT9gQXEVxUcDHBxQWXEFxScFpc2BNcRHBRwcUEFxdcQnBJwWkJYU9w
+EcFFBRcTXFxwCcElBaclhT3BRQQXFVxMcHHBJQSXTBrt8BYpFNQUCoYVCkYUCkYVCsYUCsYVCiYU
+CiYVCqYUCqYVCmYUCq4qFMwqFMwpFMwrFCwoFFxTKHhboWBRoeAdhYJ3FQquKxQsKRTcUCi4qVCw
+rFDwHYWCFYWCVYWCNYWC9xQKbikU3FYouKNQ8F2Fgu8pFKwrFNxVKNhQKNhUKKgrFNxTKLivUPBA
+oWBLoeBXFQo+UCjYVih4qFCwo1Cwq1DwSKHg1xQK9hQK9hUKDhQKPlQo+Eih4GOFgk8UCj5VKPiB
+QkTorCepWthOxzJGPDymnMqI55wKreasRB/91N+bQR/GPZaPgv9Ulha+J0tf/me25Kff/M7lf/if
+/u3rv2NbOpTSffSLDum3HNKXp21LPtp0SG2H9Fcc0tUZOV9USBP0Ah1/9/ifkFotFwsFbH/rBjxu
+QHEDXjcgDbgrme1wSNPIqqzfRMJA+PH0bdrKlDJVtfqwt1fHdR5DnEa9rWY69fbTfquvVvn5Yq+p
+5vAEqtard/p7ek8tdHuH9YEaTqdSaqn+VDVfc03RBcqW382o4ZDJRCwmajExi4lbTMJikhaTsph0
+yHB+ivwwfA+WLS4iuKjgYoKLCy4huKTgUoKzSvCRj8prBYXpBNNJphfQBDlafrpZzhUWIiExwldI
+KRsO4mqJXdws6VXpsfxGvlbNZsr5hdVu98FhvfdgAU/yFsLicaOf9uq93T293TSyK/SCe1DgBsQb
+uzkKBtXaQavPjwfVRrczqLc6fbXeUavvrLcGuCUxrOL6T+R/BX46kDk3MrVhIpc85KEZ2tIbg25v
+u9t70FfTVlb8KmSoXHKohBEfzurCzYf3iFUpu1WFBRQQoPeK1Upmu1Bcz1vYBdoqVjVNu24BXirt
+lBQiBTmuU2mnGFJL9SeP9XZbLR7W93V1j9ukqKyP/r9fCP6Wld1P261OZiujigGiQquwxx4YL8Qt
+3Wla31a38pVqcXNDFT5fpPXtwmallKmpW+IpvQyKl78yaJ4YqPKdUvaul5S7L6AGE1TotltdIfvo
+Ed+eev4BUv30e7/+c98iQtPz/CMg0zgi9Mc/v/Wt3cGv/Lzi0DPepVh62EXb0uP8yO6j5WzplhCn
+jNK/VCjgcSmxioXl8iIQs1Tu1euDcquBx+QYmlsh8tPf+fh/LHjo8pyF+Kj60b8QtZ2iUv1pvdjo
+dqQX3IXNChSm4dA0ZdqtOvqXh81WVyEDfclEy93Hei/TaR3WB92eXcLjzYKI/DV61TjgS+per36o
+q40DvfGg//BQFOinz93UVlfLxQ3bwkZ1a88hSdqlrfuZ6sICGq/ZKEqlknTNyWznMNTlYF2gbCyX
+jeUSljE/ZbKx3Gq3idf0ZvbV1ajI7qdS9X553WG+1bCjo1DmtmXrCtHsV0p6/6Cq91r1dusDvXf/
+kWiHk7R8qPcPROdxjSYnv/cH3/2xwv/5y+Hf+8cfD37px1Y3N+/iv2VvWGO9uFrJVN7F0TB9fS+a
+E2fDBL1XitgvOiBGq17Lmo8+/viCuCu4QJXcTiSwI67GADKRQCYgbkmGx/w4G1rZbPkElX55vXaS
+SmVtVSGKeMhDs7SMJprtttt6A49ExKk+QeXmzRgOHtfWT2+99dZbyraCWygvsn6e1u69W8qs5Stq
+JvvOvWKV75/U4kZhU5V7JemdD/o+tuajrVZfnPs+tHIRSR9VC2VxHPy0oR921UJL5B35isuqz2Va
+LhSqrf0Ov6RC1dQJUgI+vMVpkpe0kOalMEgEJAoSA4mDJECSICmQNIgWCiNH2EsRkChIDCQOkgBJ
+gqRA0iBaKIIcEeSIeCkKEgOJgyRAkiApkDSIFooiRxQ5osgR9VIMJA6SAEmCpEDSIFoohhwx5Igh
+Rww5Yl6KgyRAkiApkDSIFoojRxw54sgRR444csS9lABJgqRA0iBaKIEcCeRIIEcCORLIkUCOhJeS
+ICmQNIgWSiJHEjmSyJFEjiRyJJEjiRxJL6VA0iBaKIUcKeRIIUcKOVLIkUKOFHKkkCPlpTSIFkoj
+Rxo50siRRo40cqSRI40caeRII0ca9/p7Knpxflc4SR398eGgbUwM8tBV+kp2K2M8Qil2jN6x1e2I
+ByRTlM3czW9n1u+Kq+os5W8XMvdv5zO5fOV+LbMmmuYskecFKlTy+fXMRjbvI3GGz9K63lU/6Has
+S644z150j1rC1lDJR9fo5o1ydSHT7O7qGEKp+XK1sBAORWbGpgReHptnfFJgxu6o/rvf/O2/pxBe
+Gf6rKH+Stlprq7qINcvZrzrl/KGQp+hetlpQN0oVcRB8FLv2c79uV+jXf+6a6LONEcG3vvnNX//w
+ytW3LR0D3ez8P7/39tUrHxrouMpHQtqY4EdC2pgII8/4JA7+Mbr30QcVucemjDd7UolvovN/g5bR
+WerNXLdhjgVX5qp3F3ZK63POuXK3RqnOn17VtDp/equnUTWt2r7OYwrf6Xwdr2patR0Yr2pG4DSq
+plXbV5zp86fzdbyqadV2YLzqkK/jVU2rtq+IwCl9Ha9qWrV9Ha865KuhqpDRbkOj2m2uuFbMbWaH
+Gm947tYo/fnn1Jft21WYC5/G/mn0ZfuOwD+X//Nj/ZHt2/6M15fjcxp92b7tP+Iz/5zxH60v27f9
+OZ390+jL9m3/EZ/R/sjxOY2+bN/253T23fo/0HkQec7zYIz+6HrMhcfpy3Gy6zFeX7bviOsYf2T7
+p9GX7dv+zD+n/+P1Zfu2P6jv87Sj8fqyfdv/8fpyfE6jL9u3/Ud9n8f/8fqyfduf8fqj/Tf0jfPg
+bdp+nutBdG7EBN7+g5CuL+Za+61ct7H4KByKhrRX526NMiw7ZAdoLvyJDY+OzBkYlj22Q34GhmWP
+7VDMf/JQyB6fqWHZYzsUZ+zxmRqWPbZDgYM3/2m1409oWPbYDsUZe3ymhmWP7RijVXzCUIxvx5/Q
+sOyxHYoz9vhMDcse2zFGq7D64/kDe0HFmfbHP6Bh2WM7FGfs8Zkalj22Y4xW8anE+AwMyx7boTgD
+w/KZd6aGZY/tGKNVWKf0D9jcZI/P1LDssR2KM/b4TA3LHtuhQKv4VGJ8BoZlj+1QnIFhuVWcqWHZ
+YzvGaBVWX8Ezh80FbiP74/lPa3w82rDssR2KM/b4TA3LHtsxRqv4VGJ8BoZlj+1QnIHh8e34E4ZC
+9tiOMVqF1VeceTv+hIZlj+0Yn7HHZ2pY9tiOMVrFJwyF3CrO1LDssR2KM/b4TA3LHtuhQKuwTpAT
+xxWju83RMT4Dw7LHdijOwLDs8Zkalj22Y4xW8anE+AwMyx7boTgDw6NjfAaGZY/tGKNVWH3Fmbfj
+T2hY9tiO8Rl7fKaGZY/tGOPgfcJQyK3iTA3LHtuhOGOPf2DDxjt/vIOvlu6K6XIv0pfVfKPdOurr
+2V6333/c7ZmTTT30hRFpKhTUdqs/mAl82UvKFZ53+Tlj6Xg4Er+T2QiHf1W57cfC8XAkpH3720bB
+rskG0ZA50yPqnmwgpzhf/csprlf/cpI12cDvnGwgqzgnG0RDYWPWybN9cUxQkPM8h5dSQeNzBWYc
+E1wcRZmzWIaiKRk+tZ9SHrczdlJgxkvKf8JzU6/QXnep1+0O1FaHlwjWOw19BY2gjMlyN8l8ttXq
+N/uhxgd64+Co2+oMQo0PFh9FtMVDvd+v7+tC/40T9Kt6Z1By5fniCXmaerv1SOc9kQLwyUe3N3N5
+LylLPD1zmqqb1eIC5vDe2cT0z2MofZHK1KXHvFcMOGPnGJVy1KUGPaRD0nkvGWPKkEKatH7z9cob
+xqzUMqrK4nar0+w+7qsqXSCi3/cS3T99Do+ZwzhpsFaqUih+2KwPdKyf5ZPNS6U17L9hCpUKdoYw
+hdzdTSvnLP3Hv/Zr/85LD/+Hy39xh769+XTrr1opAbrBc7aP6g1M3J1EDGbojj5Qq0/7A/1QzWHu
+JE9ifJWOPz6+VKUHpNOAN9C5R0ekUom61CSd2kgXczEDVL7r9V3hKcke+hwd6oN66Mlhe8SOJZYv
+M1Rt6J16r9Uf3K9mUS0uV6Fi0VJRqCRNnCxt0bGV4qdrrxH9GtmTLi/xNGf8/aUjoi+J6ahOPOEx
+58opOEQRqHsCZDCVYoHnPvPM/gBtZyrZxXAozEs1XkSkArSWr6wW13ObvKjQjWHGJ2N+Sw/BZOQi
+VTKrq8XaWiVTFpN6fRT5M7OzVo189Av/we+0rIl701TMVzcyS+ulhUR0wdKZoEphfVNsF2aIYsKx
+IZrrhzyUIKIXjvHnoTxt8b5HJdqkCuVJpSptUoFqtE0ZE8HuSFkKoa3zgZiipz9LvKVHShzmMRN3
+aztYBXgNMfJTuZqLV3Kb4oBOUmm9KskTdGfzXY84PoZozsRVTNFroGLliiGKBZsTlNvMWiaQB6Ji
+oF4rWLOkffEXHv6Fve9c/tn/if467f4GZkXy4sgJKhTXPR7jR/ERkbEBkoc+9NAUzbWa9/uD3tyS
+h6ZprqcPHuv6QG/O4TTMePjMnDN3WhON2U/77RrPklfImIo5QaXSThmLGXlm5gQVi4bIHc8s/cUL
+/+sv/dJ/+Z3Lf+H/om9Pq1Wcjhx2H/33f91rhsNDfsy6RMsW8fLTeuleDphVUT+liKi2topujW1M
+0nqtUsTcUMh+mqD1fCaHH934ydcNyfjJZ5pGGv/k6/yj15uihFkyF44YM0jVqEjhGYyFB2LGopeK
+OZwH7MYVelU9Gqw9bGFR/Ff1xoAXpBgLyD30GWp0D0NHg/2HrdBRj9PvH/Uw8Z8zX8MPicVG6KNy
+q4gEyuYYvkwZalCDdOpTn7a4E+9Ti7qEhf+scoHW9f1642lYtGAfd1RYwN7d22s19CXzSGJArNkR
+5WU9PJOa0Olg7ITuAb/YeMdSnDAUjcuDhy7T69m1TK0aCkcWwwvYLusNS9NcKRQOITrs2ywtrtWq
+98u5ws6WsW/fkK6Y2Slyizn/0+i6pr9aVtWZwE+Jibg+3l/HcueKXUK2a8z+7XC/z+XP0fJRc+/J
+ksuJW+VcYXFnIboUcWxnaAZEjB4V+kPRSBXyfGz4jn0ScYB8vomaMkXHWBYM1khF9KKa9iVJSjmk
+tCzFHJqxiCMtqn3JPm+tY4pfj4JSyncnRGchYif6zVlaLtUf6D2eQB8Pae/eusBbFL09+RH2QTOC
+iLPn+/8EC6bvdpv1B7bP1Vx5x5Z2yrmqFzspoWO4bg6GtfBmtpZO/6pym3dRStpjYYVeHlqckdvM
+3ivlN2qe8UnKBFp99gr2UFLo+I/h3PEfO8HvM/h9J/jPGfznTvAPGPwDL5GPt3/y8appxO2w231k
+1U3ETVxgLtHkpTdjyoTHM+NRPOhw0XMe+/jAGhs7KfQzX/fSi7TRetDtwGfGschMYLMjsMs2hk6X
+PiYcXaxbogm5EGwnBee//jPOQoD7ieyCZ3HlCjqxyzYmCuEiXIXYNRFq3BcROQuF3vPUbExNRCGm
+L85CnrdmiJtxNbOa8bQjnE7rz1sF2To8+7R9f4U2inc3N9TsZqW8WclgCwMTyYVVwiq3SmZ72KlT
+ZbPa+SRt7u9XSfRRE1TKava1QMX2iM/8swzN0p1MNauuVja3q7xGiNs/+oUJymTDdt9piLgQcOok
+p8bE1eeSa82El8h7jP0VRq4NcoOYSjwE8lzoUZrz2Ott+cFh25xsY9XF8CkufPJTpvbFGhY7yhqR
+EC/s5AOBHJFQJGz+RvAb1uw9cl3X80w2j+s5rOE/oiQ6HDGEvUZE89gXYujPHMV7KEwzgZ2FUutQ
+31zPL2Gfu+bDht5UV5+qJbEzj5msbiVCmhZCXh7j/xjnzXY7j/TeQG8urD5dUvOH9VYb26I8xRoW
+Hrr5aW+9niUiMdoz62Zv6nmJ3m+a+5U02vV+3yxigqbp/Yd9/ajeeFDf1z+covcbB/Wjgd4D2zfW
+pH04Te/3H+5a0gV6f1ffb3XMlRrolbO5ghj++SCJtuqjtUoRdxkcwCne7W4mcD0g1G1IgU9cn5fp
+0aB139qxd6la+frDwV5qodOeCXhJmeLQTNNMYDtTyS7Unh7pGPhyAWa9o0OjqSsjRlNhES5cl73y
+XtO4V/SMzBRBJvZSoRf/ptUyJqmwjmra8lqxkEqKYZjplxguzdDr2dvF7Uqxlq+osZDp7ixN0Gp+
+rVSYoFXd+MnvG5Lxk187NNL4J7/PP7rxk18r7Rn58JPf5x/d+MmvHQLUjZ/8viHtH+59RBeoVNjS
+K9XiBTq0mNKehVhMqfDI0jGZ0p7JHFpMqYBnF4YdLES+ADsGc7hnMqXCIxOxmNKehVgM/OmZdgwG
+/hjInoUUHpmIxcAfU8dMgj8GUtgymNKeycAfToI/zMAfZuAPM/CHGfhT6Rv1Mhj4YyCID5LgDzPw
+hxn4wwzHx9DZyjMDf5iBP8zAH2bgDzPwhxn4wwz8YQb+9GAQx4sZ+MMM/GEG/jADf5iBP8zAH2Y4
+PqadPDPwhxn4wwz8YQb+MAN/mIE/zMCfSrVltZ8WH3cTQXwY4WbDOmg2rGMy8MfUQSNhHZOBP5wE
+f5iBP8zAH2bgDzPwhxn40zPtGAzHhxFuPyidmw2XhdYChJsNEIvh+DDC7Yd10H5Yx2Tgj6nzyGDg
+j4HsWQhOqz5yWQz8MRDEB0nwhxkcL2bgDzPwhxn4wwz8YYbjg+wcHzDwhxmODyM4rcDAH2bgT4+R
+At/WGcfLQBAfJMEfZuAPM/CHGfjDDPxhBv4wA3+YgT/MwB9m4A8z8IcZ+NPrt6Sub9fo+naNrm/X
+6Pp2ja5v1+j6dnXu+naNrm/X6PqQb28CafxjdH3IB9Do+pCPJe76kO+86+MWy90aGip31ybDh4YR
+brGsc971mU0X0UCgwHB8uENAfMDwqQ0GpxIzOJWYwanEDE4lZnAqMYNTiRmcSszgVGIGpxIzOF7M
+4FRiBqcSM/CHGfhjdD44teEG/GEG/jADf5iBP8zAH2bgDzPwhxn4wwz8YQb+MAN/mIE/zMAfZuAP
+M/CH+xz4wwz8YQb+MAN/mIE/zMAfZuAPM/CHGfjDDPxhBv4wA3+YgT/MwB9m4A8z8MfofBAf7uis
+Hg/+GAiuCEiCP8zAH2bgDzPwhxn4wwz8YQb+MAN/mIE/zMAfZuCP3fWpGDViLH9DIR7Bv6oQvaYQ
+3VSIvqgQYd8cbOiNR3V4dPamQoRHVHiqh61j8JEQTSHCozc8/sJ9G0aaeJyERyO4W0kpRNgGByNl
+bOm/rBDhXgqvXt/CEH52jjxmX/ynxiHlT5tD3j9tDuGe5W1+RDZJN/hOCPepf8a+Dfkbf+WX/12i
+F/CYU35EcIH3SNos7NzmXRSWwpqG+2yMu4qbG0thLYL3DPmN7GauuLG2hMf82duZSjVf43cO2c1S
+uZKvsi7yba7nsOXIvWKOxY0874sE0fYkkw1rWuL5PYmekScjS77ijMFMIJepZZY8dI1mAiIYCW0m
+UM1n71WKtXe5fjMBKTIBmgnYsbkEyRGdGZoJOOIDQIrQBevYeEmloO3PylxE0+aEGxCjc7YjK3Oy
+7vxz6MLu/HPYPa0u7Er+zkdO7+8zdWFX8uGZuojDaXVhV/J3LqJF558jvmN1YVfy4US7p9WFXclf
+xGGsD4jDaXVhV/LhRLun1YVdyQfEQW6/88/Rfh26sCv5cKLd0+rCruQv4nBaf5+pC7uSD8/UxXE7
+rS7sSv4iDnJ7cMQMdk+rC7uSDyfaPa0u7Eo+IA6n9feZurAr+fBMXcThWbo+eoHm5P56Ze4FmncD
+To15t8a8QpegY3fyK5dgRJJP3+mHw3IjfHan/wxdV5DmnqXrCtIzdWHXcVCf4QPsnlYXdh0H6gS7
+p9WFXckH1E1uhCfFd6wu7Eo+nGj3tLqwK/k7HwmP92FEfE/r74l2JX+fqevyF3GQ2++zOyXnMXbo
+wq7kw4l2T6sLu674ntZfxGGsLuxKPjxTF8fttLqwK/mLOMjH2BEz2D2tLuxKPpxo97S6sCv5gDic
+1t9n6sKu5MMzdRGHZ+n+SXX61r0QZqAVc+QhcyaDYiNfN9MU+rqtXYG2hPhop1DEvC1+5eWjau7O
+lqX9Mr+QPD7++GjojaSlYr6LSl8k5S+LV8cTvH/1Wn5zLVvFlJJcpnavhNu+avl2vrJZzGF763Kl
+WMqX8LTg3kaxZtnDrAGSp3+VCwW6oNCkpXCZ8k8G+NRaUy11mw/b+hKedpCHVGwb6qdjfJCsUi3U
+qrV31/Hi8wqR/3gkypUa1gU8hMLu7BAKu8MoGxjWhWNDKOwSjfR3CIXdYRR2h1HYnR2ywP4OobA7
+jMLuMAq7dnSwR+JwfC0UdkfoAhZxELrHJEXSQtnfYV1HJIXuSBR27egIXSiLOFgo7A6jrDqsC3+H
+UNgdRmF3GIVdJ2o0cbkd5zdyG/mNQs3Zjt0o7NMQCvvDKPs9rIvaDKGwO4zC7jAKu0Ruz2B3GIXd
+YRR2h1HYnR1pdxiF3WEUdodR2LWjY7UA9lfEwUJhdxiF3WEUdu3oWBZgdxiF3WEUdodR2LWjI9sd
+RmF3GIXdYRR27ejIdodR2B1GYdeJGu0Yk/c8HvIaEh7sGa3jvSjPmUSXLcD1SJ+npSn/IWFz3+vk
+o5sZNcN0lWmWaY5pnmmB6RrT20yLTO8wvct0nWmJ6QbTTaZlpu8wrTCtMq0xvcd0i+k20x2m7zJ9
+DxvlB27m1AkKzNzMqT4K3KwZAn5EFbx0M5MBWQXJguRA8iAFkDWQ2yBFkDsgd0HWQUogGyCbIGWQ
+d0AqIFWQGsg9kC2QbZAdkHdB3vORF4762E+wNWZrXlL+vQse8tANWtxtdRb7B4FXjR30W321rvYP
+sKV8vdc4aD0S87RfphNVjKFAGC8NCLMW/fT/TvJhtjhsXs8AHsJ+pf5w0O09tDbn9dE0LWJZUuD1
+zdxqKMxS2CFFHFLUkiYwCRC18dBNejL0Md8nh+2FxmGbv+jbx0yn+pyUQyX1fn3QPbyP77rf7zfq
+g/vNVv8IL2a6nfs9vW7OoscwpXZ7Ax8S4rLMuuLFCMtv0na31yz3ujhH+G99u5xkRvzhkTkEzMgK
+KPB4kuiycjyNwHDEfFQq73yJKV6rsGU/T5RsSueMjxErkkZ625H+cYR+1UpX6PhfSPwfSrw5ixnH
+CmedUd4cleutzkCtHnSPML/M/LYAJg8HrsN9fFL/1LoB4acZMbxGUjAU9KFZeEAVpoSVCX6FKXkZ
+Z0o+5rE2QvHQ28+dD7lpAlRhSpPMM6ULzDMlzPb0K0xpinGmFGCeKV1knilNM8+UZphnSpeYZ0ov
+MM+UZplnSpeZZ0ovMs+UXmKeKV1hnil9hnmmdJV5pvRZ5pnSNeaZ0svMM6XrzDOlzzHPlF5hnin9
+GPNM6fPMM6UvMM+UVOZVhTw/jeMnpi9qggsLLiK4qOBigosLLiG4pBeTqxVS6EvGSbx01Nyrt5or
+8n4P9VbrkE9kpC12+outpktbXo08QnuKPkPI22ouNbpiicCt1bmrI+CVuTH4/OrcaDPzY8yMxudX
+598YUeyt1WWun8vH4AjVlbnn0J0frWtNJ8a6og/f7w32Jo2fMD7w/H5/0D/Ya+42Dnz0GXo/+H6j
+2+72uBvVD+tHR63O/gV6v76nt/VH00jHapH+oNvTp1jS9xoHR1ZvMUmv3siUKgFjyVCQAjwPP7qv
+x0jw+xJ/IPFHEt+T+L7EDyRej0s2Jf5A4o8kvifxfYkfSLyekGxK/IHEH0l8T+L7Ej+QeD0p2ZT4
+A4k/kviexPclfiDxekqyKfEHEn8k8T2J70v8QOL1tGRT4g8k/kjiexLfl/hBGqsPlJ+YwQUHX9X/
+yjsP9d7TH/fh80ataqN7pK/4qSG4bKsvMIObGJk7QNlWttt+eNjprwSoIfHZVkPCLX60lWnKtmr6
+4VEb37FcnKaGQ8q2Bo40Wxpt7RJlWxW9z9+jbHU7K5eo4ZKzrZ4rXZat88ZHr3iwysi4PpvXUUzB
+4PEBzt+P3vISNY8VCtBV2rhXWs1X1M2CWqtksvmqqqorqjYGD4/BI2Pw6Bg8NgaPj8ETY/DkGDw1
+Bk87a1yuVRdrlexQjSXcUWMJd9RYwh01lnBHjSXcUWMJd9RYwh01lnBHjSUc8274adcl3Lr5CGt/
+8Je5RHTBKR8TPuo1i1QS6ZLM6cj/kZQuyZwOfTldkjkd+kT0kat8yJwOfTldkjkd+T9y5TdlToe+
+KXP9JJnTkR9/q676r5rxgT7+rHRJFvlRPysd9kyZ06FvynL50Od06KN+cn5T5nTkN2WR35Q5HflR
+Pzm/KXM68puyyM/yBClve/7yNG6zr9LbI/+m+CZcMZ6nYhWFF9dehQgflZ1UCA9Wyc+GXr/0yQ1Z
+HdYUbRRrBS0slsu8Ry8YUCSkaVpYVVVVAsJuIOIGom4g5gbibiDhBpJuIOUG0m5Ai7g9dQMRNxB1
+AzE3EHcDCTeQdAMpN5B2A1rU7akbiLiBqBuIuYG4G0i4gaQbSLmBtBvQYm5P3UDEDUTdQMwNxN1A
+wg0k3UDKDaTdgBZ3e+oGIm4g6gZibiDuBhJuIOkGUm4g7Qa0hNtTNxBxA1E3EHMDcTeQcANJN5By
+A2k3oCXdnrqBiBuIuoGYG4i7gYQbSLqBlBtIuwEt5fbUDUTcQNQNxNxA3A0k3EDSDaTcQNoNaGm3
+p24g4gaibiDmBuJuIOEGkm4g5QbSDBgXhffoonUFCGtaWBLCshCRhagsxGQhLgsJWUjKQkoW0rKg
+RWQPZCEiC1FZiMlCXBYSspCUhZQspGVBi8oeyEJEFqKyEJOFuCwkZCEpCylZSMuCFpM9kIWILERl
+ISYLcVlIyEJSFlKykJYFLS57IAsRWYjKQkwW4rKQkIWkLKRkIS0LWkL2QBYishCVhZgsxGUhIQtJ
+WUjJQloWtKTsgSxEZCEqCzFZiMtCQhaSspCShbQsaCnZA1mIyEJUFmKyEJeFhCwkZSElC2lZwOwB
+HjFigXU4LbkTkYWoLMRkIS4LCVlIykJKFtLmjRSe7K9nqgWjn8Kz8P/oP7d4H+mKtVcP9lDBJ6D7
++uC2Xm/qPXUV65YtVQ/9gc1i/QTfnHt4GYXJmi9GPHh8b+lepa2vZnNaWNN8XsXcEQF/VrpC/4vp
+TYCmaH11vVp8L7+i2WzYZiM2G7XZmM3GbTZhs0mbTdls2lycMIWXFHwbgXc5eD5r/HrNX5/5O2H+
+Tpq/F8xfv/k7RSphaTvXnvAwCWFBNS0eBVo8HmpYvDEjxMiLsi0ce45ZPL+aNHWmYN7kkcviL0o4
+byfDqfi6M/6g6aFLYE3+BYk37qENncsS/qLEvyTxxs5ghv5nJPyqxH9W4rHy3yoXk24s3njhYtj5
+nKTPn7A28R+T8M9L/BckXmXeS8rnfZgoYxg0mpvBnx8IIw5/MgcCzdvatcTYZ2ma6p3WwWtEhP94
+v4JvOrca3Q5OC16T8wJW8xc3lraymfX8Ri5TweNTc1kJzgW8Xjye5B7sG1uYdYW0kEIXmSniS96h
+yEXiVbrdjhoORTyk0De2mWaZZl4gxe9hQ+YflvYosj3oRUI+yWokpNlWDQGr8jc31EgoLKeEkddZ
+Hs7tY2ypg1lr3zDz+ekbppOiFpgoYKaqUWxWMkHf2M6vr+NHb0vvaA/7LbGr5wyREn0ndOOLb7/6
+2o0vYu0bf+r4AnlWHzbD6NTQBcH0at4MbCWnEC2jj/ITPiBd2lzF7nwmUsvvDCr5jCjgDcpvFNdK
+GXW1uJGpvGtsguL4K7Q69bb+egWL7bjre9nKUqtkNqrlzUots7qe55w4BY2Sq4OHe3vFAVbwMTLJ
++z+kxf4Pb5DxQNj3iucKKXTlFc9VUmjqFc/nKVDo6frteqcZDr/ieZ38llG0Ij7JUcIlqj480nvZ
+eruBL3hi1R/j0xTq1b+P1hnq1WPIwg1PoXISE/byG7nbOXOmoJe8VI6rIDMgeKlDHprH5hdxVfNR
+OT7DNMBUDTPCNMBUjTDCNMBUjTLCNMBUjTHCNMBUjTPCNMBUTTDCNMBUTTLCNMBUTTHCNMBUTTPC
+NMBUVRlhGmCqBhhhGmCqzjDC1N6f1EvlCAIRmfJROYJNRcoRORwJDkeCw5HgcCQ4HAkOR4LDkeBw
+JDgcCQ5HgsOR4HAkOBwJDkeCw5HgcCQ4HAkOR4LDkeBwJDgcCQ5HgsOR4HAkOBwJDkeCw5HgcCQ4
+HAkOR4LDkeBwJDgcCQ5HgsOR4HAkOBwJDkeCw5GwwsHtIoFwJNAuEmYgGI4BjgGOmTA3lxjHJ8bx
+iXF8YhyfGMcnxvGJcXxiHJ8YxyfG8YlxfGIcnxjHJ8bxiXF8YhyfGMcnxvGJcXxiHJ8YxyfG8Ylx
+fGIcnxjHJ8bxiXF8YhyfGMcnxvGJcXxiHJ8YxyfG8YlxfGIcnxjHJ8bxiVnxwR5Ox98Veyj5IHxP
+nGkXaa/V1pt6v7G0uOihFAXuVdbVYnmh3mz29H5fzfR4Us4CtklVs93OQO8MFjB1RaS09c7+4MA6
+7S9Qpd67cR3vBci84ri3RcJeSXMYBUk7A0bmMCruP9rHgl6za2jU9/YwZIB3lvlLrs9TW/hnXbix
+6bC0JZQjI3fo2KRPoRt08+ab6lGv2+jrA5U/lX2/2G4/7A969UG3dz9TjM49WyUDHcsPDu8/9JKy
+wXvsXKabmWL8fqHV1gvd3mF9oIohOa7Inr9j7SSIy6nnV7z/QPSYxrgKf8fHHpql7O16r9fql+qD
+XutJrmsGBJtW4XLuAxWb5ilUvutD9I+x297LZKz/xN5eMwFVjcwEjFWzMwEPXaf0TOC1TDaT28pX
+kBqeCWClaySM1IvImt/IVfPZGfMEwkCBFWJ41YNLKcq4QNU8F4Bky5zZg1fzYqdSP61Wc8VCwd6K
+62Xse7iDi/hMYCawuftVvsVRZwLLWOHNDeELrIJjNnPzf/8/vvPbM4Gwqqnd3a8uLy+WczsjFMMn
+KKqWxYhLccZp0k8BY2bC+/VOv3XR4MMsTBnC+4f1hqkTBu834aOGqRC22fePGnWhe9QQ11aM3P07
+ONJE9JdI3doOR0KYtMS1R+qFf4YkIqqNSnXljWGwYuT1UsY+bAGqN5r6gweHfRu7SK81up1Br9te
+Uu0dPF/A3WlNXd9cy97OZ++qcxhFzYnjf5deVfuDp209dNRuTIVD4bC6oEYXI4vp2EzgVfXt1199
+I9s9etpr7R8M1Nezb6jhdCqJvTpj6pbeaw2efkktdhqhmcCrMwFsFcDVvCGMNpvNqXAoqS6o4cVw
+SjJqnWKSaguqUUM1/EzVx22oxservk2vqnv1/kGr2wkdNZvqgvq41xoM9I66+1Q9fHDUG7Q6TXVB
+rdYHak5vqFpS1bSlcGRJi6jhdNrYxJir4qPB9q55fmLaYfW9XO6bvz8fzRgVwLhze/2QvufHdDRw
+NyfsgZKfttd1yvPUVK/no8lv4x3ZBGVzmhbG+XTMnGEJ59rd7cydb/7+/G9ZiI+8d1Y3zZ3IsFno
+8o3cZrb2bjk/Rcs3mt0Gum4vKV/wob+9SvP13e7DwVKbN3FdaHQPj+qD+VtXaW4MPkp/boz+nOjL
+VSpV729tFrOoWBmzdaubG+/Kmw5a/nv4JSHH0cPvCk3WbytM2axokx66aKPTNmtu6c5DUBt9wWbh
+Cyd7+G2lyXptBby7NFG8wjTZK7aC2F/aQ5dt9EWbfcliffTWfRwZw0jKtQkhXxXfujUTWC6Vsvez
+3U6/29Zx4cBVF/yWMUV0BRu48bEXM9k0wf1rOx8uM2Y+3Bh8PjN6Plxm9Ly3uTH4fGb0fLjMc8yH
+ew7d+dG6XlxG8ZzLNbPwT2Ie4r0xcR+Dz98bHfd7Y+I+Bp+/Nzru954j7s+hOz9a1zovJyn5wd/9
+m/OvWLKP/tb03xKdiBha8Z7OKWNP51ZzzK7OMa7BqHRrpJc+ycLRJzYR3z/RxNIJXsSPTrZxUk3i
+nRPdiLEb+lKxunm/nCvkzT6Ot8jOL4Q5/3CqdaCwZ2p2cyFbNB+lK/TiiE7VjWH/VsvCVVov31XX
+Ww2909fVsrGL6AzfOvGTm5doJmD6tLTVetRdDIc0ZB6diLkmGNYb425uOAVcpA+avXb9UesAl9z1
+YtVceajQhDFatKa/Wk75KbSdKRCRmPt/Sb67iYbCCnn+Ww9fA2jiKtbii9sGP4X6e3u4xFjWfFTY
+rorLp4+yDqmwXRXXU06TpMJ2VVxgOU2Stmqrwjtzts+b8GiW31js1vs6Bnv9Vle8rsCDbs34S2L+
+gOWfhKe0iDYKT4/WD2t4Zz5sJxzGnI8RODZcH4PHBe6ndaoQwm9ZeInKtdL9cCgSwC+26M3U7pt3
+0H560TiIfFfApHG0/5KBGbcKFnjZAHGbYEFmXr51sLBZU+1IIGZG3EpYSiaE2wgLEraOGgJzTqi2
+6uOjzVpNfMvkEgU8HrJH7AoRb1D7qvH1gF73q63B01Bf7z3SeyFMtg6VjW8J4NWUZVKhj0SDm6Wb
+N/g+eqHQ7QzWW318uoLHTbP0b5U21mYC1/Hk+5WSeLSH+9t/+45Imb1zO1exzqOhs8RZJ5ba/WZ/
+0DqsP7HcMWasRpI+oknjZDzlDapj4/pISLsj+hpHSjSkFUanxELa3dEp8VD8ndEpibHlJEPa7dF5
+0nLKS5R5OOhmMzk1t7OqhkPaTOC6aL0+KmWtbaZ9WK18TNdIJ8VPx8d0SXDAjFRgZm5DH0m4azk+
+RpLBATP0gVn6VlrCY3NWGjq/zFpOev6RWbO/ZOGDJNY2vUajHjf7nY+b0Q9zs/LRFGahotPjUqaU
+SZrA8+dLP4lRlRgcSwtD8CUJ95OZOOe5+pO44xJ5RmomTq2ZPLVmCnUgoqt/FRt94aphfhAiPsrX
+9DO0XxyqWVgb5UbcM0o1fHrViJeU1/gx1wyt/hvfPKwPet3+g/rqT0mHwjdpNiUPvYtrMzbq1gcq
+b8C9Mvd7/9WvzKlYcbMdL2mlo6x+0Lr9QU+vfrBRa3zwoJFuzr11K7D8ZOnJ4dGhPqirTw7bnf7S
+k5W5erO7qy91+kv4DNKieUek4CS1dglX8SWVlblZciHYjJBbyRW4c9jG6whcpoz7L9WNYrAwjOJe
+bX6k7jz6T7NVXs//b/+ZFYp/E8f2X+lfrpgVW8v7qZwtFNYqGXPghOZmIuIcvExTgVajf998SDsV
+tp8ruZMidhIf5KGoWluhr8zdqxUWUnxUrecS6k40p5bvra4Xs+pcsbq5uLit70Zzi4u5Wo7ToiFt
+cTG/IQ4y1srxW3mPyflIXHs+leLDUvFmMz7rGkY+/SKiZhGej9BLzptL3h6tzD3sdZZ4fVW9v3DY
+avS6/e7eAI+Clh4dthF1D7ruaVqu959YZ8s0LWeqO5KENPN1qZlmStYJ4CF8So3PDD9Vc9UIZnZY
+iZOUWS0WCEt/uT/xUSWfE+Phz9DyW4+PXGfqW+K66KNi8e+KFnCRXn2rkskVMxvZvBivTvO3NGaJ
+KFvBJcHw4xIVi5jucDufKWezuQpmqiM2fl4lVoplcC7zXZ+P6u26eKQ3SU/qvRuE+8X3oT9JO+X7
+5QpGVF8z5Gqmmg4J/xX6id+1auqjZsfaPgQb9C8uqss3FhbU5cPm7tJht/dg6QOUasbJeJWCx68G
+MkGl6nZRnMZTtN86PFKfNPZEHh893iyIs3qW/sb3aKLfo8/t6919fbdX95LiU+AkhkYTom8OULWU
+qdRylW3PRwrR2/jsiJ9o8m0iuvmRn2gaCxKYuyiwqwJjvbvIwXrMsR5zrMcc62HYYOgxx3rMsR4P
+FxRMNJighfaBtsA/YeMnZvzEjZ+E8ZM0fpoL5nMU+C6/8fA4X2MIrQBpM+ZbixkPTZE2Y77psI9V
+sSbP0vr7X7NSZukfVd7/8M9/978o/fzfrj75zTf+/d+2Uvz0WmE9gmfgNmK+XMdq1mNjNPrMVzIv
+j3glwx9MGftGBmNlzy9aBc5S4d6dYqG4XspmcwuVzLZoHH7KVGulhXwcS1+NN+38vup3ELKfxmIP
+Ip8WidivAuxk+mnjlR6SrRPFR1okji/q8WnjIy2qtU753mlUJfkTLmMqqaADehQOJbRby7p2a2aa
+hBS+hc9oXmMfvkFl6lGXvko6NWhAKh1Qnfqk0i7p/KlJlfpUp0ekU5PRp6TSgA5IJ5X2qEttavMH
+K1vUoX1SWbNnffWMLXVpj1R6hx5SnXr0gHa4TOMbaV5S3uTuMkbLNcy3KOQr5odf5Kd5rc5A77Vb
+/VDjYLG4UctX1ovVSCg6N5xr/mAwOFpaXHz8+PFz5DpFWfM/UFnzXlJKCjoknZapRhXK0AZVqcAf
+P1TpCR1SmxCnFZqjAxrQgI5oiRZpkR7zvxBHdsDfkmtTi/oUogYd0CLh84g1trNORapShEIUPbmY
++bMoBo/l+a73ElnHQ42Eol9GX5BAdzlHd3tPu4X2wydqrtV/YHzM80vWBXAFT37wpON0uhGtz13v
+9Vnca0xTsbq5ENaiWnQhEsZQ7PX5YiEb2Yl8wnMpOnQueUmZ5I+1vig9UMJdOr7EZ/UfPipsbu1a
+Ei6d93iS2jf/8eC7FnqR7mdzcVxQr1svS/Aq+s6a95KP7qz5xMsWP5VKmxX071ZWPxWLlU1Mn3Qg
+VRmZICpVSujXTtNhjnmHHYqN7DH5MPsp+Odu/7k/nPFgI4ZPUkZ8ZBkTaDIK/fZfs6qIt7j+v0bG
+H7/jjUhfwOJU5xvguD2k9tFqee3/tyxdoD+bXy94rG9z4kLJAG9p8YPXBPOgh5qLs0x8kM4chxhO
+KIoYdfno4+/90W9YyT76jT/63se29PH3/ug7tvQdR1pTfyLK8VFTfyqkKXqhWlzNrxfvVa3MM45T
+5cunOYmipzqJvGMaEb63hYcpoyZJREKRyLg0rDgZCucz3J00znlx8+Wje9Ec2r/rWbKPdvUnA4zH
+9w55QDGUDhzp0DtV1T1jqh7WtHEXY9ivFBL2fD0fi4BRrHW0fLRTKoivLfuoWCqXvKSk+Erto1Lo
+bkghuoJzZYpuVBs9vX54Ax86fQWQj67P8mCQx8I+KtVKs5+0QsM9ouWrnzbv1jLVzQ1xl+KjQqaC
+Rvy24c2fnf2f/2tL20fZcvYXFCL+EDYeBeNWhm8VlohqB62+OujVO/1Gr3U0UFGxgd5U652m2jQ+
+oq031d2nM4FlbLWe2Xj3VmgmUD7AZvPCIHai+cFPZzwBH2p/hveT6FCKd3d9tFq822S6x3Sf6QFT
+DOrwLp58dHc1UmfaZLrHdJ/pAVNTF0e/sH4gpqpOUXWrgk7d838bBWPUcPHxJz2EiaFqWRZnnzWs
+vkYa9sAfMQfqmnSzEHDMjvqsnceZIGfBnKmImFAlZRlKmLEcgDGR46qzEIEPlWHN2hoqQ05wlCES
+XGUI/LNS1WV35Qxu3FGC6W3gMyMrEZgd37WkZwIvU3rUzDMzyZ6VFlCtSWlIuiblcqYM31LgjmIo
+EyrkSHGWJPJ81lWQSBgux5gyp6UdznE5coqzHJHiLkckXCMpC1szXXBkcSc4S7FyXB1dmYDPeW8c
+IE1M98PtsSkEpgRudiI42d/L75StM5tfMoopOPyyUJIK21XpdXrWIRW2qxhkm5181iG9t12dsc7u
+z9FQa7XasdS8EQ0ZFzf4OOckfUcrngnczmf+JXv/ASdHUh2O46+qu1fSSKucL/WdLkh3N7udJl3i
+RhOkvdvRzu2sVkJwht6Z3t0+zUwvPbOrFcEkGzhyDkcyxoDJwcZgjE0yRzY5GcxhMCYHY8CkO/0/
+r6qru2c2SIL7/37+fb83+9nq6urq6ldVr169eu/Vq2JpvA+LRfYdkZxgpVIGY3QjVkpfcghMLPfO
+FYZgvBT8ajDSYrD0Jy9DXbYvOyy399YnVrIoAgEMkmNF9KbGKxPkXVKXwcPsdJb+ukTJYSFYxViy
+ACSWHAOkN1WUEUvFVhJFINRBybEielNFEbHUC2Dp8OMjUzfiIxYhjz8YFMavSx6EtC6iWrrRPy7F
+J3ZCVFI8/64emhASxt6C+tIjkGL5ewvCTzBS0VdQb3pUUF96T9WCcnYsR3F0TA6LWakUhHOZUnqT
+w1JiyUsrNYimystUKkqPykFwYuk9lQrS+ysVJYfFrFQKghllDwvvTQ5LiSVLTDQZUOK1ISFeG8or
+e2hwuPBmNDh2d+JY/K58rBYaQbKcsbsTPc/Kx2qhhSPLGbs70fOsfKwWGkCynLG7Ez3PysdqoX0k
+yxm7O9HzrHysti2qX6Hn7kTPXflYLTTKZGXG7k70PCsfq+3oKTN2d6LnWflYbWdPztjdiZ5n5WO1
+XT05Y3cnjtV2PTCL4fASEwXSZyF5R7IdzB+YLMh2LDlGtntTRRmx1HgRSM2DkmNF9KaKImKp/5fM
+YtZKsxg+iOgQI2ecGLMHIeGKP1hhFsP0sKR4/l46Hc1K+EL4gb70sKB4em9B+IkA1DhEfelRQb35
+e6sWlNNP8KPksJiVSkE4o+w91YqSw1JiuZdWis8bSysVpUflIDix9PC7sfT+SgXZd/TzHGzuxK9G
+pSCYQXq8lN7kCBjsWl7K+c1iu2kgaGSUPHZ34lj8rnystqcnZ+zuRM+z8rEaCpaiVUbs7kTPs/Kx
+2gU9ZcbuTvQ8Kx+roQo4KjN2d6Ln2YljtYt6yozdlXuenThWu7gnZ+yu3PNsfKIWikVlOFQ5eDR6
+71Xv+NAXorsvfOgdr3pg/sGBIaYDnCUemH9wFcoXaH0rwiAVW0lMxzg/Bsl9c2mUGjZpVPAqq6jU
+SvMPPogoCCNbnF6yB730LHgQp/bxF1ZYRfUWxIjUMgX1pUcgIVEL8i8l1cGDOESsDlF6VBCCGkvv
+qVqQHieysez9pDrIjfUNS0E4g/R4Kb3JETBqIBTrbx38KqfhWHxUem96VE5fevhCLD0OTm9yWEws
+eUmlAmjipSD0UXJYSiz5rPOPoJAEYuIKHCMBC5kgEO9UBDBo3QQzHgkWaDH5WGIw2mi5ATptzwvO
+NEJdAZs1LoDvScC8xOQPDlXHikPGkIZJaFeDyp8/Rv6vZ1cUlJ93FbPLVzEBR0tl5qb/0lCXq8CP
+3/iTr4V6QX4bVniAPUVluzAZmfYd3I54nGmlZWi7OtpQhLfX6GHJa+GY73adI96p0IUHdomwfD7o
+tm3/tFo8XkadHNrMii3JQDmVA7R3XSfkM0CQMdHSzPYRHU3USoXwWxJsi1RPG6CQtpIT+WoJjWnE
+vLoWSpPldYOJM+FLa2G0L0WG4wfL4fMEVPITo/mDaky3uw7uPFxEiBOo88YOl++PWuWWrdVuaM93
+h1vzza7bchqureLeX9ttO/792KK6sey312Ej1tSuPeeIFlwHhwrjST1lRX28GXOphfz4xPhI8VBJ
+VWOmS4W0hbshg+akcBHUjpn5ZI3p2E7ZvoMbdQtu21uw0fc1e1xY8bEAIuyUzJB5Tq2wDWKIhaaD
+xRJi1nZYF+57B8J2yOsWIGVYglxr4bYa+vvW0Y6KZdkOc83TCTRYtruq3am7Lhrxs9TB/tQdmHdw
+SeZ9mCxSp9hYeFjT7XabzsOcdsO10fsOzyRKXCnT5VhS+IGVcqmYq+97U+5M7GMsR9/HenNcimX0
+f6k3i+imQdg30l5w2l3PVyf1oWWTjTAZrYMpkFBSxe9peH8BfPrNW+/78L1db/rbn91y1xF4/9ir
+130Bu1+Jhj3PdPfymTDvGfQitBoJOg9USTE8CFElsO7fxnSmqDd9HODm7/31A4XxEVH5zZAfOV4G
+2PVrAoBHogVDg8AmyBdrpUOV0pGJEjvYXLyyBvLFwviDq8EONLQ1ypfLujaYuN9rlO2tkYBgAI78
+svz95nckoOtkAhJsgEOlYmEwYTCD4fX8Ln4TPBEFrIdqvgympuH/0kSLbc9js+t6SGka+69Gdu4a
+7ButH6110dYgOakP6QGGqw1vfqrpqL7TdHDzWkFtuvXZ+fbJpPiGed5valqGv8yObwJCZEgAoSyU
+WRgaRf0hhWd54aiKK4+Nh3cbYHi4Yp+21XytMBIiyyVgFtVDjtdyuv5pNmergUMS/gv9TayFqbmm
+2+lG7bgWRibGRkcmRsM+oHBfbIZXC8XjAZ1mFaWsopRVlLKK0qiil8IN0/PNabfZbDnt7oR30mmr
+8YTTYn7YAAqUR0fKOr+Y/GLxS55fCvxS5Jfb+KXGLxP8YvNLnV8C4wQGpsTAlBiYEgNTisCUoVS9
+GmkIM5YfhMeqx2qGpmlngv2fDMFk6EzDGdELu6Hquwt210H7QHXS9bvzdlPFG5FDhleufXRoEC9D
+uZQPpgAGkMwAkhlAMgNIjgB67bK7VVbdV2FPuac8vxHurUgOD+cPjtTGjo4XSnx3Rf5YZRRnGrXG
+TowYxl0W6mWzkcWpPeV2vHm/7gzVvdawfarVHGp0G5fdlLhBFB6eSHHZtN3sOLhdgLXNa/7Xw9v1
+553LJKAbCIE0KJBI6ugEKqGxUFd1lnStLkNCY6F+LU9Cx1AJjYW6avBcBsuFoX4tT0JnUQmNhbpq
+8lwmy4Whfi1PUi1MYqGuWjwXS2Khfi1PQqdSCY2FupriuVL4Igv1a3kSOppKaCzUcYtaIqlfm2a5
+MNSvTbMX0flUQmOhjtvTMFeG5cJQvzbDc2UxiYW6mmVJ17IkFurX8iR0UpXQWKirOZ4rhy+yUL82
+R4PdJgRCRy9r4ZbS2OjQkQput2CoshbGK0eGRsdKt4iBgiai1+BuAjER/uqXOAnif+UE0rtqSczT
+bOQobOQobOQobOQoYuSgC5SD6LywNP6wyXVhNDy5NkxKRk+TwVOCxLDLF24CtK2wbx96zOJs2Y2T
+hfJCMIZxdbHkWbjbcZn3Qp9RyzyzxPcUKIznK3hcWWDpt4IzLRlu6CzMYNvEfWjxt8PlF7+VRNky
+jLrtkKhvhoLddGds31UnNW1I0wN/Nbg5t+/JQVHCdmjZc51pz59xxDw6Vgu3p+yAfWq+5fp2xenM
+8ukIqY14eXfPY7MY5IhtJsMTAKMCzKLwemgMaTEG7CwtMhh3M6YvcTN2VT8U3LNicnRkYmK0lCwd
+KY7k0a9kCPblPS+skDvEibVwPD+ef/WrI1HAZtg3OV4ZRTZW48y+hBMNYb5g3caNIy17xqnYM249
+nDgGYKiCu3vR0JD5R1yhypfxTV2h+X+wq2uoZXdncVMXUnHR+gNQmyyrEfIG90b4jc1wZzXckj04
+crg4jthl1yfCff8ySP8d1WuTqJcxpKnz3emQIZHhJxvQRyFFS0oZtkBlpFJKCq8KiBFboOK2nP4k
+zBVsPozniidhy92MLSfDhHedGt4OQNn3Wj0JRbvrxBI2QsHjvuwmTs850Y6szeC709NDz/n0ltd8
+af/X5A8IOoN1n5gQ83VwF47udcDOGUseOxjIf5CBr9bKRMaQsnALC7eycBsLL2XhZSzcx8I81kBG
+OqfADYcnKqMK3DDbbTUxeS0mJ+AGOAwTUIFRFp+FLttxwXsVT5nMV8oJGcP1LNzAwkEWhtYAG5hh
+rjGkabjdir+L5Ks259S7PnItdtdmTKJ4JsO99957LzNg3k4pyLAsBvYnss2z/YmX6UPaVf2JmPMq
+Atf0ITDuemnNd9x6UnA67Cy+dme45bh9TjBiG83XolsMnFyCdkFCDspOdstrhHZvj8Kj5zTmPiFw
+y6ahb4bAcZv20Dnh2Q2joaeDDfDGWyeOq7r+D4OJvdjbrF9k+LXvvYeF/8C/gCjy2PsirwgyHHRK
+4cF0G2By7Lj6QgCo5EeORG9AeSI8Cl2GyQkhPZJhH5zDPuhlM11l926WXjZT347qK5bN1FfSFRHc
+lVqhLO4G4JaRci7yHbsBsgerNdbwiG6BZu+j8IFvfehjH7v5W1/+1rdu/vKXvvrtm+/+2Oc/vNyZ
+Qmwdo9aqtZpaHRufUMsjoygZWvIbTDDvIkkMY8Eyv0vNfQcOaKZmmpo2bFjalQeuvQLf6v8NJjRk
++jXjKsPQrrrygKlp2r7+TMHEgIPiPgs+IGPIKot0iUBonkMg3EBK4BOiGQg8Koo+LYo+U0RlOHzo
+YDCdSLBdyDuT2bTambXnnI6qD+2Ip8633Wmv3e1PnnJngmRR8k7xllprug1HHXWnfBShiudbep+j
+WFU8kmGkeH1o/KlBdSSPvRJs+UXKcm1t4uBkaVy/Fl3KoSPRwcRc65FNd6ruNZx6MMlQGICO13Qb
+6hpQeYTAIEzbdaerttFfZjOEJsFwZ0AegEjOgdblewGPORWA8RQ88lSkcNmPLEf3X/7U3XeJHkII
+mJCODPCrJPJtBP2fAOCNiLP4E+m8PPW23vtLw3sZaoXDoXvoNTDUaUcn6W6AASD/ipiBV5zz8YrT
+P14JZWDgc8ruCb/iTml2T4N7AhK7D65Ag3sagU/hzCcFiGuhUJ3IYAf1pGSXpOSClCphzEhhAisu
+fuLdjUw1IX7RabtrkQID7mIS20MpbHkrf0vCxfzYeAUU5v8IGdH8hIoZD6NcKwF4ZKDKFGsJqPER
+pg8m1kXp66LkKBUzBJkT/EPodoSRewJ0LY/JEM5w6+CGyki57DadAK1kkKCqo5NgHV0p68imVEVZ
+BDbhkr7drc16c0WnOb8YTodroVrrTaFgBUZsrEwTyzSxTJOVaQbw4XYtgErleDVc/qDn15GRngS/
+28E9n7xKOJE8+LV3vCC6w3aL37GGxBqj96fxCrou2rwWgtjWYKyhY/OHLDj+7djgD3EaXv12Ucb1
+sc2ASeTeStVaOamjd9QrCr6DbnCvYw591JHIMe7+icoB5N1EIQNQGBs/lg9mL9w4cmxEJjKGlIUS
+C2UWKiwcEO8qcNFjAKohpGthX8OZdtuOinu3HzbldjsPuV29UX1UmGMdDF+tHq9W1KuHsZsSna7d
+detqfdb21auDzsWGK9rtYDmCjcNc3zQQM4JY0Iz82W58xFzgYCz+DOCqxJkzv9+O1AVjv/u1gPxC
+uPogenJXa9XxUr5YO1wqTdSYJHNk7MiNIpcC6imgN4tbGW/D1d8WQAe+6IO/UCzmcftWaO9CYc9z
++EtIp0rHq6qqDfBrMCNQ2CkgGM/XJkrj4bd3wtW1ifz4RH86L09CxcfELFKmAYjHSQLCdCoB7D1D
+4XGwBgqH8+O10sQaqItIYVakiEjhsC3yBJHCbBCpi0jhcN4X5fBIYTaI1EWkcNjmj+oiUpgVKSKC
+8HQEPCxSmM3zCMLDUw7bPFIXkcKsSBERhIdlrh/O80hhNoggPOwRwsMiCA+LIDwsgvCwCMJTc0T7
+YAThYRGEh0UQHhZBeFgE4WERhIdFEB4WQXhYBOFhEYSHRRAeFkF4WAThYRGEh0UQng6Cgf3FIggP
+iyA8LILwsAjCwyIID4sgPCyC8LAIwsMiCA+LIDwsgvCwCMLDIggPiyA8LILw1EpdgT/dNVhOkMLw
+B1MOI7awPEEE4eFvMfxheRBbWJ4ggvAEeUTKYcQWlieIIDxBHpFyGLGF5QkiBYY/mCIiCE+HpRxG
+tMGvM7RheUQKQxtMEZECwx9MERGER5TDIwgPT5kVKTisHHxLRBAenoLtg48QHhZBeFgE4WER1j48
+T95nEYSHRRAeFkF4WAThYRHsLxZBeFgE4WERhKeDBSI8LILwsAjCwyIID4sgPCyC8LAIwsMirH2C
+cnwWQXhYBOFhEYSHRRAeFkF4WATh6Tjdm5ECjY0erRyprYGCJyJjTZEiImOj8yJPEBlrBhFPRMZG
+j7ZEOTwy1gwinoiMjc7zR56IjDVFioggPG0BD4sgPCyC8LAIwsMi3ug8jyA8PKUpUkaPtniKiCA8
+PKUZPEJ4eMroPI8gPDwFj6BGMBCeIx3RPhhBeFgE4WERhIdFEB4WQXhYBOFhEYSHRRAeFkF4WATh
+YRGEh0UQHhZBeFgE4WERhKeNYGB/sQjCwyIID4sgPCyC8LAIwsMiCA+LIDwsgvCwCMLDIggPiyA8
+LILwsAjCwyIIT7sTcA6bQeGnuigw6YzX8FLy2cXhl9J4BxMdfin5/I5fSuM1l7+Hl5LPLg6/lMY7
+mOjwS8nnd37HRdStY1MgytWxKVgEm4JFsClYBJuCRbApWASbgkWwKVgEm4JFsClYBJuCRbApWASb
+gkWwKVgEm4JFsClYBOFBnMFyeATh4SlNkRJgLJbDHyE8QR6REmAslsOwEcvhEYSHZUZ4WAThYRGE
+h0UQHhZBeBBnsBweQXh4Cg7tzhpsw/kgRUQQHvaItQ/Pc5ThHpbDI6x98BHCwyIID4sgPCyC8LAI
+wsMiCA8iD5bDIwgPT8H2wQJZ+2AE4WERhIdFEB4WYe3D8xxl2Ijl8AjCwx4hPCyC8LAIwsMiCE8P
+6i7gMUcKLHDUXeCou8BRd4Gj7gJH3QWOuigsxRc46uJ7roLP2IWjLr6HiRx18T12F6Aujj6Gezj6
+eASbArEIRx+PYFPwFOwaRosEscWm4CnYNfhIoC6Ww7ARy+ERbIogT0BssSl4ygNUF/tinuEno3KI
+qLx9GI3F9sGIQF1sZ4aE2M48IlAX2zlIwTHO3sIRjRHsLxbB/mIR7C8WwaHEIgJ1sZwgBWclfB37
+i0UQnv+XURdHH8M9NooRi3D08Qg2BU8JMBZHX5ASoC6OviAlQF0sh2EjlsMj2BSiHB7BpuAp2CO1
+NaycIGU0IK04C3C6h8SE5wkeITw8BbsGHyE8LMK6hqUgMcEI6xqMsK7BCMLDIjiUWAThYRGEh0VY
+12BmhAdxBsvhEYSHp/xfT3X5OpnAZVC13XZXRSGQWvU9lennmHIkgc4DAe1VAcUv55KXaVBQ/hfI
+PI6KV7kI5OHidjdmglrXbjdsv6He4nTV4kEmcxNu5AchX687nU6gRxMvynDpwWKoUOClotZBRpGe
+DNVbpVASKsElT1sr3pPg4IlQloM36C2auWaR4euv++d7RT4CTxTRATyz7d5I5LgOOn694dXdBjoy
+YZW8APKVkYdVShNMPBy4zhYiCl4OOnYjZ6gsoV9GeqmyIbyj9NLwxI2z98IAtgz76NnzrmEuW5gm
+NS5q2gmBGGm+3XFn2k5D7cx6fjcUJ6H4B/YDREeUbIYbKvZJx2cixWzcJbMM5cNmIKRBMzcmnsMQ
+JYAP1eunVDMUxMtw9PBokBddZgwbQ9pgYn/gZnsIBaFH7JZz3brL9uIzfZVnxirPzOWf7cEytWU/
+xx7pKz8yVn5krvyop24JUTX+rTiIvY96atb7qKdi4aPd/fXqfRKvVu+TeK16n8QrFT7haEzw8FY8
+3hQ9uuEop+8XD5gGJxT3yVAeKYfWczLUDo+FNuUylO1mqCVaC+VyuTwyIoz4JIaAFAYeh1JKlE8O
+PO6JYdoLQ/xX4ZjnN6q+N/g7RjEAQHnxQx/vS3DzJz/wvjP/+JtQcSPD22e/gYMVNQ0IOWog8AAA
+irJTPqIABthowSvqYPDKz7UV9VtW/VNYTf0j3jz7SI10PWfPK4cQyVAenQzb+GKojVSqqDO8EXUM
+/T+0hV3LqWPL8xYkgLWMMsjQatjM1VZfcr3lLWD7YDIF/i53GfuIrqrG3p9re8u93znpzon3Y7lx
+J8QyyafcRpi8DtDTJ2xnxATzApD3BNe/CR6iQgZxUIZKBa7uc+ypLck0MnI1c5gac+/JnYWfCRph
+aSm6KGVdCMfSUtDlOJossf0MMhNxI6Ao3kZtvLPoTjsLjo9pDbtrB8tzvD3zlX5/pLq2zOMI4GUf
+PzvyZrr628s+jt7WVn9bO5e2WCbT0lYVemQ8EdpYVvPYcwiIEZkYUaicECNqECbyB0dLg2iyOJi4
+LGw3zIP2I0dKfUlyzJYOW//j970n9HK2H/ZPoCsztHdTW/MddNuOe0YWHB/dmZ1yu7O47eawsxgU
+yk/uxrGEWlL8Hr9KwZWf3I28D0/nJ3fjjMjv+cndqI9VGa3BkvCHdAdLwx+P4wdRb4EzKhsTQbqC
+WYI4liniqGQTcTaNB3keOLmbt+fSk7s5RiF3VpwsTo4US2PJycqhDbG7iRqaJcmINdvhIQEfevtg
+oubOtO3uvO9sZwpHdK7flxrlRT5rad4wlQOBfrJHsauZSw+A97NOhjJ2drAJQ+EGX4hazNALaUzw
+levQsoltepqz/e6w7zTtrtO4XhR9+VkM41WLz+qIaeTsufXzym2cV24Tc0tAb2bT1VYo5CdG8mpt
+bPQourWrqZORQ8Z1MJl6WKE8aSDYG2CokJ+o2F3Hd+0mDh1G23vyrGV5qrbfXf75ev7c9xrz9dDB
+424YGPjxL79yUfk7/6j/9Lv3dd91UXGscBS3nYjW3Qwbayedbn326Jxa8RpOM1xwqHDmvjMba3AS
+HOgyD8BHYQ4PCgQPGuBAE2expyMFkGGsNhya2m2Fh4yg9+S2g3p5v1uf794uvrYWcE+fER60jRwT
+W1sA6nxZjNkFsBiN7ALWwP7isbI6GS6A2GkkAUeO+H1fhnl7ZwRJxrvQnHlZ5qdaMFe2feHAokK3
+OH7sUKU4wK7RuSicbCF7x7+3hbGViNBFxPsNj12HenPma3ItVKEKFmg4WlnKdqgID/5q1Tvl+FXP
+bYf91TODxM5zGARmoYXohT8OIareH/XQuVMNg5nIiUQ8s2rulFthbCJIIIMCA7AGT2WABKyHDTAI
+G2ETbIYtsBW2w07YBbvhArgQLoKL4RJQ4VK4DPbB5XAFXAlXwX64Gq6BayEJQzAMGuhggAkpSEMG
+spCD6+B6uAFuhJvgQXAzHIQCFKEEZTgMI3AL3AqjUIEjMAa3QQ0m4ChMwjE4Dg+GE/AQeCjcDn8C
+D4OHgw1TUGd4NQ0zMAt3wEloQgva4MEc8+89DwtwChbhNDwKHg2PgT+Fx8Lj4PHwBHgi/Bn8OTwJ
+ngxPgTvhqfA0eDo8A54Jz4Jnw3PgufA8eD68EF4EL4G74KXwMng5vAJeCX8Br4K/hFfDX8Fr4LXw
+OvhreD28Ad4Ib4I3w1vgrfA2eDv8LbwT/g7eBe+Gv4f3wD/Ae+Ef4Z/gffB++AB8ED4E/wwfhrvh
+o/Ax+Dh8Aj4Jn4J/gU/DZ+Cz8Dn4PHwBvgRfhq/AV+Ff4Wvwdfg3+Ab8O3wbvgP/Cd+F78H34Qfw
+Q/gR/Bh+Aj+Fn8HP4b/hF/BL+BX8D/wafgO/hd/B7+FeuA/OABBCKJGITBQyQNaQtWQdSZD1ZJBs
+JJvIZrKFbCXbyHayg+wku8husofsJReQC8lF5GJyCVHJpeQycjm5glxJriL7yQFyNbmGXEuSZIgM
+E43oxCAmsUiKpEmGZEmOXE9uIDeSm8iDyM0kTw6SAimSErmVVMgRMkaq5DYyTmpkghwlk+QYOU5O
+kDppEIdMkxkyS+4gJ0mLtIlH5sgjiE86ZJ48ijyaPIb8KXkceTx5Ivkz8ufkSeTJ5CnkTvJ08gzy
+TPIc8lzyPPJ88gLyIvIS8lLyGvI68tfk9eTN5O3kneTvyXvJ+8gHyYfJ3eQj5KPkY+Tj5BPkk+RT
+5DPks+Rz5PPkC+SL5MvkK+Sr5F/J18jXyb+Rb5B7yDfJt8i3yX+Q75D/JN8l3yc/ID8iPyY/IT8j
+/0V+Tn5Ffk1+R+4lZwihEpXperqBbqN76UVUpZfSy+g+ejm9gl5Jr6JJOkSHqUZ1alCTWjRFMzRH
+r6PX0xvojfQm+iB6M83Tg7RAi7REy/QQPUxH6C30VjpKK/QIHaNVehsdpzU6QSfpg+kJ+hB6O30Y
+tWmdNqhDp6lL76BN2qYenaOPoD7t0C5doKfoIj1NH0kfRR9NH0P/lD6OPp7+GX0yfQZ9Pn0xvYu+
+lL6MvoK+kv4FfRX9S/pX9DX0dfT19A30jfTN9C30rfTt9B30b+jf0nfSd9G/p/9A30vfR99PP0A/
+SD9E/5l+mN5NP0I/Sj9OP0E/ST9DP0u/Rr9Ov0G/Sb9Fv03/g36H/if9Lv0+/SH9Ef0J/Rn9Of1v
++kv6K/o/9Nf0N/S39Pf0XnofPUNBIhKVJEmWFGlAWiOtldZJCWm9tEEalDZKm6TN0hZpq7RN2i7t
+kHZKu6Td0l7pQuki6WLpEkmVLpUuk/ZJl0tXSFdKV0n7pQPS1VJSGpKGJU3SJUMyJUtKSWkpI2Wl
+nHSddL10g3SjdJP0IOlmKS8dlApSUSpLh6TD0oh0i3SrVJHGpHGpJk1Kx6Tj0oOlE9JDpIdKt0sP
+kx4u2dKUVJcakiNNSzPSrORKJ6WW5EmPkDrSvHRKOi09UnqU9GjpMdKfSo+VHic9XnqC9ETpz6Q/
+l54kPVl6inSn9FTpadLTpWdIz5SeJT1beo70XOl50vOlF0gvlF4kvUR6qfQy6eXSK6RXSn8hvUr6
+S+nV0l9Jr5FeK71OeoP0Zukt0lult0lvl94hvVP6O+ld0rul90jvlf5Jer/0IemfpQ9Ld0sfkT4q
+fUz6uPRJ6dPSZ6TPSp+TPi99Qfqi9CXpy9JXpK9JX5fukb4p/bv0Lenb0n9I35G+J31f+oH0Q+lH
+0o+ln0g/lX4m/Zf0c+kX0v9Iv5F+K90rgSzJsjwgr5HXyuvkhLxe3iBvkjfLW+St8jZ5u7xD3ilf
+IF8oXyRfLF8iq/I++Sr5gHy1fI18rZyUh+RhWZMtOSWn5YyclXPydfL18g3yjfJN8oPkm+W8fFAu
+yEW5JJflQ/JheUS+Rb5VHpUr8hF5TK7Kt8nj8lF5Uj4mH5cfLJ+QHyI/VL5d/hP5YfLDZVuekuty
+Q3bkaXlGnpVd+Q75pNyUW3JbfoQ8Ly/Ip+RF+VHyn8qPlR8nP15+gvxE+c/kP5efJD9Nfrr8DPmZ
+8rPkZ8vPkZ8rv1B+kfxS+WXyy+VXyK+U/0J+lfxa+XXyX8uvl98gv1F+k/xm+S3yO+S/ld8p/538
+Lvnd8nvlf5T/SX6f/H75A/IH5Q/J/yzfLX9E/qj8Mfnj8ifkT8qfkv9F/rT8Gfmz8ufkz8tfkL8o
+f0n+ivxV+V/lr8lfl/9N/oZ8j/xN+Vvyt+X/kL8j/6f8Xfl78vflH8g/lH8i/1T+mfxf8s/l/5Z/
+If9S/pX8P/Lv5N/L98r3yWdkUIgiKbKiKGuUtco6JaGsVzYog8pGZZOyWdmibFW2KduVncpuZY+y
+V7lAuVC5WLlEUZVLlcuUfcrlyhXKlcoB5WrlGuVaJakMKcOKpuiKoZiKpaSUtJJRskpOuU65XrlR
+uUm5WckrB5WCUlRKSlk5pBxWRpRblFuVUaWiHFHGlKpymzKu1JQJ5agyqRxTjisnlIcoD1VuV/5E
+eZjycMVWppS60lAcZUaZVVzlDuWk0lLmlEcovtJRusqCckpZVE4rj1Qerfyp8ljl8coTlT9Xnqw8
+RblTearyNOXpyjOUZyrPUp6nPF95gfJC5cXKS5S7lJcpL1deobxS+QvlL5VXK3+lvEZ5rfI65a+V
+1ytvUN6ovEl5s/IW5a3K25S3K+9Q/kb5W+Wdyt8p71Lerfy98h7lvco/Ke9TPqB8SPmw8hHlE8qn
+lM8qn1e+qHxV+Zryb8o3lW8p/6F8T/mB8iPlx8pPlJ8qP1N+rvxC+aXyK+XXym+U3yq/U84oMEAG
+6IA0IA8MDKwdSAysH9gwMDiwcWDTwOaBLQNbB7YNbB/YMbBzYNfA7oE9A3sHLhi4aODigUsGmkAB
+Hk2AgAyl4pGSBPSMTIDAAEzkK+NjZQoABAhQAKAAmwkQ2Ak3dTvuaKdjNwuOP9+1nfJo5dDwDRLQ
+XxACBDYAUAAZAE43vZMUAAgQoHDiwRRAJkBgAzzh4QffUjxSGgEAoACUAEE//G0nEazucE1VHitd
+EVr78/Uz/jAHW4tcBKlJdSJfGR8rHxtNDN5aGBs9qE4cL6H1YWm0EMuXXi2f4DEluP4QCinZKatr
+4KbZjtMavkE83QBPePjBtxSPlEYQBpEqQ4Kf28qYdAqJx4gnBMKoBIlBvGHnMxB4jAT0F5twjbEB
+mW0CaNpM7t7al4wCBgJb75aA/jrIjVl57ufujCVjKTz3zudipdlZEzKA9OS3SkDvYy8zUYQEb42d
+knfPPffcI6DF14EoQG/d+MzLUJBH4fdnUPjx+zM9ifeyxHt7E3/BEn/Rm/hLlvhL3KjMTn4agJua
+rZPDNyCAMvauwvt3ABKsnwdgkF3XQEJEgpQIxkwmkxFm3gSul4DStbhRUIFypXikpMA0v5Rb/I5f
+ypUGf8Yu5Ra7TPNLuVJs8/fwUm6xyzS/lCsNTJzml3KL3+Gl78MO/7CjYAF4wQ/jXaWBl2l+wQ9j
+zhZLxA/jXaWIF/ww3rX4XaXB7vgFP8yeNdroswYbDzvmZsRB1rIUbr+dAnkhYtQOiMTNpeJYRX1w
+aXI8OsliKyQa7ZLqOw3bOdx1OnbXLooGHYTxfPFIaTRfmLyueCQ02pfhVWc+h73IdHnroDjel0GC
+oxRt3dkZAhchLifWXXjmzJkzgObeTtHp2s3yk+Bn8N8QbIRH0QgMlUZHyseTR8ZGaiOTpaPjuHiX
+cR8GBVWjgKbGqkFBNSmoFgV0FaCmKagZCmqWgpqjgH5a1AQFdRC3oLERiC3z1TMS0MfdhM1B4asI
+OnvERgCOIIFPazmmJQZxAc4GvYQo2TMM2QvyktEJwXhjozMayxSLX5qbLp9bWj63FOYWgG5mREZt
+tEt3TI0lBtWbwkPo1vMqXHFFYjBKXBeMm1gSUqBwYy0FdtI0a5QBJE2cOrH7BL9HooYUK0y7/PLE
+YDzfWvji/6iXFo+UAjt3FBQI+ijA5lvjHoT9MAjdunPH1Jg67WE1KPDk9YAIGCUSzNvTLQwAThpY
+N7FelcG3J2sUYAjz87ueXpOXb1ncGIAlbg1aFs+ZZcQmMaitYWSIR5D+JDROmBKIHAyKzSj16/lR
+wB6XcN/nGL7KKFqCXxi07L3+chRALAuxcIDfslmNDbEcZE3jus5J3zvVcRfstpqd7s6rTsd2mo6v
+Os2pea+hnvbttjul6kP6ZLJlO363drQ+uk+0vAx6vlQOKK2Mc9loZeLw8A1rkAZ3Z1nkwcWxgyxy
+uuFNcbK8FoVOGwFuglGowAQchmG4gd03ocWOKxP3D4YijMHB8P40NMCDKbyXgK5l/TgAjrvgVXCf
+MU/YDG3P7fjOpNpyup3TtYMBpkggQSkxKEOilBhUIDFYYvh5hMBjQMLDuyXQUSGiYpDAYBADDTUw
+OgYqBgkMBjHQVHwDAxWDBAaDGGgJfICBikECg0EMtEF8gIGKQQKDQQw0TceP6xKoGCQwGMRA0/EB
+BioGCQwGMdBUfICBikECg0EMtAQ+wEDFIIHBIAbaID7AQMUggcEgBpqm4sdV/LgqocsDCQYxpuHe
+IR0DFYMEBoMYaKgI1DFQMUhgMIiBlsCiMFAxSGAwiIE2iA8wUDFIYDCIgaYl8OMJ/HgCP57Ajyck
+0HR8gIGKQQKDQQw0FR9goGKQwGAQAy2BDzBQMUhgMIiBhpuU2O4nFWMJDAYx0LRB/PggfnwQPz6I
+Hx/Ej+MDDFQMEhgMYqCp+AADFYMEBoMYaAl8gIGKQQKDQQy0QXyAgYpBAoPBQTwUQsZJhML1j5Hg
+ejWkglshUZsolQ7XivnSeLWmFo+UrhbjjUC40WoDJMZLE7X8OMuwDuTfnQH4LeOFcHQNKLdW0TCD
+3IqcJqMPFJ74e0FJBuEmu+XM1jvXNTqLwzdsCG/xbp24G76BzXfIphHYgGcjsvP/1wA8e8kDxi6u
+eTYOsjNnkFxuAE2P8kvQkyxyx4rBKTSeSzPCXH3JyEMiDLxV5IBsXxFyeDHOLsF5vq8C3QY74ABY
+UIVHQBceCS+AF8M74G/gI/BF+CZsIIfJCLmFjJIuWSCnyCI5TR5JHkueQF5OXkleRV5NPk2+RP6d
+/JT8gvyS/JZcS2fpPH0SfQl9OX01fS39a/o2+nf03fRj9NP0C/SL9Ev0y/Qr9Kv0X+m/0Xvov9Pv
+0R/Qn9L/or+go9IR6U+kF0t3Sa+X3iT9o/Q+aY+8V75S3i+bcld+qvwa+cPyl+Udyi7lKmW/coPy
+YMVT5pVHKY9Rnq28SHmp8jHl48qnlc8o9yj/qXxXuXDgCiCANB4njA24VRIoHcCdlYNAPyzdLX1C
++qT0Welz0jOAbmCS692wD5KQgVlw4S74JvwAfgj/Db8AIRvdRHLs7ynkKeROcif5DPki+RL5Ifvb
+R59En0K/T39HiTQmVaXbpOPSCekh0kOl26WXSHdJr5beJ90l3yXfJb9BfqP8Vvm98t3yv8g/ln8j
+/07+vXyvfJ98m3JcebRyj4Jrif7fE4GiaP3cABTgxQGMwFsNuDesClocqHVAd8Au2AuPkNYB3Ql7
+4ALwUYEEAJuA5lgL/ZA8id4l3xa8uAboxaCCJ60BeglcCnNBdvyt8MrZfhLQRwelr/6TACpHWLB6
+vtV/EtAvL/O59UD/Db4B98Ah8lkcgCv81gDdRR4l/X6ZEv73/ySgh0gUnDvAlwB9On0GfSZ9Fn02
+fQ59Ln0efT59IX0RfTF9kFRWDilnzbD8t8762lkz9Jd71hf++Azik+uAbic7yE6ye8WW3AR0PzlA
+ribXkiQZIsMrZry/fmuAPpx0CTtMeMWfAvRsWVb+vR7oEFwHJTgMI3Ab3M40cqh9ey48D+6Gj0CW
+TJJj5AXkheRF5MXkJeQu8lLyMvJy8grySvIX5G/I35IPkw+Tu8nd5CPkI+Sj5KPkY+Rj5OPk4+QT
+5BPkk+ST5EH0ZnonfQ19LX0d/Wv6evoG+kb6Jvpm+hb6Vvo2Oi3dKd0pfV/6gfRD6UfS65V3Kn+n
+vEt5t/L3ynuUf1Deq3xc+YTySeVTyk+U1wMdegDUT5AHWvUBXH1gWD1ArO4vusonBwnonQTgGqDP
+JM8izybPIc8lryJ/SV5NfkB/SH9Ef0x/Qn9Kf0F/SX9F/4dphL8mX4Oc/AfJRRJy7h+RPip9TPo4
+4+A/Jf2L9GnpM4yT/4L0RelL0pclVjacR3YJ6KsI4PSGULDXzx00Xin8XQ9UzF4fZrPUR9n8hFR0
+pTnpTknMR/8ffRnrHf3Oq0/5a/pSNMjTg3QlVLhZ+pqcOd9X8lJBKkpfC74ofudZTEkqS4ekw0uK
+6f8pQEt0VhoEiniK+Il4OXx+MCOsByJ0Wgl9BPI8kPU8WoBJep8cYK4E9DmSBPSVGLyBJUpA38Qz
+vRMTPygB/AG994I/VKxw/4gWvt4jXJCV2xQuXvhfChbrk8/zVv9WuNihAGP4j0NMAvpNScGp46eo
+Jb2T/DTMJgHF8zRW/0lAf8W6t/8nBdqhZX6fAvpL+BVQIpENZJBsJE+n0d8zYn/PjP09K/b37Njf
+c2J/z439PS/29/zY3wtjfy+K/b049kelB8X+ykr0dyj292nlM8pnlfuU/8Oqgx0mAd3YR9bxJwFN
+LpPc/1OAHpIPBxmfDBS5fVyN/rHr0JXI9bQUX4G+U1l+/fm/BpA1yBYhRxRGogZUgE7IR4OmW/6n
+AH0onaLrgN5OH0ZtWl8hKgEdZp15f/72AH06vIC+gr6DPl16hvRPyvuU9ysfUD6qfExZ5dFSCCSg
+z0cIlwRLs/7hv3VACxSkXZK6LIVa6bcOJa0/JES6S45FV8r9x//WIJgcyCuACoq4mnTvXL+pYMnx
+yg8CRQ4fOXvO0bMJghkKnfNPAXq9dOOyLaoAvUG6adlHElD1nOHu/T0IJ6ynkaeTZ5CVlypYpfhy
+Bav3ZekflbuVj5zXZ5NA/5K+mv4VvUx6svQc6ZXSG6Q3Se+UPih9Xvqm9D3pVxKRN8pJGekbDtTz
+zN5bsfvjtwboZrKFbI3RkNV/a4C+QvqA9KFle2nlnwT0q5yP+P6KtZCAPnIFONYDfZL8ZPkp8p3y
+U1d8//+0n+Cx7s/fGqDPlZ8nv+D/L42oAH0Kvfu8ix4E+inyJfnL8lfkr8r/etbX1xGK2t2NTDu4
+KVC/oYYQdYSoJUQ9IWoKUVeI2kLUF6LGEHWGqDVUyAAZIGvIGrKpR3vI9YeooEMdImoRv0S+Qe5h
+msQfkn2Uq+ueQj9LP0e/z7SKXK+IyjvULaJ2EfWLqGFEHSPXMqKeETWNqGt8X6htfIP8RqbWe6v8
+XqZ1vFv+F/lf2PLgx/LP5d/Iv5F/K/+OaSF/L9/LNJH3BcsFXDA8WrmH/T3QFGFTLDdInvaHLvaw
+/+9vHXIcwHVAf8c69j75yecPI2IoYuf9q+MW8ElA72JjUAJ6RAJ41vkDGA2k+x/Me0JAz/6TgL5u
+hQll5d8moP8J34Wr6H56J30qvXTJ964H+hDyUHI7+RPyMGKTKVInDeKQaTJDZolL7iAnSZO0SJt4
+ZI48gvjkBdILpRdJL5beKr1N+qNeXhnolX8K0Aysro+XgD45bKYLgF5DMuQgORx049vYDqqfkpfT
+V1I8ASYUpq78zegnAX3CkgZc/ScBdZC1DwKRWQF6mr5MDi4iNfqtA/oBghK+25Hrvv+iCtCX0L9V
+JLxUcC0qZMn9mtDzly0/UJzQIj/QdnSpYjxSQkRY3v97AIX+cBQCXKz1CxJXWza7y8sducTxmeyP
+Sxm5fJFLFp/L/rg0kcsRX8j+uOyQSw25tJBLCbmEMLn0U6tBtg7o2+W/kb+gfFFBRyvs5ouKwiMS
+UOmcaPAg0HeTlJSWslJOuk66BKhKLiWXkX3kcnIFuZJcFewlvjYwfjlrhhrQp5JIZfe8QJ/4V2yn
+79vJh8jXz6JUujnQEQklz+lAzaMAfbyy+sTy/2Bn8UFJQpNGtCbduGYNetcZtlLmBej6wu2eVgte
+s+nU8TRctdb15tRRt9PFd9fBYnK61R02rfRu4A4D0H++11bxlKmib59y2zMUcinsW5ZRy+yF2+bd
+Oh7XONLuOn591m7PiDMk0djPnptrunUbPzb8CHeaA5PLprbDpN1ccNQJZxF9ggSnTgJcDG7LnnGG
+F9qNoQXMMdThJw12eUZeQFbTt8BEvqruP3Fc5ccTzbcOYB34c91KXQf5uu9N2V21WiwPH1eTatXz
+uzYe5ln06vN4zGTwUTWplhYDwPXrDE3T+wGfawSAG1pmB4xNddyGO99Sq753h1PvqujmLfp2NmXs
+hJrju9OsyarN+Q6LuO0ZApZoOiNt7gva2Jvuqsc8/2RHrc35jt3ozDpOV532fLU4VqNg2uIdPZfb
+ApVq6VDSUisOntTMv7ypp5VbcxZvhJSWWQ/8gIZy0+7MUtC1i3qyLiY7s1795Cl7wUlOYxaBAXpO
+34M++rCK004HfbXg8U9BuwH0FoN91fS6850kHiw553trA0TSjb3ATudmtTnmthveqU7Y/BKYQ5qo
+Wy6NwM7NNZ2a255pOgT0HT2wIiI56HJoLUM93UhrW6AmnIgI0AgYokTdyl0Ct0yMVNX9t1RLh9QJ
+t+k0AjeN1dO+3XIbMYzJpTKXQNH1nXq3gidLqTVnhuMIHgvCz0TFPhZfz5p7oFquXVd2/U5XLcx6
+bt2JQWGiPzQcInru6jgiqvpQahVUlEAfSvV251xjOvimlkvvhLLvOHm/rub9+qy7EI0b5IiD0ZvL
+XAkCoRm+R64pg4qo+/PzDdc7IIExpK0HG2+GF5On7IXgU4ahX8bdxTn+NKI4YmPFrrvtrteZDeuJ
+Pbh4QU83IS4wLOAvBuXplrYeJp161/MZpiMqLn1vgWU4hUMheE+zUhfAbVfmcXw0EBnRtSUOjBAT
+o3pbhrkJbrEXbH70N3NPBbCzB7o77AW7w04G50PENFM7oIiH/p5y7AXHV0e9+slgVCEe8RbNbIKy
+t1j1PRUPQJuyOw42XEgFUxljLxS9ltv21OOV0TCTWlqc83yGNQMMF7JZCaqF4wTM7TEq98gpf74z
+OzRXXxSVzuT2xkhD1fdmfLsVFitDZghPaBNj1dCze6BUGx9BnBgeaU976H0LT2w65LsNxFleV8PI
+XBUrlvXDEooTjFEZEcOOta1lbINpz29dfQLhiVG93T3t69W7TjfZYccoB7XRjeweYHVAOrLgqId8
+e27WrXdizYwDJWWY10DFrvue6rQRbRsqn4IC8tgIsU4dGzteGd0AhqZl1LH2KdtvdLQeMBAJW5wa
+DTUCVBlqYdklXvSQbojm063sxTBysKIW3c5c0z7N6VWxkGdTUx+Q6ZS5E0aOTJTGR0dq6oRvtzvT
+js8yIUqYiDNYmVw6vQcYNSt68zjljDt8GsMBeJLRtsj1FKNs/JzpoKeyxmZoO91CsZw01ULT7nTc
+OsDWnjq2nW49JA2mqe2G/ILjn1aLDrrJYsga1Dw262TN3CUwNt9tet5JRE/sE7XsNRuOH+IXYkzQ
+d5qhXQU15AE6XbduN9V8226e7rgdtXa603U4Tqr7j7bdxQMS5IYMAEH6cUzk57secg4TTgsPtnfU
+itOeZ42F3+B1zVjmxVBo2r7bYRg5zFqNRaNZMxxq6Yx2KVRvLdTUfRm14J/u4tBAbFIrTqdjzzhh
+6UENDCsnQ22sNiKBNZQaBOQiGHp0vI4bQMBmnoY35Yhp0tB757cl02RQuK7ndsEt83Onu46vVk93
+Z722esTrOlOedzKqoZ611uKBUS1nrDCOFDOLrYRYouuaGZ7Rph62u/VZtWp3u47fjl430pq6ZNgK
+OsToch+XkM6lLoOxua7bcjtOQy3azQX3pFpadOrznP2JJjPeACkrvY2PvBZnKnzHOWy3GwRyEQXQ
+tdw+qNXtJg5NlZPyaCgXvBbDJacBMBjQts7CzDWLrSb/hmVmLoKiO+N27aZ6xJmxu0gKOCTq/uKR
+Qwdw6gvn7kw61c+65M6xTyxLBQRnvub4Cw4StMJIJUIPPD2OkWQx/o20fiGb6mpd22ftWakli2O1
+kNxISG/FwDaM1OWr90ZIQc0hbUq8ZunpXZweqAXPd1Q2/QbVx4prUUMblrYn9gXuzTnscBmpXiy3
+mcpeDDW36da9dtQdK5BYU8vtgrE5p62W3bbdrrt2M+R8ZdCHNGNLD4lZTHrTi7z/ckZqVwyqSbfj
+emqt67TrbhNh0s0DPa8GFHgB8w11eL6hlu22I5TIGuZ2qGqaWkhbgkVZ2jvZ3J5lqHNI2cyo4Swr
+uwnGnQU34Kbn7LoDq8xPAgHMjH4hHH8wp/vI5wQ9GEMACzuIN4SpWxuh2rRPq7WRIu9HAvpgwEPN
++Z2hjtsIsmrmPo6KSB/CPqk4XRv5WHX/QRen6QMEdMEP1Gda16t4OIPrtW/UAzJjpPT9UMIVm++1
+kQw3T6sFx++60y6O76At1P2l4ljhAMAlSzrC6XbcIZsdl3rNI905Dp2eyv5hSyTzOkPTjGWWSAGT
+o++HYKnYuySM+Oiw5gMwruvDuiFIBmJNY3Fa9IyRQ8KEjutmfHt6MZjaHB+JqB51vJGzNnHcDLL5
+9inMoiGd5RycZe5lYzzgStWaU/fQhfppNl+sgdSQPpxC8iM+beaMnXBL1QjWDnjcpzpn+11VP4Af
+5mvUO+YM0UVWNppm9GWnmXQmszdYFlbtGYctC6vzU2JxzIhtUJqWtrZAtFq1u4znJKALcpJKWTt4
+jRl3VFqsO00VD0diHLnWy5Nho7Y6SQcz8a7P6LltUJlvdt0J366fdHz0wTjfjM3K2WwqAQfztZEC
+a6BoNtJ1bXPPXIGrRgKZsxDnsFmN9JVw2DnVdLpdtWrXT9p+Q803Fux23eEDEOUBfd9MpVJLa8s4
+ReS5FleubdCcupW6ECr24imn2VTHnTYyO8LrZfipIK9hpXlH4jzWwyyIKuhmdivk62z+YlOMz/oT
+m0hkMbTsTjieFHSk6i627DlO4iQ4rmubAvxZTC7OsWdBt2SsvknPyJ5juxpGdgubX9zFU7bvqAfd
+bsueQ5h40SkztUeIAny75TDv9L0Ebu8SsjGNOVv2ScfnhRiZ7C5gUp62010yo26EFmfBhv3petYw
++DuZbGo3hI0tVqXBzLcTJyOfyYbspuB8VMPuXQC3FsMFcDa7EybdzrzdVA8iPVP3Tx48EHYh/2I6
+lbsARtqN+U4Xh7c3326wL3A+2ulIYCyGfIaRyVwUrZ0mXeeUWsPTcxl9Hmk3nMWoDTPpbH/3GGfp
+Hg6RbqWvj8sAzl0YZSOpNZchtQLTdDO3EY44xyeGa/NttYOVBRAL+ilsIg5CSjc2AHf8yWVWBLKC
+mFgpbX18bS6DbgylVl2d80KtdHYbjNTGcOV0kqN3f1+kzUtW6wtcLkcNnDaycd6KUzU2zpPBsk3N
+NxrJkTYyHFpmaAnCtgIqN2Q3Gm67f8HHgc4Z+jrIt1zfrjid2d3A52B11O12m45aajdcu60a8elF
+N829eAR6E717lBbrzfmGoxbdOoo0bf90BL+Z0/bAqDejBidTd4NTOjhXg7SKzUiM8zdy2R1QsJsO
+zkJqgZ+mylpPgkwyi3MMn7lMXdsGyDM28ei9iXDM4mdDLMilE4gFXbXGMUB0bU4zE3AQP+L4qlkc
+gLSlTrndqHTd0C6ITSR82RXjgXDVFPK+mZS2kxEZh+eLSSsJpMNvpnObYdytzwaknLF0BLTeQe13
+A4mqnkqbMGHPzISyuTijgKCEFKdsL6r7J0bK5WT5+AGAREBFu+50UFbKSq2wkImWXEYqvRMq9hyT
+k7B6IJ/IPopNypHE0s2LVl7sTLjt08i0h1Qkk8veBBOzjnrEmfc95A3cNjI/KDDB82c76oRTn217
+TW/mtDrSdrtusPyJWCI2uQdzkJ7V1kHBbTstW7WKFNLXiLY1TWMHhKIeRq6C8Yz8zqKQOaYzV52d
+BWNIylZdVh/7xdsgl7Iug3zDnmMzHeMWkuN2F1dUDWfKbjc48wuwIeB97ZafPDUlcNKw9D0xzFpu
+GcOkBWw0mKZ5GdS89mm16tZRHXDIsX211kW5pFqd9bpevjk134o6SE/nzr4oZkuaVNgo2i7gJCWX
+Yh2Pq3R1/2Kzc4BABllFBoqWyu6G2pF8FVsWpY3IYttdux9Dstr+HpqeX0WqS8Gc6kX/uUZEwnOZ
+XYBfKDedRfW2eZThTNgz6hG7FcNIU9O2QmHWZUxSNHMTVCgItNG17RBIIXABzMTdiGJIeaxozBsZ
+YyM0DuZrpXBVSWAybABTty6CQ6NcuNVymew/IGHqfuTOGM5om3uo7x0dr83RJm0Ya+GQ4x1ypnyb
+QKp3hkRedMbxZvDhEE6ywUsoLeIqk+V5YwvB4wQxl9sKtfqsgwzrNWrBa3ftereDqCFQT08Zg6Gs
+YdJtOB6+vYCR4cYC/6Ke0pG/P76Evzd6+HshktVT2iBYx9WKt+D2E4uMmdoKRa85dVoVAo58IckW
+pjYi8LBdN/nSKGUspYxISDK9yBHSxrRuyFDxfCZMErUzsuZmyCan3G4wz2CfIDVk3Opc03bbAUIY
+lr4GymMMB7B9gmRdy6yMJ+ESw0pr1y+jL0RP8C7OfGrRmXaRlHkY5TJ1z1dr3dNNR605bH4KPqih
+DuTsUsSAXY4EidOt7rCuWdkNMHZoXD1UroU1EW1hZnFlgAoxHYcrE5PXmPAZG4T3eGvOmRH5DdPa
+Dsud2yVBCuc5hX9zIxz0PbtRtztd9Vh+srQONPWQ03Z8ty54K6Ys4ahk5fQbe0jBueoamV4ymZqb
+WYbB40UbWvpiqHX9eUYUGwF1GLXbM/Mo60SqgR3LM+csY13A4+ddH/EqdX3PIMXBZze8KWfIdv0k
+Sp0DxWzSbXe6NvI3yTlck83ExASpjLYPSotdp91xp5oowu3aeDKLWm3aXZzg2DKO97doZj1rboVx
+x25yMZcQeDBlDBsRCInfTvqO3WQJfHhksuc8ba1jYrAkCiIGAzYAy2xES4VMZi/kJwuHi+rE7Hxr
+qm27Tc7Qh0jEP5o29semKT5B4Gofyzb75gkK2eMrrzZ5L1hG6lJgREcdmwr1JOp+hqWG2pmf6jjd
+A9hrQlKeS10II+2Tp3y36/jDKD/u4pqrh9MLRpJuGhfBEafbqdtzjnrQ8062bJ/rqwISzQtmI8fI
+WsupKANpAmbkEKeszLJcE+roBOORy6T2wC12qx7IC1aSG5hW+opYc+KBU4yHW051qA9pvRqNVgcV
+h0FVNT119XkQjTUQDFABsZU114NTnZ/C77dQ5Lu9ZzA4c/NTkTAsZRnb4FAJ5cSBcI7zrYyF0HH6
+4JNPKnMpTDrt7rxvc+FNZ9bx+6XhUdPmzEzfkNTuhyFppTNb+JIAJV1CS4IDPuwvCyVIbNXQm4VR
+Od7taVOLqwP54hBPhcDO8r1wyVWb9U7xBVempwFxvLU6yTk8TWIO3xvqNN2G05n1TvWvvASmp3N7
+mE5odKRW5bqgGrPtUJFLwVYTBMTSre0QzAdqwZ5yUeDAM126HBB1eypZ94QKgtcvZWhbUb7Qnjg9
+h6Oj3Q1KWAvTXrs77HUjFiyV2sIAQ2VV2W221HGv2ewFyNjGdMuoY+3Ru/fSA9QvJ22fZRGVMXK5
+nRBJg7hohi+XpT5p0BQT23D401nzYghEYw21PN9mC0616jsdp40qOS+mJEpp5qr6RmPHavrGbNqS
+wCxWCCraBNh62twAyAdH5FuMLd0w9kGpXbfnOvPs1By16nW6gdoduykYPYiSRq8Cfs7rdDmzwGuZ
+zeiXBpMWsnIOnqiJehtf7VXoLRVqBzNZw5nrRjoFS7PWRTMP4Xwjo4amYW2D2+Zt/+TxKtN6Bgsn
+RG09tRSrWFYeHq/iCxxeC7ljPq2xdWlgNtRfaaQY7LN6Jr0bjsw3m0xQy3kTXByyGQIxjBeby1gq
+HPF4t65UMsBSGV0LbVWSbXc6RGYjheTcrftcNsxEzEilsKK45Ar7V8teDbWJUXV/KKiYcDodp9nk
+4jLBZRzgbGYEa9rSdnMrJEMtNZ0WYqN/OqhblE039AugOD42UlTDk5/620lby7nWxVYoq82lNsCJ
+itdwUNpywiwSVPEEc4Kha1vhmL3gTPs4nMcO3sIKjL6ZSmW3BNg00myiBNDuej5qprXV0dCyslcH
+L1aXWIkV7K7d9HBB33A4+yCBiZyyaEpD1zeHGiShj5FgZGQERwyfOYxznhfTSMZF0Xqv3o3zJxOe
+15yy/ajepqVvR6l8YJ5THSlMCAJjDGkbAg5pMTnn1oNxZ2WNrVyWo7rIR4SzZC89W0y6uEzu4Ewp
+QLJ0bWdInI84p2KqZYRIEPtc5sIYJ8ABHxstqcWDnI3FvAHya+ltMGn7rt3uqgW72QzJh4EyDZFJ
+XyqpikmgJEgnc9jcOOyMlHVl7ONMzINhA0d93emgCVEwvchoF2DjeOX9ZOoXxiZOXEfj4EchADd/
+jMC2TH0jz6qOD+WHJodKQzHrMyuXS8Cku+BxVQHAxmA9stBuJBfcBY9XK4sGJXzlix+o9hgLhmYP
+upnewuYygVxs0YfkVbtqyXzo2R23M+TNOe3Q9AWXhqJfUsYK+jkmqAsWcKXFrm/XGTfJ4czljHVQ
+O5zXwxEXjEnTzOyB0VK+iEjZibUXMzqLWiuja/3LqktAZ8ur8th4JT9ROj5ROlIbOThaUkvtutdw
+2zM9i63ge0bO3AxpzcBVbzjW8DMCPfVUbh1j5UIAxBMjZW2LYUVIGSnkMgJzTD27HmpOfd531OId
+k0ex31Cgxw1SG3cszPP2SGeyq2nzOO8cgKyn0jtg1J2Z7Z7yMWQsFWvHmNg0Y6bXQyGyg1kPurp/
+hi86DwjodCN9abwGIe/VwxasgVwmaWiauVT20sOwCfBM/Wo4ZHftdii/YNPHjG/PzTIwg/Go7i9W
+zAOMIPNGMFJmnIU8hoZ78TGJRKV/nInOMM3cZhhFo1FmMhNZg/FlmZHaFHJkODDc9gyKsbTBeG+c
+mgmROndxmH3U7nTVmr3gNNRR+7SDMgm7yzBRfNswcoEii9dx3O50HX8ZhMlmVzU+MGJCtVxqGR00
+sxsQzZxJ7YR807U7QrB5zPX5jMgmKNHHqXRGhmPOVHUtjHqdTtPpdJA6IVnTtUx6c7CqZcop1jtx
+5Dcy6ctiCHLM84P1V79JS2ootdziK2whtK8uFEYmJtRDQxldF7JeAWQuldoDRe9Uu+nZDWYRxDQP
+HZFvXSD6ajQ7okjL1C6JgTY2HxihtXBlHjB7IeYKoa1lXgi3oPEuY7B6OC5Grvj6LBEgxR1zzkzQ
+2lrK3B5M6JxbY2yD5zMGRYBk6tbFoaBwwvF9220jS7PAOSBcKmPjcmS3DG0Z3mIdLqnUYTU1lNrZ
+Q4kjRjeAyMhlVtT4pJIx9UlqD3ALZEEGYyoDBCeEPpW5AJUU7U4XhSqqnk4WvKY37wdLDMzLQTfT
+WRXytep4TR11GzY39cMpOW6sz2YT0b96+uwTKOpmimNMyq6HqpCUqS8ZuCis0c1hQ9N7NQ2nZjh4
+aUvfCaW5jtdWx+1TfBgGJCeqg27quyBcDnHzQzRd5Gv73rYPlqZ2RyiO9ZzZz1VGgk7N2AET/rzD
+bJXa6sShfNCAjOCEY0/XtC1wutSu837BNZnLTSYEEbJSfTqJmMmqMIGMYbjAi0x2E2p7kLcU03uM
+xlrZ3A1/hHTRmltRuJjNZlSo2dPOoXm0wii16/7pOTzAd9L1u6hiL7odJnnmFNk0l3SsBOO6uYQi
+M0plZMwtS63zKeghj5bWzfUwnh8XK2omgt3WM4awH33bDxpK07QETJw4HkON4ImRzV4KI6Xakbw6
+WkmmTa4yajld362zUczIR4RLOSt7CYzNzKi1OcdZVAtew6lzDVdgAMk6VtAwb2ZGDDndSl8Etbrb
+ZW+1u2573pvvqBNeO25yEUBl6hqS8LrHixNDK5NKpWJ0kJFo0e8hmqj7q3aHicOQY+069a7TOBDi
+zmqU28xZVzFTpx7LfUb+hwOWN/wcNrm+VNGPUrjAUCqoim5qm6BgFlk7xtqfY0Ymd85y240ot7WG
+DU1LYZDuQZ5QeKvncAl7eqSWr/B5LsYecJKRTaUugqrjdzy0HMm3606n69vcmkfdX82XD5Cetb+2
+vWftL9qbwLmv/A0rHbdO4MwOThFTdofb4QbNiysKLb6iSG8PlgmFyvHYiiLCx3Tu7CYrZkSIdH07
+rKTnS4XZDCvDLZmYDn9ZkzRL03dAJJmq1X3HaavF+dacBMf1mIWdjhkP2/4CEorQkBElg7gSDmeN
+TC6zIVhUVn1v3IlJQs2svhGCTRSB5AXrLwaWoZnqEqwN2jPsLURXY1V0FcVZlrUjMAUt2y23eToc
+WKsZhAaoZerboOo1T894aCfbjOM7z5FJWdcCY5GZUKd3BJ/3wOVjCPeuCHP5kN/Vh/qpKwdAT6fP
+shbWk6HeO5fdA4dPz+HZ7ItdtWL7J+fnQuEOdp+xjgthZrvCWDuXMS+D4kRNLXizjo8yuXzdm+90
+3XpH3V8s5A9wngpAmL0ioW50AxGZqemBBTYy1SG2CDEiFycRMNcBX1jVZyJTXMPYCsxADTcBhaMr
+FKXg1JLRtFXtuwgOfN5OOdPaDjW3NdcMLdY520ggrjZKdhYCfVvOyMXlBpNovdwnDhcTOYrTdHNZ
+kThjJIa6waaHXmG4MIEOCKthWoNQa3EjzEAmeEkkKBjy7caM3XK6Xc9rdoY6PGOI5Vo2AZHkFfty
+RTGXwtouvZGLzzy/JbqwZ43Nm820tJ3B0gxxu4r7ngIj40HIZYYrbrPptNvufKtXb908NRdUS0tr
+26A6i4RxrO1EXB0OeY7vudT+Hq5mdfMJ3e79UrgfU9czG0NZFJesD6DhvXpkQqDXVCsQqKeyViS3
+4tsnBU4iXKJV9VzuKpiY96c8tehMzc/MOL56q3O60/W9k4467tQ9H2UTbCrseQ/3I95iL9jMKrTp
+oH3rjAMQG13hJyxrVzAlMOOWJFdJhwIL3g1pPRdZUiB3vrh0Pd9jSyGKNy29R6HHdzV6PoVqoZdR
+Xkw2XNzx6Pm8V1LaUgOFNbglMakPpXu7wO8K5lpL5XYC8jgVprZk6BIoGZk8TLDHGfPiGNUU6798
+o4ECNqbMxObkdc9lmJXWjFu3J71Fp6lOeou44GihIi+a4rTMIDcbqpVvDTuEl2BZejLEDN7bbHyp
+OWFvzFgrtEFR9x+bLOgH9sCKj0LeLW32mYDqZ7N85tBksrij2uYn7fevvHaiaG+pGWyfyVAL2SMu
+ozRS6wHPvg8wHnEjnINTutlv6RnbxWOlNFxFeVOOOtLmO8XC6TVUofaKgL3Ylj5eGSuDhXROMwZ7
+kUujPe9k2AEDjNYYWv/G1/QqJlJYhz4MCwd5JpXeDCe8OUd12IbKYGcnDi3eHrplnMuMowuSUJ9p
+CaTMWnGmLiZQCxpHkPsINTOa2S/I3AiaWi1UcAm1VG7J2yyNpzKtZgMTKBnmHfV4dUKNttSF69Qs
+MomCJQqVFNG2rgEwDTTuTC5ZQrWEMmgIt6OitCbpLIrdYIJoGFr6IsaB1cQ2KPEtQfuxi0I0szL6
+KmwFgVSM7PEWyFqZXXEBZ1xQKIGBBlM8o5XS++yRDU3LLeX7lu4WNk1jIxyucqKq9psM6jj7BVvQ
++VTBZkyBFOE8YWZSewWrivJsNKnly5AQw0WjmWlzB9Tm5xy/YDfr8RoxLkRkM7L6xXEbGmYkxXf5
+Cy1b/yqdacQC7E5vgaNtt+41uLeEAIoE59rm0OCLj7hcZpUuYcKMJZ1ipVLaMlZeXLhYddpsppvA
+ba52nVl9IZ2PxoJuGttCnORElknbUGpuo1BqeDGJ+3BbtmgKM5dLgtgGgKjIZz1WbsCcIfJHyny+
+R11I+NDWVxRlIJfax4ajTIovdmNoa65qaGKY6UugVG+6cx2n4HvBqhsZHxX9YjDIsMaCtckaF6Es
+TT3mTKGmmm37Z5Mo29DIJAcRZTKz5iWAhp3MdopN8Lw5cSQGUiyAXlvKaTfkpC3jolgNg3UT7tv1
+fA4YW9v1+klYTM5xE38+mnTc4RC1EtfDsWYSu/mRk9VCvxSZdK5vijPMXr5jyW5Y/qFcTgtsk7ER
+1EOOh2ZiuDiOtZ6RTi0jFmL2t7yUtJndCLVqrcaYf1YUvs4fZlL6Nr6+7F19x9QoJprvMjkxmowg
+H8K1jWFJIfaktbNt+4xL7zXDTJ4L/VZDM8ZwOhWy7Ix+zmISHKypPtkIb4JU2lhGCi2Dbq7M+/M3
+dUO7NNDHsw6KOYFh93xlxIh8XCvPaVBKu7h/eaoKs4EOex97SQwRy+J2PrVZu4EsM+64QqOHGhoI
+8Yy82Jy1Hape/aTDtmxG4tJ4nqy2kmpb71dtB9/Xsymx2XYEt8XyYcfrhyWHKJAS2wvZOj7UB7IB
+Ec50qZy+F8bthot71NTxQwdLfQVuDdaxuAj2g3ziE3omuzfayVd1F52mWrHn1CRfBQPsCl5eTM4F
+dgfJOXexZYfadiNnLGvslcW5OKiwlrUuhsNoFdrkhKvqCy23mLyZaQ9HBDOXUmChYPsNEJPIQt32
+heGdbmZRS9p2C/niMN/nzUYbgeyqc7CosqWltuJI8cemp5E4jnCzLCa8AQGxjhNn2Wu6XtyOGPEQ
+O4jDmdbMISyou6rzAcYtBdM6Mx1m1nooKNCNnLWVqYrtqfjUjF8QwJqZ3LKtG3NMY2jGVXDEPYl2
+zsH+9drouFqwW45vR0vbEHhRtJFOr4dDJ0aqIeb1kvmZR7phJ+uGfgkKKLtd38POi4PLiHVxrCaB
+nrTEQsRKm2i+Xllivp7uMV8PGHbTzF7NiaLQ+ERiLa5UazpMX8rZongDWWZWgUJ+YiRPILXywiBA
+RD2b3glFf0g9bDe9YJhUbdzsGetXyzCXIWFsl33v6jRSoXGEMDO5uBFNsBEwECoyLxnqqDvlB3uy
+ApBMTbsZzbkbjj81326ouE2n6rtoZzbrzQ2zaIXpf4fzLbTwttvqId9xum57poN9gTa8Cuha0gi1
+kmiaGnMuhYc8n2Vpo/cunsOlTdY09+DqD22+Fpw2WtDH+gangXBTj67viSjJEafLJGRCusbItuBk
+59qBnNCwrIvgVuf0rNd0+iV/6v7jlVFmh8wbN6ujwqYbDlu+0QT5i8WIndFRMrTE2wCjr8jycXne
+YrIz4yanTgUdYGjaOr71sOjbp9hULZ6k8ZNLPTgxGTafICx9F9sbw7FUYG+g9BODQc9YcTaphBud
+2agJWRwKudiWOj1t7At0w0zWyOUWjGno+8JSdVRLuDAzNGsjjDt2k0sVCk2XjRvRmNbyNNCMt6Zh
+pHby3VxiPxrjVxABcAgGraSb5g4o2/7UtNNs8LYOKUrYAiltDdOTJ0dr+CqHwshpK1gN6edsNcRL
+yhj6IEyOHlPLaHcnABSUTjfSS/dnB7vRGQ/FC0mj+U3oO4eZ+LGiEMtNUZOcrm+Gwni+wh4FFcV1
+WhwL9VxckNfl3hQCLBSD4FQrlE8ZxlVnn0eiFTfbucPRT88Yu2PMc0BqYkSG18w00rug1G4c8bro
+36PdDpzr8Ybq556ddqPtdZ1knWcMitDOSYRhierVZwLabiAyR/w9m6qXmz6YSpmvE7MrWYDFZgXh
+m2KpindRcKK63i9oinbRmZauALoPqK3uj4IZFvIWSKf1TVBzZ1q2Op4/JrpT4EXW0HbARNpSJ3Dn
+A+vrADkifM/ktPPda27WewlzS9Quq6f3wJGjE+oYuktB02TbbaOnp5D2CAlSOrMBKmw/PEfECB7T
+1C6EqmOfFGTaboZmKAFuhAyRgTb9S2ghBT0cGVbGsLiwU7Ahkx7yV9hrw2zvZMVpecwQ162fjD1E
+gAJaouWsP36jatzqydD4jmCXux2I2WPiV3nHZjXrQtwW7WL9EVq1hDuz2vWlPZjKWNeFBjnYybhq
+wK2mBa/VmkffJyiE6Khum0kb6m6b810hKmzpEX413LoXDJOMmekxrxtEidNSA7t0Gg2sxidUNpXh
+JBJInHlVDCN3HgZvJlMD8zez6dxeKBSP82kG16bCgR+TtWBrheTUtIaixZaDEoYkZprDdVS7K5y7
+VZseeopiLYqv8++YlhmfC5cVGZhDK4kMAjTRzVyPBI7A6uI3/mldN66AWtdtNsUuXl5ZMadG1IVx
+K4mAXbhjzgnYFT2biTvKsnCDdQCRlrG2BYZv6Hun6Xa4/COqeNo0ronRQSbJXW47ixDoskVeJt2D
+LwvtRq+F5ApKPD3c0ZLDxakQrwZ8JVY0giuXS18RMBtM19OZRYertj8TeYsMsXdP0CQIh41C+qE5
+3PuMr4h2MDO72Fxmc15hf3EifyB4XwJdzwp6aaWNPVCp4cIBBw8zYsatmqj64zoUPhcYmU0RrnEL
+Z5yP4+rRoQbaWbIVlW5oKhRHyuXxfGEOTZXRXitkWgI42FZ2jhJWNvuHeb7gWyOtfqlobNu0ZWTU
+kAkXUlS1EW1KRc0OdoMYVhbaby3D4KaGFvctwYJO1/YbLlPktof4zjxeo5SW7hGPETibXzLxfSOb
+OgBL/Ayiv6oOk4PizMLMuvsQKJUyVlTZSFCopSP23DRyW/k6r+nY0+HaP94KholOt2yx/kECwQgt
+swTQoqIMLXU28RjbSyZqp+uZHYHOWE8aSb5zsoOObNlgXyo54P5iWV5RhmlZcW6LszKIXX1CRM3S
+JThcLAcr4mBo6FljGxxsut1HLrVTCvou12v/jWMLcZ5BGJe2tdzA65mRSm2G4h2T3Gkh6yIcPBtj
+47Rxx8J8AIFpGMtuMSKQPef9RaaV6nELHOgrSu1ZFD81VKGxRpY4XPA5rYAnM3VjRQdvChhD2pDZ
+O0PGPLylNO1yjjw4O5UW55qe7/hIMHAdpdbt+qwTDireoFmUQlTs0zaXZjHEDRspwiVLT29FPyuH
+nUUxeaH1PqIlLydjpS+Jq4Z6fECGquRUSNss9KmL9DHSgwk5l9Cj8WmVQYRNFdmnGtlLuOuruaaT
+5LN8/2IaYH1ggrGYbLVD4z8zpe2AsVbbreLea6bmDqwiopromXR8+l1mKcrmnRAcI91vr31yyUaV
+PveOZtq6HI4wRshu8rHrn+ZKhV5vDQyteze94sTSdrsBthjpVJ+x+LIfZyuHAMU13IFVPnhc3V92
+m60pb/FAX1ey3Relg4XiSCF5NLYWzaHXrMBoLbZREFsuHP7p3FaYdDtdmzWuEG1gFo4mKS07hFIy
+v4vG+u36rFqw/a7vNmYc9bDXCURZgt0IGXX+MjMtCtWm+JQhB5YeAqAZqzrJyg6dg5OsbCa1BsbR
+43hlFPoECH5jGrcqht9LZ7dghV2mPBSIjhBxkLOGcTEUxidUdJAYVRX54vgwEwsRM3dBOJU3pgp8
+dRloP0KHDHxdaxo7l5rzFWZtny21Q9F3Bv1PjJfTVgwmM70L+2A5SWWf5yIjZWyLc2To6w43IiJ/
+EZtpdKNHqscUbz36qXBsEzgerpd0y9wNJ0ZquFTcXzgxwr2AsX5FCi1IRdYyLoF8Hbd8YfuqhflO
+12ux9Ryy0qwpY5VDs6Uj9oLbQeFaJ/wwjlk0cQ87LmNt4fY7OH1E9no5rBfvu7Smm8htrCi87tP3
+B4S+X4KdTi+rLosJs0wLbWXnm10XzUvYaGSI3Se5M3P6NWfVu6K2G41xHK51NZZh0XPZC9jeT67n
+VfmG1muDjTa43zEBNlP8otsN3hQZE5c+0c4m3sWhioJpynnOrGZs5V79cb3nNdBTatqKCSkzOWs3
+jFcrKnMdOINCzbaNBlrI7DFjI9FFlp7dDEz7g48QoRhe7I7N3C3Btw+5deG9xkplU8vowwWhD2aV
+4gr+TngtjGxOhmP58QIjwL1C/1O2XxekVDN2whHH9punGRsdbEJCNGUctMiW1fcyfTPKIHyvGVhz
+xZXNQU5DS18GI625+WbHUc0iKykEFXn/MeaPAtFdtJKR1XYAuixHhzotzC18ukDfoQLMHoGtATQD
+9w3jTmU0CF3GJ3SA2b0SNd40ubR+MVS8KVwKOscjnyK4iTkcu5sFBrF8yUXB3GTSeg/rTUHv8y60
+RDMdtIxuGRfGPdyp1eb8jNtW0V8NcwYPsJRBjZmXcOB1M7eDyepDbBBTFOvoa5YsIpbZiMn2m7n1
+oEQrkz4fK8h+x4hzoftvwzK3wonymLo/2EjFySEeTB5z88Kmf1R1oovItGagA6am4w/hmjC52Gom
+Q9+wGSTdMUfcbMHAzq+IrTYsK4US8AW3Gy6qV7OuFjhnZoylm6VlGBkZuSbGMmp40gXbtRAzcULU
+5X1hZoxroWT73dnIs3rN7jrNptt1AuHNrGM3OAPLhn44dZhWdi+bKBtO5yRbgHWFlTgu3ZHYa+E6
+OmXmVuUJcqvzBAIBczkFxnFoo1S0gHSS1yNjYfF46gVf7bDZL0Yao21hKInmTqYqo1VV7AzkZJjx
+GYzqIoPXQFdUQ61mIFc10+ZeqJUOqQ/mFEEY3wTjLWamIHpIz5hbAZlcrhgR6I4a3JA10PVUv+Et
+m9eFfHqqFSo3TS27GQ52fddZcBgIuJzDwkLTtpyWXWEDuIVyf95UWdwUEs1QKiMajMm6bAmS+8xK
+1/Yd7h8h9EKewTUiEw1NjgfkkEGEiBV0lZExlplyKaBoJciim+k1UOEacgLpcyAdqVXMLnENz/S6
+nDWzznZiStRqVvoA28U0NjffCTYx9bRPs7vSfibeorqROofdxHjeT68KA5GsFff8wkVJmfPxOjje
+txdQyN1zmnUxHGzOO13P686qtbbnzbHZ3sGl3Vx33o9RASOt9+24Q6YtWDDinB+snabCsW9krCtQ
+iO7W1VrdaeOpA1210PRwg38BXR16wtcdooQYEKaRwS0HjLwwjo+bRYT0P55Xz2Z2AaOXjq+Oj5TL
+nMlkpAXz8aY3UvqVMd54ucEv7C+RkUtBBIum7V1+zw77hIQeZbYFXM5icvEUEyA05oURvIHOG6LZ
+Wx135oQtWQRdxjS24IL9ZGAyjaslGzdNXBisixED/J6tCVNu+ySvWjZjDaD9fBc3R67nRordebzl
+zy0rfX6uNgykOsHQM83Uxdh/p4WIOe4o8qCLhkZYDdFxRiancnZVHzY4rQxYVTNgXd32DFrB2Ix6
+RjyrkTIuX9I/PZgVMOy42QvdR4gvmjntvPns5fYv66nctsBAZqQ97SHJZP1LcHdL+DEre3UgzEBm
++ATO7XyuZkfKBOYcMTRdHyJGox6IuCwttTlaF+fbp1EZ3dOG6dxOODE2Fqctge4CswUdY+TSK+xu
+ZhBzCpHSNy/xDIu8U4ypMAz9cnTPetD3TnUcf7hiz3FLBDHm0HoKOwK/zREqnbH2Bi40cNKqolya
+LTb5qWPM4oDnzGrmdcxvUWcZs9Ph0iJuklKPud2200GC2prDxVAvSeAVMTP7erGD77IPcCI2Y+rL
+m55GHdijrem1aJlAsXBUTTOFR8o0kEgtOHy3KvfHGljciu2qdisQVVtp/apl1jKoSmKu9Bnn6/Ur
+kCwLFQdiaYQguV5cT9ZrbIqEgEnJRY2MdGobTKCONsCRgHhAn2puMdm1w41MeiqzmYsehfAmMKPl
+3ZZBU4tej4wbQT+bxX2OuetG1qoWHChm9MKOnDFz9M2/Yli5cxvyAUFeG0zYeNJP1F5c1sAwFMcs
+44mEMMLKZM+L3Z/f1MPYhEZDppHdBsLcYKQVI+C9rMhiaGrgO9OhE3czdXUM3mVnHhS/BLgso7Yw
+mj81PX3OthQxzpKPGl3bvaKn59goNTXrHI/NMJY9NkOcyaCZ2Qth3Gkww3kmJxo3iweY4pgbFjOB
+Ae/8XDZ9Fi+XfA7E4RiiupnaBb3OmJnv+F7kNYz0uaNViCpmJj0IgbkJn6LjX9Yz2iaoFjipD6g8
+Pud1SWXxqBZ0impwsTBDEC6p4bk4p6nHjenYwgP7PbSZilGxFQzoea/mzEtBeAwYd+wmHjDJjHtQ
+dy8Uc0jltQS00K/X8IIvNpoaRs/2G46MSN1x7DBw4mgYnoNoGtbWcACwzRRsbuxfxUf430GvtKLf
+dC0TGTOjl+UG06UHBU3YU00HAGANTLe6w/8/8v60vY0jSRuFU5LbtrzJsla3vFS7N6o9oCoza53n
+mfd6IS4SbVJkE2pLj7/MVQKKZLUAFKYASqT/5vlB51x35FpYKKpnzqfzpVVtFiq3yFjviIg4n6tS
+INbzRVhYU2immyTxXbZxUkzPVfsqbYvCCaX2Hj4UIeRlacIDoqY8vmv9O891Ic69GnWQ8WnDj2LZ
+Sv5DM6eTAs2cbPyE82zRdotcOMmz3YSQf3C2cndcjVBQLdjY2qUd926Foj0epl+iEFljWkkNi+nJ
+FRa1DYizzlzbSfXjOGvhugZVYYP9VDrW6yQKuTOadt5OnDM9TL6imfYIUGicsmpnsOmc82RV3rwE
+6EtNIo/FJ1QORMnVK8gaK0g7nM0Ks8dRuqTYD+/4+GaBnoHAN28PC+WRxVT0lckvTNSho0DJLi8l
+Ow5zVGNH4p7L+FVwvL0SdS6mczTGk3kdZXl/0Q8Y98q3JHF2h22cT1DV9O+nCCyZG4zZ2xuEWixk
+RNRjvKP5RrDfC17iPbWTHNkrpuyO2s1g7UX3F1vv6yECbWpvz7zek6GI/qa+fikHoydm7Pwi/v1i
+cwVSDMFcAnBon/lzVGkdVU2BlhDXWRcemoCDG2vtVsK/dvD8yWkF58w/0RAT31BVvtRqRSr9QsKK
+lRFXte4cx1tJJ1a/S8N4MZ1QrFZu9IwE539Um9sAkgnff1MVQ+uJCxZAikmS3mYHiHkDTxJsnKI7
+GlbhTkzkGo1sXD6tsmLg4eJRSyXBNawXy81Bb0ZVSHid9Xw5F6sun4D+b14T0R2231TwzhrskpK8
+H5kcOKNNxTK90DOXXOyZU7sfhYmXn/GkKcn8CTqwJQu/t0cUpe9ntXqMOwbIW8WrPbyx2WNSERcL
+e84338l49gH7P10EFeHNUCIcdW+c7kmOLSrFO3du0XrYZsA4t5YHx9wcmWX/QnqeNWDb6XnpJwSQ
+Vk4EcPA31aCsaXBd/Jv+gzoJjuYpF2xSfPFxavoJZXQhPor6CJA0CAX/24VchjhFKy7nAYNlFl1n
+f0fja/jYECyhpTz6L/Of7IZycZNtUktOFBeG94hcDu4MI/RIrhtTcWeuNRn8pFYrFAh/AZBqaMcH
+XOJ68sWeOEuu59R1mVZ7n8cZmjOiEgB5VbUqBOagbx94hN5ikeffwBozCRWUQYFaMJNgsxyenmHK
+KGemX+ci/pL91O1t7Ac/vejpz33A9ne3LAgikfLhPBkfoKI0GKZXidpv/iMX+FCLnteLCzoAhfEK
+bzOHt9meXJzfAIGYPCYE6XEaZ4YBJTy8wbqHP83vk9pTkWXSW9Q2sWn4J6g8RNkEuqY+mqvX46Pq
++FRVAMBOqy9IHq4E80Bqh6uxPFKkzkLcrobwReI03ceTMPkbVveu+LQXxeOgWbo7HOW5NZgDHucK
+jbXxfUBtrH86Estyq66yl+4Syij8gD3e3N+4wng7uQgc6tWg7nc4igTTqCHpE3VjK1uZ8Fuwp6HX
+xEoxS8UdU36fNEJcvJ4qNL6kAa1EXjgMF9fQ0RW20f0cjd6XteruePxWkSa2V2l4MloCq5z3eZv6
+dzEqhyjW51Ut/oBx/q6czlSEH7C9x/sv53OIAc1RVBSL/A6lhgTLYE4EgHp01vnn+FhNnIuvnTdw
+/hfEYkyEx2YZJWE+B9sbBGu6Gv/DjyGHqRjpsqJlaop5HH7C9nb2tgIqBolRwuXd5OIkUbUxf0e1
+Mc89t3gSrczEp3wiTUL5t4ptgxEHctOSTqD7IeEI9axE6hvIvyypBYSPVONjRAy5XAolJt/Y+qAp
+8N56qwO1qQSkRuN5/Ge2dbjZ7QU7e90nO8+2QN7nnWm/GJraciqS4GYoouyyQQS4qWE/G+YW8fwH
+tvnLZoAcbbIcCGb/qui/Pp24/+jGkjL/ccFFp7l5oLrR9AoUWhoElJaAXyqKkrnfOBb316o+H4I4
+HuVLK5Fq8RHm2Rfs5a/EXfRw+LT+K8/5LXNzTDkTVLPGK3ap8WIp2etMhCHVyM183OfgrQX7iZzf
+XAbxSSGNzad5kt9gB08PDKIN7B0kOSvPlO2mdyBZYAY0gQj3op3mbVhCKqM/qMISPWr1Me9gUTox
+lqnIJ4uzWyuRS1Z/yNPwNvv5pKnH9ZSQQqhyDL6NSStW8HpmWh4JEf5ABUFahQRN0QB7htdQpLvt
+dwTv9moI2t3K4z9B5IwHRTMIiEEUw+q3cjCfmojZ0BZOj42jKMqzb3TTzZfBbtUvx9MyMCAg41wx
+A4kouemJXm1H4JYiq9u+xcNFHAJ21NBWZnzz2tJ1RtbBfEqrCN8782i+pJVX3icTN1tQagLm+TNL
+02/V+hZmtmD1cZHfIJVRZ2MrLYavh98sKFDT0/H62Wi43i+GumtkliU32NPne7sk5etxOVaNthTN
+RWjteaiy93HpgpL+F2gSH4KXw6tj3KRPhvBgGPUVazLHwWX4B6YRH0AHE10Gb6vZSTBEms+rpixe
+TxGrI9KgsidmHtGnLP21mnhD6zMM0UPjXYnxrrKiiMQNBaPB/XpIU/iXMTQxjz9TWANNgFcZSiqq
+OSd5+B1ztXuI+e5W49cI+ejLfYslEZp8tTpTQrkhSSahxO7W/brXb6rJzG4puTXMnkqera7MJe+1
+KOCsg2tE3V7Nz4VM3p0DYL2KUZq+V3RjrryVn9ohpW5H4lROkUEx2e79+3bVIGR/QrlvhpCQCWDN
+iTTNb7FJv1jEDSzS/Kw/QWx8Ha+rk4lDPl9Uj99t7dSkbrcwyTNxl1XUGQ4nTVSjzxAErklRoHCH
+8k3+Y2In7jmCYlSJd8a8go7vFf2mhlYdLqrHptupmncWJ9++qyJqC0bAP2fdjcd7gdGiMVf9KRl+
+xv4+ncBxuQePPf5kl5Evh8c4JUsClvfk4gaCYn0OGOkaCMZZcpvtVkel7ZlZldOgt0lgcqOIp+n3
+VrVHIhzQkc6GoiNA5qrf9TBL77DNrY3gxUFPuwI99L4h+QhNg3TMAaDpU4WqgIHvu5+rsYbQp2k7
+Hegqk3OulhW+7hytjA1X7PXLsUsHw26rgxAyeu9YXWHNUyGvo7RkM4Oaio+azcvlPQYnsooAB73T
+CUWIlCIADqPMJx5/ucCQ2/zX0AQc66qUP6INQ2SqwCHwdlnur8r7OWpK9Z5aqOT8HkmpYLsaF+M+
+PJkGpwZzIFmStWJWw+Vt9rx41ZmWk6IpUL/6TTE8LaeMfa1Excz/Y0f90Zw3l/G3jALoF+Uquhzc
+OE2XhNQNJZHPaFrOglUAYbNhiNHQ4W+gqBfURrv7dmY8/IZtV2flIHhRDWYnwS+0Kicb20dhfibC
+aA4S1YYmEENRxEByRITyu1UWkWqN/PAKCxlT9UPlA2bYRXu/KNniGsvSwpiHx6ZQQiajP67KGIDE
+NiG+a4w/ckxEiOwj9mzv8KCalJiuopMkS++zxyXlvdpcDjMj91oe8ztACVUAFZ+OB815gLhDkETu
+nSjMv3OWrip8ogyslvdXn5jEIijJelmDWpQTUc1q8X1DmOhaT/5eC13Rbgq8pNaTC/mFx/PPXux1
+3R/TnK9OZ3+A66I8Rl7r9P2DTvf5/l4bNzA6syXTeBJ9u3LRFPZYWpHoFbUl01shePwjU5bq06ps
+CjQ7Awwc7EOBPlCunCwLogosSP+Ux9HKRigeq46i9HNdvRkCtUQfmrb482tS6m/LUNxndBKqmkvw
+pB5ShU/8/go0CXNFOEc7Fuo8QumHsElNqmLUidsZU8SuuJBmFPSBo756G0+RJm5u/jUmka1qh4iz
+daYaPQ8tfVqOhrLz/epI45OCtZ0nW72H5Fo0EfLquJzqEUWa32AbQLad2dGuYLeUbRnLL5dCqrI2
+DTQzLbBySZbKMUoE7IwKJBIBjL9wVCKL77IFfYuuGg7ULFSk2XVynhEPvcLkXR3Qgw3YeB0+Fb1n
+ibinEgYVb1FEowb/kCUR7qGRXyKM/431zsd92KvKTHSIVhRrOVbk34pQqmFyFH76qTw6mpo1zlOi
+jPJ3YcCd2cwz+TFIa1TubxySMmbmGIWp5y7zwkf6LkGYKjYbp3etwoIU9Bplimj52E51lmKZ05DP
+ted2Lgou0ztsa1j2Z00NVtdtZtOA/Pf4pNqJLGvhTA250rYAn9E6/JvaB4CzG007o3pQGV7G8+tM
+burGNe7zPOLfqSikrSgdrB1s7IHbbu8f7nWfz8d4zbSyryATBk3VU+3X7QFZyhIRAIXTfkE1KBXK
+o3dSljNsKtmA/anOo+A5v6e4c/fwBUF9HGlDfbAu+zzP77Cfm2pWuK0wQ7dBHWed13jtI60L5V/M
+dczWJcnNbHnGl0PmE2cgJcjkUa2+IPEP5nCKn7Kn9agMlJ2ONSotbEXXGb4eBo9QeGqVbWJOLk/a
+JfZAfOjZiEM0sxdJeo89RqkyePObcgo4dBeM/U3rvSjmS5R/fEkzqzDlX6q8kQDNQ9QxMNYOPp5R
+6+GyM6imr8npZDY5m9/kq2yHOujRFQr57/0aqguF7Pl62OZ5Z7apoBTxLZDb0t6PyaVzs/OIf8Ye
+F/3XwT+MWWAYAc+jVZb2VZb+6I4zep/uHMn6ksR5z7OmLpPIoi/Yk6YaoCeN4qsgegqE0M4JIS7j
+yfuIyfW4E61f7M1TY6Y8Qjf9FRlcZldimV0Yok8vjukqrphHl8KXiTa+TBMkT7OHECKzk3JWocfL
+FP9LiWUBsKFGBhsmsEgL58WoOClQev+oUwAHpGaVJu9RzHO+S86ZFsY8E34CNsWTDijwqRE1qp8j
+5b2au8qj9C47KJpiUJ+RvUFQOcIKUxlVs/Ao/5jhjgzqt2PGdPuPUdHQf9BrEO+xBrE+36xFUYKM
+0ztMPjk4UAifR6ocGuQKDBTasUfyeKK9KyKJ5pDTy1FJV5l8Zagoy+VtVomgOy6G59OZ6heHguNg
+PGoScUKrnTX19HUBZ5AfYoy+Wg7dsYHKLIpvMT9BFQazajjnqlLHAl58AmBRETTUBnYTSFJ5MQbd
+uskyHi8vDeelOiVZ/i7v4JdMCngHH1fHwdYYxUnBY+i6h0ly0yn5gDGARPwsfhHmf2Ib+7u73c2u
+rSbVnU5L1BbWEOy1ze7WQ8buMI3ZHA/W+/VwWAyKH206lRT5bdY7KYdDIy3sRWqnvcIJok4q5cmX
+bG9/U1EL3EunyBMxZ82zxFe1qHqcoWiRaCHfOxRzQt4dRI4aImjt5CmLG/VYVe+ad+PUx8dqViJO
+LgfINEEE9bMk5+8+J+XF9c9J/TgNs3ng1w0mAgB3A9P57VN9gXx4nODZbdY7fXVYTYLe6atZNUOL
+mhZgPorCVQGQ9PctRxaOFcAJVTHa7DRGODifnRA2YdQO7/u5Gmn4absPgWiBSI1cTwCFPDtoakuM
+CBpZJVpk8aL8Evi70WGQ4DHvGd3f7R4EBFHE4SuGluXX2MHGyyssMsBwrO83SoZZn5iMl1TypdlN
+jh/k/GvWfVW9qBsXq2wpTuoEZZJfrq2e10kpCpO2e1msr6x6alh+FKIynivNCfZE5uKitGrVLULY
+Wc00yZJPdQI0VTYAGfMlusWbsj+r1Svqh0LKdW/rX1CHT9oXh2qUnYjklXab4jjMzGUo0fNycDol
+z+AJSrlh9tX4mPiReU/w/EsVXUbtGa15khLzXYtczzrVeFbiJdNkXZOsjOIHbLc8Lvrngen41JR+
+uqlEd2BDUDz1EQvK1W4UY9tlre1yACUZj7udNvx4jjAp6QgVLq6y3PNkyfgbtjVfX/7g9LffFu5t
+moTzGe7vQk6bqUghl3VOusp2fjG8NRXRH1dXg7VM8iFjQWvTsfLjuj4elp0SGdfrr0e/6W2n4tRb
+O8pmsSYVKECRT5bwC+x9KXx7P0vFHxj8xy65WVtxZBsGa9u7O9sPoVSQ0fDoaFgdmfOUUaC8Mi3g
+Iw5Wd7ishoClgaeoiUVh9sDJSL8ut1KgsQSzxnhZxIM8SuZb4d0W5r0py6eg9L2XV72QHw8h+RDP
+0U0GFTT+XcX19SxkLL5mirKJsPeo144R7lrlUfNJUv77C+xMDy+t3s+z6DayPdHE/aCpkQR3btUb
+R1/Rt4slY9AY3wB9NU+3P0izm+pMcOHNrVegJqgqyrpNBFokumZKakZJGP3IeheUmMC6A6r8/Y9x
+dfYQ/XOJe6uPyu/Y/niIoLHxw1fo61nXQ6uemDshkmwRQoOvQ2UirmYRD22GJfl95cE3pXu3zpYG
+UdL4rx6HME2PDkrTuXBp9yOZ5V+jYqZyIwWm0L6ePfiiV4NTZuKLFvnt/nKFcVfB6mhoMOM8zG+v
+kOvCxTgyzlu4RxwfUt6sEyXO+DX2Um7CE+bPAzi6zSag0t/Kn45bpE40AwzWE2EqpxNfsJ+NwtW9
+VLjpoSCyBSgN6G45iCZDDu2qcj6eTOZxdI+cc5soCLG9u7OhM12qeuyWkAvUrSh0m2h9Fu7PQor3
+i9UJAmSp7Yl4vrQkerRaOVA/FJm8wcblbGNzuyODJIKrnrE21G5czvquUgiX99ivSNsAFAR1NGuL
+gSPsgOY3IsxuU1oXGs+RObew4jjmFxYmdCIwTNGQ4ZeNp5sBhckDVYmGPut2UObyG/a4bobgnYfl
+0bA8C44QgwZGDeAEvGqmJ9PV/haUalLbk4Tp30B2K1GuuOpBC+RNfAR2VCrEbbb/elYMz38rG22v
+zE2DZ/AI1YPzCVVUlJuYohpaJuIBe9Lr7QS9ncMOD4PD7mb3Ga3FfkW9mWX5R2y7LGYnJSWv6yXy
+PNM1Qz7U3NkwrSzMWjdUYXqotLgViPILxXyDw/Xu+i/rW+sksAxz5rkMkE8xPZ8Ga71J2TTnDwPV
+U1ltCQSmW0seprfYL0VTFWNUYRgaPtq+v1IsjulB5kWe3WYHtE+ovA9RowS877YQafw5k3sKVD4F
+9I2IUm1UlPE15tXdf0fTQHna9gfa/FmeZJdyQoCzOCuFyxvUbXvHgW3cDqWpmNPcRPJtS4uCCVz3
+X78t3pSdo2ExPVEmS5Qvj99EhuNl8RoDC4a3HlEPP0VaX0lbrHsVqtB1jstSX9yRw2kxYQTn89aq
+we1lQBmEwVi/LdUv1dFwHi2mFPI5axC3Ko7lHU8gKtWbAG7Y7bN369wyjZfrFSHOSt+dMBe3WZd6
+7R9UZ4rMLE2bSyDTZBVB2vI/WZTdZi3XkK4u0upYxpPY39alVhI8etTFgyxbtWtZkphGNb3iqGgq
+NJWigKryu6u30kyujgPfXRoH5nPgqtHZkQrgpxdlYFuYQ5xm95lSvjfOZ9RI+9xqId6FlBAmyEdZ
+AttAuVG+zpcAN9Sq8lB8wh4PUdO6CeSm6V5neFwiWpSq6IS0587WGCi5gTXYYMyGKW/dNk2mJVpB
+rCzirCaShsmiHya8wA+jfiZ52sqzVT3A6uY6dDHlt22HZs46ptemJcAovu/dBUU2JkTneAvn8tJ1
+tckLruwjr642zxcvZ9y6nGZKIkqXeI6IrygU/2XcR1kUMAvHhg/aazgcaNAuY4v10lTJa4S6p9Xx
+uFMNRsPOpOi/Lo6NmR8m6GldDetP2cHm40CLJOCFleYveOIrYT5/U98BIqC0Jovb4yiOflgC7FG1
+Mrqv6tN5ZSWX+WpdOrVUnGXfvRPmqRy5czBPRWR5mn3E5GYv2CuoRLz6rzzMlyVZv0vgqB/LVN5g
+urKAx8ygh2vmKeLMr6SuK1qAOo+b+nQSoB5NM6LkTstT1bcztH562d3b1Va0lk/eNovkc1iRTTUN
+9opZU3mrymQWMGMgbZ1NEIYMdDk7O1N8ytGq+Nq7PkvWZKunxTxtY3E/Zlysh+uh8GrOSQngIeFC
+0MjDFfG4wsQiwJ2AIZ1xPSstmEzGYnkQmNzYaoviXF5nG8W4HsOFDVPOK4UaCfGdaiE8RDMvoDOm
+Km+JalgY5VN9iefij+znelC8ti78lT2plK7Bs/mOrNE72hZFKzSoSPAl9srv2E/FpBi32d6kNmhZ
+TV5UveTvp8XSYGy+6NKkV9X/vjwAUejvhEl+nW2cAmlYlh6NxTxpn/VH+qytZp8hHIzoXxnAx/s6
+eHY6elU25LlUe5uK7C7BWuBSHAZbHWqBSUhrUKB+icu/UnVcFWuAq2lcDoPHTV0M+sV0FiD3HXJf
+w+EN3fIs+XqxidLhk8f2NhktPpM/sqfl22E5mwXEwJqBwZIREqfqE/h1MbsvB4fYWOiiw3HL7TRQ
+QXe/90hA/RofU+MaKjBqdH+zz6n8mj0h/58VTgGw6vZFtR1SRn9hG835dFYMh7UudNlmFk0xKuF/
+xh6ar+fhZ2zrrD88HTgNTX0wl4kPZdTAu2Bn9gdPobvCuJ/BYyu3p7m4BwvnqCoHwdbodEh1K5Qr
+EcPrIeL8DrNAwh6wggvvRDJconBeZS9tSC8X4XVm6wc8AFBGeRHbTD0gzc9sv8jDO6y7t0P+FXOq
+pHNheurCxtEflur++utqNXM8RMbx56zX7RHLnDuiRCZ+jQISjwcUb25rVtSnUalV78qznaKlYzux
+Dd0xoOpzLlOvTmK6yEO9cINasBQ3yBGDjE1t9HzIDjl/xOcbUphtlLn8fjFLqdfZ3O9ZakWsZEmw
+wwNSaGKUMWrtns4uqAzKvWbaIs781DaVIainTbs312YFSiklBGriy/httlX8do6f0EnpEwUBqFfi
+lP+ebdajalwHKNNrlEPIx7qhF/XUhZCfs4NiUjYg52Cv+9LT05Mo+4Kp9ry/VOVbmN4YQ+05UKa9
+fjGEUm3oUHMXYHLXbWeE6ZtjF/pNc3lRJWbLayViabv1SlemhPzTi5XhLVYenr46DyjnaLAEn89F
+dNeT+cos6A4GnZ0xVmQ2A93je6dN+fwEZrMGkdIW4y217ij0D49iWmZ7re3bdqSNpiAZQ3kiDn3w
+htFcDspmWgOTu10PBy2hInJ+k6wlMwxhuXF9xaXbsekFhlFyE5yhX9OiPLoxs+MSVRaBV1ntNfCa
+LDP2mQ01F9WRBQwLmb4rBShxJ5gDJmgZ4eceI2yzPuoSubWhWJTB+dNKqJLtZGJwOY8GZb8zOFtX
+BJKKBA0CmtlpMQx8fJlRt7OI/6DCiLqfZXfzpXbcKV4ZrHV3UHYGAQhiUSLJliDarjJkjui95kgw
+WBUND/ElZXsQ1ul0RrxLl5nfrPrwIetCIuZohBAfsw2NgGALdfH6A7v7HL6OvaKvvGbmUoKE9Yak
+8bzX6V3daNQPoyj6pk2KxhTQGwWq9D3kXPybZcwoXNCAwu1vWgUM9DmaM+EyvtUeSh0FRrDONZlm
+37KNk3JUAUlO/NCRBRkbaC/Z1y88Ouv0B9qNkEZ8uYOGMNJmy3mWP2S9zd6GkrABxYIOT8ed3XJ8
+PDtReIty4IUt9OGHUfgV26TKGDSr3mlzVPSJi5hvSw4Ybu9wB3HrR4a/AnVOHi0QCJFaGPO7NrXo
+p/qUttAszuxWnOdz8XZkx/pFMDzmMSIg52eUyxzU47dFM5iGy9wgYFsrvSBmHTwLb7CdMdS3aqYz
+mj1SyxOTad09gsK5dXRU9mdEjHqvZBjdWp6SaKWBSIWvgMCmnG8HAFVEuU8o4KboNYvy9inTjXAQ
+LcXR41brHCUbxPqZGgZGQrB2Npw+vMLEajejXkuYp7fYrwfdv+NQT1Bw052UfkVk3JX5522809ui
+0Sm0SRpfFIwl37daIw/lnxju1nhKzeKfniMSW57N5l0ouDn8Tuucz05moyGEs55byLNLl2m8xrJ1
+P4wnxV+ZsoJVdoTX009vAjaebjn8//YOixi1dm3hUHW2lkVo9yedqZ4kj8XKbBB/QlF+iz2rZ0Xw
+GFlyNjcYtGloV/L8LoPdosLiBx7UyGt8HckEZW6Oq1kxDJ6Vx8XMHWywtvnsyUNsbWi6XqCYkToa
+iau7HHOJyKhvwYfRktBMjA1Wn+JZ1ga4WacrT+T/9lQbtX+kljioDe9Is5MBomSC/p8HvFGD5El0
+m21XxKOb4mhmmcY1Fnesc0rG4SokqT5YY4fK8CsVTSIeaIAyOAB9lCHaee+i59hePS2qfoC+cNaY
+0S9xuP53q+OT2dsG/0thBqIjogo19TjNbXCZhCvxTp5Hn7FfKRe1bIJf5aan3GaZ+JwsAGCfAhGG
+IYW69KBhmN5jm/rMe5Oy7J9YTzYWoEaVKf+GbZkeWwYED9QAbPihci+Y3UDauzFGLeRHiw4yNSzL
+F2l6gy3k7thE5QSJ66qUOKxihcqKLzST1HSzcFlpIgKQ6mXzVNxmL8pXqn0pAVYVA4NMCj9hR/V4
+9uhtfXSkneBJlP7eIz7tfsMGkNlA262YbJ5cULuQsesqNQP8SM01QdXKJ9XIJNfhluq5uP1PhQS6
+pSnGA7SqxgHU07IJXHVLc9N5Ju60vEebxcyYuZfwHek5iegWo9rsupKDNyFzymn2vdV4VmQYKxvP
+iO4si+6w7svN4Onz5wcBAECgVSwX6zTzj2Ssc9v90CiaTDjWqT2uWBlgKFdZbu9sFvNHDKUzimGt
+GqriLWyYa48arD3Z2tzY3zN9ITG8WjcPxeVFzJzxOi9iRM4/Y4fb28P6LRxIzcxjtHGUXYgMcHUy
+QxF3LgzQ22YymskR1sfeIJ7Lv9jbvVe/IWuv6lP2oVUgFRaFWDtuptLW01Dlf8HTQEWhDkvtal04
+sVC0WDp4IK65J6J4KL6EsaNyQAOqAkyakdp2EfF/8453/+gIfXtVtXrLTX1O/hHLU9SG8SpTZPn8
+blvBkSLl3LC4lWItWhRrOY8DtldMpkd1c4wiQ7BUAhRYxx54d0ItI5bRhdkc8uJsDvURmYaXw8za
+M5Zp+p23e+0a6a42svq8yNIP0Boy9jhW3CqEqtTCaD2EbFVpn0otvMqi3mq9UH0+TqIlfu8PGI9X
+A3XczRf65keGZeRSIJAzO+kXDmh8DQ2ZzBtx29+hSef5STkqGWtjY+FdGk07NVHXDG+oOaepnDcS
+03ZMfwGaoH4Y5a1cDT321rjfnE+QZr+p/TPaANlXBgiuGMlsIVLK4IZF5FhQkmePcOHHAzizoRXO
+TkocigJq1kfQdgan/VlAeQeEl3G/llF6d3k/hx0AQwv4O7wuDkmU3PYd/dPg5/IcIZurLMzNFsuE
+32WTUzTN2vr55/3AtA1qIerzKP+UlAxdDOlTxHQeRev8UbS+JDgEg2tSNrCQ1F7mfsEKVQ4SsX59
+x9Y2X2yfeaXH4jhdQmZQ1FdixfUwIrrXvitVTQyrGpNHksuHLbtB0ww5JdcBF6/GqFJWjWFLqE/K
+TG6xX3Y2uofBmsrtIb1uTuRAGT0sqcdPMXyIss3jcgZA6XJOEkWt2LlBzHrWUJQlEVwfjUbLrnUH
+9avyoQ2zY/wJokHlwLFuc2bmyskkvUO1nGmB4+D5k25bGqglxkm8ZLs/YDxZvd9azxJSfso2d57s
+bO5vdF7u7ULKWMYc5eJ/Mx+v9PKCcFvQcbfgAO0jO/FCE7bJ4EgpYam8dObWVXaYf84UJADHbZPP
+ZJ4+YFtjarhvAgpIBV8jw6EkctSr5HF6a3kzEsul8yz8inW7W0GvGpT9otGUjaurPxLm+XX2fONQ
+lYnCH8wx8ST5gh3sbPSWwS7TMJ+HZ3zPwuCFV0f6+daz3s7j3S3wpnpQjZemzYTivQte2sVlYfgJ
+2+j9otvkIY0Ihvmj/vRNZwqvVKHXKGPSY0fVcBlK+wMWd7iNSScy/oBtHOzsYysUIfIwgjywRK8h
+4vxS7FqI0Icav6BUDd94B7PVWtQ1AEUMA6QmOdu73Y1gDRfRwe+V+xglSvoPf8f4ukChX8Vjj4aF
+6WMoRXKrpZFrdzu0Y55eQifXe8fz5P/Hejvdw81grVcfUZO6DgAYpu+9SguBilcfBYflkBAI8ARr
+SBZ0QwGvpflglEK9OXuL/LRDBTTqUaUdonNlD2Hz9fuC5x+zJ+PTUdlUfZQr9h3RZ51j/RdLtVn2
+lwWfIsxFVZFbt3MkZukNIgVfultX2GW2SpGJTJLLdgAW6/x2ax1gAV4HYBRBdoaHopkXSHtyPodV
+ZJOJ9K5OM4BKfIB0djyY3B6zq7H4gsEdsD081Ug8tR1KD0eFMjT7LIKerd+jKt1AkTC3TH9L8vR2
+i9SMCgJ/Bb8Eqan9E6T3Wk8VhUEVCtJbq9ZqtFt1Z2ET60k5PhsNUfigmBm1y4SsWhXQR8PW/1Vz
+iJPsAxjoBx8z8vkNygGMZ70pQnyPdS5rsktz3NzvkbllaFGI9Gv2pFRRd6jwzWsPczUfVDlWvkI1
+kSwPv2TzyW44IfXnOJK32JOtPQsS1/cGfWG8Chl5+gftOx72TqpyOCD5THWBD0+HpUKQu68mufwQ
+0v2ks/87lSDpFi/TdDksk2qYWq4lw+SSXWIikwzYPx79r+BN2cCv8R+RWh+XCCgebD1Z7BDW1ig1
+CXLg4pdHg4QTGBL+avJRw8k1wW1SnEB1DNDfEnl8d7FNwdabcgyCRg6zmqOU8r6LjswB6rCphgyk
+TG9r3+0zAJMCwxqvMLnoVvIQTEqrSKJLaxXQc2xgGDzFqhURlCmqkANmYDiCdpOq9QjJ30NSeeCp
+LJXXyRWMqBqpWcQgxtJsAM/D64TbIa8VW1LwT9ekoRQsNZsUgWAf4uvJzCvOVktR3tAxDVMaea8e
+V2iiBXiOa1FIHkg7qRQZ6MWxdk47nkWRU00LUqJY9Rt009gsAX88gA9bW1h019VsszTTvthW8pXn
+Qc1CawLby8JFeoOCcNqIw9HQES5WRDRVQGENKLKIW/25lf0cr6NuL0TFa2TiKwv6AxY/yuPVNrTZ
+kCgKv2Yb3ec7XVwMMva8RK0rbO4TfrEn8wkR88sG381PeMxvqQM8booj6oBWHY9VyoXaWymkLjGC
+WKDyPWolCBoDBJLSfs5GxksYJ7c1Bo90NQs2Z8zXuY8mZ2or0Yf4JzAbfK8dmKcoJ5V1twEIIHjN
+QEnsF6MmEQ1hZbPTPLKKL+45x3NxlwBC9HuFlNFeNvAStRURF74/2I6n9ce2cHzynsKRrOOmRlP2
+anw8Gq4bsanvQogiWzvPnm8d7u70cA7lkDYMWqtzHUohl2pSlAx4aV2Ay/Q+3Qxz1/zrT1fkX2up
+wKW8xfRuKR96QNYz4tiqVcRZBw2MRka3CXP+JUMx11enroEgqrH5Wig4LWCIr061KsNF9EfqaDiZ
+AuJWDgIUdsRnJgrvosW1qszlASsmFpZp7kck8+/0raRAS7B2UDSulGFVjx9eeDXN4UGxAUT7M/Z0
+czt2GG1FWEmE6nG6yARBjkhDpvPVcw3Q/X2ibPuHSCMgu0NZsLgSZsIy47fYZlVYYIT+vaNiEaWX
+FjSkXRSeGoJSZb1ZU8wM7oKkKU0UI5hZRPAxHVIXbChyFNV/Apz0Rb2w9V7JMFkRMSfhBjeaCPlt
+tlfAn1fMqn5Bgh0sd540Ru4dtdMyT75nB3X/dTlDwRsc53BkfSUP7UL0XAQVFEZcDjZMIDcDlaSH
+tepXwjTzmcJBA06qEsZeFVOEQ9adfsaj8N0QEyugYgRwD+rh+bgeVdTG6Iyc+HuFn0SK2h7dwRvU
+FyWcJkLlKPFoELZq4Tzh/31DkmNuZt1SXNhUxqtSLMMPWe9n4wmyy+OIoCJRRsvfHUXfxF++X7zh
+p6oQd6XeMiJAZHfZRne3R6q1uh7am4UzUmtPk2jO2cuzS3kPcpH5AkZhGedQobNy3K+GZNdf1O1g
+qt5bH8GRoZN1bLcDs6Vp+IAdUFvKBgDds7J/OiN2oAShW1GU5u+V9FesgKwnaX4REOMqZeorKZ1k
+76EHixV6sOCZVjem9dEsoP4vUAKxMr0JAtGEJSWhrYsoTfncaYr4Hadp2BJHH1aVHUA9vzY2FaMl
+kmNmBmEqVuMmiKyVQZogaKOxbbvVuJydT8pgszyqxhUEg+UlZvQoXtZEHUtXZJrEycfs5+a8hlNA
+i3X1lyxMArZbvimHQRzsdZ9TVXKoKqNiFqztdZ/vdh+Ta9IOFcmbmv+q8gh6Mm011Nch9e7zNLzL
+VI6gjtu9qBoliOBpt+juLIKBPAckxFLMDHjKb7OdMaonqRTa6cK1lEl8eY9NeJHHJhHJDbb7tEur
+XLgsOc88JPpniEl2exs7Owp/qXaYR+m//0teafHvIkRIcsUFy2P5CTnblcp8hYnrWl2ezbRIyuIw
+YM9OSfk1OoB26xXDwOtdYFheEvlMyWqhy7TeaLnWq9ac5/xT1nvaFXFCG4fjU3c9ihfzbz8C9+/w
++eyXZqbhQHGe+lEWmpYBxfwPa8YzDfJTy5CZyJQJQzylHQpFljzEcNA35a6CtRmxVIRFpg+xZvWZ
+OE1QcKmYnBwUSIirpiMydM2uR/nXFvqgjFEvVnUNZmubBAZvLWI1ivhD91vtmKUtD3br/ms4b730
+sYtUJDVVjkoYzurW4hOSNHi5t9vebKaXlyGLaGOzc7i/9+hlN1grX87g/B0oStPFiWk7DCeI45VA
+tNhphJGIbxP2oqn6hlhpadhZ+ym+8lPcTDBJAD4YoBPGczBSDoLWIJ1q6H9ORPE3qiHFQVPXR3B8
+b5bDCm4Co8A9WlQftPM8CcX6UTUcls06vJSds9Gw81vlpXrKJame5Ki5RJKn5PltuCgbnZRl7c8r
+LLqE8aW3S8rkT2x3q7sJQO402C2LQcAfVzNfxSH7QG2xEkZcBQ8VSNTHA36i3EFea5A0mU/L58ll
+BWgWfcl6p+M5R/5X2hA560xPx52mmM5MY20pQqFKwhD+A5cu0KGQqh4Hay/K4bDz87h+O6Y5++gc
+I0miKP+O9WZlOQw2y1lRUQvlZ+XprClMyQXWWqXiYUh69cKMAV8XFwQaoQGI9gW2lRHSkP/AtOsf
+d3UTgriejMrxLNgav6maeoxnnIa6n4nI/RxMcqUfUEZR76R+C1UxTLcXKPS9fOfTYTUopye1KWUW
+vg+0NUc8yGyviKIlFI9iXRcRvP21FN96rEgfLaBydaNsbezsPGqqo0vr6e1CA8fNYvimeh1coPPm
+ufyY7W3GlruYOcgsuUP4h47ABI6bYmQbe3+i/QqjSWmc1SFP/wawxaXLn2Twr5jBRBQv367sou0y
+QkTwGyxFOZqg+6y3QyTfpl21IwJ5Ko7Bv6CoJUlUTYZ1A+0veFGNB/Vb6r0fOvVVpP8Cesza1hJI
+Tm9sNQQ6407rBlV0lM/hrPO2GmMlo0JXMY1l8rVuO0Y5jXuqGJf21gIctoSiXENXzfxCye/R6RTK
+s7C2+bxruMI1xl10RQhx6eqUVFTIDBDJr7z1HRy+DIx8UZvPo+hfUwX5v/M8zxEHb/mUbJWhkMu7
+zoNHybNHZUOjk2vcTDCOHrAu1QXaGFYXZZaLMPuUeSgFcCBDp1KEn6PRQn9WDJ20UCuUMvnRtKwA
+Wb2entTUJ8oQVPD85HT0alxUysWI7+rJ8SQNGALRRAVwiBhkITbRU731+yJdVhz7KuOpUyG4SL+0
+6tHLpjwKdmvyupq18DT1/doeP7VeKIyOaZqfCB6viNBxcsfo6YUoxXdwUkzLYH9cBjs7f18K0paR
+UB05YZWdLfomytm0Wi+mVb9T/vhbpe9DJtN7UAaWxSuvs9wEUY3+k8b5nFnL56rMLCDSlKCL+A0K
+JZMLvTdrymIEnkIHRK5B9RpYj7FWEemgPBEvkRFe1IHptCfD+JJxRLk0jigVofFE+OY+VZ+dDIsx
+6VNMGxtcet0CTATJHCykh7iuFzMZ68q0eRTeZsvjjRJFgvTpyih9QGseoISZckcaCQWCIUmcOcaZ
+pV+w7e7zYLOa2irtHpnG4Z9Z27qwurytE0nfBSHqKYg8/Iy1AcGuCnMo5b2LeJ00WyT5QgYwGjm1
+Y43m7NJILE8LInNGHUwSi6Vhgivs8oXfeWpaAUK3hulaDkkqWUNUH7MaUvA0YBT9tYWuHjf122mJ
+mmv9E2UPYef06yn32x+/IBHoBSHbI3lLi6PwOtuoxuWoCKJNiB1bTC+P0j9SGWTC9QBPrx2GRJgU
+/dTnZ4x0Wws5zaL3LjfUb+uTfscZzm+wg2FRKa1XD+pr6pp8wjC+y56VRTM8J8ak8TOgO3/JPPuS
+UYWF4DEwh+Xjun59je0ebhvmEqUx94TeXjEujksoraDcKbILeuNi0qnG+P+zph6SeYzTMJpLmr8T
++a88BIcgWz19wcUfGTqBnk9n5SiA3HhbNKUevwk2flEuGwykjj2JY+cDI7iy+u+plN+w7V3jlNL6
+I80yWNvePSDLVb0aC/5XrYfQuU6GGBAmCeAaTdWfBkj0oN9iYD3XMJSrt9qC3oTILh08Af7GObtF
+pvKctbVuaj3y9blqvuDDxtf9tqmsJcXD8IIcEwrU8OvK1nN5JnmCxJANXYae6MzK6E+1I+q/+uVw
+omREJi9fVg0MKPLjuV5BtTS56aEdDgoU46BUdHVCIpQ/0j38pW5eVVMFprvsfTTHlYj77GkxnZbD
+V8NiEMjtQ7oh1jA2mgAP489JCCCpgWo4zGto06F2iIk09u22uYwTkhY25yQWsdF2qb8SFLl6PNOg
+UZ+uZHwhk7cu1SSJPmdPyuYVfQqu3Xm4HUjjmF5Q+5hF8kemZgmOYBKsIYxgm9JWBGsWm+7fkUQu
+B/deUFvTbKjMwt/rvi8IlhsDYaD9WuC4luqjDJobQh6BjnkEzrQgPY4gZtplpcHy/97Sn7HoVm29
+Fm6sHU/hOnGLC3mpADDUuXeAx1Oe3kEEuixGUNn7r8smUCCIa4y79poizz1VozuuRlRaZW17WPV3
+q1fe1kd5eoPBqT4sp84nbjwob8aDztti0nn7amRbi4loroiF7qulb4KU+dzfP2BZh1uWFQmx5KzJ
+Y7mqKJEirwQdDJeny3HUSQMXpXQ59brMsx8MdJqMG7gAildDJdlbCGr1g4hnSyZ2HSkzwaNArotV
+01PMKg3fKyDVrpVim43EMmXPi+PjcqDvCxRDzSOJvtUtsm03z4O15zvb24920MTpE62YuqTYOBRf
+sMfleDYsz4NfsmDzyTPwAnN3RBr5lgyZ9LhEGnHijOULHfdZLh4yqC/16XhAH9EJGPQtcyHNx66z
+xNgai3A3Qp34ORmRuEQRBEtbKZodKKctQX2uMvGu2uyaanmWfsmo0BSXNk6ArVK0kcjUd7Psj0tg
+99B5w7etR9N6XCJ1Rf8oTlV0PUBZmINiUjbIbu3brnJsrgNHfTYxTtcwl4EJfBEc2gNftZqpKeJL
+4vcgPr5AfGYTkuyB6vy206/H06Bugt7bYtY/KacBXKXYD/2qDOUfPA1O9Rfp9ZtioiEJRLjqB8oj
+nGf3rdzrbuz0fKcc3tN7liYXRYCvMFv1M8nTH1h3MKgoQXyvGJ8eFUgqhEvUuzTu07mMf2CKFZaA
+4DSKG8ybeJByylglVl//Vg2HxXoxGR/bWxOmd9nLjiFslbqoLuY19hK6tPr9WefMNGVUWxBlKI0y
+RWWUxzDPYZo0VOVHLT5D9d1f63pUHZ2r7+mbj0WY0SWApir3vzuZNHXRPwmQvkWLvsrydDHjTIFI
+C/22Giviccsu8ROTwzi5xXrPugfBHmwCEubYUkxD/TqP4i9JZXp+UtaNhU1d1+7N+thulsR+DB53
+e1vkXgz2ypG34hhU4USwwi0uD9Nttq4azuZiD/V00pTFYIqSEiNXXtTsYsTlPfYYtE2UHayVFt3g
+CcckEZBl42Jao3nfYUFJcvoK8DT/inXfFudyU28RaUkQ4mfOTJeCr+j5sGMtfSny+6yLgFGwW7wq
+gRqtLb/0UKOxSOfTbK4zESDVuKn6RoE+67wt3ph1ilB8r3RfSnsYljCxFGSlVUpFHyuPvgPYuTpG
+ndtZc1rqzn8VxCYt70OmPHzGlONp5nvc1QmSDCl09OcKe2lejlL5wDtubXvsvx1Dx9RRNTvzLPoz
+23m8B/fHZFick4USUN4T3QpFTuZXav4yy/6qaguhRclGMSzHg6KhxLde/6QcnFKwBoG2YsZMv62+
+fk19Is7DWwr+CaQYgUwPUXkPJ6qu9T/P9Kspz1boYt6ZySzxC+RZ/rNxCgc2rQRzwecVk5Cp/JK9
+/D9quUZo+i1COOffsv3dLUEmCslc85r3ObWaRKL50+z06KiaqdDqG+yhil8q0REli8F1uLlsJDSF
+A3+jaGbltCrGqJ3VLydU3gkyHy4LhM5ob+zWuuUImV1gxCCuY4gjgUVJcWBVW6V7qIq6mOPCdVFb
+xDknNMkU6lwHdNmhiiN2G4zJQdlIOpgM5qVvbhgmq21rOx+eJ/e0MmEL5ZoRrrKNnlujTOIb7Hn5
+UltbmijnqyoP3lRm/Dj+gO2J59Qt3dA7D1GaQON3yDAMloAtMv4N2xm/fkuW+CMoITOKaxieiVWq
+o5dS18lrTsmNclQdn+pmrObSmKElsP5KpLyznMxHaEPREXBr2p+jsPNBMdftDow+2KY0E92kOtgt
+kMK+/wp1lzwuy5Pwz9i+R7sFNnGzfIPUez+rzGz7YiGuwZtKLTdLk28YsFsj2g/zi2BNc8eHaDXu
+K2uQIBO4nfC+XUoSLm/8Fvs8XUSZ7wxcVVUuWENU7eF1xnNVKsDpqUDgHx7sBchIQAVZ5QFrSBZ5
+zIOnua+fk4WqIrctDqsdm2YRIs596IuCCWofO3kNxJ2FnaDkW7OT6VdaZKCUazlGj5d6/DuWdjLV
+nwmYVy4kX8iqdTwjzuajB+Lb1qAL0QMze5knf0GxUco1xwU3mpCTQcEaZT0+hKgVLbGnVhDFebik
+UDSZN7vVdGaVaSi4s/NhGfRK0rHUz5NcuMQnKvxsHN9ZonuXhSbMACqa712WifwBk3vbCEfN6aUk
+QlcUBZCjI4OxN3vBY9lqgqCDn1umCI5pVg5+HV7X8qkcGX1ehtnnfrGvgw3HHuI0vX9B+XgfVyiV
+w2p3p3dgWYfeqSxe4be/TI1gJX+Sy6dJ265IC34+osgw5B8RgHfr5eEVJj7V+3HWKY3ElrFYXU0p
+dn6iJBNfsOcIOk6Dzcd0K6+ylwdLytebBMX1t+ps1mf41biohp0+wghqjUIsCZjMm2EmYCLy5A4t
+w/IwP/rEF0uy18W0mpI2bOhn/RjAsapvq17zPPqc7Rzu9Oy3QAlqcjJr0RgSS8FdDLGZWeCyhV+1
+7nC71iaHSu10+RbbIS/cCq5jiF2I5GuFzek2/Uc746N63kTU4lPw6DbbKF6Xb4vh6+DF4c8mioY1
+KcLME3mf7e8929kgi5uwOUvMmExEN/3aE9TzmbZGfSZDV4deVR4fNVU5CHrV8bggyDuYkRstEeHH
+yOx8Ur5qiitsLk4KFnFc1sf4I7Bepk4+D+P77OlCd34VnHAfl3l0i232/nM6q5uSSBEQ2w1PfkZ5
++N8qYXA874G2UKMsCz9iP/X2n3V2/a45cf4X76AJNU07Z/Qfan62Mx6UVDlen1qYJbfYXj1+XZ7/
+dargp3YbDQnwJPsGkaNB2byCL4lc2UApTQCpOz0Do0PKpf4kj7MVJQ8sfERwbpK/Dk7qWR1saIw1
+Nlh/Jgyzj9hmrwcywn82s4nCdL4NJF/lA1T0koYLBtoNxi9oU2HCWVn+BbXVGyJtgrZFRa8oVd7M
+R0BBhoqjoCzPq1EZ9GbFaEI/0EqAngjn85bi90wEly/IoD4jZOqXgtT2mi6KhDvgc4qr1FDRTJYq
+tqA9nGFe7funt54LeYftN9VxNcbuQzHUNsyn7Fk97vxjXKGqvlP9o8TPblHW5v7m4w3XZdRMIIrl
+5wR1JvgmgiH4CjmJZzOLhZUy/pNWfVFXQe1/b1Q0s1FBMTozfcYWcRbKr3LUlOp3buTogXc/qCEQ
+7NHeSd3M+qdzECvFhEW+RELMt3N+a70qEYqjbZbD0zM066jGs0B5mnzylTK8x346nc6UKfm8PJtZ
+Zo731BFHIr2ptD1oiE5NROsFZ+HwxBa7oWukhsZX9EGGWX6L/fK4R+X3g7VfHvcM9tWYdzyLr7OD
+BkS7v3GIYKSt7hMhGLmQjnyVvXTXOOO3mDIP1nqnr4ycIyaoNjBLtWomLlLNRBRenqA/0hW+bPiI
+Z61q0qvU8asst17KOGxX/73CsnfowWo56fukeR/OQS9MNCHL4zvsp6393eDZ3qEWhPW0X09aAcE8
+/oodbHUhdeeKqCoKSaP4Bz/3+hF1ZVhAQFD+s6YGIcWCbeCSQrnIf2AHm9tIeLgQAyvW50DsBF5T
+PoAo/M66kwevNurxuOzPAmMGtxV6IXO/40ybjb2ofiuawSIjU4sXPP+Td5fVqqFJvAJIy9OTSD2y
+tJ6GvtNnya8293sfML7uwmJ5nt0jrU9jKlScxxgMX2pdFi7PhhiT3mkZ5vdaKn5TH5VTeGSKoXc9
+OVpk9XY29//xLHhRjbsAOmhbxF1yweVN9vfTqv+6HNMaicXjK2ov8rSVz20URJT0A6QBoUjIBPcD
+ATMXeQBGh/QRM7BZxPvm7RoWKxLxLZX66s2KFvbUcrjWeYgo/h55oqcIEJQDtTib3OJ2Qq1TZImp
+T2cNWp611r7q6pN5bX+UoRPmXH7UNZYC3KqPL4zF1+zxz8+6vaBXVtNR1VdzU1PCFhGQVpF8HLly
+iJBnSLycINzxHGYytt1sTyRaSaDmpJwAMoSaR/K69nF1q4b27H+1VPw344Fu+VRUDUIIw6pPlR06
+FVWhHpaNaf/koIYiDX3/iCL/C8BSkiTzaPaIyzj04tbLIXLc7+4Q5a2qjaezYV2/ho+yVd8/QAta
+KBKq+55E3cbUDsqz/HO2fwwgUDk9QbAOe6loIc5FqxUQ1cjXh8dl/ID1/r5bzVzvI7oB+vg8vJBE
+K8y9n0SwtldTpgGhI1EH9yHun0o2H/1T4wUEF18zGEBAXcC5Cj9FOTtVih4mZw6a9Cdncyl9aA8A
+B1IH2/l1OMvRVPVbU6vjcM62Lqjv8SHyW0xdWWJuThrXr0t9OefyXZGE37Eu0t6wfkXfkUw/18nt
+GhZ5hUXtJdT9WTnrTAlVqgaSsby/MmLugKcIietjE1l6i22N39TnllnQqVFhO7OxIo19z5u1ihci
+4ysKOajJxXmrs7A6Hui1CAg/VqU8cTuQK65BJ7qWUbxwC83JrVNIa/0V/Xo53iQV+TsIU7Zt8bPO
+9L+G1ay0NVqkzFYVqYkeLExN6cEIfOuKB2ksdc9ErG7aoqorXuqszG75moXRPq8oUKqiChEFVtA/
+Htb910F3NmuqV6ez0op7XAm141mC4pPGKxMclsWgbBQC0b0UyewSNcalD6fQtBPG4XdKRJK2LDet
+C844uR/62HkRRg8gd1D+WOWvHjQ1PCOaQ3hT4uGXLnJFcYs3sHadSzVL0YG2bmZN4WHXkji8D1R3
+8zp4iVs41dVWiaLZZaotG4qPBL/HyEhod4owgX/znszy9/HP8iX+WZmF33tcqw1i1lcfW6NUYeTH
+tDOn5IVaI1+XbaQraY0kXsIkvulaSRk1jvi0GiuL1hhOFlKV7Dblhqbd1GdmD/o6ejpQw8V5LI/Z
+KhHHPt7c1iH3DQSZRn4jv/ZemBkqCU+zwL5oYuSh+IL947QcQ7IN5v8qgL8kPWWju+l5b6Jc/v/9
+/Xy0dcFukoa7NT6uxmVJyIqDze1HWx2+0mPEo+xfy1aRqxKXzWbKKLzLVJAK3BgXyaiVV1metI98
++FZnPcRZ9g07LAfYIjTzC9YO5eZDXewF2qq+rorXxKjvpENwhgyMSYGNN3OJOH9gC8ccGCTJ1pkp
+kMBYZ4FJOlexAaJ1HOZAMa8oFvft0ehyP/iXJorx9WtJC8+qJ0rKm9Hr4mRZ778PyKpf5cIyi+PA
+dsBzM/2RCHBu9Iyn35BPUP2xdUtIYZlHNf1zWhv8OMe0tuthRfW3B7UCrRC7V0uLw+g+xkbYfhCg
+bjmlz5kP2+Xx8I6ibc0tgudlgU4rxBn19RDg8gRApzkOy+INIA/nAYoj2EWZVctI+D2PdaPKvVKh
+VexbXH7LVLo18Lue/WeOwR1TwlPfKzwX/4Iy1vIKqw1I0W+sN65Rjw7oTrTynsDl7L7L01aOo1Iq
+MBOLuIBzxXfeSPE1601KKOvBxul0Vo9WdD2SefbjO2NvJupfDigA16qEpbhonPm6k5pgpIt5Q9VR
+lciusuhFW7vDnhg1xxxiHH7Aevu9nWssW+efK0A53pvW00ptWc7FDU+aUE4HsXRzaDxtGQmaxVL9
+JFT/PyiRkwYxF6yRRup5lWS44Ja7yuZq9Zq4jYzj5UiKvM2bmpnRJnjEP9W9JUhPusrAV+aD1G/K
+/qxWzSfUgjPB/8CeJFHwZOOwY7i/SxJauC0iDt8jSSDyqmok8Y+s93w3WOvN9JV8Xk6n5VCVdbU4
+xIdalwWNmk0XMvyE7e1s7ihXPzTxQlX+rgb64CScEnRcsEwnkPzK7XGFWdegCPNvmOr5qK5d2QQ7
++pv2DqttyTN5gz3bUR06vU3QlMRT+TEyEEfl3maMmapfxZmwvUw8OzTkd9nzEySHw7lqUoTgn0ZT
+8dgzWnj8tWoHi3tRDctBoAAOlEaAca5r4RKJ5DO2W/XL8VTFcfBHEwAQ4kuTiQujiLy12DOqnegl
+yyeSf+sTO1q8H1Aut5FT5IJItxbICK6jS9dCbZe0iAHZhpmII8IezO18EuX32d7B1pMOD7aGlMiD
+suI9MtOwStXXPFttqJEIMBsl4+gz1useWPmOT9hThIgeVsVU+WAUnqpWiW6oKmfz+BLuA1QVE8pT
+6A4eD7rCstUsyMyHJ/wLtmc6vJFjDjNS9COo9uoB4KxGVyQpSMazkVeRzD9kj3ubO9vbZJdb10MI
+Hz1k7fL04jDGm0o3EfKud/BqPY+L/uvTiZtMkmSfsv3h+WhyOoW24/1FxHd0pJM4xUY9OVdLwYSc
+b1agOV2velUOq9OpbrbaK4GYONJ1hNw3Mxn/EbbHsoxSEkgvVEycLD5zfihs2B2grIYFVXkwJ7Wn
+uZS3Wa8aIVVKtd7R1/lKq5FsZ/rmyNyfnKtQHQrvTdTmr9Jz9EzCGKWRAXLV1pbSZ6ATYon6LYHU
+TlIieuV/nZbjWYUqDxpAp2aboV7Ak+J0SvC4nfHkdDb/KXWCMnVlVzbqYX3adDapcHYxnqn2NQqM
+Yt156vtJzG9hm20VD63zXGU8vEQVD/0RwX1lZA4VD4HaUkbM6kUM/OuktA1LrzGvOVUqlhUfAAPi
+4UXlB8zFimTyqXb4kDS+0N1jfsQ5QOrWwifB6TsXtR5sVhCK79iT0/NRcVw2Qbf/X6fVVJXDgn/N
+nrbapFzyL6mOn9/qYx7wKnl+T8EUepOCyGEHkW77KTNPmcfKRW2SIUwk0boMNIswPxCZuMEy1DBY
+VY3BEHvcglQqVoDEmL+flg0JYbUcKfgDPdOTYqKKnTxVDhGaMshcKWxJ/h7pAwJ6GME9iW4oz+4I
+zlsRRx8DKDo40vfDrIzHyU32SzmenTaFs4IxvJpoEomv2fbpP6ujCtUPuy8UZ9KX3r3H47gDnIVG
+twQrE2XWnpQ10mIeQoSqqcIBqEbLRXLf46QKp+JLTy7/tiA9R9MOQeSsZLTF8tRH0yz8I5v0i0nw
+DHFWAh2Snzw4mFPjv1n49qw/GZyOJuv4ufpaJOWlD+QLuCFSgAMy/M/yPhJZApT2ee/vu4q7tOrC
+kbxyuxyl0U1r3D4th4teZ8nj+8wY36o8kqsz5z4U86jd5B0cCwKNqCXMEMHrHQQIJ+qgQqC6ePog
+ZUNDMsy/YhsnFQWzG6sa+GDJOP1f1nqGn+KynTx4Ad/DMp+GHVzEAXtaNG8QKbT3WIsm8/8141En
+yFP+7ghg6xd5Hv/3K2JSeELNIImiH9iz0+GQ0uFVYB5xokC1Dx0uAI0yYCs2hkVTTRVThZsFwgY1
+gGsEhzqkICthJtJ7zi1LeRTUgA2i0TnGPD2Zc9TvqmenU40xpjGQnUHW6u3WrSCHMhfOHZ2G37TD
+fQt+eOpRbc5L8NSGtTY835fM87vMwOFfWsGPSYNslZoq5pEz1xlXF3oux0Ltc5pmPqTApBOtqjku
+mJ0lR3V8J8l656NX9ZDKlo1fB2u9/7P780M7NS3OpEiX6gIkc7NLaAP6O2EYrUbAW9RFlMcfs+7z
+jf3Oxs42NkitOM/Tr1nvoNdzri84Kj12rUfhPL7NVtXF8HTOOL7NtK6IPuENZaLWteexTzNYGMWs
+bKB/zUVu78I2Udy2GAY6vTuQr9pm98igIgRvwXOU+IT/RE+hHYjptggTitLFhlQ77YgIXO+GyML7
+7NnGU/K9KNlAMGZ7xmp34yi/N68hG157jW307OnIXPwH22t6OxrRpvc/WKMyA52mnNbDUwof9spi
+ROm9ZLvQYQG+QH4Oc2Uint5iT5/v7QaU9wIEwdzE0lDcZ/8Yo7DdtBgi9oF7o0d11MHDGEpU3zSF
+okTMK/Pur7PO9KTuv35bvCk7R8NiqrPSMh4u6eMnAb7Xuygj9Dg+bbf/r4bKiai0GfTNpvgq/rty
+/rVMMwAvQmGiO/+clLqMi0iQHT6nWbaZIHAbV5l85V1isUwZQ3mQie5BrSfOhfgP9kt3D6F+1aA6
+sM20u+NieD6tpoDzKdcnXajnTTFWNZmsiFYrzEMNdoqXg53MofIw/MJyaSUCcFDmz4Kjue9ynkxo
+aDP1JLy5zG0rvW3I4k+o7M/jCiW4MIom51R+gKztPahiKs79tnxl67uGySoO4YmyKE7+tATPT0qs
+tpkOwDk93TeO8yXJ3B8wHr4rw17Iy3vL0GAl9IghErnvRIZOro1LlJQfldNpcVw+ao76mdCh/hxx
+v43D7p4iVnXQ0AtslRgeR/+xMsBNfGtrWPZnDWUoEjiP1GOTGL6FWiSG1qEEm8PnaXJ/ZYtc8seo
+AxRtby6RNv7XUw2CuJO4E+dhtowBvKsoshotiuQ9TyYq5VxHOEivAHYD3v8FL2k1rWr1jTRJ5pJP
+RPgO0J36YYLBu73ne8FWnC5hcMb+4uEXOqUV1xSsFPl57XyvAf6z+qxMwtvMa16I0hTkb1Z7pvSp
+mBSc1ygG0zhPvsf5qcWKvpChzB/YqBaSQOiTa4d7m05jUGMnPLprZSvYPyKbisowuiWGKP8WcY3X
+s3pCvokm0K2eAD8BH/XfliL7ilFQ5TFQcGYT8IoaNc9a7UB1ujyZQsh2Mt9TzCxO/PwDJY6ljhaQ
+/FThgitMrnbVqWF5GvvOUfUpSHYbGYGeBE1fvR9nKQrBHpW2nEJVToPuY1/ZEaikaOWNdTceNHWw
+vbuzgTUrtTF6YGrYTZfInGssywuToXNcHanfxMAwDqr6EbWdUD/TcSpzRjc00zzrjKbEPtXU0yx9
+3wJQYrEAlCHoXNzQKgcxENTfY+xrbTlD5yngsVufAEGPP2oy5BROb4pipoWLPVn9Qhjmt9n245eo
+tjEcvarP/EiBfoeH0Q+sVxazGXKMq+FIcZje9qIXwFCryOUf2NPy7bCcIfGg/7pllu0W4+PT4rhk
+7N4ClziZdJ4ePNlVe5hw/g3b3YSjGQANIuiWooDDVa9mcXqLPSveVFNqAm6vpc1IYmY5ISrf7iz2
+RbnisIQJKtb0yqY6oqyJAzRlI2yAQtZdZUCF6t0RMv9O+R9pepo4Hp8HB9WZ7riCWaqbJOOFaBXx
+zCUgBRO0EvH7dN8AYM34tNMo+rNvLvqw4BdIIrK1QVvIYJF8yHqTonl93orRCR4tCeJZJKXI0wty
+osR75USpvRLJwl5B7PLPPaq3u5TI6BtPKtlID+nLcJEwtoiKGk07qHx1Ug7tfZHRLbYiVuCWmkZ3
+lXW1fzqDLxncMlibTt48xEmbOxCF8iYiB41GMyu/yDUWe4YVT/Jbup7H9jZx/5K+5n9HhPmfWG+z
+t6H/jiLPwUttCwWbp8gusdqnugxRntxnpJu+NNelwt21r6kdzsLLY2lQvatdPvDMKioy5l95aS/O
+FiKT39yTNLy52KbhKkMrT/0KT5Jb7Fk1qzW4mVZwVJIf0uwqD1F2RSNA7DGT+/QRQW6xUmyf2gnB
+Ez/TQ2krwPa9p8aSp/lNdJTGnZmUzQwYhanHfkQW3jPpD3sE/w4GupBtiyhklnbeGb//x7hvK0BQ
+BN+aQi31MM++o12n/OiAYhJbA2qjZhxebh/yOLnB0Ku/A61nECDu6/4ayctXp4E3k4ePRMg5/ke0
+rqOx4HksLoHf477Wq85LRpGva2iDf1k+rVj382nVr+NM3tXh+mUN/jSZhZzfdQLAEBkRDhiMc3tw
+wQO2ubO9fdjdmEAGoAEnSSIQmVYA2kwykZ+wX3cO9N/mQVi21KvMotRjVxrwoCEtUBVxSn4LglE5
+qkkjnRI56ZWIKLrHft3eD9Z6qN+2p8wXo2EuAoPhaEQ9xRU17dUmShRa+sWW5jDFHb5Uke6zzhvz
+J6ObiGSlo1HlyB1yV8KUo/noKo8TBVPJ98zz0FiHvRcbRi91FMsj+WeGFkuYnvbSrC1LnnvIWAvf
+qHeOJ9FXTClGfj1iN4CIxPtkpJhMJOv7idN4vjvENcZ9/2yS3SG1FTAly8j2yvEpJmHYnYQbsDsc
+nE4p++0E1To16yILUC9H8uR79mRXEfOoovQSTYMWDYnSDNQN/tHxcHbUUfBkdeRxFlqIBeWV0xkI
+5IMuhWFzk27Po7/5Dv2Ar4cXYBUpnHajpfLZRFYp4htsazx4Vs/mymrQXEKZ3IMwXRGGR3VotZSI
+p4ZwdobD0+msQV29qyzL7rYHrqc6FK1kYXp5DvghO+T80QrOl8bpnGmLYjC+LbzgWNNnGEYXdZ+F
+D17can2o1YA2ktGCunQhq357rMflWeYnV3A4NowKKcMM3mw/4cjqEHq/k3yN7fXgdiMqhmsYFinu
+ZTEL1n5+0f0peFM0VTFWklCPKnl2uxUz97UGeQk/uRpepMk8BabvoMB0gQLtZZPRfQ1gXSzctawm
+BEVBkHPToMiXmlHCMxfHJ4pvezZgrC2N5AN52Xtdzvon/5jY3fCiZ0mSf8r2qnE9nBVt6IgEpH6r
+d7gDtX6ocMb69vu8RKCr2WH30Hi8IOzCNklhck2hqyfFUlw6xgkrhksoBPMlXtWupFH6/SpbhABm
+1O/a4/8h/wN7Wh2fBFtHR1W/Ksd9jYbxZS88lip4fFJW9hhFyue9Px+wnZ2dH60JyCNohUWrpzlc
+L/6uqXnnPLmttrbb9PcKdzDYWEPKMrvFDut6Nt0rjnV+2KtiCtxOx5Z/TbPsfd0B803YvHrQkYyu
+Mbm511I+OI/uaPJVRYJe1I3yz9BJP2gxD5y0Il96VzHBKFuOj/SiijIOVCJwK2UEnhwqB0On8ylL
+AgiqXYSDDCtJUVsPkWSdcWKlWIJjUS62hLuWSbvFeDDtF5NyEX0kZPZvnvak7Sud9G5wAuBARn9U
+ie/qRDNUoDwom6H2iOAg9erT5au3RYEy4BKUkx5alP6Ad9MMQXB5S23SxtPu4XPvMtvSVWmef8zI
+InxWv8UULA+KIei6PZq+iaHqSrmt9yhteQIAFoEv8Te1wCRPPrYAMfxntbokufRlBteSS/X6OOJ/
+YZvVcTUrhpRWOj62+pON+akAA9EcjpbkNxfpPdarx+dB9/AFuJe5b9WQ+JB1pss4ep9GhXwuhugy
+SWSYrugKGhmSzMPwgXW9HiwmCXzIkih4Vc06CzdnZGJQq1MFRNKqwUF3kYwHsAZ1uq7+ntOPJeef
+sOdvy3IWoKwHmytACqi+OuY4T36v7lOwW0+nFD1U0cqNelD2cfCaHHkaXcIZd4WJld44Q5wiye6z
+x7/uHKjKdpD1piHYm5Kxtiw567z6rZroKEoULXH87iEG2dkaA/QwIMdvuphuPJqqfMh18jQvT6fL
+4uhb3GmVUtod90vofaoXSbB20N2Gb9qeehJGbHO/p+4NMaq902nVV/67o6JfBrY7qirc7N1wtfeZ
+FF8jpD0OdsboM6/Rrfo9XJ8zUL5iaiK/qZgBOJ5he1RN2WjRubjPev1iiH0IFjAr3Lm8pm+Ofzwb
+aV+6yPUkjObU4siYBF+0AN+RGqopJsziB+z54c4eMs0AcA4Oy6OyKcd9xeBBXIYmZCy/YX5Se0Bs
+jfDRJA3wstq2JIz/hUZDziAJRX6RERBGLtop8vQ2I8XCQsMVx9Eb/ztiS/lFeGenjAiOKERZj4tm
+oD+jj9qtLQPXJTp6ubfL2F8WWEZT9utmUDTl+gjUdjYauqOUPP4C7R3OA2AF6BZfYeJzVlDoYdJM
+16fV4EM2aepxPfqVva1eV3ASdAYW8zvt8PVwPQzWNg/3dzb/09ZB2q6G5X/+kifrZ6PhvzmHQgdN
+26lQUkeEIgy54Hjj4ZX/Gyv6vz7+SNMuNw/SPETmITEPqXnIzENuPAM8tE/CPkn7lNqnzD7Z36Ji
+k75A3PxV2K8I+xUR2b8mS57sGMKOIewY0s5P2jGkHUPaMaQdQ8ZmDGlHk3YMaceQdozIjhHZMSI7
+RmTHiOwYl36yc4nsXCI7l8jOJbJzie1cYjuXWHNobgLe3O1ybGcH4Kg6DfTb0E921NiOGttRYztq
+YkdN7KiJ3YHEjpHYHUjsyhI7RmLHSOwYiR0jtWN4T3a01I6GFBM1+9SOltrRUjtaakdL7WipHS2z
+o3lPdrTMjpbZtWV2tMyOltnRMjtalilWyUVsHtxbdvzcjp/bUXM7qvdkx8/t+N6TnQl4l9qV3M4k
+t+vOc8PreRi6R+4ehXu0iEAeRu6/xu4xcY+pe8zcoxuNuyG4G4K7Ibgbwn90o3E3GnejcTeEcAsS
+bjT/0Q3sP7o5+I9uOv6jm45w0xFuOsItXro5SDewdKNJN4R0H5PuY9L7mFtm5JbpP7rRIjda5EaL
+3GiRGy1yo0VutMiNFrvRYjdE7IaI3RCxGyJ2GxW70WI3WuxG8x/dwIkbOHEDJ27gxA2cuIETN1ri
+RkvcaP8Dj26SqZtk6iaZukmmbpKpm2Tqdid1803dfFM339SNlrnRMjda5kb7f+3RrSJzq/Af3YIy
+t4rMrSJzq8jdKvxHtyAUgVEcjOdu4NwN4T+60XI3muNywnE54biccFyu9WhHE+9+tPsgHEdsPdqD
+FSse7dSFY5nCsUzB7UYJxz2FY5mrHt3MHPcUjnsSXFvtr0BzdPNoT0g4Rioc9xSOZQrHJ4VjjsIx
+R+GY4/9nHt1pOhkghNtU6U7Tf7RkL5yQEE5ICCckhIyNGhGtfpDmT46WnXARTri0Hu3NEU7OCCdc
+hBMuwgkX4YSLcMJFRPaeCidchBMuwgkX4cSIcGJEODEinBgRTowIJ0aEkx3CyQ7hZEfr0e21/2jZ
+p/Af3fY54SIStzYnZ1qPjgqcnBFOYAgnMIQTGMIJDOEEhnACQziBIZzAEE5gCCcwhBMYwgkM4QSG
+cFJCOMYuHDcXjpuLzHExx9iF49vC8W3h+LZwfFvkjh05vt16dEM4bt56dOTpP9qrJR2Pbz3a45aO
+3UvH2KVj4dKxcOmYtXQcuvVopyMds5aOWUvHrKVTdVuPlrikY9b/E4+WPKVj99Ipy9Kx+0s8uv19
+56MTGNIJjNajvWTSMSbpZId0skM62SEdU5WOqUrHVKXjpK1Hd/L+o5uD46/S8Vcp3fY5ltl6tLdb
++o+OHvxHt32OkUrHSFuPbmbvfnS045iudExXOuVeOv4rHf+Vjv9Kp9xLp9xLx5Wl48rScWXpuLJ0
+XFk6riwdK5aOFUvHdKXjtNJxWuk4rUxiLcf837vt/1cf3VE5niwdT249OiJy7Fk69iwde5aOPUvH
+nqVjz9KxZ+nYs3TsWWZcRXZ4pP+N9b+J/jfV/2b631x5PaX6R/8q029n+u1Mv53l6ld5qP6N9Ndj
+/f9jof57rmeBlgwI9YhQn0Jo/qA/bP/VA9h/zUC5+aH9gn0wPir3YHSWUIcuc72MPDFfMQpPaMgi
+tH9KzTvG2xKasT2WnAm1T4n6J1b/uGvn5KB0crD16HiD/2hFl2w96t00y9Gnw/Umcr3bXP890v9/
+4V/z9/l/9fcW/tXfd//qrbGdMT1x5KR569FdEP/R8TIn+VuP7q4YEopjtQmx3oxYU06sKSbWlGL+
+TfTk7b96UzxOkRvKkXoDUOcDZBppMo4MjUmzcml/Yh8MsUm9q0IPLPSAwvx3PYjQCwGUGoMJvRCh
+FyL0QqSehNTfk/p7Zhw7af09qTcm0eMk+r8n5r/rcRI9TqLHSfU4qR4n1eOket6p/l6qv5fq76X6
+e6n+HgocYj2cm8vlHswtcw/murkHc+/cg7mA7sHeRD13qecgM3UBPbJxYu3dj1ajlO9+1LuQ6V0z
+fDbTu5bpXcv0rmWxvjPCrEaYRUhDXZ5W4nRX6XRX6bTU1qN3i/R+OPEaOR02Msw21nNKDMkKQ9ZC
+r8Yp1JFTWyOntkZOV42crho5xhg5XXXVo704yUfsaDR79P+wdzY5kuW6Fe73Bga8GMMhUb8b8swD
+Ax54YV6gcevyO2Q4VZGRWd393IB70gdRGVcSRX5SXElUMXzBcIEQGC2ErIdQkDZaE4KyQtD0EHhr
+o6ca9WnUp1GNTpd1ygpBWQdBoSEoNASlh6AaSfAivMWMv8WM/8elkPfblxbz9Rbz9Rbz9RZz8Cxj
+4t1i4t1itt1itt1itt1itt1itt3Cr5u8v2PT+KHdYv6dZUzFmyKl0+kxIWwxDW8xDW8xDW8xDW8x
+DX+Smge2mHC3mHC3mHC3mHC3mFq3mES3mES3mES3mES3mES3mES3mES3mEQ/SbGtZSnMtZhPN/nQ
+wE0n7jXxqklHiNuLPx64skAx+NagAyaRMPnWwssXzi32Lb6+KFRLWZvnbJ6jUXnzwM0DN63QRC4E
+T97E6kFQ+TdEZX5Zr2uprnGtMr9MwmtYmU9W5pOV+WRlPlmZT1bmk7V422vxtlfgWAtPZgytxdte
+GTErA2VlfKyMj5Xl2srru8orvsraYa3eKZUZTAW7FdpWIFuBbIWtFaRWSFohaQWgtbnBK0yseGYS
+VKN7x1WCvA7sgxvXwd/ghxU/rPhhne69dWLD6f5TiYJKFFSioE6eTDjUxXNC8MAQPDkE3RSCBh6E
+O209CExHgCSBt4SgqiGoagiqGoKqhqCqIahhCAxFEBmRYkSKESlGgBgBYgSIESBGgBgBYgRICCIl
+CTeChXAjWAg3goVwI1gIN4KFcCMY8ZWEt91CeDeZBD9xjcmVEYxGMBrBaASjEYxW3eDGiJkEZTEc
+Gj9LzGg7sWxGS3nJb0yzjDBPgnaFUFkICGAQ4CSoBkwwmGDMrgw4GLMrY3Zl4MKYZhmzKwMgxhQq
+CVoKWwykGJONJGgpkDEgY0DGGP6M4c8GRQy8hQHRBv31+wuqGoK+kIB+SRAXYNDAoIFBA4MGBg0M
+Ghg0MGjXHXLX8GdMDwweGtAzeHgSWAz6Gawz5gAG6wzWGWTTT3SDYwa+DGoZsEqCTgFWBqwasGrA
+Kgn33vZKOFIaQGsArQG0JLwaLYT3aQvhfdokYF2DbA2gJUE14FgSbvAGxxoca1CrAasGrBqwasAq
+CcqCWg1qNajVoFaDWg1YNWDVgFUDVg1YNWDVgFUDVg1YNdDUIFKDSA0iNfjT4E+DPw3+NPjT4E+D
+Pw3+NPjT4E+DPw3+NLCjyXYDOw3sNGjToE2DNg3avBRYHv40+NPgTwM7DUo0mJCEM6GFwNVDUBaU
+aFCiQYkGJRqUaFCiQYkGJRqUaMChgYsGExpMaDChwYQGExpMaDChMclpMKHBhJMgHKBEAw4NODTg
+0IFDBw4dFHTivRPvnXjvRHcnqDux3InlTix3YrkTwp0Q7kRuJ3I7kduJ3E7kdiK3E7mdyO0EbCdg
+OwHbCdhOwHYCthOwnYBNwv2nE7mdyO1EbidyO5HbidxO5HYitxO5ncjtRG4ncjuR24ncTuR2IrcT
+uUk4TrsEsZwEzQnh4dBD2P3arhPmndlFJ8w7YZ6E472HoD4SECAJ6hOC+oSgPiF8TtJDuKv3ED6V
+7VCiQ4nOFOKVgCQdbnRw0cFFBxcdXHRw0cFFBxcdXHRw0cFFBxcdXJwETX4lMMJBYA1o06FNhzYd
+2iRB77wS9BdE6hCpw58Of5Lw6UEHRB0QDUA0ANEARIMZyIBIAyIl4S0dIbysEcJ9dYRwXx1Qa0Ct
+AbVOwj1zwLEkvC8GQBsAbQC0AdAGQBsAbQC0AdAGQBuQZEC2lwJrhMAa0G9AvwH9BtAbQG8AvQH0
+kqCl0G9AvwH9BtA7CfexAQYHGBxgcIDBJGgXPBzwcEC/g4CHJ0E1DsI9fBwEDgAqB6gcoHKAygEY
+BzwcQG/AugHiBmQbAG0AtAHQksCfIdsAXwN8DfA1wNcAXwN8DfA1wNcAXwN8DfA1wNcAXwN8DRg1
+ANFJ0IMQaUCkJIhKCRiVBB0XwlE54M+APwPsDLBzEl7oBEQTEE1ANAHRBEQTEE2wM6HNBDITyISA
+Nkl4cybYScKdbYbwls4QHowT7EywM8HOBDsT7EywM8HOBDsT7ExoMyHJhCRJuB9OkPJKQJsJbSa0
+SYImg50JbSa0mdBmQpsJISfYmUBmApkJZCaQmUBmwpYJWyZsmbBlQpIJLiZwmDBhwoQk6B3gMIHD
+BA4TOEwmSxNKTCgxocSEEhNKTCgxocQEDhM4TOAwgcMEDhM4TOAwgcMEDhM4TOAwgcNBgIsJLia4
+mOBigosJLiZwmMBhAocJCiYomAT+ZCqSBP4TgpAJQaeEcDRNcDHBxYIJCyYsmLBgwoIJCyYsmJCE
+j8LrIDyIFrhYUGIBhwUcFihYEGBBgAUBFgRYEGBBgAUBFgQ4CR/+Fkx4T7idF9xYcGPBjQUuknD2
+LuCwgMMCDgsmLJiwYMKCCQsmLJiwYMKCCQsmLJjwnnCPWm8JjABbFmxZsGXBlgVbFmw5CR8dFrRZ
+0GZBmwVkFpBZQCYJfCwElpeAP0lQOiBagGgBogWIFiBagGgBogWIFiBagGgBogWIFiBagGgBopMg
+rEDTj6tHr1e4bwlgtYDVglELRi0YtWDUglFJYN5XAoPDsQXHFhx7T+CQAG3BsQXHksA+AhqznY9i
+w7qTcE/Y0G9Dvw39NvTb0G/Dug3iNojbIG6DuA3iNohLwsNqh3CGb6C3gd5JeO/sg/BO2R8FqDwJ
+rHEQDs8NPDdg3IBxA8YNGDdg3PBww8MNDzc83PBww8MND5PwEW0Dxg0YN2DcgHEDxg0YN6zbAO2l
+oC9CUHoI98MN/ZKgCyTg4YZ+SWBeMLjB4AaDGwxuMLiB3oZ1vypA5YaQG0JuCLkh5IaQGwxuMLjB
+4AaDGwxuMLjB4AaDG+htoJcExoRsG7JtpmEbxG0Q956gl8HgBoMb6G0QtyHbhmwbam2otaHWhlob
+WG1gta/cQBfMy+PxICPHo0hVKZNqUl1qSE2phbpSM9xlFD05KZVRVEZRGUVlJKXSklK5SUUNQm3V
+SupK/3DXLynVNCnVNCnVOSnVPim1Iym1Iym1IynVPinV3tRvpjqbamqqi6mmprqYanAllrhtYCrN
+VMaVWOL+1yuxxAel0prKaCq3qYymtl0JI/wpKqOrHV3tSEpldJVxJYq4n5KU2paUatBVg65WdtXg
+Sh5xPy8p1WWoBkM1GGrlULlDpQ2VdqWR8CertKknTz3vl5TqkpRqlZTql5RqOmWXqZpeiSfu2l+J
+J1zJGlfiCf9MNbgST/hnqsGVgsI/U2lHpRos1eBKQnF/90pC8UGpLknJplu12qrLlg2uJBT+vCiN
+GhQxsYiJRUwsYmIRE4uYWMTEIiYWMbE8KK2IjkVMLGJiEROLmFhEwiL+FVGviHVFhCviWhHNfqRe
++dHyInJ9prBk+URh5yKuFdGsiGFFDCtiWBHDihiWFb1axLUimmVF/xZxrYhrWakXRLgiwhVx7axU
+F7EuK9lA/CuiXhEJi/hXxL+sYERJCm8vImER/4qoV0S9IuoVUa+IekWsK2JdEeuKWFfEuiLWFbGu
+iHVFrMtKdg51Jcq5/U/Uy0q+JkoVUaqIUkWUKqJUEaWKKFVEqSJKFbGpiE1FRCriUBGHijhUxKEi
+5hQxp4g5RcwpYk4Rc0qQRvSp4ksVX6qoUkWVKqpUUeUzRS9UMaeKOVXMqWJOFXOqmPNHKqK2imZV
+NKuiWRWvqjhUxZwq5lQxp4o5nylZSESqIlIVkao4VMWhKg5V0aeKPlX0qWJOFXOqmFPFnCq+VPGl
+iipVVKliSRVfqqhSRZUqglQRpIogVQSpIkgVQaoIUkWQKoIkJZZkBcNqUjCsJgVPa1JQoCYFWWtS
+8pykmGXUpBjZa1Lq81CTuWhWakdSakdSakdSaofmYVVcq+JaVqppUqppKFGvinpV1KuiXhX1qqhX
+Rb0q6lVRr4p6VdSrol4V9aqol5XaK/5V8a+Kf1X8q8G/f7gyzfr+6gqPNY0p7yu82DRCmEYI0whh
+GiFMI4SJ4yaOnxU+bkeFt9tJaUZ7Vmr5URG1dlRq+VHh2XZUsMmOCkrZUcErOyrZ6qhkK41bpnHL
+NG6Zxq2s1KKk1I6kVHuNaqZRzTSqmUY106hmGtWykp01vpnGN9P49pmS1Y5KVktKtgqlUTIr+VBS
+8pyk1I5uV0bT//g7uUmVL/Gabt0HKa/AcaUsiVfg+GfKv3gFjn+m3IiXy9+fXU7tSpkYLyPen/GW
+98cvvPujayS/1TWOulJKxmsMuT+7xgFX/kb/lBzyGgXuv4qUOuWhDAQljvKmHAdPGRZ1zPDbuRTj
+CXGw8ylNoU4zP2UhVEqFn6UIVIKBlNnjKaufDneXSNFRIn/GU467qGRkbSiRoqBEXoKUM+4pt5uO
+kuYcbHHWtlY16CnTWXwtrFNZ3ijVF3tKWPFLybLCSE9JrdTaN1JSycxPGafiCVNm/lNyS+kk9FNm
+KLnQU9omWTznKIqjwm9k7FHv5IQ8TfnXnlLvyFDGIkcZfrr+r5KLxRM6sCxaWAQtrHSmxCr3NunC
+iuefnVBFDLPlaSm+nCTF7rwiSn7iC5k/y20S/fuTLCb6vj/43cQlkaiEU/46/XRIUOJ2Zyk0JSbx
+Yv8/DQnQZGG8sDBeWBjPmUVuL7Dp/1+vEop4Ho1/WA4Qr2wXfk/ZPlh1/bUsHzmfR8XpiHqbfMLk
+w3yx9ZSDwzdpFBbQ38u4QTh1Su88p9PBvXs1uoaFp6wYCtmn9BZ6DI2IUfIpDUU8M8aB9n8tT0R4
+QtdArJNphe01KSeC99MpzYEb5lXqASyeDtK7B5zOz/PARaHU53SCnT9mUfx0Ot177JcPnHt9TqfK
+3duMnTcGTl4eFHcjGM5lzNzSAW/9DaUTRacj1lSD4DGCx9hZcjhQTYQYEWL0l3UP3TgRPTyKNF2J
+s830qbHR4XB2ly0LrXigNkz33XOn7gCNPT0vT4e6MdNZULdYw2Jx8pM9NA2mNBCZDka6fdp0H2sY
+4YtnHfk6P8u+eKCRrxOn6USi2+fVQUT2aZ2OAvqTO37Y8cPOXKLzu6PTBZ1tVd0ctW8d88MP3ztE
+513Z2ZB0OiDnndvpuI73dibbfWKf6Y59Otzlvno6poV92HCTDmV5wA6YMJhQDCYUXzzX5GX98jEk
+d9oBSeKMED6fjvS4DQfj3sCGg+1Qg11Q6ciKF/Grpz7Y4zjBRTqA4f012RQ48cx0TMIrP/HDCeIm
+iPtrHidw10pHBdyNJ33xiwcDFj/iYis85v3mzmN20aUduu4kiyBa4Cu2z9LLX9z66dY47e/0QmNb
+J0PAacum4+vlLkzvi7Tn0r3u1Q7Lw65HKsbm7z0ccaddjxQBvmKzI4Nv2hTIc7TyknYA6q1q2q90
+vf+7V+4fvCPNezl4T3zee8Hb16L3xOcdF/o7rawVraKl9f9Y10qr29QgrxlrRe96efqj9vV6eXor
+4x34n7L+qpW6vLZCDT5bR9Eb7VgVUdvyaofeVKdVDJWRViewVV51UBnqo6d3+ddb7v/2e9bS/Mx9
+Tp/EdIEA1uCXfgw4pvWJJljprLM76PgwwtXiEfhRKAlEhxFfPJXr8ZbySfonH39vFEZKiY+/N4p5
+Kz5m1SrsE4/8IikZInb9Hus+Js4QqNM0wxn14tR0ynrmPaZzjDH0N5xJmfmOGPUduBG7hV9w2nhd
+BMDd+XPGg8Pm5djckF1WIZAUwKpkM44kvfev/nFx5F7dAO9pMuCvaj5mBeaqNqZu6f6lp3fresP4
+s4sofBKXfoJ4xdJEJh7S9Xbv6YYKf0i6IyMS58QaiHLk6ddNJO/r7q07zqX4LFdZUYb2Gdi1Wnzb
+LFaauoeLZsU73n0oz6kyonzxMLU/+0unquOUHCNwHPUiyFYsTSm3Uzos6F2x0musRyTSxEL55KBe
+vnKgYXFSZ8UymfEjZcGYOG7HL8eYF9H4xcuNOKLDz8M4JxDnH+jQJDyyHhpEiwaGtAksbfBgAHl3
+W0faQGEMwMeFaZEjL+NSv/NCLZH8tBR7DU33f3//J3/leod1bOX9Aw868JMu5kW/19kHwHo+3eD4
+Lg+Idz7IEP8Kgh+aEjzU8+fDCPTFg2l+uWL+x+zpsxMIKsxwn4cB9U9PINB7+qqmJA+x/CG+nw8g
+qLCkZNCY4MrfHszZYzf3+XSCDKo9AOeTCCpM++iezh/QRv2ZttFlhb9/5/gBmySeDiJQrP5Ru97S
+XF+z73w2QZ2RlIw81Qzta3s6VUCx8qhr+8HtSJoYPzS05OMFKkIb17LyMS/uV7gG8vu5+sGSlTpP
+fMtHBdREzT/OhwZkPG1le2xfT+GdRGHYSFv9tcUsUBHRG6GVDxZQo3DKIo8p6sSnIwZuau3gShVI
+n2GIUojUL5w1oAg9RD9GPjtqoPboJ0ipWPM7hwRUeWHm/QMBspQqJcycf52qsBbfwD3zjn81iFOZ
+6fBGZ0T8Azb30y3EYhFSikDy2S7/+K5aoV2zZagI/aPwUSasyj/eZTJBowgaeZO/ihU+0s993myl
+Uy7Cx2f7/tU/wsfTHn9MphcPosb7W/xlC9GgiAZ+o0P83wtUwFRBoeq9yvk0AD73/rkAWhcvP649
+aT/oeD4CoCIKZqtyprw9H2tVTUKqGgYTajQxyk//ime8v50fOz9t58ee/nKvxHM19/zFzfyU4L+A
+00YqmUuTkipGVDHiC/v3KUrWFy3y9n0Ve1TxXWLquH1faKhCw3lbvkoberIgcd5kr2aowwSJ8y56
+uYIgkXfMq1hB4rxjXsUyx/hDt8nTVQqFT/bLMz3AT+Wd9oCbeZs2VjcdAjKN+6Zx3zSfUprzOEtn
+Cvb8xlGFJUXIfmeHN8bOu7mxiRKaF1MRokJWqlRS8Y1QOMr59aZspvjJimiwpHDQ42tQ+ZHp14+m
+G+ed1HiqmQo775p252FF9NeF/6Y34edpezXFSeCMIE371Vj2KKx8F/YKvN5oc2944r2sXvSkDTeM
+DwdBLV4Jqv5KQOrOoMC1XiyxFl7H5EtJvOq+Z4vdF4W1s8La2Vs3kXCnncZF3nEWlotPV5PABLar
+6KVn2mtDm74p/GY+n46wtp927nj/pHtJvveJdg7eTlf5PVTphIqpP95t5l/B9yrbKVnMrWyDjGvP
+/CvT/SPtJdI/ueXy7Rx3n7N7oZLUo7Icebqug9qxlJau4qCoRVG4AeBId2H4O8zjXRj3uyu3IJlq
+TldjuHHT3iV94r2Wrs/4+In7m7Hh9s0LNW6rscPj99/wpAvZqDFuauQDenkNhndQug/DnSJ94tY3
+1pQMH0jC6Wy6Qw9zqUdkAtU0/oZaGEWZe4eZe4eBX/0tL/sNDhscPu3ZooQQtPMgqAUrDC8FFWx0
+NQ9mB4ix6+m9uzSoKdC2TnVgtcFqY3FFdoPVxqKQsXPH2LmTBEWxhSc2oA1aNWgVHUK6ION1uU08
+b9LnkwcfBNbh1bzBnNj0NimTSoCa000YlAlq0pY57AZqDNT8Ofde3GByU2iJREsfDcY00PLFqy3c
+OscbLW7q/O/rQ083XLj93rvGwp3gtMGQ2rwSVMsdJl2CoU+oDhhq0Od404U3ky/Bmu/eeOEO0w6C
+lr8SmOCVoMq4BfBqQKc1ioIsSThlG6yBXWmHpa+7xVZL5o6NTYG/3wUa9BpoaRDlresy+Mn73nUZ
+9PFfSdDJQO+7F3rwHGZR6foOXBa0fXG3q9M43dGBE7OBrDEL9utXcbDTlR3eR50pUtop6wWluzu8
+5ukSD3f8Dtn+0Ns8bnQQHGnjrk8K060e1B0UHe/w8OdhAmY7v76l1x/sPnC6wYMaw9UkqA686RCj
+w5sOb/JVHl4mDVefM5PpspsarArGt+hh5i35cg4vgT9h3tKZt3Tg0oFLBy6d6Upno9/xlg0vAadi
+3tKZt3TmLUlgLiK3E7md6UpnutL5idSZrjAr60xXOr+MTnds0J/EdCeUO9OVl5dk0OfbGZwuwPCb
+0d20373RQl93A8ZtFazRnS6pcAO+vInC33vQwYMxPy6ZIOIGP1mH/7zDF073P1BPRt/BWIvfpOsf
+3HqnWx/c50cIHsx4PBh98cO019tH3/QJRYXwHhshsFoI945BMA0iZTDlx/cHofPyPgdaFd9yzo+D
+8NBh9+zgnnL9KZPugRen7eqUhDufbligAJ/OnK5TwEghMFIIjMQoNnhPMNhFmS5Y8DLBQLpfwYtK
+G+y9qMk4loQ3b4bwQDldveCeMxnQkvCmzwf1cj9J+/Tdl2LDPm+FTpcyuC0mbxNfX8FwsxEATqbf
+k1CcDHWz0gZ+8yfBYzAX0++XgnbyFmDyFuCrtyx4G2g4rwMmwT0ZDiej4FdvW/AS6HKC++WtC3gD
+404SHnGHyxbY0zYJbkabdA0D3hACSx4ELn0QdNZBYMqDwAYHQYsPAt85CIxxEIRGEt4TPE9BfBBY
+5SCwykFglYPAKgeBVQ4CqxyEWoENWISbzCSmD5H6C2YUSdBOjg59+0ILt63+f78cnryDn0w1Tvda
+YBsIPCHwx8srdIvF3F4UjVq8h/3ibRa++IAl0pUTFOAdfrpoQv/kPZ8uiHDjM8h99zYI793FNISx
+Mt0B4TH98hPv5wXN0tUPbv0373e4+5nxOV3vQAlMOl7e4UCrIBXD/BfvafDwWJAhX8/gFfUwOZ2R
+ooeojFxAPa7O02PijynAPf10BQKWxa+ToOb8UF64MwETJ66YWXz7eoLbEgTge9cUeNU3E4F0BYGb
+axMfzK3SpQLuC5v42NVn3sy6NoP8F+8CoC1UD9/fePFmwp2E23o394XdvOfpl3RSjJozq351ZIxR
++piU343uS5z092bc3Qy372XZpwk4ej6K5iXROAazL+bf5zH0LljdRMUmKk7J9n0I2yGo8XK+8iIo
+JcvnT66q32cZ4lDHQ8c7vqE4AlAeOipQHsqwUB7KsPCUQ0t5IkokgSoP5Z74buKsa0eGt05HR0qN
+itX0aVQhndyIrBXlJzm24mFx1OQNGWb4iYzqNGVQKj3M28OmWcZze/paGLIrzdPP8nzF3yY5o+Cf
+ZP8KS54Tga2oTuRdS/0T9XpKGYYrXttn7p6sqkp9qNAnqWMoNZzpKaOYjFRL/G2WskGNetVUcLjQ
+k4zqVPVODceqKVNZjSJy0jJ5U/1cRmmRj6tatM2ibeHRNTy6Rh6WGs6dTlHVcO4aqblqU1fVEfX9
+UkI0+UINH6uRYe4pTVp0d5ZR8OfJ0yKnYBj92kfr/hTNCc+rkayuRtq/usO6O6y7ozk7Svg0oVpQ
+0cJPLZ2TCj+1oiJyHrYIn3AGCze1OFf5Y0PY3d5wFgvP+3FMyf9dbfxZljY5nv1EyuDhghb+YZFV
+0MITbExiPVzUonNtyuA2BUebYZgAk031qQWYLMBkkaHwd5ZRyUizZ8G+N6Q8yD6X0cxw3SepMLEs
+owO3OGXh2xa+bTtMHW5u4eZPMlXnJ5JkofKxFkN/iyhoMfS362DL7ZmBo1ZEhackW/HYoHkrakIL
+sLcA+1Niq3huluqTp+RWMmOLmGoRPi28vwWAW1C32cblYxLQIjVii+G+dfXkk1T3tRj527UV2i2m
+mLoyDNyfdaPQoaSsQ9lRh5KyTn12vcO4v7voP0V4cDQppWld+oJaep0zuB+29WfX+SP/rN/nGf/2
+22+//e23f/7tv/7l3/79P/+1lPI/AQAA//8=
+`
@@ -0,0 +1,34 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build static
+
+//go:generate go run gen.go
+
+// Package static embeds roy's default (PRONOM) signature file, so that programs can identify files without shipping or locating a .sig file.
+// It is only built with the static tag e.g. `go build -tags static`; use it through siegfried.NewEmbedded.
+//
+// The signature file is held as generated Go source, rather than with go:embed, as this module still builds with Go 1.13.
+// Run `go generate` in this directory to refresh it after updating cmd/roy/data/default.sig.
+package static
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// Default returns the contents of the embedded signature file.
+func Default() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Replace(defaultSig, "\n", "", -1))
+}
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/richardlehane/siegfried/internal/identifier"
 	"github.com/richardlehane/siegfried/pkg/config"
@@ -68,7 +67,7 @@ func New(opts ...config.Option) (core.Identifier, error) {
 	// Having retrieved our PUIDs from newWikidata, assign them to our
 	// provenance global to generate source information from Wikidata.
 	sourcePuids = puids
-	updatedDate := time.Now().Format(identifierDateFormat)
+	updatedDate := config.BuildTime().Format(identifierDateFormat)
 	wikidata = identifier.ApplyConfig(wikidata)
 	base := identifier.New(
 		wikidata,
@@ -104,16 +103,17 @@ func (i *Identifier) Recorder() core.Recorder {
 //    warning :
 //
 type Identification struct {
-	Namespace  string         // Namespace of the identifier, e.g. this will be the 'wikidata' namespace.
-	ID         string         // QID of the file format according to Wikidata.
-	Name       string         // Complete name of the format identification. Often includes version.
-	LongName   string         // IRI of the Wikidata record.
-	MIME       string         // MIMEtypes associated with the record.
-	Basis      []string       // Basis for the result returned by Siegfried.
-	Source     []string       // Provenance information associated with the result.
-	Warning    string         // Warnings generated by Siegfried.
-	archive    config.Archive // Is it an Archive format?
-	confidence int            // Identification confidence for sorting.
+	Namespace  string             // Namespace of the identifier, e.g. this will be the 'wikidata' namespace.
+	ID         string             // QID of the file format according to Wikidata.
+	Name       string             // Complete name of the format identification. Often includes version.
+	LongName   string             // IRI of the Wikidata record.
+	MIME       string             // MIMEtypes associated with the record.
+	Basis      []string           // Basis for the result returned by Siegfried.
+	Source     []string           // Provenance information associated with the result.
+	Warning    string             // Warnings generated by Siegfried.
+	codes      []core.WarningCode // Codes for the warnings.
+	archive    config.Archive     // Is it an Archive format?
+	confidence int                // Identification confidence for sorting.
 }
 
 // String creates a human readable representation of an identifier for output
@@ -144,7 +144,21 @@ func (id Identification) String() string {
 //
 //      e.g. namespace => becomes => ns
 //
+// Describe returns the format information recorded for a Wikidata QID.
+func (i *Identifier) Describe(id string) ([][2]string, bool) {
+	inf, ok := i.infos[id]
+	if !ok {
+		return nil, false
+	}
+	return [][2]string{{"format", inf.name}, {"uri", inf.uri}, {"mime", inf.mime}}, true
+}
+
+// Fields returns the labels of the values of wikidata identifications.
 func (i *Identifier) Fields() []string {
+	return fields()
+}
+
+func fields() []string {
 	// Results with extra source field we can populate with provenance
 	// information.
 	var resultsFieldsWithSource = []string{
@@ -156,6 +170,7 @@ func (i *Identifier) Fields() []string {
 		"basis",
 		"source",
 		"warning",
+		"warncode",
 	}
 	// Result field without source field. This is a little more like
 	// other identifiers used in Siegfried.
@@ -167,6 +182,7 @@ func (i *Identifier) Fields() []string {
 		"mime",
 		"basis",
 		"warning",
+		"warncode",
 	}
 	if config.GetWikidataSourceField() {
 		return resultsFieldsWithSource
@@ -181,6 +197,14 @@ func (id Identification) Archive() config.Archive {
 	return id.archive
 }
 
+// Annotate returns a copy of the identification with extra basis and
+// warning information from post-identification analysis.
+func (id Identification) Annotate(basis []string, warn core.Warning) core.Identification {
+	id.Basis = append(id.Basis[:len(id.Basis):len(id.Basis)], basis...)
+	id.Warning, id.codes = core.AddWarning(id.Warning, id.codes, warn)
+	return id
+}
+
 // Known returns false if the ID isn't recognized or true if so.
 func (id Identification) Known() bool {
 	return id.ID != unknown
@@ -191,6 +215,17 @@ func (id Identification) Warn() string {
 	return id.Warning
 }
 
+// WarnCodes returns the codes for the identification's warnings.
+func (id Identification) WarnCodes() []core.WarningCode {
+	return id.codes
+}
+
+// Fields returns the labels of the identification's values, as for the
+// identifier's Fields.
+func (id Identification) Fields() []string {
+	return fields()
+}
+
 // Values returns a string slice containing each of the identifier segments.
 func (id Identification) Values() []string {
 	var basis string
@@ -215,6 +250,7 @@ func (id Identification) Values() []string {
 			basis,
 			source,
 			id.Warning,
+			core.JoinCodes(id.codes),
 		}
 	}
 	// Slice must match the order of resultsFueldsWithoutSource.
@@ -226,5 +262,6 @@ func (id Identification) Values() []string {
 		id.MIME,
 		basis,
 		id.Warning,
+		core.JoinCodes(id.codes),
 	}
 }
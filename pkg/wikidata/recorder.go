@@ -32,6 +32,7 @@ const (
 	extScore = 1 << iota
 	mimeScore
 	textScore
+	externalScore // a Tentative result from an External matcher
 	incScore
 )
 
@@ -87,6 +88,8 @@ func (recorder *Recorder) Record(matcher core.MatcherType, result core.Result) b
 		return recordContainerMatcher(recorder, matcher, result)
 	case core.ByteMatcher:
 		return recordByteMatcher(recorder, matcher, result)
+	case core.ExternalMatcher:
+		return recordExternalMatcher(recorder, result)
 	}
 }
 
@@ -153,6 +156,33 @@ func recordNameMatcher(recorder *Recorder, matcher core.MatcherType, result core
 	return false
 }
 
+// recordExternalMatcher records the results of External matchers, which
+// identify formats by their Wikidata IDs (QIDs).
+func recordExternalMatcher(recorder *Recorder, result core.Result) bool {
+	e, ok := result.(core.ExternalHit)
+	if !ok || !e.For(recorder.Name()) {
+		return false
+	}
+	if _, ok := recorder.infos[e.ID]; !ok {
+		return false
+	}
+	confidence := externalScore
+	if e.Confidence == core.Definite {
+		recorder.cscore += incScore
+		confidence = recorder.cscore
+	}
+	recorder.ids = add(
+		recorder.ids,
+		recorder.Name(),
+		e.ID,
+		recorder.infos[e.ID],
+		e.Basis(),
+		"",
+		confidence,
+	)
+	return true
+}
+
 // recordByteMatcher ...
 func recordByteMatcher(recorder *Recorder, matcher core.MatcherType, result core.Result) bool {
 	var hit bool
@@ -275,7 +305,12 @@ func (recorder *Recorder) Satisfied(mt core.MatcherType) (bool, core.Hint) {
 		if mt == core.ContainerMatcher ||
 			mt == core.ByteMatcher ||
 			mt == core.XMLMatcher ||
-			mt == core.RIFFMatcher {
+			mt == core.RIFFMatcher ||
+			mt == core.MPEGMatcher ||
+			mt == core.EBMLMatcher ||
+			mt == core.BMFFMatcher ||
+			mt == core.StructMatcher ||
+			mt == core.DataMatcher {
 			if mt == core.ByteMatcher ||
 				mt == core.ContainerMatcher {
 				keys := make([]string, len(recorder.ids))
@@ -308,6 +343,7 @@ func (recorder *Recorder) Report() []core.Identification {
 			Namespace: recorder.Name(),
 			ID:        "UNKNOWN",
 			Warning:   "no match",
+			codes:     []core.WarningCode{core.WarnNoMatch},
 		}}
 	}
 	// Sort IDs by confidence to return highest first.
@@ -343,11 +379,8 @@ func (recorder *Recorder) updateWarning(identification Identification) Identific
 	if recorder.extActive && (identification.confidence&extScore != extScore) {
 		for _, v := range recorder.IDs(core.NameMatcher) {
 			if identification.ID == v {
-				if len(identification.Warning) > 0 {
-					identification.Warning += "; extension mismatch"
-				} else {
-					identification.Warning = "extension mismatch"
-				}
+				identification.Warning, identification.codes = core.AddWarning(identification.Warning, identification.codes,
+					core.Warning{Code: core.WarnExtMismatch, Msg: "extension mismatch"})
 				break
 			}
 		}
@@ -23,6 +23,7 @@ package wikidata
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -50,8 +51,14 @@ func (i *Identifier) Save(ls *persist.LoadSaver) {
 	// Save the no. formatInfo entries to read.
 	ls.SaveSmallInt(len(i.infos))
 
-	// Save the information in the formatInfo records.
-	for idx, value := range i.infos {
+	// Save the information in the formatInfo records, in order of ID.
+	keys := make([]string, 0, len(i.infos))
+	for k := range i.infos {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, idx := range keys {
+		value := i.infos[idx]
 		ls.SaveString(idx)
 		ls.SaveString(value.name)
 		ls.SaveString(value.uri)
@@ -104,7 +111,11 @@ type formatInfo struct {
 func infos(formatInfoMap parseableFormatInfo) map[string]formatInfo {
 	idx := make(map[string]formatInfo, len(formatInfoMap))
 	for key, value := range formatInfoMap {
-		idx[key] = value.(formatInfo)
+		fi := value.(formatInfo)
+		if config.Compact() {
+			fi.name, fi.sources = "", nil
+		}
+		idx[key] = fi
 	}
 	return idx
 }
@@ -0,0 +1,85 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// salt for anonymized names; nil if names aren't anonymized
+var salt []byte
+
+// SetAnonymize causes writers to replace each component of a file's path with a salted hash, keeping its extension.
+// The same salt always gives the same hashes, so results from separate scans can be compared.
+func SetAnonymize(s []byte) {
+	salt = s
+}
+
+// Anonymize returns an anonymized version of a path (if anonymization is set).
+// Path separators (including the # that separates an archive from its contents), volume names and extensions are preserved.
+func Anonymize(path string) string {
+	if salt == nil {
+		return path
+	}
+	vol := filepath.VolumeName(path)
+	var sb strings.Builder
+	sb.WriteString(vol)
+	start := len(vol)
+	for i := start; i <= len(path); i++ {
+		if i < len(path) && !isSep(path[i]) {
+			continue
+		}
+		sb.WriteString(hashComponent(path[start:i]))
+		if i < len(path) {
+			sb.WriteByte(path[i])
+		}
+		start = i + 1
+	}
+	return sb.String()
+}
+
+func isSep(c byte) bool {
+	return c == '#' || c == '/' || os.IsPathSeparator(c)
+}
+
+func hashComponent(c string) string {
+	if c == "" || c == "." || c == ".." {
+		return c
+	}
+	ext := filepath.Ext(c)
+	if ext == c { // e.g. .profile
+		ext = ""
+	}
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(strings.TrimSuffix(c, ext)))
+	return hex.EncodeToString(mac.Sum(nil)[:8]) + ext
+}
+
+// anonymize a name and any occurrence of it in an error string
+func anonymizeFile(name, errStr string) (string, string) {
+	if salt == nil {
+		return name, errStr
+	}
+	anon := Anonymize(name)
+	if name != "" {
+		errStr = strings.Replace(errStr, name, anon, -1)
+	}
+	return anon, errStr
+}
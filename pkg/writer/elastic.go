@@ -0,0 +1,178 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// DefaultBatch is the default number of results sent in each Elasticsearch _bulk request.
+const DefaultBatch = 500
+
+// ElasticOptions configures an Elastic writer.
+type ElasticOptions struct {
+	URL    string       // base URL of the Elasticsearch or OpenSearch cluster e.g. http://localhost:9200. User info in the URL is used for basic authentication
+	Index  string       // name of the index to write to (default "siegfried")
+	Batch  int          // number of results sent in each _bulk request (default DefaultBatch)
+	APIKey string       // if set, sent as an "ApiKey" authorization header
+	Client *http.Client // HTTP client (default http.DefaultClient)
+}
+
+// ElasticWriter posts results to an Elasticsearch or OpenSearch _bulk endpoint.
+// Since a Writer can't return errors, check Err after calling Tail.
+type ElasticWriter struct {
+	opts     ElasticOptions
+	endpoint string
+	user     *url.Userinfo
+	action   []byte
+	buf      bytes.Buffer
+	js       *jsonWriter
+	n        int
+	err      error
+}
+
+// Elastic returns a writer that indexes each file's results as a document (in the same form as a JSON Lines record).
+// Results are sent in _bulk batches. Once a request fails, no further results are sent.
+func Elastic(opts ElasticOptions) (*ElasticWriter, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("bad Elasticsearch URL %s: expecting an http or https URL", opts.URL)
+	}
+	if opts.Index == "" {
+		opts.Index = "siegfried"
+	}
+	if opts.Batch < 1 {
+		opts.Batch = DefaultBatch
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	e := &ElasticWriter{opts: opts, user: u.User}
+	u.User = nil
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/_bulk"
+	e.endpoint = u.String()
+	action, _ := json.Marshal(map[string]map[string]string{"index": {"_index": opts.Index}})
+	e.action = append(action, '\n')
+	e.js = &jsonWriter{
+		lines:    true,
+		replacer: strings.NewReplacer(`"`, `\"`, `\\`, `\\`, `\`, `\\`),
+		w:        bufio.NewWriter(&e.buf),
+	}
+	return e, nil
+}
+
+func (e *ElasticWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
+	e.js.Head(path, scanned, created, version, ids, fields, hh)
+}
+
+func (e *ElasticWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
+	if e.err != nil {
+		return
+	}
+	e.buf.Write(e.action)
+	e.js.File(name, sz, mod, checksum, err, ids)
+	e.js.w.Flush()
+	e.n++
+	if e.n >= e.opts.Batch {
+		e.flush()
+	}
+}
+
+func (e *ElasticWriter) Tail() {
+	if e.err == nil && e.n > 0 {
+		e.flush()
+	}
+}
+
+// Err returns the first error encountered sending results.
+func (e *ElasticWriter) Err() error {
+	return e.err
+}
+
+type bulkResponse struct {
+	Errors bool                         `json:"errors"`
+	Items  []map[string]json.RawMessage `json:"items"`
+}
+
+type bulkItem struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error"`
+}
+
+func (e *ElasticWriter) flush() {
+	defer func() {
+		e.buf.Reset()
+		e.n = 0
+	}()
+	req, err := http.NewRequest("POST", e.endpoint, bytes.NewReader(e.buf.Bytes()))
+	if err != nil {
+		e.err = err
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if e.opts.APIKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+e.opts.APIKey)
+	} else if e.user != nil {
+		pass, _ := e.user.Password()
+		req.SetBasicAuth(e.user.Username(), pass)
+	}
+	resp, err := e.opts.Client.Do(req)
+	if err != nil {
+		e.err = fmt.Errorf("error sending results to Elasticsearch: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		byt, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		e.err = fmt.Errorf("error sending results to Elasticsearch: got %s %s", resp.Status, bytes.TrimSpace(byt))
+		return
+	}
+	var br bulkResponse
+	if err := json.NewDecoder(resp.Body).Decode(&br); err != nil {
+		e.err = fmt.Errorf("error reading Elasticsearch response: %v", err)
+		return
+	}
+	if !br.Errors {
+		return
+	}
+	var failed int
+	var reason json.RawMessage
+	for _, item := range br.Items {
+		for _, raw := range item {
+			var bi bulkItem
+			if json.Unmarshal(raw, &bi) == nil && bi.Status >= 300 {
+				failed++
+				if reason == nil {
+					reason = bi.Error
+				}
+			}
+		}
+	}
+	e.err = fmt.Errorf("Elasticsearch failed to index %d of %d results; first error: %s", failed, len(br.Items), reason)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// ManifestRecord describes a scan and its results, for audit. It is written as a JSON object by a Manifest writer.
+type ManifestRecord struct {
+	ScanID      string               `json:"scanid,omitempty"`
+	Siegfried   string               `json:"siegfried"`
+	Started     time.Time            `json:"started"`
+	Ended       time.Time            `json:"ended"`
+	Hostname    string               `json:"hostname,omitempty"`
+	Flags       string               `json:"flags,omitempty"`
+	Signature   string               `json:"signature"`
+	Created     time.Time            `json:"created"`
+	SigSHA256   string               `json:"sigsha256,omitempty"`
+	Identifiers []ManifestIdentifier `json:"identifiers"`
+	Hashes      string               `json:"hashes,omitempty"`
+	Files       int64                `json:"files"`
+	Bytes       int64                `json:"bytes"`
+	Errors      int64                `json:"errors"`
+}
+
+// ManifestIdentifier names an identifier in a scan, with the details (e.g. signature file versions) it was built with.
+type ManifestIdentifier struct {
+	Name    string `json:"name"`
+	Details string `json:"details"`
+}
+
+// ManifestWriter writes a manifest of a scan after the results written by the Writer it wraps.
+type ManifestWriter struct {
+	Writer
+	out io.Writer
+	rec ManifestRecord
+	err error
+}
+
+// Manifest wraps a writer, and writes a manifest of the scan to out once the results are complete (when Tail is called).
+// The manifest records the scan's provenance (see SetProvenance), its start and end times, the signature file and identifiers
+// used, and the number of files, bytes and errors in the results. It documents results in any format, including those
+// without a header block (CSV, JSON Lines and DROID). Check Err after calling Tail.
+func Manifest(w Writer, out io.Writer) *ManifestWriter {
+	return &ManifestWriter{Writer: w, out: out}
+}
+
+func (m *ManifestWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
+	m.rec = ManifestRecord{
+		Siegfried:   fmt.Sprintf("%d.%d.%d", version[0], version[1], version[2]),
+		Started:     scanned,
+		Signature:   path,
+		Created:     created,
+		Identifiers: make([]ManifestIdentifier, len(ids)),
+		Hashes:      hh,
+	}
+	if provenance != nil {
+		m.rec.ScanID, m.rec.Hostname, m.rec.Flags, m.rec.SigSHA256 = provenance.ScanID, provenance.Hostname, provenance.Flags, provenance.SigSHA256
+	}
+	for i, id := range ids {
+		m.rec.Identifiers[i] = ManifestIdentifier{id[0], id[1]}
+	}
+	m.Writer.Head(path, scanned, created, version, ids, fields, hh)
+}
+
+func (m *ManifestWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
+	if sz >= 0 { // not a directory
+		m.rec.Files++
+		m.rec.Bytes += sz
+	}
+	if err != nil {
+		m.rec.Errors++
+	}
+	m.Writer.File(name, sz, mod, checksum, err, ids)
+}
+
+func (m *ManifestWriter) Tail() {
+	m.Writer.Tail()
+	m.rec.Ended = now()
+	byt, err := json.MarshalIndent(m.rec, "", "  ")
+	if err == nil {
+		_, err = m.out.Write(append(byt, '\n'))
+	}
+	m.err = err
+}
+
+// Err returns any error writing the manifest.
+func (m *ManifestWriter) Err() error {
+	return m.err
+}
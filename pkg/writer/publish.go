@@ -0,0 +1,262 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// PublishOptions configures a Publish writer.
+//
+// Brokers are given as URLs whose scheme selects the protocol:
+//
+//	kafka://host:8082 (or kafkas:// for https) for a Kafka REST Proxy (v2 API)
+//	rabbitmq://host:15672/vhost (or rabbitmqs:// for https) for the RabbitMQ management HTTP API; the vhost defaults to "/"
+//
+// User info in a URL is used for basic authentication.
+type PublishOptions struct {
+	Brokers  []string     // broker URLs; if a broker can't be reached, the next in the list is tried. All must use the same protocol
+	Topic    string       // Kafka topic, or RabbitMQ routing key (default "siegfried")
+	Exchange string       // RabbitMQ exchange (default "amq.default", which routes to the queue named by Topic)
+	Batch    int          // number of messages sent in each Kafka request (default DefaultBatch). RabbitMQ messages are sent one at a time
+	Client   *http.Client // HTTP client (default http.DefaultClient)
+}
+
+type broker struct {
+	endpoint string
+	user     *url.Userinfo
+}
+
+// PublishWriter publishes results as messages to Kafka or RabbitMQ.
+// Since a Writer can't return errors, check Err after calling Tail.
+type PublishWriter struct {
+	opts    PublishOptions
+	kafka   bool
+	brokers []broker
+	current int
+	names   []string
+	records [][]byte
+	buf     bytes.Buffer
+	js      *jsonWriter
+	err     error
+}
+
+// Publish returns a writer that publishes each file's results as a message (in the same form as a JSON Lines record), keyed by the file name.
+// Once a message can't be published, no further results are sent.
+func Publish(opts PublishOptions) (*PublishWriter, error) {
+	if len(opts.Brokers) == 0 {
+		return nil, fmt.Errorf("no brokers to publish to")
+	}
+	if opts.Topic == "" {
+		opts.Topic = "siegfried"
+	}
+	if opts.Exchange == "" {
+		opts.Exchange = "amq.default"
+	}
+	if opts.Batch < 1 {
+		opts.Batch = DefaultBatch
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	p := &PublishWriter{opts: opts, brokers: make([]broker, len(opts.Brokers))}
+	for i, b := range opts.Brokers {
+		u, err := url.Parse(strings.TrimSpace(b))
+		if err != nil {
+			return nil, err
+		}
+		var kafka bool
+		switch u.Scheme {
+		case "kafka", "kafkas":
+			kafka = true
+			u.Path = strings.TrimSuffix(u.Path, "/") + "/topics/" + url.PathEscape(opts.Topic)
+		case "rabbitmq", "rabbitmqs":
+			vhost := strings.Trim(u.Path, "/")
+			if vhost == "" {
+				vhost = "/"
+			}
+			u.Path = "/api/exchanges/" + vhost + "/" + opts.Exchange + "/publish"
+			u.RawPath = "/api/exchanges/" + url.PathEscape(vhost) + "/" + url.PathEscape(opts.Exchange) + "/publish"
+		default:
+			return nil, fmt.Errorf("bad broker URL %s: expecting a kafka, kafkas, rabbitmq or rabbitmqs URL", b)
+		}
+		if i > 0 && kafka != p.kafka {
+			return nil, fmt.Errorf("bad broker URL %s: can't publish to both Kafka and RabbitMQ", b)
+		}
+		p.kafka = kafka
+		if strings.HasSuffix(u.Scheme, "s") {
+			u.Scheme = "https"
+		} else {
+			u.Scheme = "http"
+		}
+		p.brokers[i].user, u.User = u.User, nil
+		p.brokers[i].endpoint = u.String()
+	}
+	p.js = &jsonWriter{
+		lines:    true,
+		replacer: strings.NewReplacer(`"`, `\"`, `\\`, `\\`, `\`, `\\`),
+		w:        bufio.NewWriter(&p.buf),
+	}
+	return p, nil
+}
+
+func (p *PublishWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
+	p.js.Head(path, scanned, created, version, ids, fields, hh)
+}
+
+func (p *PublishWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
+	if p.err != nil {
+		return
+	}
+	p.js.File(name, sz, mod, checksum, err, ids)
+	p.js.w.Flush()
+	p.names = append(p.names, name)
+	p.records = append(p.records, bytes.TrimSuffix(append([]byte(nil), p.buf.Bytes()...), []byte("\n")))
+	p.buf.Reset()
+	if !p.kafka || len(p.records) >= p.opts.Batch {
+		p.flush()
+	}
+}
+
+func (p *PublishWriter) Tail() {
+	if p.err == nil && len(p.records) > 0 {
+		p.flush()
+	}
+}
+
+// Err returns the first error encountered publishing results.
+func (p *PublishWriter) Err() error {
+	return p.err
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+type rabbitMessage struct {
+	Properties      struct{} `json:"properties"`
+	RoutingKey      string   `json:"routing_key"`
+	Payload         string   `json:"payload"`
+	PayloadEncoding string   `json:"payload_encoding"`
+}
+
+func (p *PublishWriter) flush() {
+	defer func() {
+		p.names, p.records = p.names[:0], p.records[:0]
+	}()
+	var (
+		body  []byte
+		ctype string
+	)
+	if p.kafka {
+		recs := make([]kafkaRecord, len(p.records))
+		for i, r := range p.records {
+			recs[i] = kafkaRecord{Key: p.names[i], Value: r}
+		}
+		body, _ = json.Marshal(map[string][]kafkaRecord{"records": recs})
+		ctype = "application/vnd.kafka.json.v2+json"
+	} else {
+		body, _ = json.Marshal(rabbitMessage{RoutingKey: p.opts.Topic, Payload: string(p.records[0]), PayloadEncoding: "string"})
+		ctype = "application/json"
+	}
+	resp, err := p.post(body, ctype)
+	if err != nil {
+		p.err = err
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		byt, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		p.err = fmt.Errorf("error publishing results: got %s %s", resp.Status, bytes.TrimSpace(byt))
+		return
+	}
+	if p.kafka {
+		var kr kafkaResponse
+		if err := json.NewDecoder(resp.Body).Decode(&kr); err != nil {
+			p.err = fmt.Errorf("error reading Kafka REST Proxy response: %v", err)
+			return
+		}
+		var failed int
+		var reason string
+		for _, o := range kr.Offsets {
+			if o.ErrorCode != nil {
+				failed++
+				if reason == "" {
+					reason = o.Error
+				}
+			}
+		}
+		if failed > 0 {
+			p.err = fmt.Errorf("Kafka failed to publish %d of %d results; first error: %s", failed, len(p.records), reason)
+		}
+		return
+	}
+	var rr struct {
+		Routed bool `json:"routed"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rr); err != nil {
+		p.err = fmt.Errorf("error reading RabbitMQ response: %v", err)
+		return
+	}
+	if !rr.Routed {
+		p.err = fmt.Errorf("RabbitMQ didn't route the result for %s to any queue: check the exchange %q and routing key %q", p.names[0], p.opts.Exchange, p.opts.Topic)
+	}
+}
+
+// post sends a request to the current broker, failing over to the others in turn if it can't be reached
+func (p *PublishWriter) post(body []byte, ctype string) (*http.Response, error) {
+	var err error
+	for range p.brokers {
+		b := p.brokers[p.current]
+		req, e := http.NewRequest("POST", b.endpoint, bytes.NewReader(body))
+		if e != nil {
+			return nil, e
+		}
+		req.Header.Set("Content-Type", ctype)
+		if p.kafka {
+			req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+		}
+		if b.user != nil {
+			pass, _ := b.user.Password()
+			req.SetBasicAuth(b.user.Username(), pass)
+		}
+		resp, e := p.opts.Client.Do(req)
+		if e == nil {
+			return resp, nil
+		}
+		err = e
+		p.current = (p.current + 1) % len(p.brokers)
+	}
+	return nil, fmt.Errorf("error publishing results: %v", err)
+}
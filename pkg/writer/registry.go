@@ -0,0 +1,91 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"io"
+	"sync"
+)
+
+// Format is a named output format.
+// Embedders and downstream CLIs register their own formats (e.g. a writer that sends results to a message queue or inserts them
+// into a database) so that they can be chosen by name alongside the built-in ones, as with sf's -format flag and the format parameter of -serve.
+type Format struct {
+	Name string                 // name used to choose the format e.g. "csv"
+	MIME string                 // content-type of the output, for -serve (may be empty for sinks that don't write to w)
+	New  func(io.Writer) Writer // makes a writer for the format; sinks that send results elsewhere can ignore w
+}
+
+var (
+	formatsMu sync.RWMutex
+	formats   = []Format{
+		{"yaml", "application/x-yaml", YAML},
+		{"json", "application/json", JSON},
+		{"jsonl", "application/x-ndjson", JSONL},
+		{"csv", "text/csv", CSV},
+		{"droid", "application/x-droid", Droid},
+	}
+)
+
+// Register adds an output format. It panics if the format has no name or New function, or if its name is already registered.
+// Call it from an init function, before formats are looked up.
+func Register(f Format) {
+	if f.Name == "" || f.New == nil {
+		panic("writer: Register needs a name and a New function")
+	}
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	for _, v := range formats {
+		if v.Name == f.Name {
+			panic("writer: Register called twice for format " + f.Name)
+		}
+	}
+	formats = append(formats, f)
+}
+
+// Lookup returns the registered format with a name.
+func Lookup(name string) (Format, bool) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	for _, v := range formats {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Format{}, false
+}
+
+// LookupMIME returns the first registered format with a content-type, e.g. for the Accept header of a request.
+func LookupMIME(mime string) (Format, bool) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	for _, v := range formats {
+		if v.MIME != "" && v.MIME == mime {
+			return v, true
+		}
+	}
+	return Format{}, false
+}
+
+// Formats returns the names of the registered formats, built-in formats first.
+func Formats() []string {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	names := make([]string, len(formats))
+	for i, v := range formats {
+		names[i] = v.Name
+	}
+	return names
+}
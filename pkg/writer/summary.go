@@ -0,0 +1,260 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/siegfried/pkg/core"
+)
+
+// summaries is set if writers should follow the results for each file with a summary of the scan.
+var summaries bool
+
+// SetSummary causes YAML, JSON and JSON Lines writers to end their output with a summary: the number of files, bytes and errors,
+// and, for each identifier, the number of unknowns and the count and total size of files of each format; for the whole scan,
+// then for each directory. CSV and DROID writers don't write summaries, as extra tables would stop their output being read as results.
+func SetSummary() {
+	summaries = true
+}
+
+// tally accumulates a summary of the files written. A nil *tally does nothing.
+type tally struct {
+	files, dirs, bytes, errors int64
+	ids                        []*idTally
+	byDir                      map[string]*tally // tallies for the files in each directory (nil in those tallies)
+}
+
+// idTally summarises the results of an identifier
+type idTally struct {
+	name             string
+	id, format, mime int // indexes of fields in the identifier's values (-1 if the identifier doesn't have the field)
+	unknown          int64
+	counts           map[string]*formatCount
+}
+
+type formatCount struct {
+	id, format, mime string
+	count, bytes     int64
+}
+
+func newTally(ids [][2]string, fields [][]string) *tally {
+	if !summaries {
+		return nil
+	}
+	t := &tally{ids: make([]*idTally, len(fields)), byDir: make(map[string]*tally)}
+	for i, f := range fields {
+		var name string
+		if i < len(ids) {
+			name = ids[i][0]
+		}
+		it := &idTally{name: name, id: -1, format: -1, mime: -1, counts: make(map[string]*formatCount)}
+		for j, v := range f {
+			switch v {
+			case "id":
+				it.id = j
+			case "format":
+				it.format = j
+			case "mime":
+				it.mime = j
+			}
+		}
+		t.ids[i] = it
+	}
+	return t
+}
+
+// dirTally returns the tally for a directory, making an empty one with the same identifiers if it isn't there yet
+func (t *tally) dirTally(dir string) *tally {
+	d, ok := t.byDir[dir]
+	if !ok {
+		d = &tally{ids: make([]*idTally, len(t.ids))}
+		for i, it := range t.ids {
+			d.ids[i] = &idTally{name: it.name, id: it.id, format: it.format, mime: it.mime, counts: make(map[string]*formatCount)}
+		}
+		t.byDir[dir] = d
+	}
+	return d
+}
+
+// dirOf returns the directory of a path. Archives count as the directories of their entries.
+func dirOf(path string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if isSep(path[i]) {
+			if i == 0 {
+				return path[:1]
+			}
+			return path[:i]
+		}
+	}
+	return "."
+}
+
+func value(vals []string, i int) string {
+	if i < 0 || i >= len(vals) {
+		return ""
+	}
+	return vals[i]
+}
+
+func (t *tally) add(name string, sz int64, err error, ids []core.Identification) {
+	if t == nil {
+		return
+	}
+	if sz < 0 { // a directory
+		t.dirs++
+		return
+	}
+	t.count(sz, err, ids)
+	t.dirTally(Anonymize(dirOf(clean(name)))).count(sz, err, ids)
+}
+
+func (t *tally) count(sz int64, err error, ids []core.Identification) {
+	t.files++
+	t.bytes += sz
+	if err != nil {
+		t.errors++
+	}
+	var thisName string
+	idx := -1
+	for _, id := range ids {
+		vals := id.Values()
+		first := vals[0] != thisName
+		if first {
+			idx++
+			thisName = vals[0]
+		}
+		if idx >= len(t.ids) {
+			break
+		}
+		it := t.ids[idx]
+		if first && !id.Known() {
+			it.unknown++
+		}
+		key := value(vals, it.id)
+		fc, ok := it.counts[key]
+		if !ok {
+			fc = &formatCount{id: key, format: value(vals, it.format), mime: value(vals, it.mime)}
+			it.counts[key] = fc
+		}
+		fc.count++
+		fc.bytes += sz
+	}
+}
+
+// formats returns the formats matched by an identifier, most common first
+func (it *idTally) formats() []*formatCount {
+	ret := make([]*formatCount, 0, len(it.counts))
+	for _, fc := range it.counts {
+		ret = append(ret, fc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].count == ret[j].count {
+			return ret[i].id < ret[j].id
+		}
+		return ret[i].count > ret[j].count
+	})
+	return ret
+}
+
+// directories returns the directories tallied, in order
+func (t *tally) directories() []string {
+	ret := make([]string, 0, len(t.byDir))
+	for dir := range t.byDir {
+		ret = append(ret, dir)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func (t *tally) rate(it *idTally) string {
+	if t.files == 0 {
+		return "0.0"
+	}
+	return strconv.FormatFloat(100*float64(it.unknown)/float64(t.files), 'f', 1, 64)
+}
+
+func (t *tally) yaml(w *bufio.Writer, replacer *strings.Replacer) {
+	if t == nil {
+		return
+	}
+	w.WriteString("---\nsummary :\n")
+	t.yamlTotals(w, replacer, "  ")
+	w.WriteString("  directories :\n")
+	for _, dir := range t.directories() {
+		fmt.Fprintf(w, "    - path    : '%s'\n", replacer.Replace(dir))
+		t.byDir[dir].yamlTotals(w, replacer, "      ")
+	}
+}
+
+// yamlTotals writes the totals for the scan, or a directory, with each line indented by in
+func (t *tally) yamlTotals(w *bufio.Writer, replacer *strings.Replacer, in string) {
+	fmt.Fprintf(w, "%sfiles   : %d\n", in, t.files)
+	if t.dirs > 0 {
+		fmt.Fprintf(w, "%sdirs    : %d\n", in, t.dirs)
+	}
+	fmt.Fprintf(w, "%sbytes   : %d\n%serrors  : %d\n%sidentifiers :\n", in, t.bytes, in, t.errors, in)
+	for _, it := range t.ids {
+		fmt.Fprintf(w, "%s  - ns          : '%s'\n%s    unknown     : %d\n%s    unknownrate : %s%%\n%s    formats     :\n",
+			in, it.name, in, it.unknown, in, t.rate(it), in)
+		for _, fc := range it.formats() {
+			fmt.Fprintf(w, "%s      - id     : '%s'\n%s        format : '%s'\n%s        mime   : '%s'\n%s        count  : %d\n%s        bytes  : %d\n",
+				in, replacer.Replace(fc.id), in, replacer.Replace(fc.format), in, replacer.Replace(fc.mime), in, fc.count, in, fc.bytes)
+		}
+	}
+}
+
+func (t *tally) json(w *bufio.Writer, replacer *strings.Replacer) {
+	w.WriteString("\"summary\":{")
+	t.jsonTotals(w, replacer)
+	w.WriteString(",\"directories\":[")
+	for i, dir := range t.directories() {
+		if i > 0 {
+			w.WriteString(",")
+		}
+		fmt.Fprintf(w, "{\"path\":\"%s\",", replacer.Replace(dir))
+		t.byDir[dir].jsonTotals(w, replacer)
+		w.WriteString("}")
+	}
+	w.WriteString("]}")
+}
+
+// jsonTotals writes the fields for the totals for the scan, or a directory
+func (t *tally) jsonTotals(w *bufio.Writer, replacer *strings.Replacer) {
+	fmt.Fprintf(w, "\"files\":%d,", t.files)
+	if t.dirs > 0 {
+		fmt.Fprintf(w, "\"dirs\":%d,", t.dirs)
+	}
+	fmt.Fprintf(w, "\"bytes\":%d,\"errors\":%d,\"identifiers\":[", t.bytes, t.errors)
+	for i, it := range t.ids {
+		if i > 0 {
+			w.WriteString(",")
+		}
+		fmt.Fprintf(w, "{\"ns\":\"%s\",\"unknown\":%d,\"unknownrate\":%s,\"formats\":[", it.name, it.unknown, t.rate(it))
+		for j, fc := range it.formats() {
+			if j > 0 {
+				w.WriteString(",")
+			}
+			fmt.Fprintf(w, "{\"id\":\"%s\",\"format\":\"%s\",\"mime\":\"%s\",\"count\":%d,\"bytes\":%d}",
+				replacer.Replace(fc.id), replacer.Replace(fc.format), replacer.Replace(fc.mime), fc.count, fc.bytes)
+		}
+		w.WriteString("]}")
+	}
+	w.WriteString("]")
+}
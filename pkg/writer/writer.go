@@ -24,7 +24,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/richardlehane/siegfried/internal/checksum"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
 )
@@ -35,6 +37,72 @@ type Writer interface {
 	Tail()
 }
 
+// rawNames adds a field to YAML, JSON and CSV output containing the hex encoded bytes of the original filename.
+var rawNames bool
+
+// SetRawNames causes writers to emit a rawname field alongside the filename.
+// This preserves filenames that are not valid UTF-8 (writers always replace invalid sequences with U+FFFD).
+func SetRawNames() {
+	rawNames = true
+}
+
+// Provenance describes the scan that produced a results file.
+// It is added to the header of YAML and JSON output. CSV, JSON Lines and DROID output have no header block to add it to,
+// so for those formats the provenance (and the start and end times of the scan) is recorded by a Manifest.
+type Provenance struct {
+	ScanID    string // identifies the scan, e.g. a UUID. Scans of the volumes of a collection can share a scan ID
+	Hostname  string // host that ran the scan
+	Flags     string // command line flags in effect for the scan, including those read from a conf file
+	SigSHA256 string // hex encoded SHA-256 checksum of the signature file
+}
+
+var provenance *Provenance
+
+// now is the clock that records when results ended (tests fix it)
+var now = time.Now
+
+// SetProvenance causes YAML and JSON writers to add provenance fields to their header (see Manifest for other formats).
+func SetProvenance(p Provenance) {
+	provenance = &p
+}
+
+// ValidName returns a version of the name that is valid UTF-8 (invalid sequences are replaced with U+FFFD) and reports whether the original was valid.
+func ValidName(name string) (string, bool) {
+	if utf8.ValidString(name) {
+		return name, true
+	}
+	return strings.ToValidUTF8(name, string(utf8.RuneError)), false
+}
+
+func clean(s string) string {
+	s, _ = ValidName(s)
+	return s
+}
+
+// cleanValues returns the values of an identification, with any that aren't valid UTF-8 (e.g. a basis naming an archive entry) cleaned.
+// The values are only copied if one needs cleaning.
+func cleanValues(id core.Identification) []string {
+	vals := id.Values()
+	for i, v := range vals {
+		if !utf8.ValidString(v) {
+			ret := make([]string, len(vals))
+			copy(ret, vals[:i])
+			for j := i; j < len(vals); j++ {
+				ret[j] = clean(vals[j])
+			}
+			return ret
+		}
+	}
+	return vals
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return clean(err.Error())
+}
+
 func Null() Writer {
 	return null{}
 }
@@ -47,9 +115,118 @@ func (n null) File(name string, sz int64, mod string, cs []byte, err error, ids
 }
 func (n null) Tail() {}
 
+// csvFields selects and orders the columns of CSV output; nil for the full layout
+var csvFields []string
+
+// SetCSVFields selects and orders the columns of CSV output.
+// Columns are named as in the full CSV header (e.g. filename, filesize, sha256, id, mime): "hash" is an alias for the checksum column and "puid" for id.
+// An identifier field on its own (e.g. mime) selects that field for every identifier; qualify it with an identifier name (e.g. tika.mime) to select a single column.
+func SetCSVFields(f []string) {
+	csvFields = f
+}
+
+// CheckCSVFields reports an error if any of the CSV fields selected with SetCSVFields don't match a column
+// in the output for the given identifiers, fields and hash.
+func CheckCSVFields(ids [][2]string, fields [][]string, hh string) error {
+	base := csvBase(hh)
+	var bad []string
+	for _, f := range csvFields {
+		if len(csvResolve(f, base, ids, fields)) == 0 {
+			bad = append(bad, f)
+		}
+	}
+	if len(bad) == 0 {
+		return nil
+	}
+	avail := append([]string{}, base...)
+	for i, fs := range fields {
+		for _, f := range fs {
+			avail = append(avail, ids[i][0]+"."+f)
+		}
+	}
+	return fmt.Errorf("unknown CSV fields %s; choose from %s (identifier fields can be given without the identifier name)", strings.Join(bad, ", "), strings.Join(avail, ", "))
+}
+
+// csvBase returns the names of the columns that precede the identifier fields
+func csvBase(hh string) []string {
+	base := []string{"filename", "filesize", "modified", "errors"}
+	if rawNames {
+		base = append(base, "rawname")
+	}
+	return append(base, hashNames(hh)...)
+}
+
+// hashNames returns the names of the hash algorithms in hh, a comma-separated list (e.g. md5,sha256)
+func hashNames(hh string) []string {
+	if hh == "" {
+		return nil
+	}
+	return strings.Split(hh, ",")
+}
+
+// hashes splits a checksum calculated with the hash algorithms named in hh into their names and hex encoded digests
+func hashes(hh string, cs []byte) [][2]string {
+	names := hashNames(hh)
+	ret := make([][2]string, len(names))
+	for i, v := range checksum.Split(hh, cs) {
+		ret[i] = [2]string{names[i], hex.EncodeToString(v)}
+	}
+	return ret
+}
+
+// csvResolve returns the indexes of the columns in the full CSV layout that a selected field refers to
+func csvResolve(name string, base []string, ids [][2]string, fields [][]string) []int {
+	var qual string
+	for _, id := range ids {
+		if strings.HasPrefix(name, id[0]+".") {
+			qual, name = id[0], strings.TrimPrefix(name, id[0]+".")
+			break
+		}
+	}
+	switch name {
+	case "puid":
+		name = "id"
+	case "hash":
+		if qual == "" {
+			var ret []int
+			for i, b := range base {
+				if checksum.GetHash(b) >= 0 {
+					ret = append(ret, i)
+				}
+			}
+			if len(ret) > 0 {
+				return ret
+			}
+		}
+	}
+	if qual == "" {
+		for i, b := range base {
+			if b == name {
+				return []int{i}
+			}
+		}
+	}
+	var ret []int
+	offset := len(base)
+	for i, fs := range fields {
+		if qual == "" || qual == ids[i][0] {
+			for j, f := range fs {
+				if f == name {
+					ret = append(ret, offset+j)
+				}
+			}
+		}
+		offset += len(fs)
+	}
+	return ret
+}
+
 type csvWriter struct {
 	recs  [][]string
 	names []string
+	hh    string
+	sel   []int    // indexes of selected columns in the full layout (-1 for an unknown field); nil if all columns are output
+	out   []string // selected columns
 	w     *csv.Writer
 }
 
@@ -59,8 +236,9 @@ func CSV(w io.Writer) Writer {
 
 func (c *csvWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
 	c.names = make([]string, len(fields))
-	l := 4
-	if hh != "" {
+	c.hh = hh
+	l := 4 + len(hashNames(hh))
+	if rawNames {
 		l++
 	}
 	for i, f := range fields {
@@ -71,35 +249,74 @@ func (c *csvWriter) Head(path string, scanned, created time.Time, version [3]int
 	c.recs[0] = make([]string, l)
 	c.recs[0][0], c.recs[0][1], c.recs[0][2], c.recs[0][3] = "filename", "filesize", "modified", "errors"
 	idx := 4
-	if hh != "" {
-		c.recs[0][4] = hh
+	if rawNames {
+		c.recs[0][idx] = "rawname"
+		idx++
+	}
+	for _, h := range hashNames(hh) {
+		c.recs[0][idx] = h
 		idx++
 	}
 	for _, f := range fields {
 		copy(c.recs[0][idx:], f)
 		idx += len(f)
 	}
-	c.w.Write(c.recs[0])
+	if csvFields == nil {
+		c.w.Write(c.recs[0])
+		return
+	}
+	c.sel = c.sel[:0]
+	hdr := make([]string, 0, len(csvFields))
+	base := csvBase(hh)
+	for _, f := range csvFields {
+		cols := csvResolve(f, base, ids, fields)
+		if len(cols) == 0 {
+			cols = []int{-1}
+		}
+		for _, col := range cols {
+			c.sel = append(c.sel, col)
+			hdr = append(hdr, f)
+		}
+	}
+	c.out = make([]string, len(c.sel))
+	c.w.Write(hdr)
 }
 
-func (c *csvWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
-	var errStr string
-	if err != nil {
-		errStr = err.Error()
+// write outputs a row, or just its selected columns
+func (c *csvWriter) write(row []string) {
+	if c.sel == nil {
+		c.w.Write(row)
+		return
 	}
-	c.recs[0][0], c.recs[0][1], c.recs[0][2], c.recs[0][3] = name, strconv.FormatInt(sz, 10), mod, errStr
+	for i, col := range c.sel {
+		if col < 0 {
+			c.out[i] = ""
+		} else {
+			c.out[i] = row[col]
+		}
+	}
+	c.w.Write(c.out)
+}
+
+func (c *csvWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
+	n, e := anonymizeFile(clean(name), errString(err))
+	c.recs[0][0], c.recs[0][1], c.recs[0][2], c.recs[0][3] = n, strconv.FormatInt(sz, 10), mod, e
 	idx := 4
-	if checksum != nil {
-		c.recs[0][4] = hex.EncodeToString(checksum)
+	if rawNames {
+		c.recs[0][idx] = hex.EncodeToString([]byte(name))
 		idx++
 	}
-	if len(ids) == 0 {
-		empty := make([]string, len(c.recs[0])-idx)
-		if checksum != nil {
-			c.recs[0][4] = ""
+	hidx := idx
+	if checksum != nil {
+		for _, h := range hashes(c.hh, checksum) {
+			c.recs[0][idx] = h[1]
+			idx++
 		}
-		copy(c.recs[0][idx:], empty)
-		c.w.Write(c.recs[0])
+	}
+	if len(ids) == 0 {
+		empty := make([]string, len(c.recs[0])-hidx)
+		copy(c.recs[0][hidx:], empty)
+		c.write(c.recs[0])
 		return
 	}
 
@@ -107,7 +324,7 @@ func (c *csvWriter) File(name string, sz int64, mod string, checksum []byte, err
 	var rowIdx, colIdx, prevLen int
 	colIdx = idx
 	for _, id := range ids {
-		fields := id.Values()
+		fields := cleanValues(id)
 		if thisName == fields[0] {
 			rowIdx++
 		} else {
@@ -123,13 +340,15 @@ func (c *csvWriter) File(name string, sz int64, mod string, checksum []byte, err
 		copy(c.recs[rowIdx][colIdx:], fields)
 	}
 	for _, r := range c.recs {
-		c.w.Write(r)
+		c.write(r)
 	}
 	c.recs = c.recs[:1]
 	return
 }
 
-func (c *csvWriter) Tail() { c.w.Flush() }
+func (c *csvWriter) Tail() {
+	c.w.Flush()
+}
 
 type yamlWriter struct {
 	replacer *strings.Replacer
@@ -137,6 +356,7 @@ type yamlWriter struct {
 	hh       string
 	hstrs    []string
 	vals     [][]interface{}
+	sum      *tally
 }
 
 func YAML(w io.Writer) Writer {
@@ -165,6 +385,7 @@ func header(fields []string) string {
 }
 
 func (y *yamlWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
+	y.sum = newTally(ids, fields)
 	y.hh = hh
 	y.hstrs = make([]string, len(fields))
 	y.vals = make([][]interface{}, len(fields))
@@ -173,32 +394,51 @@ func (y *yamlWriter) Head(path string, scanned, created time.Time, version [3]in
 		y.vals[i] = make([]interface{}, len(f))
 	}
 	fmt.Fprintf(y.w,
-		"---\nsiegfried   : %d.%d.%d\nscandate    : %v\nsignature   : %s\ncreated     : %v\nidentifiers : \n",
+		"---\nsiegfried   : %d.%d.%d\nscandate    : %v\nsignature   : %s\ncreated     : %v\n",
 		version[0], version[1], version[2],
 		scanned.Format(time.RFC3339),
 		y.replacer.Replace(path),
 		created.Format(time.RFC3339))
+	if provenance != nil {
+		if provenance.ScanID != "" {
+			fmt.Fprintf(y.w, "scanid      : '%s'\n", y.replacer.Replace(clean(provenance.ScanID)))
+		}
+		fmt.Fprintf(y.w,
+			"sigsha256   : %s\nhostname    : '%s'\nflags       : '%s'\n",
+			provenance.SigSHA256,
+			y.replacer.Replace(clean(provenance.Hostname)),
+			y.replacer.Replace(clean(provenance.Flags)))
+	}
+	y.w.WriteString("identifiers : \n")
 	for _, id := range ids {
 		fmt.Fprintf(y.w, "  - name    : '%v'\n    details : '%v'\n", id[0], id[1])
 	}
 }
 
 func (y *yamlWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
+	y.sum.add(name, sz, err, ids)
 	var (
 		errStr   string
+		raw      string
 		h        string
 		thisName string
 		idx      int = -1
 	)
+	n, e := anonymizeFile(clean(name), errString(err))
 	if err != nil {
-		errStr = "'" + y.replacer.Replace(err.Error()) + "'"
+		errStr = "'" + y.replacer.Replace(e) + "'"
+	}
+	if rawNames {
+		raw = fmt.Sprintf("rawname  : '%s'\n", hex.EncodeToString([]byte(name)))
 	}
 	if checksum != nil {
-		h = fmt.Sprintf("%-8s : %s\n", y.hh, hex.EncodeToString(checksum))
+		for _, v := range hashes(y.hh, checksum) {
+			h += fmt.Sprintf("%-8s : %s\n", v[0], v[1])
+		}
 	}
-	fmt.Fprintf(y.w, "---\nfilename : '%s'\nfilesize : %d\nmodified : %s\nerrors   : %s\n%smatches  :\n", y.replacer.Replace(name), sz, mod, errStr, h)
+	fmt.Fprintf(y.w, "---\nfilename : '%s'\n%sfilesize : %d\nmodified : %s\nerrors   : %s\n%smatches  :\n", y.replacer.Replace(n), raw, sz, mod, errStr, h)
 	for _, id := range ids {
-		values := id.Values()
+		values := cleanValues(id)
 		if values[0] != thisName {
 			idx++
 			thisName = values[0]
@@ -214,14 +454,24 @@ func (y *yamlWriter) File(name string, sz int64, mod string, checksum []byte, er
 	}
 }
 
-func (y *yamlWriter) Tail() { y.w.Flush() }
+func (y *yamlWriter) Tail() {
+	y.sum.yaml(y.w, y.replacer)
+	// YAML and JSON results always record when they ended, as well as their scandate
+	if y.sum == nil {
+		y.w.WriteString("---\n")
+	}
+	fmt.Fprintf(y.w, "ended : %s\n", now().Format(time.RFC3339))
+	y.w.Flush()
+}
 
 type jsonWriter struct {
 	subs     bool
+	lines    bool // JSON Lines: one object per file, with no header
 	replacer *strings.Replacer
 	w        *bufio.Writer
 	hh       string
 	hstrs    []func([]string) string
+	sum      *tally
 }
 
 func JSON(w io.Writer) Writer {
@@ -231,35 +481,60 @@ func JSON(w io.Writer) Writer {
 	}
 }
 
+// JSONL writes results as JSON Lines: a JSON object for each file, on its own line, with no enclosing document or header.
+func JSONL(w io.Writer) Writer {
+	return &jsonWriter{
+		lines:    true,
+		replacer: strings.NewReplacer(`"`, `\"`, `\\`, `\\`, `\`, `\\`),
+		w:        bufio.NewWriter(w),
+	}
+}
+
 func jsonizer(fields []string) func([]string) string {
+	keys := make([]string, len(fields)) // don't overwrite the caller's fields (e.g. those of a shared Siegfried)
 	for i, v := range fields {
 		if v == "namespace" {
-			fields[i] = "\"ns\":\""
+			keys[i] = "\"ns\":\""
 			continue
 		}
-		fields[i] = "\"" + v + "\":\""
+		keys[i] = "\"" + v + "\":\""
 	}
 	vals := make([]string, len(fields))
 	return func(values []string) string {
 		for i, v := range values {
-			vals[i] = fields[i] + v
+			vals[i] = keys[i] + v
 		}
 		return "{" + strings.Join(vals, "\",") + "\"}"
 	}
 }
 
 func (j *jsonWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
+	j.sum = newTally(ids, fields)
 	j.hh = hh
 	j.hstrs = make([]func([]string) string, len(fields))
 	for i, f := range fields {
 		j.hstrs[i] = jsonizer(f)
 	}
+	if j.lines {
+		return
+	}
 	fmt.Fprintf(j.w,
-		"{\"siegfried\":\"%d.%d.%d\",\"scandate\":\"%v\",\"signature\":\"%s\",\"created\":\"%v\",\"identifiers\":[",
+		"{\"siegfried\":\"%d.%d.%d\",\"scandate\":\"%v\",\"signature\":\"%s\",\"created\":\"%v\",",
 		version[0], version[1], version[2],
 		scanned.Format(time.RFC3339),
 		path,
 		created.Format(time.RFC3339))
+	if provenance != nil {
+		if provenance.ScanID != "" {
+			fmt.Fprintf(j.w, "\"scanid\":\"%s\",", j.replacer.Replace(clean(provenance.ScanID)))
+		}
+		fmt.Fprintf(j.w,
+			"\"sigsha256\":\"%s\",\"hostname\":\"%s\",\"flags\":\"%s\",",
+			provenance.SigSHA256,
+			j.replacer.Replace(clean(provenance.Hostname)),
+			j.replacer.Replace(clean(provenance.Flags)))
+	}
+	j.w.WriteString("\"identifiers\":[")
 	for i, id := range ids {
 		if i > 0 {
 			j.w.WriteString(",")
@@ -270,27 +545,31 @@ func (j *jsonWriter) Head(path string, scanned, created time.Time, version [3]in
 }
 
 func (j *jsonWriter) File(name string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
-	if j.subs {
+	j.sum.add(name, sz, err, ids)
+	if j.subs && !j.lines {
 		j.w.WriteString(",")
 	}
 	var (
-		errStr   string
+		raw      string
 		h        string
 		thisName string
 		idx      int = -1
 	)
-	if err != nil {
-		errStr = err.Error()
+	n, e := anonymizeFile(clean(name), errString(err))
+	if rawNames {
+		raw = fmt.Sprintf("\"rawname\":\"%s\",", hex.EncodeToString([]byte(name)))
 	}
 	if checksum != nil {
-		h = fmt.Sprintf("\"%s\":\"%s\",", j.hh, hex.EncodeToString(checksum))
+		for _, v := range hashes(j.hh, checksum) {
+			h += fmt.Sprintf("\"%s\":\"%s\",", v[0], v[1])
+		}
 	}
-	fmt.Fprintf(j.w, "{\"filename\":\"%s\",\"filesize\": %d,\"modified\":\"%s\",\"errors\": \"%s\",%s\"matches\": [", j.replacer.Replace(name), sz, mod, errStr, h)
+	fmt.Fprintf(j.w, "{\"filename\":\"%s\",%s\"filesize\": %d,\"modified\":\"%s\",\"errors\": \"%s\",%s\"matches\": [", j.replacer.Replace(n), raw, sz, mod, j.replacer.Replace(e), h)
 	for i, id := range ids {
 		if i > 0 {
 			j.w.WriteString(",")
 		}
-		values := id.Values()
+		values := cleanValues(id)
 		if values[0] != thisName {
 			idx++
 			thisName = values[0]
@@ -298,12 +577,32 @@ func (j *jsonWriter) File(name string, sz int64, mod string, checksum []byte, er
 		j.w.WriteString(j.hstrs[idx](values))
 	}
 	j.w.WriteString("]}")
+	if j.lines {
+		j.w.WriteString("\n")
+	}
 	j.subs = true
 	return
 }
 
 func (j *jsonWriter) Tail() {
-	j.w.WriteString("]}\n")
+	var ended string
+	if !j.lines {
+		ended = fmt.Sprintf(",\"ended\":\"%s\"", now().Format(time.RFC3339))
+	}
+	switch {
+	case j.sum == nil:
+		if !j.lines {
+			j.w.WriteString("]" + ended + "}\n")
+		}
+	case j.lines: // the summary is the last line
+		j.w.WriteString("{")
+		j.sum.json(j.w, j.replacer)
+		j.w.WriteString("}\n")
+	default:
+		j.w.WriteString("]" + ended + ",")
+		j.sum.json(j.w, j.replacer)
+		j.w.WriteString("}\n")
+	}
 	j.w.Flush()
 }
 
@@ -312,6 +611,7 @@ type droidWriter struct {
 	parents map[string]parent
 	rec     []string
 	w       *csv.Writer
+	hh      string
 }
 
 type parent struct {
@@ -320,6 +620,8 @@ type parent struct {
 	archive string
 }
 
+// Droid writes results in the layout of DROID's CSV export.
+// It can't write DROID profiles (.droid files): they package an Apache Derby database, which can only be produced by Derby itself.
 func Droid(w io.Writer) Writer {
 	return &droidWriter{
 		parents: make(map[string]parent),
@@ -330,8 +632,11 @@ func Droid(w io.Writer) Writer {
 
 // "identifier", "id", "format name", "format version", "mimetype", "basis", "warning"
 func (d *droidWriter) Head(path string, scanned, created time.Time, version [3]int, ids [][2]string, fields [][]string, hh string) {
-	if hh == "" {
-		hh = "no"
+	d.hh = hh
+	// DROID has a single hash column: if more than one hash is calculated, report the first
+	hh = "no"
+	if names := hashNames(d.hh); len(names) > 0 {
+		hh = names[0]
 	}
 	d.w.Write([]string{
 		"ID", "PARENT_ID", "URI", "FILE_PATH", "NAME",
@@ -343,8 +648,9 @@ func (d *droidWriter) Head(path string, scanned, created time.Time, version [3]i
 func (d *droidWriter) File(p string, sz int64, mod string, checksum []byte, err error, ids []core.Identification) {
 	d.id++
 	d.rec[0], d.rec[6], d.rec[10] = strconv.Itoa(d.id), "Done", mod
+	p = clean(p)
 	if err != nil {
-		d.rec[6] = err.Error()
+		_, d.rec[6] = anonymizeFile(p, errString(err))
 	}
 	d.rec[1], d.rec[2], d.rec[3], d.rec[4], d.rec[9] = d.processPath(p)
 	// if folder (has sz -1) or error
@@ -362,10 +668,9 @@ func (d *droidWriter) File(p string, sz int64, mod string, checksum []byte, err
 	}
 	// size
 	d.rec[7] = strconv.FormatInt(sz, 10)
-	if checksum == nil {
-		d.rec[12] = ""
-	} else {
-		d.rec[12] = hex.EncodeToString(checksum)
+	d.rec[12] = ""
+	if hs := hashes(d.hh, checksum); checksum != nil && len(hs) > 0 {
+		d.rec[12] = hs[0][1]
 	}
 	// leave early for unknowns
 	if len(ids) < 1 || !ids[0].Known() {
@@ -383,7 +688,7 @@ func (d *droidWriter) File(p string, sz int64, mod string, checksum []byte, err
 		} else {
 			d.rec[8] = "File"
 		}
-		fields := id.Values()
+		fields := cleanValues(id)
 		d.rec[5], d.rec[11] = getMethod(fields[5]), mismatch(fields[6])
 		d.rec[14], d.rec[15], d.rec[16], d.rec[17] = fields[1], fields[4], fields[2], fields[3]
 		d.rec[3] = clearArchivePath(d.rec[2], d.rec[3])
@@ -392,11 +697,13 @@ func (d *droidWriter) File(p string, sz int64, mod string, checksum []byte, err
 	return
 }
 
-func (d *droidWriter) Tail() { d.w.Flush() }
+func (d *droidWriter) Tail() {
+	d.w.Flush()
+}
 
 func (d *droidWriter) processPath(p string) (parent, uri, path, name, ext string) {
 	path, _ = filepath.Abs(p)
-	path = strings.TrimSuffix(path, string(filepath.Separator))
+	path = Anonymize(strings.TrimSuffix(path, string(filepath.Separator)))
 	name = filepath.Base(path)
 	dir := filepath.Dir(path)
 	par, ok := d.parents[dir]
@@ -407,7 +714,7 @@ func (d *droidWriter) processPath(p string) (parent, uri, path, name, ext string
 		puri := "file:/" + escape(filepath.ToSlash(dir))
 		uri = toUri(puri, "", escape(name))
 	}
-	ext = strings.TrimPrefix(filepath.Ext(p), ".")
+	ext = strings.TrimPrefix(filepath.Ext(path), ".")
 	return
 }
 
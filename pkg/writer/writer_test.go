@@ -16,10 +16,19 @@ package writer
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
@@ -45,6 +54,24 @@ func (t testID) Warn() string            { return "" }
 func (t testID) Values() []string        { return testValues }
 func (t testID) Archive() config.Archive { return 0 }
 
+// badID has a basis naming a container entry that isn't valid UTF-8
+type badID struct{ testID }
+
+func (t badID) Values() []string {
+	vals := append([]string(nil), testValues...)
+	vals[5] = "container name " + badName
+	return vals
+}
+
+// warnID has a warning
+type warnID struct{ testID }
+
+func (t warnID) Values() []string {
+	vals := append([]string(nil), testValues...)
+	vals[6] = "extension mismatch"
+	return vals
+}
+
 func makeFields() []string {
 	return []string{"namespace",
 		"id",
@@ -63,11 +90,16 @@ func TestYAMLHeader(t *testing.T) {
 	}
 }
 
+// the time examples end
+var exampleEnd = func() time.Time { return time.Date(2015, 5, 24, 17, 0, 0, 0, time.UTC) }
+
 func ExampleYAML() {
+	now = exampleEnd
+	defer func() { now = time.Now }()
 	yml := YAML(ioutil.Discard)
 	yml.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
 	yml.(*yamlWriter).w = bufio.NewWriter(os.Stdout)
-	yml.File("example.doc", 1, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{testID{}})
+	yml.File("example.doc", 1, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{warnID{}})
 	yml.Tail()
 	// Output:
 	// ---
@@ -82,15 +114,429 @@ func ExampleYAML() {
 	//     version : '1.01'
 	//     mime    : 'image/jpeg'
 	//     basis   : 'extension match jpg; byte match at [[[0 14]] [[75201 2]]]'
-	//     warning :
+	//     warning : 'extension mismatch'
+	// ---
+	// ended : 2015-05-24T17:00:00Z
 }
 
 func ExampleJSON() {
+	now = exampleEnd
+	defer func() { now = time.Now }()
 	js := JSON(ioutil.Discard)
 	js.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
 	js.(*jsonWriter).w = bufio.NewWriter(os.Stdout)
 	js.File("example.doc", 1, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{testID{}})
 	js.Tail()
 	// Output:
-	// {"filename":"example.doc","filesize": 1,"modified":"2015-05-24T16:59:13+10:00","errors": "mscfb: bad OLE","matches": [{"ns":"pronom","id":"fmt/43","format":"JPEG File Interchange Format","version":"1.01","mime":"image/jpeg","basis":"extension match jpg; byte match at [[[0 14]] [[75201 2]]]","warning":""}]}]}
+	// {"filename":"example.doc","filesize": 1,"modified":"2015-05-24T16:59:13+10:00","errors": "mscfb: bad OLE","matches": [{"ns":"pronom","id":"fmt/43","format":"JPEG File Interchange Format","version":"1.01","mime":"image/jpeg","basis":"extension match jpg; byte match at [[[0 14]] [[75201 2]]]","warning":""}]}],"ended":"2015-05-24T17:00:00Z"}
+}
+
+func ExampleJSONL() {
+	js := JSONL(os.Stdout)
+	js.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	js.File("example.doc", 1, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{testID{}})
+	js.File("example2.doc", 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	js.Tail()
+	// Output:
+	// {"filename":"example.doc","filesize": 1,"modified":"2015-05-24T16:59:13+10:00","errors": "mscfb: bad OLE","matches": [{"ns":"pronom","id":"fmt/43","format":"JPEG File Interchange Format","version":"1.01","mime":"image/jpeg","basis":"extension match jpg; byte match at [[[0 14]] [[75201 2]]]","warning":""}]}
+	// {"filename":"example2.doc","filesize": 1,"modified":"2015-05-24T16:59:13+10:00","errors": "","matches": [{"ns":"pronom","id":"fmt/43","format":"JPEG File Interchange Format","version":"1.01","mime":"image/jpeg","basis":"extension match jpg; byte match at [[[0 14]] [[75201 2]]]","warning":""}]}
+}
+
+var badName = "bad\xffname.doc"
+
+func TestValidName(t *testing.T) {
+	if _, ok := ValidName("example.doc"); !ok {
+		t.Error("expecting example.doc to be a valid name")
+	}
+	n, ok := ValidName(badName)
+	if ok {
+		t.Errorf("expecting %q to be an invalid name", badName)
+	}
+	if n != "bad\uFFFDname.doc" {
+		t.Errorf("expecting invalid sequence to be replaced with U+FFFD, got %q", n)
+	}
+}
+
+func TestUTF8(t *testing.T) {
+	for _, fn := range []func(w *bytes.Buffer) Writer{
+		func(w *bytes.Buffer) Writer { return YAML(w) },
+		func(w *bytes.Buffer) Writer { return JSON(w) },
+		func(w *bytes.Buffer) Writer { return CSV(w) },
+		func(w *bytes.Buffer) Writer { return Droid(w) },
+	} {
+		buf := &bytes.Buffer{}
+		w := fn(buf)
+		w.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+		w.File(badName, 1, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{testID{}})
+		w.File("good.doc", 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{badID{}})
+		w.Tail()
+		if !utf8.Valid(buf.Bytes()) {
+			t.Errorf("expecting valid UTF-8 output, got %q", buf.String())
+		}
+		if bytes.HasPrefix(buf.Bytes(), []byte("\xef\xbb\xbf")) {
+			t.Error("expecting output without byte order mark")
+		}
+	}
+}
+
+func TestRawNames(t *testing.T) {
+	rawNames = true
+	defer func() { rawNames = false }()
+	buf := &bytes.Buffer{}
+	c := CSV(buf)
+	c.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "md5")
+	c.File(badName, 1, "2015-05-24T16:59:13+10:00", []byte{1}, nil, []core.Identification{testID{}})
+	c.Tail()
+	lines := strings.Split(buf.String(), "\n")
+	if !strings.HasPrefix(lines[0], "filename,filesize,modified,errors,rawname,md5,namespace") {
+		t.Errorf("expecting rawname column before hash, got %s", lines[0])
+	}
+	if !strings.Contains(lines[1], ",626164ff6e616d652e646f63,01,") {
+		t.Errorf("expecting hex encoded raw name, got %s", lines[1])
+	}
+}
+
+func TestProvenance(t *testing.T) {
+	SetProvenance(Provenance{ScanID: "vol-1", Hostname: "o'brien", Flags: `-sig "my sigs.sig"`, SigSHA256: "abc123"})
+	defer func() { provenance = nil }()
+	buf := &bytes.Buffer{}
+	y := YAML(buf)
+	y.Head("default.sig", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	y.Tail()
+	if !strings.Contains(buf.String(), "scanid      : 'vol-1'\nsigsha256   : abc123\nhostname    : 'o''brien'\nflags       : '-sig \"my sigs.sig\"'\nidentifiers : \n") {
+		t.Errorf("expecting provenance in YAML header, got %s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "---\nended : ") {
+		t.Errorf("expecting YAML to end with the time the results were completed, got %s", buf.String())
+	}
+	buf.Reset()
+	j := JSON(buf)
+	j.Head("default.sig", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	j.Tail()
+	var head struct {
+		ScanID    string    `json:"scanid"`
+		Hostname  string    `json:"hostname"`
+		Flags     string    `json:"flags"`
+		SigSHA256 string    `json:"sigsha256"`
+		Ended     time.Time `json:"ended"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &head); err != nil {
+		t.Fatalf("expecting valid JSON, got %v: %s", err, buf.String())
+	}
+	if head.ScanID != "vol-1" || head.Hostname != "o'brien" || head.Flags != `-sig "my sigs.sig"` || head.SigSHA256 != "abc123" || head.Ended.IsZero() {
+		t.Errorf("bad provenance in JSON header, got %+v", head)
+	}
+}
+
+func TestEnded(t *testing.T) {
+	buf := &bytes.Buffer{}
+	y := YAML(buf)
+	y.Head("default.sig", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	y.Tail()
+	if !strings.Contains(buf.String(), "scandate    : ") || !strings.Contains(buf.String(), "---\nended : ") {
+		t.Errorf("expecting YAML to record its start and end times without provenance, got %s", buf.String())
+	}
+	buf.Reset()
+	j := JSON(buf)
+	j.Head("default.sig", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	j.Tail()
+	var head struct {
+		Scandate string    `json:"scandate"`
+		Ended    time.Time `json:"ended"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &head); err != nil || head.Scandate == "" || head.Ended.IsZero() {
+		t.Errorf("expecting JSON to record its start and end times without provenance, got %v: %s", err, buf.String())
+	}
+}
+
+func TestManifest(t *testing.T) {
+	SetProvenance(Provenance{ScanID: "vol-1", Hostname: "host", SigSHA256: "abc123"})
+	defer func() { provenance = nil }()
+	buf, man := &bytes.Buffer{}, &bytes.Buffer{}
+	m := Manifest(CSV(buf), man)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.Head("default.sig", start, time.Time{}, [3]int{1, 10, 0}, [][2]string{{"pronom", "DROID_SignatureFile_V120.xml"}}, [][]string{makeFields()}, "md5")
+	m.File("a.doc", 10, "2015-05-24T16:59:13+10:00", []byte{1}, nil, []core.Identification{testID{}})
+	m.File("dir", -1, "2015-05-24T16:59:13+10:00", nil, testErr{}, nil)
+	m.Tail()
+	if m.Err() != nil || !strings.HasPrefix(buf.String(), "filename,") {
+		t.Fatalf("expecting CSV results and no error, got %v: %s", m.Err(), buf.String())
+	}
+	var rec ManifestRecord
+	if err := json.Unmarshal(man.Bytes(), &rec); err != nil {
+		t.Fatalf("expecting a JSON manifest, got %v: %s", err, man.String())
+	}
+	if rec.ScanID != "vol-1" || rec.Siegfried != "1.10.0" || !rec.Started.Equal(start) || rec.Ended.Before(start) ||
+		len(rec.Identifiers) != 1 || rec.Identifiers[0].Details != "DROID_SignatureFile_V120.xml" ||
+		rec.Files != 1 || rec.Bytes != 10 || rec.Errors != 1 || rec.Hashes != "md5" {
+		t.Errorf("bad manifest, got %+v", rec)
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	SetAnonymize([]byte("salt"))
+	defer SetAnonymize(nil)
+	a := Anonymize("secret/plans/report.doc")
+	parts := strings.Split(a, "/")
+	if len(parts) != 3 || !strings.HasSuffix(parts[2], ".doc") || strings.Contains(a, "secret") || strings.Contains(a, "report") {
+		t.Errorf("bad anonymized path, got %s", a)
+	}
+	if b := Anonymize("secret/other.zip#report.doc"); !strings.HasPrefix(b, parts[0]+"/") || !strings.HasSuffix(b, "#"+parts[2]) {
+		t.Errorf("expecting stable hashes for the same components, got %s and %s", a, b)
+	}
+	buf := &bytes.Buffer{}
+	c := CSV(buf)
+	c.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	c.File("secret/plans/report.doc", 1, "2015-05-24T16:59:13+10:00", nil, errors.New("can't open secret/plans/report.doc"), []core.Identification{testID{}})
+	c.Tail()
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("expecting no names in anonymized output, got %s", buf.String())
+	}
+	SetAnonymize([]byte("pepper"))
+	if Anonymize("secret/plans/report.doc") == a {
+		t.Error("expecting a different salt to give different hashes")
+	}
+}
+
+type tikaID struct{ testID }
+
+func (t tikaID) Values() []string {
+	return []string{"tika", "image/jpeg", "JPEG", "", "image/jpeg", "magic match", ""}
+}
+
+func TestCSVFields(t *testing.T) {
+	SetCSVFields([]string{"filename", "puid", "tika.mime", "sha256", "basis", "bogus"})
+	defer SetCSVFields(nil)
+	ids := [][2]string{{"pronom", ""}, {"tika", ""}}
+	fields := [][]string{makeFields(), makeFields()}
+	if err := CheckCSVFields(ids, fields, "sha256"); err == nil || !strings.Contains(err.Error(), "unknown CSV fields bogus;") {
+		t.Errorf("expecting an error for the bogus field, got %v", err)
+	}
+	buf := &bytes.Buffer{}
+	c := CSV(buf)
+	c.Head("", time.Time{}, time.Time{}, [3]int{}, ids, fields, "sha256")
+	c.File("example.jpg", 1, "2015-05-24T16:59:13+10:00", []byte{0xab}, nil, []core.Identification{testID{}, tikaID{}})
+	c.Tail()
+	expect := "filename,puid,puid,tika.mime,sha256,basis,basis,bogus\n" +
+		"example.jpg,fmt/43,image/jpeg,image/jpeg,ab,extension match jpg; byte match at [[[0 14]] [[75201 2]]],magic match,\n"
+	if buf.String() != expect {
+		t.Errorf("expecting %s, got %s", expect, buf.String())
+	}
+}
+
+func TestElastic(t *testing.T) {
+	var reqs, docs int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		if user, pass, ok := r.BasicAuth(); r.URL.Path != "/_bulk" || !ok || user != "sf" || pass != "secret" {
+			t.Errorf("bad request: %s %v", r.URL.Path, r.Header)
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for i := 0; scanner.Scan(); i++ {
+			var obj map[string]interface{}
+			if err := json.Unmarshal(scanner.Bytes(), &obj); err != nil {
+				t.Fatalf("bad bulk line %s: %v", scanner.Text(), err)
+			}
+			if i%2 == 0 && obj["index"] == nil {
+				t.Errorf("expecting an index action, got %s", scanner.Text())
+			}
+			if i%2 == 1 {
+				docs++
+			}
+		}
+		w.Write([]byte(`{"took":1,"errors":false,"items":[]}`))
+	}))
+	defer srv.Close()
+	es, err := Elastic(ElasticOptions{URL: strings.Replace(srv.URL, "http://", "http://sf:secret@", 1), Batch: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	es.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	for _, n := range []string{"a.doc", "b.doc", "c.doc"} {
+		es.File(n, 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	}
+	es.Tail()
+	if es.Err() != nil || reqs != 2 || docs != 3 {
+		t.Errorf("expecting 3 documents in 2 requests, got %d in %d (%v)", docs, reqs, es.Err())
+	}
+	// item level failures are reported
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errors":true,"items":[{"index":{"status":201}},{"index":{"status":400,"error":{"type":"mapper_parsing_exception"}}}]}`))
+	}))
+	defer failing.Close()
+	es, _ = Elastic(ElasticOptions{URL: failing.URL})
+	es.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	es.File("a.doc", 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	es.Tail()
+	if es.Err() == nil || !strings.Contains(es.Err().Error(), "1 of 2") {
+		t.Errorf("expecting an indexing error, got %v", es.Err())
+	}
+}
+
+func TestPublish(t *testing.T) {
+	var reqs, msgs int
+	kafka := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		if user, pass, ok := r.BasicAuth(); r.URL.Path != "/topics/ingest" || !ok || user != "sf" || pass != "secret" {
+			t.Errorf("bad request: %s %v", r.URL.Path, r.Header)
+		}
+		var body struct {
+			Records []struct {
+				Key   string                 `json:"key"`
+				Value map[string]interface{} `json:"value"`
+			} `json:"records"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		for _, rec := range body.Records {
+			if rec.Value["filename"] != rec.Key {
+				t.Errorf("expecting a record keyed by filename, got %v", rec)
+			}
+			msgs++
+		}
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":1}]}`))
+	}))
+	defer kafka.Close()
+	// the first broker can't be reached, so the writer fails over to the second
+	p, err := Publish(PublishOptions{
+		Brokers: []string{"kafka://127.0.0.1:1", strings.Replace(kafka.URL, "http://", "kafka://sf:secret@", 1)},
+		Topic:   "ingest",
+		Batch:   2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	for _, n := range []string{"a.doc", "b.doc", "c.doc"} {
+		p.File(n, 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	}
+	p.Tail()
+	if p.Err() != nil || reqs != 2 || msgs != 3 {
+		t.Errorf("expecting 3 messages in 2 requests, got %d in %d (%v)", msgs, reqs, p.Err())
+	}
+	// RabbitMQ messages are sent one at a time, and unrouted messages are reported
+	var paths []string
+	rabbit := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		var msg map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil || msg["routing_key"] != "siegfried" {
+			t.Errorf("bad message %v: %v", msg, err)
+		}
+		w.Write([]byte(`{"routed":` + strconv.FormatBool(len(paths) < 2) + `}`))
+	}))
+	defer rabbit.Close()
+	p, err = Publish(PublishOptions{Brokers: []string{strings.Replace(rabbit.URL, "http://", "rabbitmq://", 1)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	for _, n := range []string{"a.doc", "b.doc", "c.doc"} {
+		p.File(n, 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	}
+	p.Tail()
+	if len(paths) != 2 || paths[0] != "/api/exchanges/%2F/amq.default/publish" {
+		t.Errorf("expecting 2 messages to the default exchange, got %v", paths)
+	}
+	if p.Err() == nil || !strings.Contains(p.Err().Error(), "b.doc") {
+		t.Errorf("expecting a routing error, got %v", p.Err())
+	}
+	if _, err := Publish(PublishOptions{Brokers: []string{"amqp://localhost"}}); err == nil {
+		t.Error("expecting an error for an amqp URL")
+	}
+}
+
+func TestSummary(t *testing.T) {
+	summaries = true
+	defer func() { summaries = false }()
+	buf := &bytes.Buffer{}
+	c := CSV(buf)
+	c.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	c.File("example.jpg", 10, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	c.Tail()
+	if strings.Contains(buf.String(), "files,bytes,errors") {
+		t.Errorf("expecting CSV output not to have a summary, got %q", buf.String())
+	}
+	buf.Reset()
+	j := JSON(buf)
+	j.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	j.File("a/example.jpg", 10, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	j.File("a/b.zip#c/example2.jpg", 5, "2015-05-24T16:59:13+10:00", nil, testErr{}, []core.Identification{testID{}})
+	j.File("a/example3.jpg", 1, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	j.Tail()
+	type totals struct {
+		Path        string
+		Files       int
+		Bytes       int
+		Errors      int
+		Identifiers []struct {
+			Ns      string
+			Formats []struct {
+				Id    string
+				Count int
+			}
+		}
+	}
+	var doc struct {
+		Summary struct {
+			totals
+			Directories []totals
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("expecting valid JSON, got %v: %s", err, buf.String())
+	}
+	sum := doc.Summary
+	if sum.Files != 3 || sum.Errors != 1 || len(sum.Identifiers) != 1 || sum.Identifiers[0].Ns != "pronom" || sum.Identifiers[0].Formats[0].Count != 3 {
+		t.Errorf("bad JSON summary: %s", buf.String())
+	}
+	if dirs := sum.Directories; len(dirs) != 2 || dirs[0].Path != "a" || dirs[0].Files != 2 || dirs[0].Bytes != 11 ||
+		dirs[1].Path != "a/b.zip#c" || dirs[1].Errors != 1 || dirs[1].Identifiers[0].Formats[0].Id != "fmt/43" {
+		t.Errorf("bad JSON summary of directories: %+v", dirs)
+	}
+	buf.Reset()
+	y := YAML(buf)
+	y.Head("", time.Time{}, time.Time{}, [3]int{}, [][2]string{{"pronom", ""}}, [][]string{makeFields()}, "")
+	y.File("a/example.jpg", 10, "2015-05-24T16:59:13+10:00", nil, nil, []core.Identification{testID{}})
+	y.Tail()
+	expect := "  directories :\n    - path    : 'a'\n      files   : 1\n      bytes   : 10\n      errors  : 0\n      identifiers :\n        - ns          : 'pronom'\n"
+	if !strings.Contains(buf.String(), expect) {
+		t.Errorf("expecting the YAML summary to have directories %q, got %q", expect, buf.String())
+	}
+}
+
+func TestDirOf(t *testing.T) {
+	for _, c := range [][2]string{
+		{"a/b.txt", "a"},
+		{"/b.txt", "/"},
+		{"b.txt", "."},
+		{"a/b.zip#c.txt", "a/b.zip"},
+		{"a/b.zip#c/d.txt", "a/b.zip#c"},
+	} {
+		if got := dirOf(c[0]); got != c[1] {
+			t.Errorf("%s: expecting %s, got %s", c[0], c[1], got)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if f, ok := Lookup("csv"); !ok || f.MIME != "text/csv" {
+		t.Fatalf("expecting the built-in csv format, got %v", f)
+	}
+	Register(Format{Name: "test", New: func(w io.Writer) Writer { return Null() }})
+	if f, ok := Lookup("test"); !ok || f.New(nil) == nil {
+		t.Errorf("expecting the registered test format")
+	}
+	if names := Formats(); names[0] != "yaml" || names[len(names)-1] != "test" {
+		t.Errorf("expecting built-in formats first and the test format last, got %v", names)
+	}
+	if f, ok := LookupMIME("application/x-ndjson"); !ok || f.Name != "jsonl" {
+		t.Errorf("expecting jsonl for application/x-ndjson, got %v", f)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expecting a panic registering the csv format twice")
+		}
+	}()
+	Register(Format{Name: "csv", New: CSV})
 }
@@ -39,18 +39,31 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"path"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/richardlehane/siegfried/internal/bmffmatcher"
 	"github.com/richardlehane/siegfried/internal/bytematcher"
 	"github.com/richardlehane/siegfried/internal/containermatcher"
+	"github.com/richardlehane/siegfried/internal/datamatcher"
+	"github.com/richardlehane/siegfried/internal/ebmlmatcher"
 	"github.com/richardlehane/siegfried/internal/mimematcher"
+	"github.com/richardlehane/siegfried/internal/mpegmatcher"
 	"github.com/richardlehane/siegfried/internal/namematcher"
+	"github.com/richardlehane/siegfried/internal/pdf"
 	"github.com/richardlehane/siegfried/internal/persist"
+	"github.com/richardlehane/siegfried/internal/refine"
 	"github.com/richardlehane/siegfried/internal/riffmatcher"
+	"github.com/richardlehane/siegfried/internal/structmatcher"
 	"github.com/richardlehane/siegfried/internal/siegreader"
+	"github.com/richardlehane/siegfried/internal/signing"
 	"github.com/richardlehane/siegfried/internal/textmatcher"
+	"github.com/richardlehane/siegfried/internal/textprofile"
 	"github.com/richardlehane/siegfried/internal/xmlmatcher"
 	"github.com/richardlehane/siegfried/pkg/config"
 	"github.com/richardlehane/siegfried/pkg/core"
@@ -64,7 +77,7 @@ import (
 
 var ( // for side effect - register their patterns/ signature loaders
 	_ = pronom.Range{}
-	_ = mimeinfo.Int8(0)
+	_ = mimeinfo.Mask{}
 	_ = loc.Identifier{}
 
 	// Is this what we want to do here..?
@@ -75,19 +88,33 @@ var ( // for side effect - register their patterns/ signature loaders
 // used to identify file formats.
 // They contain three matchers as well as a slice of identifiers. When identifiers
 // are added to a Siegfried struct, they are registered with each matcher.
+//
+// A Siegfried can be shared by goroutines: Identify and its variants may be called concurrently.
+// The matchers and identifiers are only read while identifying files; each call takes its own buffer from a pool
+// and its own recorders from the identifiers. Set up a Siegfried (Add, Disable, SetTracer) before sharing it.
 type Siegfried struct {
 	// immutable fields
-	C  time.Time    // signature create time
-	nm core.Matcher // namematcher
-	mm core.Matcher // mimematcher
-	cm core.Matcher // containermatcher
-	xm core.Matcher // bytematcher
-	rm core.Matcher // riffmatcher
-	bm core.Matcher // bytematcher
-	tm core.Matcher // textmatcher
+	C     time.Time    // signature create time
+	nm    core.Matcher // namematcher
+	mm    core.Matcher // mimematcher
+	cm    core.Matcher // containermatcher
+	xm    core.Matcher // bytematcher
+	rm    core.Matcher // riffmatcher
+	bm    core.Matcher // bytematcher
+	tm    core.Matcher // textmatcher
+	am    core.Matcher // mpegmatcher
+	em    core.Matcher // ebmlmatcher
+	fm    core.Matcher // bmffmatcher
+	sm    core.Matcher // structmatcher
+	dm    core.Matcher // datamatcher
+	built [2]int       // major and minor versions of the roy that built the signature file (zero if unknown)
 	// mutatable fields
-	ids     []core.Identifier // identifiers
+	ids     []core.Identifier           // identifiers
+	off     []map[core.MatcherType]bool // matchers disabled for each identifier
+	skip    []bool                      // identifiers disabled altogether (see DisableIdentifier)
 	buffers *siegreader.Buffers
+	timings *timings    // time spent in each matcher (see Timings)
+	tracer  core.Tracer // receives callbacks as files are identified (see SetTracer)
 }
 
 // New creates a new Siegfried struct. It initializes the three matchers.
@@ -105,8 +132,10 @@ type Siegfried struct {
 //  err = s.Save("pronom.sig") // save the Siegfried
 func New() *Siegfried {
 	return &Siegfried{
-		C:       time.Now(),
+		C:       config.BuildTime(),
+		built:   [2]int{config.Version()[0], config.Version()[1]},
 		buffers: siegreader.New(),
+		timings: &timings{},
 	}
 }
 
@@ -139,10 +168,111 @@ func (s *Siegfried) Add(i core.Identifier) error {
 	if s.tm, err = i.Add(s.tm, core.TextMatcher); err != nil {
 		return err
 	}
+	if s.am, err = i.Add(s.am, core.MPEGMatcher); err != nil {
+		return err
+	}
+	if s.em, err = i.Add(s.em, core.EBMLMatcher); err != nil {
+		return err
+	}
+	if s.fm, err = i.Add(s.fm, core.BMFFMatcher); err != nil {
+		return err
+	}
+	if s.sm, err = i.Add(s.sm, core.StructMatcher); err != nil {
+		return err
+	}
+	if s.dm, err = i.Add(s.dm, core.DataMatcher); err != nil {
+		return err
+	}
 	s.ids = append(s.ids, i)
 	return nil
 }
 
+// Disable switches off a matcher type for the named identifier (e.g. the name matcher for "pronom").
+// The matcher keeps running for other identifiers, but its results are ignored by the named identifier.
+// This is a runtime setting and has no effect on signature files saved with Save. Don't call Disable while identifying files.
+func (s *Siegfried) Disable(name string, mt core.MatcherType) error {
+	for i, v := range s.ids {
+		if v.Name() == name {
+			if s.off == nil {
+				s.off = make([]map[core.MatcherType]bool, len(s.ids))
+			}
+			if s.off[i] == nil {
+				s.off[i] = make(map[core.MatcherType]bool)
+			}
+			s.off[i][mt] = true
+			return nil
+		}
+	}
+	return fmt.Errorf("siegfried: can't disable matcher, no identifier named %s in this signature file", name)
+}
+
+// DisableIdentifier switches off the named identifier (e.g. "loc" in a signature file with pronom, tika and loc identifiers):
+// it is left out of the results and of Identifiers and Fields. Its signatures remain in the matchers but their results are ignored.
+// Like Disable, this is a runtime setting that has no effect on signature files saved with Save and mustn't be called while
+// identifying files. At least one identifier must remain enabled.
+func (s *Siegfried) DisableIdentifier(name string) error {
+	for i, v := range s.ids {
+		if v.Name() != name {
+			continue
+		}
+		if s.skip == nil {
+			s.skip = make([]bool, len(s.ids))
+		}
+		var on int
+		for j := range s.ids {
+			if j != i && !s.skip[j] {
+				on++
+			}
+		}
+		if on == 0 {
+			return fmt.Errorf("siegfried: can't disable %s, it is the only identifier left enabled", name)
+		}
+		s.skip[i] = true
+		return nil
+	}
+	return fmt.Errorf("siegfried: can't disable identifier, no identifier named %s in this signature file", name)
+}
+
+// Limit restricts byte matching to the signatures of the given formats (e.g. PUIDs, as expanded from format sets by the sets package),
+// which makes scans for particular formats much faster. Other formats can still be identified by the other matchers (e.g. by extension)
+// but their byte signatures are ignored, even if they would take priority. A nil slice removes the limit.
+// Like Disable, this is a runtime setting that has no effect on signature files saved with Save and mustn't be called while identifying files.
+func (s *Siegfried) Limit(ids []string) error {
+	bm, ok := s.bm.(*bytematcher.Matcher)
+	if !ok {
+		return fmt.Errorf("siegfried: can't limit matching, this signature file has no byte signatures")
+	}
+	if ids == nil {
+		bm.Limit(nil)
+		return nil
+	}
+	sigs := []int{}
+	for i, id := range s.ids {
+		if d, ok := id.(describer); ok && !s.skipped(i) {
+			sigs = append(sigs, d.Lookup(core.ByteMatcher, ids)...)
+		}
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("siegfried: can't limit matching, no byte signatures in this signature file for %s", strings.Join(ids, ", "))
+	}
+	bm.Limit(sigs)
+	return nil
+}
+
+func (s *Siegfried) skipped(i int) bool {
+	return i < len(s.skip) && s.skip[i]
+}
+
+func (s *Siegfried) disabled(i int, mt core.MatcherType) bool {
+	if s.skipped(i) {
+		return true
+	}
+	if i >= len(s.off) {
+		return false
+	}
+	return s.off[i][mt]
+}
+
 // Save persists a Siegfried struct to disk (path)
 func (s *Siegfried) Save(path string) error {
 	f, err := os.Create(path)
@@ -178,6 +308,18 @@ func (s *Siegfried) SaveWriter(w io.Writer) error {
 	for _, i := range s.ids {
 		i.Save(ls)
 	}
+	// matchers added since the v1.9 signature format are appended so that older signature files still load
+	mpegmatcher.Save(s.am, ls)
+	ebmlmatcher.Save(s.em, ls)
+	bmffmatcher.Save(s.fm, ls)
+	xmlmatcher.SaveQualified(s.xm, ls)
+	structmatcher.Save(s.sm, ls)
+	datamatcher.Save(s.dm, ls)
+	for _, i := range s.ids {
+		if a, ok := i.(appender); ok {
+			a.SaveAppended(ls)
+		}
+	}
 	if ls.Err != nil {
 		return ls.Err
 	}
@@ -185,23 +327,57 @@ func (s *Siegfried) SaveWriter(w io.Writer) error {
 	return err
 }
 
-// Load creates a Siegfried struct and loads content from path
+// Signature files begin with the magic bytes, followed by the major and minor versions of the roy (or sf) that built them.
+// This version of sf loads signature files built from minSigVersion on, within the same major version.
+// Files from older versions can't be converted, as the code to load their formats is gone, and need to be rebuilt.
+// Files from later minor versions are loaded: data for matchers added since the v1.9 format is appended (see SaveWriter)
+// and sf ignores appended data it doesn't know, so older files simply lack those matchers.
+var minSigVersion = [2]int{1, 9}
+
+// sigCompatible checks the version of roy that built a signature file against the compatibility rules above.
+func sigCompatible(major, minor int) error {
+	v := config.Version()
+	if major < minSigVersion[0] || (major == minSigVersion[0] && minor < minSigVersion[1]) {
+		return fmt.Errorf("siegfried: signature file was built with roy v%d.%d, but this version of sf (v%d.%d.%d) needs signature files built with roy v%d.%d or later; try running `sf -update` or rebuild it with `roy build`",
+			major, minor, v[0], v[1], v[2], minSigVersion[0], minSigVersion[1])
+	}
+	if major > v[0] {
+		return fmt.Errorf("siegfried: signature file was built with roy v%d.%d, which is newer than this version of sf (v%d.%d.%d); upgrade sf to load it",
+			major, minor, v[0], v[1], v[2])
+	}
+	return nil
+}
+
+// Load creates a Siegfried struct and loads content from path.
+// If a verify key is configured (config.SetVerifyKey), the signature file must have a valid detached signature (see roy build -sign).
 func Load(path string) (*Siegfried, error) {
 	errOpening := "siegfried: error opening signature file, got %v; try running `sf -update`"
-	errNotSig := "siegfried: not a siegfried signature file; try running `sf -update`"
-	errUpdateSig := "siegfried: signature file is incompatible with this version of sf; try running `sf -update`"
 	fbuf, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf(errOpening, err)
 	}
+	if key := config.VerifyKey(); key != "" {
+		if err := signing.VerifyFile(path, fbuf, key); err != nil {
+			return nil, fmt.Errorf("siegfried: signature file %s failed verification with key %s: %v", path, key, err)
+		}
+	}
+	return loadSig(fbuf)
+}
+
+// loadSig loads the contents of a signature file: magic bytes, version and the compressed Siegfried struct
+func loadSig(fbuf []byte) (*Siegfried, error) {
+	errOpening := "siegfried: error opening signature file, got %v; try running `sf -update`"
+	errNotSig := "siegfried: not a siegfried signature file; try running `sf -update`"
+	errNewer := "siegfried: error loading signature file built with roy v%d.%d, which is newer than this version of sf (v%d.%d.%d), got %v; upgrade sf to load it"
 	if len(fbuf) < len(config.Magic())+2 {
 		return nil, fmt.Errorf(errNotSig)
 	}
 	if string(fbuf[:len(config.Magic())]) != string(config.Magic()) {
 		return nil, fmt.Errorf(errNotSig)
 	}
-	if major, minor := fbuf[len(config.Magic())], fbuf[len(config.Magic())+1]; major < byte(config.Version()[0]) || (major == byte(config.Version()[0]) && minor < byte(config.Version()[1])) {
-		return nil, fmt.Errorf(errUpdateSig)
+	major, minor := int(fbuf[len(config.Magic())]), int(fbuf[len(config.Magic())+1])
+	if err := sigCompatible(major, minor); err != nil {
+		return nil, err
 	}
 	r := bytes.NewBuffer(fbuf[len(config.Magic())+2:])
 	rc := flate.NewReader(r)
@@ -210,7 +386,21 @@ func Load(path string) (*Siegfried, error) {
 	if err != nil {
 		return nil, fmt.Errorf(errOpening, err)
 	}
-	return load(buf)
+	s, err := load(buf)
+	if err != nil {
+		if v := config.Version(); major == v[0] && minor > v[1] {
+			return nil, fmt.Errorf(errNewer, major, minor, v[0], v[1], v[2], err)
+		}
+		return nil, err
+	}
+	s.built = [2]int{major, minor}
+	return s, nil
+}
+
+// Built returns the major and minor versions of the roy that built the signature file.
+// For a new Siegfried, these are the versions of this library; they are zero if unknown, as for signature files loaded with LoadReader.
+func (s *Siegfried) Built() (int, int) {
+	return s.built[0], s.built[1]
 }
 
 // LoadReader creates a Siegfried struct and loads content from a reader
@@ -224,7 +414,7 @@ func LoadReader(r io.Reader) (*Siegfried, error) {
 
 func load(buf []byte) (*Siegfried, error) {
 	ls := persist.NewLoadSaver(buf)
-	return &Siegfried{
+	s := &Siegfried{
 		C:  ls.LoadTime(),
 		nm: namematcher.Load(ls),
 		mm: mimematcher.Load(ls),
@@ -241,28 +431,114 @@ func load(buf []byte) (*Siegfried, error) {
 			return ids
 		}(),
 		buffers: siegreader.New(),
-	}, ls.Err
+		timings: &timings{},
+	}
+	if ls.Done() {
+		return s, ls.Err
+	}
+	s.am = mpegmatcher.Load(ls)
+	s.em = ebmlmatcher.Load(ls)
+	s.fm = bmffmatcher.Load(ls)
+	s.xm = xmlmatcher.LoadQualified(s.xm, ls)
+	s.sm = structmatcher.Load(ls)
+	s.dm = datamatcher.Load(ls)
+	for _, i := range s.ids {
+		if a, ok := i.(appender); ok {
+			a.LoadAppended(ls)
+		}
+	}
+	return s, ls.Err
+}
+
+// appender is implemented by identifiers that persist data for matchers added since the v1.9 signature format
+type appender interface {
+	SaveAppended(*persist.LoadSaver)
+	LoadAppended(*persist.LoadSaver)
 }
 
 // Identifiers returns a slice of the names and details of each identifier.
+// Identifiers disabled with DisableIdentifier are left out.
 func (s *Siegfried) Identifiers() [][2]string {
-	ret := make([][2]string, len(s.ids))
+	ret := make([][2]string, 0, len(s.ids))
 	for i, v := range s.ids {
-		ret[i][0] = v.Name()
-		ret[i][1] = v.Details()
+		if !s.skipped(i) {
+			ret = append(ret, [2]string{v.Name(), v.Details()})
+		}
 	}
 	return ret
 }
 
-// Fields returns a slice of the names of the fields in each identifier.
+// Fields returns a slice of the names of the fields in each identifier (other than those disabled with DisableIdentifier).
 func (s *Siegfried) Fields() [][]string {
-	ret := make([][]string, len(s.ids))
+	ret := make([][]string, 0, len(s.ids))
 	for i, v := range s.ids {
-		ret[i] = v.Fields()
+		if !s.skipped(i) {
+			ret = append(ret, v.Fields())
+		}
 	}
 	return ret
 }
 
+// SinglePRONOM reports whether the signature file has just one identifier (other than those disabled with DisableIdentifier)
+// and it is a PRONOM identifier, as needed for DROID output.
+func (s *Siegfried) SinglePRONOM() bool {
+	var n int
+	var ok bool
+	for i, v := range s.ids {
+		if !s.skipped(i) {
+			n++
+			_, ok = v.(*pronom.Identifier)
+		}
+	}
+	return n == 1 && ok
+}
+
+// BufferPool is a pool of the buffers that files and streams are read into for identification. Buffers are re-cycled between calls,
+// so services identifying many files don't allocate new ones for each. Each Siegfried has its own pool; share one between Siegfrieds with SetBufferPool.
+type BufferPool struct {
+	buffers *siegreader.Buffers
+}
+
+// NewBufferPool creates a pool that keeps up to size idle buffers of each type for re-use (size <= 0 is no limit).
+func NewBufferPool(size int) *BufferPool {
+	p := &BufferPool{siegreader.New()}
+	p.SetSize(size)
+	return p
+}
+
+// Get returns a buffer reading from r. Identify it with Siegfried.IdentifyBuffer, then return it to the pool with Put.
+// Supply any error returned as the second argument to IdentifyBuffer.
+func (p *BufferPool) Get(r io.Reader) (*siegreader.Buffer, error) {
+	buffer, err := p.buffers.Get(r)
+	if err == io.EOF {
+		err = nil
+	}
+	return buffer, err
+}
+
+// Put returns a buffer to the pool. The buffer must not be used afterwards.
+func (p *BufferPool) Put(buffer *siegreader.Buffer) {
+	p.buffers.Put(buffer)
+}
+
+// SetSize limits the number of idle buffers of each type kept for re-use (size <= 0 is no limit).
+func (p *BufferPool) SetSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	p.buffers.SetSize(size)
+}
+
+// BufferPool returns the pool of buffers used by the Siegfried.
+func (s *Siegfried) BufferPool() *BufferPool {
+	return &BufferPool{s.buffers}
+}
+
+// SetBufferPool sets the pool of buffers used by the Siegfried. Don't call SetBufferPool while identifying files.
+func (s *Siegfried) SetBufferPool(p *BufferPool) {
+	s.buffers = p.buffers
+}
+
 // Buffer gets a siegreader buffer from the pool
 func (s *Siegfried) Buffer(r io.Reader) (*siegreader.Buffer, error) {
 	buffer, err := s.buffers.Get(r)
@@ -277,13 +553,16 @@ func (s *Siegfried) Put(buffer *siegreader.Buffer) {
 	s.buffers.Put(buffer)
 }
 
-func satisfied(mt core.MatcherType, recs []core.Recorder) (bool, []core.Hint) {
+func (s *Siegfried) satisfied(mt core.MatcherType, recs []core.Recorder) (bool, []core.Hint) {
 	sat := true
 	var hints []core.Hint
 	if mt == core.ByteMatcher || mt == core.ContainerMatcher {
 		hints = make([]core.Hint, 0, len(recs))
 	}
-	for _, rec := range recs {
+	for i, rec := range recs {
+		if s.disabled(i, mt) { // treat disabled matchers as satisfied
+			continue
+		}
 		ok, h := rec.Satisfied(mt)
 		if mt == core.ByteMatcher || mt == core.ContainerMatcher {
 			if !ok {
@@ -302,23 +581,72 @@ func satisfied(mt core.MatcherType, recs []core.Recorder) (bool, []core.Hint) {
 	return sat, hints
 }
 
+// SetTracer attaches a core.Tracer to the Siegfried. As each file is identified, the Tracer is called as each matcher starts and stops and
+// with the results that the matchers send. The byte matcher also reports its strikes (hits on the sequences and frames it searches for) and
+// the segments of signatures that match. Set a nil Tracer to stop tracing. Don't call SetTracer while identifying files.
+func (s *Siegfried) SetTracer(t core.Tracer) {
+	s.tracer = t
+	if bm, ok := s.bm.(*bytematcher.Matcher); ok {
+		bm.SetTracer(t)
+	}
+}
+
+// start is called as a matcher starts identifying a file. It calls the Tracer and returns the time to time the matcher from (see clock).
+func (s *Siegfried) start(name string, mt core.MatcherType) time.Time {
+	if s.tracer != nil {
+		s.tracer.Start(name, mt)
+	}
+	return clock()
+}
+
+// stop is called once a matcher has identified a file
+func (s *Siegfried) stop(name string, mt core.MatcherType, t time.Time) {
+	s.timings.add(mt, t)
+	if s.tracer != nil {
+		s.tracer.Stop(name, mt)
+	}
+}
+
+// record sends a result to the first recorder that claims it, skipping recorders whose identifier has that matcher disabled.
+func (s *Siegfried) record(recs []core.Recorder, name string, mt core.MatcherType, res core.Result) {
+	if s.tracer != nil {
+		s.tracer.Result(name, mt, res)
+	}
+	for i, rec := range recs {
+		if s.disabled(i, mt) {
+			continue
+		}
+		if rec.Record(mt, res) {
+			return
+		}
+	}
+}
+
 // IdentifyBuffer identifies a siegreader buffer. Supply the error from Get as the second argument.
 func (s *Siegfried) IdentifyBuffer(buffer *siegreader.Buffer, err error, name, mime string) ([]core.Identification, error) {
 	if err != nil && err != siegreader.ErrEmpty {
 		return nil, fmt.Errorf("siegfried: error reading file; got %v", err)
 	}
+	var expired func() bool
+	if config.MaxScanTime() > 0 {
+		expired = buffer.Deadline(config.MaxScanTime())
+	}
 	recs := make([]core.Recorder, len(s.ids))
 	for i, v := range s.ids {
 		recs[i] = v.Recorder()
-		if name != "" {
+		if name != "" && !s.disabled(i, core.NameMatcher) {
 			recs[i].Active(core.NameMatcher)
 		}
-		if mime != "" {
+		if mime != "" && !s.disabled(i, core.MIMEMatcher) {
 			recs[i].Active(core.MIMEMatcher)
 		}
 		if err == nil {
-			recs[i].Active(core.XMLMatcher)
-			recs[i].Active(core.TextMatcher)
+			if !s.disabled(i, core.XMLMatcher) {
+				recs[i].Active(core.XMLMatcher)
+			}
+			if !s.disabled(i, core.TextMatcher) {
+				recs[i].Active(core.TextMatcher)
+			}
 		}
 	}
 	// Log name for debug/slow
@@ -327,126 +655,256 @@ func (s *Siegfried) IdentifyBuffer(buffer *siegreader.Buffer, err error, name, m
 	}
 	// Name Matcher
 	if len(name) > 0 && s.nm != nil {
+		t := s.start(name, core.NameMatcher)
 		nms, _ := s.nm.Identify(name, nil) // we don't care about an error here
 		for v := range nms {
-			for _, rec := range recs {
-				if rec.Record(core.NameMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.NameMatcher, v)
 		}
+		s.stop(name, core.NameMatcher, t)
 	}
 	// MIME Matcher
 	if len(mime) > 0 && s.mm != nil {
+		t := s.start(name, core.MIMEMatcher)
 		mms, _ := s.mm.Identify(mime, nil) // we don't care about an error here
 		for v := range mms {
-			for _, rec := range recs {
-				if rec.Record(core.MIMEMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.MIMEMatcher, v)
 		}
+		s.stop(name, core.MIMEMatcher, t)
 	}
 	// Container Matcher
-	_, hints := satisfied(core.ContainerMatcher, recs)
+	var notes []core.Annotation // annotations sent by matchers
+	_, hints := s.satisfied(core.ContainerMatcher, recs)
 	if s.cm != nil {
+		t := s.start(name, core.ContainerMatcher)
 		if config.Debug() {
 			fmt.Fprintln(config.Out(), ">>START CONTAINER MATCHER")
 		}
 		cms, cerr := s.cm.Identify(name, buffer, hints...)
 		for v := range cms {
-			for _, rec := range recs {
-				if rec.Record(core.ContainerMatcher, v) {
-					break
-				}
+			if a, ok := v.(core.Annotation); ok {
+				notes = append(notes, a)
+				continue
 			}
+			s.record(recs, name, core.ContainerMatcher, v)
 		}
 		if err == nil {
 			err = cerr
 		}
+		s.stop(name, core.ContainerMatcher, t)
 	}
-	sat, _ := satisfied(core.XMLMatcher, recs)
+	sat, _ := s.satisfied(core.XMLMatcher, recs)
 	// XML Matcher
 	if s.xm != nil && !sat {
+		t := s.start(name, core.XMLMatcher)
 		if config.Debug() {
 			fmt.Fprintln(config.Out(), ">>START XML MATCHER")
 		}
 		xms, xerr := s.xm.Identify("", buffer)
 		for v := range xms {
-			for _, rec := range recs {
-				if rec.Record(core.XMLMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.XMLMatcher, v)
 		}
 		if err == nil {
 			err = xerr
 		}
+		s.stop(name, core.XMLMatcher, t)
 	}
-	sat, _ = satisfied(core.RIFFMatcher, recs)
+	sat, _ = s.satisfied(core.RIFFMatcher, recs)
 	// RIFF Matcher
 	if s.rm != nil && !sat {
+		t := s.start(name, core.RIFFMatcher)
 		if config.Debug() {
 			fmt.Fprintln(config.Out(), ">>START RIFF MATCHER")
 		}
 		rms, rerr := s.rm.Identify("", buffer)
 		for v := range rms {
-			for _, rec := range recs {
-				if rec.Record(core.RIFFMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.RIFFMatcher, v)
 		}
 		if err == nil {
 			err = rerr
 		}
+		s.stop(name, core.RIFFMatcher, t)
+	}
+	sat, _ = s.satisfied(core.EBMLMatcher, recs)
+	// EBML Matcher
+	if s.em != nil && !sat {
+		t := s.start(name, core.EBMLMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START EBML MATCHER")
+		}
+		ems, _ := s.em.Identify("", buffer)
+		for v := range ems {
+			s.record(recs, name, core.EBMLMatcher, v)
+		}
+		s.stop(name, core.EBMLMatcher, t)
 	}
-	sat, hints = satisfied(core.ByteMatcher, recs)
+	sat, _ = s.satisfied(core.BMFFMatcher, recs)
+	// BMFF Matcher
+	if s.fm != nil && !sat {
+		t := s.start(name, core.BMFFMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START BMFF MATCHER")
+		}
+		fms, _ := s.fm.Identify("", buffer)
+		for v := range fms {
+			s.record(recs, name, core.BMFFMatcher, v)
+		}
+		s.stop(name, core.BMFFMatcher, t)
+	}
+	sat, hints = s.satisfied(core.ByteMatcher, recs)
 	// Byte Matcher
 	if s.bm != nil && !sat {
+		t := s.start(name, core.ByteMatcher)
 		if config.Debug() {
 			fmt.Fprintln(config.Out(), ">>START BYTE MATCHER")
 		}
-		ids, _ := s.bm.Identify("", buffer, hints...) // we don't care about an error here
+		ids, _ := s.bm.Identify(name, buffer, hints...) // we don't care about an error here
 		for v := range ids {
-			for _, rec := range recs {
-				if rec.Record(core.ByteMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.ByteMatcher, v)
 		}
+		s.stop(name, core.ByteMatcher, t)
 	}
-	sat, _ = satisfied(core.TextMatcher, recs)
+	sat, _ = s.satisfied(core.DataMatcher, recs)
+	// Data Matcher
+	if s.dm != nil && !sat {
+		t := s.start(name, core.DataMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START DATA MATCHER")
+		}
+		dms, _ := s.dm.Identify("", buffer)
+		for v := range dms {
+			s.record(recs, name, core.DataMatcher, v)
+		}
+		s.stop(name, core.DataMatcher, t)
+	}
+	sat, _ = s.satisfied(core.MPEGMatcher, recs)
+	// MPEG Matcher
+	if s.am != nil && !sat {
+		t := s.start(name, core.MPEGMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START MPEG MATCHER")
+		}
+		ams, _ := s.am.Identify("", buffer)
+		for v := range ams {
+			s.record(recs, name, core.MPEGMatcher, v)
+		}
+		s.stop(name, core.MPEGMatcher, t)
+	}
+	sat, _ = s.satisfied(core.StructMatcher, recs)
+	// Struct Matcher
+	if s.sm != nil && !sat {
+		t := s.start(name, core.StructMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START STRUCT MATCHER")
+		}
+		sms, _ := s.sm.Identify("", buffer)
+		for v := range sms {
+			s.record(recs, name, core.StructMatcher, v)
+		}
+		s.stop(name, core.StructMatcher, t)
+	}
+	sat, _ = s.satisfied(core.TextMatcher, recs)
 	// Text Matcher
 	if s.tm != nil && !sat {
+		t := s.start(name, core.TextMatcher)
 		ids, _ := s.tm.Identify("", buffer) // we don't care about an error here
 		for v := range ids {
-			for _, rec := range recs {
-				if rec.Record(core.TextMatcher, v) {
-					break
-				}
-			}
+			s.record(recs, name, core.TextMatcher, v)
 		}
+		s.stop(name, core.TextMatcher, t)
 	}
-	if len(recs) < 2 {
-		return recs[0].Report(), err
+	// External Matchers
+	if core.HasExternal() {
+		t := s.start(name, core.ExternalMatcher)
+		if config.Debug() {
+			fmt.Fprintln(config.Out(), ">>START EXTERNAL MATCHERS")
+		}
+		hits, eerr := core.IdentifyExternal(name, siegreader.ReaderFrom(buffer), buffer.SizeNow())
+		for _, v := range hits {
+			s.record(recs, name, core.ExternalMatcher, v)
+		}
+		if err == nil {
+			err = eerr
+		}
+		s.stop(name, core.ExternalMatcher, t)
+	}
+	// Post-match refinement
+	if config.Refine() {
+		parse := refine.New(buffer)
+		for i, rec := range recs {
+			if r, ok := rec.(core.Refiner); ok && !s.skipped(i) {
+				r.Refine(parse)
+			}
+		}
 	}
 	var res []core.Identification
-	for idx, rec := range recs {
-		if config.Slow() || config.Debug() {
-			for _, id := range rec.Report() {
-				fmt.Fprintf(config.Out(), "matched: %s\n", id.String())
+	if len(recs) < 2 {
+		res = recs[0].Report()
+	} else {
+		for i, rec := range recs {
+			if s.skipped(i) {
+				continue
 			}
+			ids := rec.Report()
+			if len(recs) > 1 && (config.Slow() || config.Debug()) {
+				for _, id := range ids {
+					fmt.Fprintf(config.Out(), "matched: %s\n", id.String())
+				}
+			}
+			res = append(res, ids...)
 		}
-		if idx == 0 {
-			res = rec.Report()
-			continue
+	}
+	for _, a := range notes {
+		basis, warn := a.Annotation()
+		annotate(res, basis, warn)
+	}
+	// Post-identification analysis
+	if config.PDF() {
+		if info, ok := pdf.Analyze(buffer); ok {
+			annotate(res, info.Basis(), info.Warn())
+		}
+	}
+	if config.TextProfile() && plainText(res) {
+		if info, ok := textprofile.Analyze(buffer); ok {
+			annotate(res, info.Basis(), info.Warn())
+		}
+	}
+	if expired != nil && expired() {
+		annotate(res, nil, core.Warning{Code: core.WarnScanAbandoned, Msg: fmt.Sprintf("scan abandoned after %v (maxscantime); identification may be incomplete", config.MaxScanTime())})
+	}
+	if bof, eof := config.ScanWindows(); (bof > 0 || eof > 0) && s.bm != nil {
+		var lims []string
+		if sz := buffer.SizeNow(); bof > 0 && sz > int64(bof) {
+			lims = append(lims, fmt.Sprintf("first %d bytes (-bof)", bof))
+		}
+		if sz := buffer.SizeNow(); eof > 0 && sz > int64(eof) {
+			lims = append(lims, fmt.Sprintf("last %d bytes (-eof)", eof))
+		}
+		if len(lims) > 0 {
+			annotate(res, nil, core.Warning{Code: core.WarnScanLimited, Msg: "byte scan limited to the " + strings.Join(lims, " and ") + "; identification may be incomplete"})
 		}
-		res = append(res, rec.Report()...)
 	}
 	return res, err
 }
 
+// annotate adds extra basis and warning information to those identifications that support it.
+func annotate(res []core.Identification, basis []string, warn core.Warning) {
+	for i, id := range res {
+		if a, ok := id.(core.Annotator); ok {
+			res[i] = a.Annotate(basis, warn)
+		}
+	}
+}
+
+// plainText reports whether any of the identifications is for plain text.
+func plainText(res []core.Identification) bool {
+	for _, id := range res {
+		if s := id.String(); s == config.TextPuid() || s == config.TextMIME() {
+			return true
+		}
+	}
+	return false
+}
+
 // Identify identifies a stream or file object.
 // It takes an io.Reader and the name and mimetype of the file/stream (if unknown, give empty strings).
 // It returns a slice of identifications and an error.
@@ -456,20 +914,68 @@ func (s *Siegfried) Identify(r io.Reader, name, mime string) ([]core.Identificat
 	return s.IdentifyBuffer(buffer, err, name, mime)
 }
 
+// IdentifyAt identifies the region of r that starts at off and is l bytes long, such as a partition inside a disk image or an object inside a PDF stream.
+// Matchers treat the region as a complete file, so BOF and EOF signatures are anchored to its bounds.
+// The name and mimetype (if unknown, give empty strings) should describe the region.
+func (s *Siegfried) IdentifyAt(r io.ReaderAt, off, l int64, name, mime string) ([]core.Identification, error) {
+	return s.Identify(siegreader.Window(r, off, l), name, mime)
+}
+
+// IdentifyBytes identifies content that is already held in memory. Matchers slice b directly, without copying it,
+// so b must not be modified until IdentifyBytes returns.
+func (s *Siegfried) IdentifyBytes(b []byte, name, mime string) ([]core.Identification, error) {
+	return s.Identify(siegreader.FromBytes(b), name, mime)
+}
+
+// IdentifyReaderAt identifies the size bytes of r, reading them on demand rather than buffering them as a stream.
+func (s *Siegfried) IdentifyReaderAt(r io.ReaderAt, size int64, name, mime string) ([]core.Identification, error) {
+	return s.IdentifyAt(r, 0, size, name, mime)
+}
+
+// IdentifyURL identifies an HTTP(S) resource. Where the server supports range requests, only the parts of the resource
+// that matchers read are fetched (usually a little from the beginning and end); otherwise the resource is read as a stream.
+// The last element of the URL's path is used as the name. If client is nil, http.DefaultClient is used.
+func (s *Siegfried) IdentifyURL(client *http.Client, u string) ([]core.Identification, error) {
+	r, err := siegreader.OpenURL(client, u)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return s.Identify(r.Reader(), URLName(u), "")
+}
+
+// URLName returns the name used to identify a resource at URL u: the unescaped last element of its path.
+func URLName(u string) string {
+	p, err := url.Parse(u)
+	if err != nil || p.Path == "" || strings.HasSuffix(p.Path, "/") {
+		return ""
+	}
+	return path.Base(p.Path)
+}
+
 // Label takes the values of a core.Identification and returns a slice that pairs these values with the
-// relevant identifier's field labels.
+// relevant identifier's field labels. Use core.Map for the values keyed by label.
 func (s *Siegfried) Label(id core.Identification) [][2]string {
-	ret := make([][2]string, len(id.Values()))
-	for i, p := range s.Identifiers() {
-		if p[0] == id.Values()[0] {
-			for j, l := range s.Fields()[i] {
-				ret[j][0] = l
-				ret[j][1] = id.Values()[j]
+	values := id.Values()
+	var fields []string
+	if f, ok := id.(core.Fielder); ok {
+		fields = f.Fields()
+	} else {
+		for i, p := range s.Identifiers() {
+			if len(values) > 0 && p[0] == values[0] {
+				fields = s.Fields()[i]
+				break
 			}
-			return ret
 		}
 	}
-	return nil
+	if len(fields) != len(values) {
+		return nil
+	}
+	ret := make([][2]string, len(values))
+	for i, l := range fields {
+		ret[i] = [2]string{l, values[i]}
+	}
+	return ret
 }
 
 // Blame checks with the byte matcher to see what identification results subscribe to a particular result or test
@@ -566,6 +1072,26 @@ func (s *Siegfried) Inspect(t core.MatcherType) string {
 		if s.tm != nil {
 			return s.tm.String()
 		}
+	case core.MPEGMatcher:
+		if s.am != nil {
+			return s.am.String()
+		}
+	case core.EBMLMatcher:
+		if s.em != nil {
+			return s.em.String()
+		}
+	case core.BMFFMatcher:
+		if s.fm != nil {
+			return s.fm.String()
+		}
+	case core.StructMatcher:
+		if s.sm != nil {
+			return s.sm.String()
+		}
+	case core.DataMatcher:
+		if s.dm != nil {
+			return s.dm.String()
+		}
 	case core.XMLMatcher:
 		if s.xm != nil {
 			return s.xm.String()
@@ -582,3 +1108,248 @@ func (s *Siegfried) Inspect(t core.MatcherType) string {
 	}
 	return "matcher not present in this signature"
 }
+
+// Tune reports on the Aho-Corasick automata built to match the BOF and EOF sequences of byte and container signatures,
+// estimating the memory used by the default dense layout and the sparse layout chosen with config.SetLowMem.
+func (s *Siegfried) Tune() string {
+	buf := &bytes.Buffer{}
+	if bm, ok := s.bm.(*bytematcher.Matcher); ok {
+		bof, eof := bm.Automata()
+		fmt.Fprintf(buf, "byte signatures BOF: %s\nbyte signatures EOF: %s\n", bof, eof)
+	}
+	if cm, ok := s.cm.(containermatcher.Matcher); ok {
+		bof, eof := cm.Automata()
+		fmt.Fprintf(buf, "container signatures BOF: %s\ncontainer signatures EOF: %s\n", bof, eof)
+	}
+	if buf.Len() == 0 {
+		return "no byte or container signatures"
+	}
+	return buf.String()
+}
+
+// describer is implemented by identifiers that can report the information recorded for a format in a signature file.
+type describer interface {
+	Describe(string) ([][2]string, bool)
+	Hit(core.MatcherType, int) (bool, string)
+	Lookup(core.MatcherType, []string) []int
+	Start(core.MatcherType) int
+	IDs(core.MatcherType) []string
+}
+
+// Info returns what the signature file knows about a format: name, version, MIME-type, extensions, number of signatures and priority relations.
+// The id can be from any identifier (e.g. a PUID, MIME-type or FDD ID). If no identifier has a format with that id, the id is treated as a MIME-type
+// and all formats that declare it are described.
+func (s *Siegfried) Info(id string) (string, error) {
+	return s.info(id, false)
+}
+
+// InspectFormat reports what Info does and adds the compiled byte signatures of the format: the key frames (sequences, offsets and ranges)
+// that the byte matcher looks for. It can be used to check why a format isn't matching without rebuilding the signature file with roy.
+func (s *Siegfried) InspectFormat(id string) (string, error) {
+	return s.info(id, true)
+}
+
+func (s *Siegfried) info(id string, sigs bool) (string, error) {
+	buf := &bytes.Buffer{}
+	for _, i := range s.ids {
+		if d, ok := i.(describer); ok {
+			s.describe(buf, i.Name(), id, d, sigs)
+		}
+	}
+	if buf.Len() == 0 && s.mm != nil {
+		seen := make(map[string]bool)
+		mms, _ := s.mm.Identify(id, nil)
+		for r := range mms {
+			for _, i := range s.ids {
+				d, ok := i.(describer)
+				if !ok {
+					continue
+				}
+				if ok, fid := d.Hit(core.MIMEMatcher, r.Index()); ok && !seen[i.Name()+fid] {
+					seen[i.Name()+fid] = true
+					s.describe(buf, i.Name(), fid, d, sigs)
+					break
+				}
+			}
+		}
+	}
+	if buf.Len() == 0 {
+		return "", fmt.Errorf("siegfried: can't find %s in this signature file", id)
+	}
+	return buf.String(), nil
+}
+
+func (s *Siegfried) describe(w io.Writer, ns, id string, d describer, sigs bool) {
+	fields, ok := d.Describe(id)
+	if !ok {
+		return
+	}
+	fmt.Fprintf(w, "---\nnamespace    : %s\nid           : %s\n", ns, id)
+	for _, f := range fields {
+		fmt.Fprintf(w, "%-12s : %s\n", f[0], f[1])
+	}
+	var exts []string
+	if nm, ok := s.nm.(*namematcher.Matcher); ok {
+		for _, idx := range d.Lookup(core.NameMatcher, []string{id}) {
+			exts = append(exts, nm.Patterns(idx)...)
+		}
+	}
+	fmt.Fprintf(w, "extensions   : %s\n", strings.Join(exts, ", "))
+	counts := make([]string, 0, 12)
+	for _, mt := range []struct {
+		typ  core.MatcherType
+		name string
+	}{
+		{core.ByteMatcher, "byte"},
+		{core.ContainerMatcher, "container"},
+		{core.XMLMatcher, "xml"},
+		{core.RIFFMatcher, "riff"},
+		{core.MPEGMatcher, "mpeg"},
+		{core.EBMLMatcher, "ebml"},
+		{core.BMFFMatcher, "bmff"},
+		{core.StructMatcher, "struct"},
+		{core.DataMatcher, "data"},
+		{core.TextMatcher, "text"},
+		{core.NameMatcher, "filename"},
+		{core.MIMEMatcher, "mime"},
+	} {
+		if l := len(d.Lookup(mt.typ, []string{id})); l > 0 {
+			counts = append(counts, fmt.Sprintf("%s %d", mt.name, l))
+		}
+	}
+	fmt.Fprintf(w, "signatures   : %s\n", strings.Join(counts, ", "))
+	var sups, subs []string
+	if bm, ok := s.bm.(*bytematcher.Matcher); ok {
+		sups = superiors(bm, d, id)
+		subSeen := make(map[string]bool)
+		start := d.Start(core.ByteMatcher)
+		for j, sid := range d.IDs(core.ByteMatcher) {
+			if sid == id || subSeen[sid] {
+				continue
+			}
+			for _, sup := range bm.Superiors(start + j) {
+				if ok, hid := d.Hit(core.ByteMatcher, sup); ok && hid == id {
+					subSeen[sid] = true
+					subs = append(subs, sid)
+					break
+				}
+			}
+		}
+		sort.Strings(subs)
+	}
+	fmt.Fprintf(w, "superiors    : %s\nsubordinates : %s\n", strings.Join(sups, ", "), strings.Join(subs, ", "))
+	if !sigs {
+		return
+	}
+	if bm, ok := s.bm.(*bytematcher.Matcher); ok {
+		fmt.Fprint(w, "byte signatures :\n")
+		for _, idx := range d.Lookup(core.ByteMatcher, []string{id}) {
+			fmt.Fprintf(w, "  - index %d\n", idx)
+			for _, kf := range bm.DescribeSignature(idx) {
+				fmt.Fprintf(w, "    %s\n", kf)
+			}
+		}
+	}
+}
+
+// superiors returns the sorted ids of the formats that take priority over the byte signatures of a format
+func superiors(bm *bytematcher.Matcher, d describer, id string) []string {
+	var sups []string
+	seen := make(map[string]bool)
+	for _, idx := range d.Lookup(core.ByteMatcher, []string{id}) {
+		for _, sup := range bm.Superiors(idx) {
+			if ok, sid := d.Hit(core.ByteMatcher, sup); ok && !seen[sid] && sid != id {
+				seen[sid] = true
+				sups = append(sups, sid)
+			}
+		}
+	}
+	sort.Strings(sups)
+	return sups
+}
+
+// Priorities lists, for each identifier, the formats with byte signatures that have priority relations and the formats that take priority over them.
+func (s *Siegfried) Priorities() string {
+	bm, ok := s.bm.(*bytematcher.Matcher)
+	if !ok {
+		return "no byte signatures"
+	}
+	buf := &bytes.Buffer{}
+	for _, i := range s.ids {
+		d, ok := i.(describer)
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(buf, "---\nnamespace : %s\npriorities :\n", i.Name())
+		seen := make(map[string]bool)
+		for _, id := range d.IDs(core.ByteMatcher) {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if sups := superiors(bm, d, id); len(sups) > 0 {
+				fmt.Fprintf(buf, "  %s : %s\n", id, strings.Join(sups, ", "))
+			}
+		}
+	}
+	return buf.String()
+}
+
+// Signed lists, for each identifier (keyed by name), the sorted ids of the formats with signatures other than filename and MIME-type patterns:
+// the formats that can be identified by their contents.
+func (s *Siegfried) Signed() map[string][]string {
+	ret := make(map[string][]string)
+	for i, v := range s.ids {
+		d, ok := v.(describer)
+		if !ok || s.skipped(i) {
+			continue
+		}
+		seen := make(map[string]bool)
+		var ids []string
+		for _, mt := range []core.MatcherType{core.ByteMatcher, core.ContainerMatcher, core.XMLMatcher, core.RIFFMatcher, core.MPEGMatcher, core.EBMLMatcher, core.BMFFMatcher, core.StructMatcher, core.DataMatcher, core.TextMatcher} {
+			for _, id := range d.IDs(mt) {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		sort.Strings(ids)
+		ret[v.Name()] = ids
+	}
+	return ret
+}
+
+// Explain runs only the byte signatures of a format against a stream or file and reports how each fared: where the sequences and frames
+// searched for each segment were found, whether those hits passed their offset, left and right tests, and whether the segments combined
+// into a match. Priorities aren't considered, so this reports matches that Identify would discard in favour of a superior format.
+func (s *Siegfried) Explain(id string, r io.Reader) (string, error) {
+	bm, ok := s.bm.(*bytematcher.Matcher)
+	if !ok {
+		return "", fmt.Errorf("siegfried: this signature file has no byte signatures")
+	}
+	buffer, err := s.Buffer(r)
+	defer s.buffers.Put(buffer)
+	if err != nil && err != siegreader.ErrEmpty {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	for _, i := range s.ids {
+		d, ok := i.(describer)
+		if !ok {
+			continue
+		}
+		idxs := d.Lookup(core.ByteMatcher, []string{id})
+		if len(idxs) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "---\nnamespace : %s\nid        : %s\n", i.Name(), id)
+		for _, l := range bm.Explain(buffer, idxs) {
+			fmt.Fprintln(buf, l)
+		}
+	}
+	if buf.Len() == 0 {
+		return "", fmt.Errorf("siegfried: can't find byte signatures for %s in this signature file", id)
+	}
+	return buf.String(), nil
+}
@@ -2,7 +2,14 @@ package siegfried
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/richardlehane/siegfried/internal/persist"
 	"github.com/richardlehane/siegfried/internal/siegreader"
@@ -24,6 +31,464 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestSigCompatible(t *testing.T) {
+	v := config.Version()
+	if err := sigCompatible(v[0], v[1]); err != nil {
+		t.Errorf("expecting a signature file built by this version to load, got %v", err)
+	}
+	if err := sigCompatible(v[0], v[1]+1); err != nil {
+		t.Errorf("expecting a signature file built by a later minor version to load, got %v", err)
+	}
+	if err := sigCompatible(1, 8); err == nil || !strings.Contains(err.Error(), "built with roy v1.8") {
+		t.Errorf("expecting an error naming the version of roy that built the signature file, got %v", err)
+	}
+	if err := sigCompatible(v[0]+1, 0); err == nil || !strings.Contains(err.Error(), "upgrade sf") {
+		t.Errorf("expecting an error asking for sf to be upgraded, got %v", err)
+	}
+	f, err := ioutil.TempFile("", "old*.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.Write(append(config.Magic(), 1, 8))
+	f.Close()
+	if _, err := Load(f.Name()); err == nil || !strings.Contains(err.Error(), "built with roy v1.8") {
+		t.Errorf("expecting the v1.8 signature file to be rejected, got %v", err)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	info, err := s.Info("fmt/61")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(info, "id           : fmt/61\n") || !strings.Contains(info, "*.xls") {
+		t.Errorf("bad info for fmt/61, got %s", info)
+	}
+	if _, err = s.Info("application/vnd.ms-excel"); err != nil {
+		t.Errorf("expecting MIME-type lookup to succeed, got %v", err)
+	}
+	if _, err = s.Info("bogus"); err == nil {
+		t.Error("expecting an error for an unknown format")
+	}
+}
+
+func TestInspectFormat(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	info, err := s.InspectFormat("fmt/18")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(info, "BOF sequence 255044462D312E34") || !strings.Contains(info, "EOF sequence 2525454F46") {
+		t.Errorf("expecting the compiled byte signature of fmt/18 (%%PDF-1.4 ... %%%%EOF), got %s", info)
+	}
+	if !strings.Contains(s.Priorities(), "  fmt/18 : fmt/144,") {
+		t.Error("expecting priorities to list the formats superior to fmt/18")
+	}
+}
+
+func TestPriorityGraph(t *testing.T) {
+	s, err := Load("./cmd/roy/data/default.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var byt, cont bool
+	for _, e := range s.PriorityEdges() {
+		switch {
+		case e.Matcher == "byte" && e.Subordinate == "fmt/18" && e.Superior == "fmt/144":
+			byt = true
+		case e.Matcher == "container" && e.Subordinate == "fmt/412" && e.Superior == "fmt/597":
+			cont = true
+		}
+	}
+	if !byt || !cont {
+		t.Errorf("expecting byte (fmt/18 < fmt/144) and container (fmt/412 < fmt/597) priorities, got byte %v, container %v", byt, cont)
+	}
+	dot, err := s.PriorityGraph("dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(dot, `"pronom fmt/412" -> "pronom fmt/597" [style=dashed]`) {
+		t.Errorf("expecting a dashed container edge in the dot graph")
+	}
+	if _, err := s.PriorityGraph("svg"); err == nil {
+		t.Error("expecting an error for an unknown graph format")
+	}
+}
+
+// testTracer counts the callbacks made for each matcher
+type testTracer struct {
+	mu                                   sync.Mutex
+	starts, stops, strikes, kfs, results map[core.MatcherType]int
+}
+
+func (tt *testTracer) count(m map[core.MatcherType]int, mt core.MatcherType) {
+	tt.mu.Lock()
+	m[mt]++
+	tt.mu.Unlock()
+}
+
+func (tt *testTracer) Start(name string, mt core.MatcherType) { tt.count(tt.starts, mt) }
+func (tt *testTracer) Stop(name string, mt core.MatcherType)  { tt.count(tt.stops, mt) }
+func (tt *testTracer) Strike(name string, mt core.MatcherType, s core.Strike) {
+	tt.count(tt.strikes, mt)
+}
+func (tt *testTracer) KeyFrame(name string, mt core.MatcherType, k core.KeyFrame) {
+	tt.count(tt.kfs, mt)
+}
+func (tt *testTracer) Result(name string, mt core.MatcherType, r core.Result) {
+	tt.count(tt.results, mt)
+}
+
+func TestTracer(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	tt := &testTracer{
+		starts:  make(map[core.MatcherType]int),
+		stops:   make(map[core.MatcherType]int),
+		strikes: make(map[core.MatcherType]int),
+		kfs:     make(map[core.MatcherType]int),
+		results: make(map[core.MatcherType]int),
+	}
+	s.SetTracer(tt)
+	if _, err = s.IdentifyBytes([]byte("%PDF-1.4\n%%EOF"), "test.pdf", ""); err != nil {
+		t.Fatal(err)
+	}
+	if tt.starts[core.NameMatcher] != 1 || tt.starts[core.ByteMatcher] != 1 || tt.stops[core.ByteMatcher] != 1 {
+		t.Errorf("expecting the name and byte matchers to start and stop, got %v and %v", tt.starts, tt.stops)
+	}
+	if tt.strikes[core.ByteMatcher] == 0 || tt.kfs[core.ByteMatcher] == 0 || tt.results[core.ByteMatcher] == 0 {
+		t.Errorf("expecting strikes, key frames and results from the byte matcher, got %v, %v and %v", tt.strikes, tt.kfs, tt.results)
+	}
+}
+
+func TestDisable(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Disable("bogus", core.NameMatcher); err == nil {
+		t.Error("expecting an error disabling a matcher for a missing identifier")
+	}
+	ids, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "test.doc", "")
+	if len(ids) != 1 || !strings.Contains(ids[0].Warn(), "extension mismatch") {
+		t.Fatalf("expecting an extension mismatch, got %v", ids)
+	}
+	if err = s.Disable("pronom", core.NameMatcher); err != nil {
+		t.Fatal(err)
+	}
+	ids, _ = s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "test.doc", "")
+	if len(ids) != 1 || strings.Contains(ids[0].Warn(), "extension") {
+		t.Errorf("expecting name matcher to be disabled, got %v", ids)
+	}
+}
+
+func TestDisableIdentifier(t *testing.T) {
+	s, err := Load("./cmd/roy/data/deluxe.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ns := range []string{"pronom", "loc"} {
+		if err = s.DisableIdentifier(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = s.DisableIdentifier("bogus"); err == nil {
+		t.Error("expecting an error disabling a missing identifier")
+	}
+	if ids := s.Identifiers(); len(ids) != 2 || ids[0][0] != "tika" || len(s.Fields()) != 2 {
+		t.Errorf("expecting tika and freedesktop.org identifiers, got %v", ids)
+	}
+	if s.SinglePRONOM() {
+		t.Error("expecting mimeinfo identifiers not to be taken for PRONOM")
+	}
+	ids, _ := s.Identify(strings.NewReader("%PDF-1.4\n%%EOF\n"), "test.pdf", "")
+	if len(ids) != 2 || ids[0].Values()[0] != "tika" || ids[1].Values()[0] != "freedesktop.org" {
+		t.Errorf("expecting pronom and loc results to be left out, got %v", ids)
+	}
+	if err = s.DisableIdentifier("tika"); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.DisableIdentifier("freedesktop.org"); err == nil {
+		t.Error("expecting an error disabling the last identifier")
+	}
+}
+
+func TestLimit(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	const pdf = "%PDF-1.4\n%%EOF\n"
+	if err = s.Limit([]string{"fmt/11"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ := s.Identify(strings.NewReader(pdf), "", ""); len(ids) != 1 || ids[0].String() == "fmt/18" {
+		t.Errorf("expecting PDF signatures to be ignored, got %v", ids)
+	}
+	if err = s.Limit([]string{"fmt/11", "fmt/18"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ := s.Identify(strings.NewReader(pdf), "", ""); len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18, got %v", ids)
+	}
+	if err = s.Limit([]string{"bogus"}); err == nil {
+		t.Error("expecting an error for a limit without byte signatures")
+	}
+	s.Limit(nil)
+	if ids, _ := s.Identify(strings.NewReader(pdf), "", ""); len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18 without a limit, got %v", ids)
+	}
+}
+
+func TestReproducible(t *testing.T) {
+	config.SetHome("./cmd/roy/data")
+	config.SetBuildTime(time.Date(2020, 1, 21, 0, 0, 0, 0, time.UTC))
+	defer config.SetBuildTime(time.Time{})
+	var sigs [2][]byte
+	for i := range sigs {
+		s := New()
+		p, err := pronom.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Add(p); err != nil {
+			t.Fatal(err)
+		}
+		buf := &bytes.Buffer{}
+		if err = s.SaveWriter(buf); err != nil {
+			t.Fatal(err)
+		}
+		sigs[i] = buf.Bytes()
+	}
+	if !bytes.Equal(sigs[0], sigs[1]) {
+		t.Error("expecting identical signature files from identical builds")
+	}
+}
+
+func TestIdentifyAt(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	img := strings.NewReader("01234567%PDF-1.4\n%%EOF\n89abcdef")
+	ids, err := s.IdentifyAt(img, 8, 15, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting the embedded PDF to be identified as fmt/18, got %v", ids)
+	}
+	if ids, _ = s.Identify(strings.NewReader("01234567%PDF-1.4\n%%EOF\n89abcdef"), "", ""); len(ids) != 1 || ids[0].String() == "fmt/18" {
+		t.Errorf("expecting the whole image not to be identified as a PDF, got %v", ids)
+	}
+}
+
+func TestIdentifyBytes(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	ids, err := s.IdentifyBytes([]byte("%PDF-1.4\n%%EOF\n"), "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18, got %v %v", ids, err)
+	}
+	ids, err = s.IdentifyReaderAt(strings.NewReader("%PDF-1.4\n%%EOF\n"), 15, "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18, got %v %v", ids, err)
+	}
+}
+
+func TestScanWindows(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	if !s.SinglePRONOM() {
+		t.Error("expecting a single PRONOM identifier")
+	}
+	pdf := []byte("%PDF-1.4\n" + strings.Repeat(" ", 64) + "\n%%EOF\n")
+	config.SetScanWindows(0, 4)
+	defer config.SetScanWindows(0, 0)
+	ids, err := s.IdentifyBytes(pdf, "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() == "fmt/18" || !strings.Contains(ids[0].Warn(), "-eof") || !hasCode(ids, core.WarnScanLimited) {
+		t.Errorf("expecting the EOF window to prevent a match and give a warning, got %v %v", ids, err)
+	}
+	config.SetScanWindows(0, 0)
+	ids, err = s.IdentifyBytes(pdf, "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" || strings.Contains(ids[0].Warn(), "-eof") {
+		t.Errorf("expecting fmt/18 without a warning, got %v %v", ids, err)
+	}
+}
+
+func TestWarnCodes(t *testing.T) {
+	s, err := Load("./cmd/roy/data/deluxe.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdf := "%PDF-1.4\n%%EOF\n"
+	for _, c := range []struct {
+		content, name, mime string
+		code                core.WarningCode
+	}{
+		{"\x01\x02", "test.zzz", "", core.WarnNoMatch},
+		{"hello world\n", "test.txt", "", core.WarnWeakMatch},
+		{"\x01\x02", "test.pdf", "", core.WarnSignatureMismatch}, // the mimeinfo identifiers
+		{pdf, "test.doc", "", core.WarnExtMismatch},
+		{pdf, "test.pdf", "image/png", core.WarnMIMEMismatch},
+	} {
+		ids, _ := s.Identify(strings.NewReader(c.content), c.name, c.mime)
+		if !hasCode(ids, c.code) {
+			t.Errorf("%s: expecting a %s warning, got %v", c.name, c.code, ids)
+		}
+	}
+	config.SetMaxScanTime(time.Nanosecond)
+	defer config.SetMaxScanTime(0)
+	ids, _ := s.Identify(strings.NewReader(strings.Repeat("x", 1<<20)), "", "")
+	if !hasCode(ids, core.WarnScanAbandoned) {
+		t.Errorf("expecting a %s warning, got %v", core.WarnScanAbandoned, ids)
+	}
+}
+
+func hasCode(ids []core.Identification, code core.WarningCode) bool {
+	for _, id := range ids {
+		for _, c := range core.WarnCodes(id) {
+			if c == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestFastExt(t *testing.T) {
+	s := New()
+	config.SetHome("./cmd/roy/data")
+	p, err := pronom.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Add(p); err != nil {
+		t.Fatal(err)
+	}
+	wav, err := ioutil.ReadFile("./cmd/sf/testdata/skeleton-suite/fmt/fmt-1-signature-id-1032.wav")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := s.IdentifyBytes(wav, "test.wav", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/1" {
+		t.Errorf("expecting fmt/1, got %v %v", ids, err)
+	}
+	config.SetFastExt(true)
+	defer config.SetFastExt(false)
+	ids, err = s.IdentifyBytes(wav, "test.wav", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/6" {
+		t.Errorf("expecting the first KB match for the extension, fmt/6, got %v %v", ids, err)
+	}
+}
+
+func TestConcurrentIdentify(t *testing.T) {
+	s, err := Load("./cmd/roy/data/default.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	samples := []string{"%PDF-1.4\n%%EOF\n", "GIF89a\x01\x00;", "{\\rtf1\\ansi test}", "<?xml version=\"1.0\"?><a/>"}
+	want := make(map[string]string)
+	for _, k := range samples {
+		ids, err := s.IdentifyBytes([]byte(k), "", "")
+		if err != nil || len(ids) != 1 {
+			t.Fatalf("expecting one identification, got %v %v", ids, err)
+		}
+		want[k] = ids[0].String()
+	}
+	var wg sync.WaitGroup
+	errs := make(chan string, 8*len(samples))
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k, v := range want {
+				ids, err := s.Identify(strings.NewReader(k), "", "")
+				if err != nil || len(ids) != 1 || ids[0].String() != v {
+					errs <- fmt.Sprintf("expecting %s, got %v %v", v, ids, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}
+
+func TestBufferPool(t *testing.T) {
+	s, err := Load("./cmd/roy/data/default.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewBufferPool(4)
+	s.SetBufferPool(p)
+	buf, err := p.Get(strings.NewReader("%PDF-1.4\n%%EOF\n"))
+	ids, err := s.IdentifyBuffer(buf, err, "", "")
+	p.Put(buf)
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18, got %v %v", ids, err)
+	}
+	if s.BufferPool().buffers != p.buffers {
+		t.Error("expecting the Siegfried to use the pool that was set")
+	}
+}
+
 func TestIdentify(t *testing.T) {
 	s := New()
 	s.nm = testEMatcher{}
@@ -40,15 +505,87 @@ func TestIdentify(t *testing.T) {
 	}
 }
 
+// external matcher test stub: identifies content that begins with the prefix, followed by a format ID
+
+type testExternal string
+
+func (t testExternal) Name() string { return "test" }
+
+func (t testExternal) Identify(name string, r io.ReaderAt, size int64) ([]core.ExternalResult, error) {
+	buf := make([]byte, 32)
+	n, _ := r.ReadAt(buf, 0)
+	if !bytes.HasPrefix(buf[:n], []byte(t)) {
+		return nil, nil
+	}
+	return []core.ExternalResult{{ID: string(buf[len(t):n]), Detail: string(t)}}, nil
+}
+
+func TestExternal(t *testing.T) {
+	s, err := Load("./cmd/roy/data/default.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	core.RegisterExternal(testExternal("\x00TENTATIVE:"), core.Tentative)
+	core.RegisterExternal(testExternal("\x00DEFINITE:"), core.Definite)
+	ids, err := s.Identify(strings.NewReader("\x00DEFINITE:fmt/18"), "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" || ids[0].Warn() != "" {
+		t.Errorf("expecting a definite match on fmt/18, got %v (%v)", ids, err)
+	}
+	if len(ids) == 1 && !strings.Contains(ids[0].Values()[5], "test match") {
+		t.Errorf("expecting the external matcher in the basis, got %s", ids[0].Values()[5])
+	}
+	// tentative matches are ruled out when the format's byte signatures don't match
+	ids, _ = s.Identify(strings.NewReader("\x00TENTATIVE:fmt/18"), "", "")
+	if len(ids) != 1 || ids[0].Known() || !strings.Contains(ids[0].Warn(), "external matcher are fmt/18") {
+		t.Errorf("expecting fmt/18 as a possibility, got %v", ids)
+	}
+	// IDs that the identifier doesn't know are ignored
+	ids, _ = s.Identify(strings.NewReader("\x00DEFINITE:bogus"), "", "")
+	if len(ids) != 1 || ids[0].Known() {
+		t.Errorf("expecting no match, got %v", ids)
+	}
+}
+
+func TestDetectMIME(t *testing.T) {
+	s, err := Load("./cmd/roy/data/pronom-tika-loc.sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []struct{ name, content, mime string }{
+		{"", "%PDF-1.4\n%%EOF\n", "application/pdf"},
+		{"test.txt", "%PDF-1.4\n%%EOF\n", "application/pdf"},
+		{"test.txt", "hello world\n", "text/plain"},
+		{"", "\x00\x01\x02\x03", DefaultMIME},
+	} {
+		mime, err := s.DetectMIME(v.name, strings.NewReader(v.content))
+		if err != nil || mime != v.mime {
+			t.Errorf("expecting %s for %q, got %s (%v)", v.mime, v.content, mime, err)
+		}
+	}
+}
+
 func TestLabel(t *testing.T) {
 	s := &Siegfried{ids: []core.Identifier{testIdentifier{}}}
-	res := s.Label(testIdentification{})
-	if len(res) != 2 ||
-		res[0][0] != "namespace" ||
-		res[0][1] != "a" ||
-		res[1][0] != "id" ||
-		res[1][1] != "fmt/3" {
-		t.Errorf("bad label, got %v", res)
+	// labels come from the identification if it reports them, or else from its identifier
+	for _, id := range []core.Identification{testFielder{}, testIdentification{}} {
+		res := s.Label(id)
+		if len(res) != 2 ||
+			res[0][0] != "namespace" ||
+			res[0][1] != "a" ||
+			res[1][0] != "id" ||
+			res[1][1] != "fmt/3" {
+			t.Errorf("bad label, got %v", res)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	m := core.Map(testFielder{})
+	if len(m) != 2 || m["namespace"] != "a" || m["id"] != "fmt/3" {
+		t.Errorf("bad map, got %v", m)
+	}
+	if m = core.Map(testIdentification{}); m != nil {
+		t.Errorf("expecting no map for an identification without labels, got %v", m)
 	}
 }
 
@@ -123,3 +660,7 @@ func (t testIdentification) Warn() string            { return "" }
 func (t testIdentification) Known() bool             { return true }
 func (t testIdentification) Values() []string        { return []string{"a", "fmt/3"} }
 func (t testIdentification) Archive() config.Archive { return 0 }
+
+type testFielder struct{ testIdentification }
+
+func (t testFielder) Fields() []string { return []string{"namespace", "id"} }
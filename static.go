@@ -0,0 +1,40 @@
+// Copyright 2026 Richard Lehane. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build static
+
+package siegfried
+
+import (
+	"fmt"
+
+	"github.com/richardlehane/siegfried/pkg/static"
+)
+
+// NewEmbedded loads the default (PRONOM) signature file embedded in programs built with the static tag
+// e.g. `go build -tags static`, so that no .sig file needs to be shipped or located.
+//
+// Example:
+//  s, err := siegfried.NewEmbedded()
+//  if err != nil {
+//  	log.Fatal(err)
+//  }
+//  ids, err := s.Identify(f, "example.pdf", "")
+func NewEmbedded() (*Siegfried, error) {
+	sig, err := static.Default()
+	if err != nil {
+		return nil, fmt.Errorf("siegfried: error decoding embedded signature file, got %v", err)
+	}
+	return loadSig(sig)
+}
@@ -0,0 +1,21 @@
+// +build static
+
+package siegfried
+
+import (
+	"testing"
+)
+
+func TestNewEmbedded(t *testing.T) {
+	s, err := NewEmbedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Identifiers()) != 1 || s.Identifiers()[0][0] != "pronom" {
+		t.Errorf("expecting the default pronom identifier, got %v", s.Identifiers())
+	}
+	ids, err := s.IdentifyBytes([]byte("%PDF-1.4\n%%EOF\n"), "", "")
+	if err != nil || len(ids) != 1 || ids[0].String() != "fmt/18" {
+		t.Errorf("expecting fmt/18, got %v %v", ids, err)
+	}
+}